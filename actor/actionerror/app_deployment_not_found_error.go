@@ -0,0 +1,10 @@
+package actionerror
+
+// AppDeploymentNotFoundError is returned when an app has never been deployed.
+type AppDeploymentNotFoundError struct {
+}
+
+// Error method to display the error message.
+func (e AppDeploymentNotFoundError) Error() string {
+	return "No deployments found for app."
+}
@@ -0,0 +1,10 @@
+package actionerror
+
+// DeploymentTimeoutError is returned when a deployment does not finish within
+// the startup timeout.
+type DeploymentTimeoutError struct {
+}
+
+func (e DeploymentTimeoutError) Error() string {
+	return "Timed out waiting for deployment to finish."
+}
@@ -0,0 +1,17 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginLockFileError is returned when a plugin lock file cannot be
+// parsed or a locked plugin is missing its name or version.
+type InvalidPluginLockFileError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidPluginLockFileError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("Plugin lock file %s is invalid: %s", e.Path, e.Err)
+	}
+	return fmt.Sprintf("Plugin lock file %s is invalid: every plugin requires a name and version", e.Path)
+}
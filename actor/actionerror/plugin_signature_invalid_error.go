@@ -0,0 +1,10 @@
+package actionerror
+
+// PluginSignatureInvalidError is returned when a plugin binary's signature is
+// malformed or was not made by any of the trusted plugin publisher keys.
+type PluginSignatureInvalidError struct {
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Plugin binary's signature could not be verified against any trusted key."
+}
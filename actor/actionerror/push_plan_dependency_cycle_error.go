@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PushPlanDependencyCycleError is returned when the apps being pushed depend
+// on each other, so no push order satisfies every dependency.
+type PushPlanDependencyCycleError struct {
+	AppNames []string
+}
+
+func (e PushPlanDependencyCycleError) Error() string {
+	return fmt.Sprintf("Apps %s depend on each other and cannot be ordered for push", strings.Join(e.AppNames, ", "))
+}
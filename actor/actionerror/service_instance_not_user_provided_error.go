@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceNotUserProvidedError is returned when a user-provided service
+// instance operation is attempted on a managed service instance.
+type ServiceInstanceNotUserProvidedError struct {
+	Name string
+}
+
+func (e ServiceInstanceNotUserProvidedError) Error() string {
+	return fmt.Sprintf("The service instance '%s' is not user-provided", e.Name)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// TaskFailedError is returned when a task finishes in the FAILED state.
+type TaskFailedError struct {
+	SequenceID    int
+	Name          string
+	FailureReason string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %d (%s) failed: %s", e.SequenceID, e.Name, e.FailureReason)
+}
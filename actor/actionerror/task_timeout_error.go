@@ -0,0 +1,18 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskTimeoutError is returned when a task does not finish within the timeout
+// and has been terminated.
+type TaskTimeoutError struct {
+	SequenceID int
+	Name       string
+	Timeout    time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Task %d (%s) did not finish within %s and was terminated", e.SequenceID, e.Name, e.Timeout)
+}
@@ -55,6 +55,22 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceWithLabelSelectorStub        func(string, string) ([]v3action.Application, v3action.Warnings, error)
+	getApplicationsBySpaceWithLabelSelectorMutex       sync.RWMutex
+	getApplicationsBySpaceWithLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationsBySpaceWithLabelSelectorReturns struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceWithLabelSelectorReturnsOnCall map[int]struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetOrganizationByNameStub        func(string) (v3action.Organization, v3action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -319,6 +335,73 @@ func (fake *FakeV3Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelector(arg1 string, arg2 string) ([]v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceWithLabelSelectorReturnsOnCall[len(fake.getApplicationsBySpaceWithLabelSelectorArgsForCall)]
+	fake.getApplicationsBySpaceWithLabelSelectorArgsForCall = append(fake.getApplicationsBySpaceWithLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationsBySpaceWithLabelSelector", []interface{}{arg1, arg2})
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.Unlock()
+	if fake.GetApplicationsBySpaceWithLabelSelectorStub != nil {
+		return fake.GetApplicationsBySpaceWithLabelSelectorStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceWithLabelSelectorReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelectorCallCount() int {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceWithLabelSelectorArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelectorCalls(stub func(string, string) ([]v3action.Application, v3action.Warnings, error)) {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceWithLabelSelectorStub = stub
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelectorArgsForCall(i int) (string, string) {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceWithLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelectorReturns(result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceWithLabelSelectorStub = nil
+	fake.getApplicationsBySpaceWithLabelSelectorReturns = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceWithLabelSelectorReturnsOnCall(i int, result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceWithLabelSelectorStub = nil
+	if fake.getApplicationsBySpaceWithLabelSelectorReturnsOnCall == nil {
+		fake.getApplicationsBySpaceWithLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceWithLabelSelectorReturnsOnCall[i] = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3Actor) GetOrganizationByName(arg1 string) (v3action.Organization, v3action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -593,6 +676,8 @@ func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationsByGUIDsMutex.RUnlock()
 	fake.getApplicationsBySpaceMutex.RLock()
 	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceWithLabelSelectorMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
 	fake.getOrganizationsByGUIDsMutex.RLock()
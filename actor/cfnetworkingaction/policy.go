@@ -16,6 +16,25 @@ type Policy struct {
 	EndPort              int
 }
 
+// AppSelector picks the apps on one side of a set of network policies: the
+// named app, the apps whose labels match the label selector or, when neither
+// is given, every app in the space.
+type AppSelector struct {
+	SpaceGUID     string
+	AppName       string
+	LabelSelector string
+}
+
+// NetworkPolicyPlan is the policies that applying a source and destination
+// selector would create and, when pruning, remove.
+type NetworkPolicyPlan struct {
+	Additions []Policy
+	Removals  []Policy
+
+	additions []cfnetv1.Policy
+	removals  []cfnetv1.Policy
+}
+
 func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -128,6 +147,204 @@ func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID,
 	return allWarnings, actionerror.PolicyDoesNotExistError{}
 }
 
+// PlanNetworkPolicies expands the selectors into a policy from every source
+// app to every other destination app on the protocol and ports, leaving out
+// the policies that already exist. When pruning, existing policies on the
+// same protocol and ports that involve a selected app, but which the
+// selectors no longer match, are planned for removal.
+func (actor Actor) PlanNetworkPolicies(src AppSelector, dest AppSelector, protocol string, startPort int, endPort int, prune bool) (NetworkPolicyPlan, Warnings, error) {
+	var allWarnings Warnings
+
+	srcApps, warnings, err := actor.selectApplications(src)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return NetworkPolicyPlan{}, allWarnings, err
+	}
+
+	destApps, warnings, err := actor.selectApplications(dest)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return NetworkPolicyPlan{}, allWarnings, err
+	}
+
+	srcScope, destScope := srcApps, destApps
+	if prune {
+		srcScope, warnings, err = actor.pruneScope(src, srcApps)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return NetworkPolicyPlan{}, allWarnings, err
+		}
+
+		destScope, warnings, err = actor.pruneScope(dest, destApps)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return NetworkPolicyPlan{}, allWarnings, err
+		}
+	}
+
+	appByGUID := map[string]v3action.Application{}
+	for _, apps := range [][]v3action.Application{srcScope, destScope, srcApps, destApps} {
+		for _, app := range apps {
+			appByGUID[app.GUID] = app
+		}
+	}
+
+	selectedGUIDs := uniqueAppGUIDs(append(append([]v3action.Application{}, srcApps...), destApps...))
+
+	var v1Policies []cfnetv1.Policy
+	if len(selectedGUIDs) > 0 {
+		v1Policies, err = actor.NetworkingClient.ListPolicies(selectedGUIDs...)
+		if err != nil {
+			return NetworkPolicyPlan{}, allWarnings, err
+		}
+	}
+
+	existing := map[cfnetv1.Policy]struct{}{}
+	for _, v1Policy := range v1Policies {
+		existing[v1Policy] = struct{}{}
+	}
+
+	var plan NetworkPolicyPlan
+	desired := map[cfnetv1.Policy]struct{}{}
+	for _, srcApp := range srcApps {
+		for _, destApp := range destApps {
+			if srcApp.GUID == destApp.GUID {
+				continue
+			}
+
+			v1Policy := newV1Policy(srcApp.GUID, destApp.GUID, protocol, startPort, endPort)
+			if _, ok := desired[v1Policy]; ok {
+				continue
+			}
+			desired[v1Policy] = struct{}{}
+
+			if _, ok := existing[v1Policy]; !ok {
+				plan.additions = append(plan.additions, v1Policy)
+				plan.Additions = append(plan.Additions, namedPolicy(v1Policy, appByGUID))
+			}
+		}
+	}
+
+	if prune {
+		srcSelected, destSelected := appGUIDSet(srcApps), appGUIDSet(destApps)
+		srcInScope, destInScope := appGUIDSet(srcScope), appGUIDSet(destScope)
+		ports := cfnetv1.Ports{Start: startPort, End: endPort}
+
+		// A policy between two selected apps is listed once for each app.
+		removed := map[cfnetv1.Policy]struct{}{}
+		for _, v1Policy := range v1Policies {
+			if _, ok := desired[v1Policy]; ok {
+				continue
+			}
+			if _, ok := removed[v1Policy]; ok {
+				continue
+			}
+			if string(v1Policy.Destination.Protocol) != protocol || v1Policy.Destination.Ports != ports {
+				continue
+			}
+			if !srcInScope[v1Policy.Source.ID] || !destInScope[v1Policy.Destination.ID] {
+				continue
+			}
+			if !srcSelected[v1Policy.Source.ID] && !destSelected[v1Policy.Destination.ID] {
+				continue
+			}
+
+			removed[v1Policy] = struct{}{}
+			plan.removals = append(plan.removals, v1Policy)
+			plan.Removals = append(plan.Removals, namedPolicy(v1Policy, appByGUID))
+		}
+	}
+
+	return plan, allWarnings, nil
+}
+
+// ApplyNetworkPolicyPlan creates and removes the policies in the plan.
+func (actor Actor) ApplyNetworkPolicyPlan(plan NetworkPolicyPlan) error {
+	if len(plan.additions) > 0 {
+		err := actor.NetworkingClient.CreatePolicies(plan.additions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(plan.removals) > 0 {
+		return actor.NetworkingClient.RemovePolicies(plan.removals)
+	}
+
+	return nil
+}
+
+func (actor Actor) selectApplications(selector AppSelector) ([]v3action.Application, v3action.Warnings, error) {
+	switch {
+	case selector.AppName != "":
+		app, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(selector.AppName, selector.SpaceGUID)
+		if err != nil {
+			return nil, warnings, err
+		}
+		return []v3action.Application{app}, warnings, nil
+	case selector.LabelSelector != "":
+		return actor.V3Actor.GetApplicationsBySpaceWithLabelSelector(selector.SpaceGUID, selector.LabelSelector)
+	default:
+		return actor.V3Actor.GetApplicationsBySpace(selector.SpaceGUID)
+	}
+}
+
+// pruneScope returns the apps whose policies pruning may remove: every app in
+// the space when selecting by label, since an app that no longer matches the
+// selector is exactly the one whose policies should go.
+func (actor Actor) pruneScope(selector AppSelector, selectedApps []v3action.Application) ([]v3action.Application, v3action.Warnings, error) {
+	if selector.LabelSelector == "" || selector.AppName != "" {
+		return selectedApps, nil, nil
+	}
+	return actor.V3Actor.GetApplicationsBySpace(selector.SpaceGUID)
+}
+
+func newV1Policy(srcAppGUID string, destAppGUID string, protocol string, startPort int, endPort int) cfnetv1.Policy {
+	return cfnetv1.Policy{
+		Source: cfnetv1.PolicySource{
+			ID: srcAppGUID,
+		},
+		Destination: cfnetv1.PolicyDestination{
+			ID:       destAppGUID,
+			Protocol: cfnetv1.PolicyProtocol(protocol),
+			Ports: cfnetv1.Ports{
+				Start: startPort,
+				End:   endPort,
+			},
+		},
+	}
+}
+
+func namedPolicy(v1Policy cfnetv1.Policy, appByGUID map[string]v3action.Application) Policy {
+	return Policy{
+		SourceName:      appByGUID[v1Policy.Source.ID].Name,
+		DestinationName: appByGUID[v1Policy.Destination.ID].Name,
+		Protocol:        string(v1Policy.Destination.Protocol),
+		StartPort:       v1Policy.Destination.Ports.Start,
+		EndPort:         v1Policy.Destination.Ports.End,
+	}
+}
+
+func appGUIDSet(applications []v3action.Application) map[string]bool {
+	guids := make(map[string]bool, len(applications))
+	for _, app := range applications {
+		guids[app.GUID] = true
+	}
+	return guids
+}
+
+func uniqueAppGUIDs(applications []v3action.Application) []string {
+	var appGUIDs []string
+	occurances := map[string]struct{}{}
+	for _, app := range applications {
+		if _, ok := occurances[app.GUID]; !ok {
+			appGUIDs = append(appGUIDs, app.GUID)
+			occurances[app.GUID] = struct{}{}
+		}
+	}
+	return appGUIDs
+}
+
 func filterPoliciesWithoutMatchingSourceGUIDs(v1Policies []cfnetv1.Policy, srcAppGUIDs []string) []cfnetv1.Policy {
 	srcGUIDsSet := map[string]struct{}{}
 	for _, srcGUID := range srcAppGUIDs {
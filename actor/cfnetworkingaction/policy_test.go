@@ -638,4 +638,202 @@ var _ = Describe("Policy", func() {
 			})
 		})
 	})
+
+	Describe("PlanNetworkPolicies", func() {
+		var (
+			src, dest AppSelector
+			prune     bool
+			plan      NetworkPolicyPlan
+		)
+
+		policy := func(srcGUID string, destGUID string, protocol string, port int) cfnetv1.Policy {
+			return cfnetv1.Policy{
+				Source: cfnetv1.PolicySource{ID: srcGUID},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       destGUID,
+					Protocol: cfnetv1.PolicyProtocol(protocol),
+					Ports:    cfnetv1.Ports{Start: port, End: port},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			src = AppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=web"}
+			dest = AppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=api"}
+			prune = false
+
+			web1 := v3action.Application{GUID: "web1-guid", Name: "web1"}
+			web2 := v3action.Application{GUID: "web2-guid", Name: "web2"}
+			api := v3action.Application{GUID: "api-guid", Name: "api"}
+			db := v3action.Application{GUID: "db-guid", Name: "db"}
+
+			fakeV3Actor.GetApplicationsBySpaceWithLabelSelectorStub = func(spaceGUID string, labelSelector string) ([]v3action.Application, v3action.Warnings, error) {
+				if labelSelector == "tier=web" {
+					return []v3action.Application{web1, web2}, v3action.Warnings{"web-warning"}, nil
+				}
+				return []v3action.Application{api}, v3action.Warnings{"api-warning"}, nil
+			}
+			fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{web1, web2, api, db}, v3action.Warnings{"space-warning"}, nil)
+
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				policy("web1-guid", "api-guid", "tcp", 8080),
+				policy("db-guid", "api-guid", "tcp", 8080),
+				policy("web1-guid", "db-guid", "tcp", 8080),
+				policy("web1-guid", "db-guid", "tcp", 8080),
+				policy("web2-guid", "api-guid", "udp", 9000),
+				policy("other-space-app-guid", "api-guid", "tcp", 8080),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.PlanNetworkPolicies(src, dest, "tcp", 8080, 8080, prune)
+		})
+
+		It("plans a policy from each matching source to each matching destination that does not exist yet", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("web-warning", "api-warning"))
+
+			_, srcLabelSelector := fakeV3Actor.GetApplicationsBySpaceWithLabelSelectorArgsForCall(0)
+			Expect(srcLabelSelector).To(Equal("tier=web"))
+			_, destLabelSelector := fakeV3Actor.GetApplicationsBySpaceWithLabelSelectorArgsForCall(1)
+			Expect(destLabelSelector).To(Equal("tier=api"))
+
+			Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("web1-guid", "web2-guid", "api-guid"))
+
+			Expect(plan.Additions).To(Equal([]Policy{
+				{SourceName: "web2", DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}))
+			Expect(plan.Removals).To(BeEmpty())
+			Expect(fakeV3Actor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+		})
+
+		When("pruning", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("also plans the removal of policies on the same protocol and ports the selectors no longer match", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("web-warning", "api-warning", "space-warning", "space-warning"))
+
+				Expect(plan.Additions).To(Equal([]Policy{
+					{SourceName: "web2", DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				}))
+				Expect(plan.Removals).To(Equal([]Policy{
+					{SourceName: "db", DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{SourceName: "web1", DestinationName: "db", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				}))
+			})
+		})
+
+		When("selecting every app in the space", func() {
+			BeforeEach(func() {
+				src = AppSelector{SpaceGUID: "some-space-guid"}
+				dest = AppSelector{SpaceGUID: "some-space-guid"}
+				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+					{GUID: "a-guid", Name: "a"},
+					{GUID: "b-guid", Name: "b"},
+				}, v3action.Warnings{"space-warning"}, nil)
+				fakeNetworkingClient.ListPoliciesReturns(nil, nil)
+			})
+
+			It("plans a policy between every pair of different apps", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(plan.Additions).To(Equal([]Policy{
+					{SourceName: "a", DestinationName: "b", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{SourceName: "b", DestinationName: "a", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				}))
+			})
+		})
+
+		When("selecting a named app", func() {
+			BeforeEach(func() {
+				src = AppSelector{SpaceGUID: "some-space-guid", AppName: "appA"}
+				fakeNetworkingClient.ListPoliciesReturns(nil, nil)
+			})
+
+			It("uses that app as the only source", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				Expect(plan.Additions).To(Equal([]Policy{
+					{DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				}))
+			})
+		})
+
+		When("looking up the apps fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationsBySpaceWithLabelSelectorStub = nil
+				fakeV3Actor.GetApplicationsBySpaceWithLabelSelectorReturns(nil, v3action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("some-list-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-list-error"))
+			})
+		})
+
+		Describe("ApplyNetworkPolicyPlan", func() {
+			var applyErr error
+
+			BeforeEach(func() {
+				prune = true
+			})
+
+			JustBeforeEach(func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				applyErr = actor.ApplyNetworkPolicyPlan(plan)
+			})
+
+			It("creates the planned additions and removes the planned removals", func() {
+				Expect(applyErr).NotTo(HaveOccurred())
+
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+					policy("web2-guid", "api-guid", "tcp", 8080),
+				}))
+
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+					policy("db-guid", "api-guid", "tcp", 8080),
+					policy("web1-guid", "db-guid", "tcp", 8080),
+				}))
+			})
+
+			When("creating the policies fails", func() {
+				BeforeEach(func() {
+					fakeNetworkingClient.CreatePoliciesReturns(errors.New("some-create-error"))
+				})
+
+				It("returns the error without removing policies", func() {
+					Expect(applyErr).To(MatchError("some-create-error"))
+					Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+				})
+			})
+
+			When("there is nothing to remove", func() {
+				BeforeEach(func() {
+					prune = false
+				})
+
+				It("only creates policies", func() {
+					Expect(applyErr).NotTo(HaveOccurred())
+					Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+					Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
 })
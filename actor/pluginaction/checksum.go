@@ -1,8 +1,66 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
 
+const (
+	SHA1ChecksumPrefix   = "sha1:"
+	SHA256ChecksumPrefix = "sha256:"
+	SHA512ChecksumPrefix = "sha512:"
+)
+
+// ValidateFileChecksum returns true if the file's checksum matches the
+// provided checksum. The checksum can be prefixed with its algorithm, one of
+// 'sha1:', 'sha256:' or 'sha512:'; otherwise the algorithm is inferred from
+// the length of the checksum.
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
-	plugin := configv3.Plugin{Location: path}
-	return plugin.CalculateSHA1() == checksum
+	fileHash, expected := checksumHash(checksum)
+	if fileHash == nil {
+		return false
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	if _, err = io.Copy(fileHash, file); err != nil {
+		return false
+	}
+
+	return hex.EncodeToString(fileHash.Sum(nil)) == strings.ToLower(expected)
+}
+
+// checksumHash returns the hash for the checksum's algorithm and the checksum
+// without its algorithm prefix. The hash is nil if the algorithm is unknown.
+func checksumHash(checksum string) (hash.Hash, string) {
+	lowerChecksum := strings.ToLower(checksum)
+	switch {
+	case strings.HasPrefix(lowerChecksum, SHA1ChecksumPrefix):
+		return sha1.New(), checksum[len(SHA1ChecksumPrefix):]
+	case strings.HasPrefix(lowerChecksum, SHA256ChecksumPrefix):
+		return sha256.New(), checksum[len(SHA256ChecksumPrefix):]
+	case strings.HasPrefix(lowerChecksum, SHA512ChecksumPrefix):
+		return sha512.New(), checksum[len(SHA512ChecksumPrefix):]
+	}
+
+	switch len(checksum) {
+	case hex.EncodedLen(sha1.Size):
+		return sha1.New(), checksum
+	case hex.EncodedLen(sha256.Size):
+		return sha256.New(), checksum
+	case hex.EncodedLen(sha512.Size):
+		return sha512.New(), checksum
+	default:
+		return nil, checksum
+	}
 }
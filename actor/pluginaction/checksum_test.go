@@ -44,6 +44,25 @@ var _ = Describe("Checksums", func() {
 			})
 		})
 
+		When("the checksum is a matching SHA-256", func() {
+			It("returns true", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeTrue())
+				Expect(actor.ValidateFileChecksum(file.Name(), "sha256:2C26B46B68FFC68FF99B453C1D30413413422D706483BFA0F98A5E886266E7AE")).To(BeTrue())
+			})
+		})
+
+		When("the checksum is a matching SHA-512", func() {
+			It("returns true", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "sha512:f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7")).To(BeTrue())
+			})
+		})
+
+		When("the checksum's algorithm prefix does not match its value", func() {
+			It("returns false", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "sha512:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeFalse())
+			})
+		})
+
 		When("the checksums do not match", func() {
 			It("returns false", func() {
 				Expect(actor.ValidateFileChecksum(file.Name(), "blah")).To(BeFalse())
@@ -12,6 +12,7 @@ type Config interface {
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
+	PluginTrustedKeys() []configv3.PluginTrustedKey
 	RemovePlugin(string)
 	WritePluginConfig() error
 }
@@ -0,0 +1,137 @@
+package pluginaction
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// PluginLock pins installed plugins to exact versions and binaries from the
+// repositories they are available in, so the same set of plugins can be
+// installed on another machine.
+type PluginLock struct {
+	Plugins []LockedPlugin `json:"plugins"`
+}
+
+// LockedPlugin is a plugin version pinned to a repository.
+type LockedPlugin struct {
+	Name       string                 `json:"name"`
+	Version    string                 `json:"version"`
+	Repository LockedPluginRepository `json:"repository"`
+	Binaries   []LockedPluginBinary   `json:"binaries"`
+}
+
+// LockedPluginRepository is the repository a locked plugin is pinned to.
+type LockedPluginRepository struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// LockedPluginBinary is a platform specific binary of a locked plugin. The
+// checksum is the strongest checksum the repository provides for the binary.
+type LockedPluginBinary struct {
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Checksum  string `json:"checksum"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// BinaryForPlatform returns the locked plugin's binary for the platform.
+func (plugin LockedPlugin) BinaryForPlatform(platform string) (LockedPluginBinary, bool) {
+	for _, binary := range plugin.Binaries {
+		if binary.Platform == platform {
+			return binary, true
+		}
+	}
+	return LockedPluginBinary{}, false
+}
+
+// CreatePluginLock pins every installed plugin to the first registered
+// repository that contains its installed version. It also returns the names
+// of the installed plugins that could not be found in any repository.
+func (actor Actor) CreatePluginLock() (PluginLock, []string, error) {
+	lock := PluginLock{Plugins: []LockedPlugin{}}
+	lockedPlugins := map[string]bool{}
+
+	installedPlugins := actor.config.Plugins()
+	for _, repo := range actor.config.PluginRepositories() {
+		repository, err := actor.client.GetPluginRepository(repo.URL)
+		if err != nil {
+			return PluginLock{}, nil, actionerror.GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
+		}
+
+		for _, installedPlugin := range installedPlugins {
+			if lockedPlugins[installedPlugin.Name] {
+				continue
+			}
+
+			for _, plugin := range repository.Plugins {
+				if plugin.Name != installedPlugin.Name || plugin.Version != installedPlugin.Version.String() {
+					continue
+				}
+
+				lockedPlugin := LockedPlugin{
+					Name:       plugin.Name,
+					Version:    plugin.Version,
+					Repository: LockedPluginRepository{Name: repo.Name, URL: repo.URL},
+					Binaries:   []LockedPluginBinary{},
+				}
+				for _, pluginBinary := range plugin.Binaries {
+					lockedPlugin.Binaries = append(lockedPlugin.Binaries, LockedPluginBinary{
+						Platform:  pluginBinary.Platform,
+						URL:       pluginBinary.URL,
+						Checksum:  strongestChecksum(pluginBinary),
+						Signature: pluginBinary.Signature,
+					})
+				}
+
+				lock.Plugins = append(lock.Plugins, lockedPlugin)
+				lockedPlugins[installedPlugin.Name] = true
+				break
+			}
+		}
+	}
+
+	var unlockedPlugins []string
+	for _, installedPlugin := range installedPlugins {
+		if !lockedPlugins[installedPlugin.Name] {
+			unlockedPlugins = append(unlockedPlugins, installedPlugin.Name)
+		}
+	}
+
+	return lock, unlockedPlugins, nil
+}
+
+// ReadPluginLock reads the plugin lock file at path.
+func (Actor) ReadPluginLock(path string) (PluginLock, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return PluginLock{}, err
+	}
+
+	var lock PluginLock
+	err = json.Unmarshal(raw, &lock)
+	if err != nil {
+		return PluginLock{}, actionerror.InvalidPluginLockFileError{Path: path, Err: err}
+	}
+
+	for _, plugin := range lock.Plugins {
+		if strings.TrimSpace(plugin.Name) == "" || strings.TrimSpace(plugin.Version) == "" {
+			return PluginLock{}, actionerror.InvalidPluginLockFileError{Path: path}
+		}
+	}
+
+	return lock, nil
+}
+
+// WritePluginLock writes the plugin lock to the file at path.
+func (Actor) WritePluginLock(path string, lock PluginLock) error {
+	raw, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0644)
+}
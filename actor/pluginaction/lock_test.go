@@ -0,0 +1,169 @@
+package pluginaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin lock actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("CreatePluginLock", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+				{Name: "Coo Plugins", URL: "https://reallycooplugins.org"},
+			})
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1, Minor: 0, Build: 0}},
+				{Name: "plugin-2", Version: configv3.PluginVersion{Major: 2, Minor: 0, Build: 0}},
+				{Name: "plugin-3", Version: configv3.PluginVersion{Major: 3, Minor: 0, Build: 0}},
+			})
+		})
+
+		When("getting a repository errors", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("generic-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				_, _, err := actor.CreatePluginLock()
+				Expect(err).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "CF-Community", Message: "generic-error"}))
+			})
+		})
+
+		When("the repositories contain the installed versions", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryStub = func(repositoryURL string) (plugin.PluginRepository, error) {
+					if repositoryURL == "https://plugins.cloudfoundry.org" {
+						return plugin.PluginRepository{
+							Plugins: []plugin.Plugin{
+								{Name: "plugin-1", Version: "2.0.0"},
+								{Name: "plugin-2", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+									{Platform: "linux64", URL: "https://example.com/plugin-2-linux", Checksum: "some-sha1", SHA256: "some-sha256", Signature: "some-signature"},
+									{Platform: "osx", URL: "https://example.com/plugin-2-osx", Checksum: "other-sha1"},
+								}},
+							},
+						}, nil
+					}
+					return plugin.PluginRepository{
+						Plugins: []plugin.Plugin{
+							{Name: "plugin-1", Version: "1.0.0", Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "https://example.com/plugin-1-linux", Checksum: "some-sha1"},
+							}},
+							{Name: "plugin-2", Version: "2.0.0"},
+						},
+					}, nil
+				}
+			})
+
+			It("pins each plugin to the first repository with its installed version", func() {
+				lock, unlocked, err := actor.CreatePluginLock()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(lock.Plugins).To(ConsistOf(
+					LockedPlugin{
+						Name:       "plugin-1",
+						Version:    "1.0.0",
+						Repository: LockedPluginRepository{Name: "Coo Plugins", URL: "https://reallycooplugins.org"},
+						Binaries: []LockedPluginBinary{
+							{Platform: "linux64", URL: "https://example.com/plugin-1-linux", Checksum: "some-sha1"},
+						},
+					},
+					LockedPlugin{
+						Name:       "plugin-2",
+						Version:    "2.0.0",
+						Repository: LockedPluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+						Binaries: []LockedPluginBinary{
+							{Platform: "linux64", URL: "https://example.com/plugin-2-linux", Checksum: "sha256:some-sha256", Signature: "some-signature"},
+							{Platform: "osx", URL: "https://example.com/plugin-2-osx", Checksum: "other-sha1"},
+						},
+					},
+				))
+				Expect(unlocked).To(ConsistOf("plugin-3"))
+			})
+		})
+	})
+
+	Describe("WritePluginLock and ReadPluginLock", func() {
+		var (
+			dir      string
+			lockPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "plugin-lock")
+			Expect(err).ToNot(HaveOccurred())
+			lockPath = filepath.Join(dir, "plugins.lock")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("round trips the lock through the file", func() {
+			lock := PluginLock{Plugins: []LockedPlugin{{
+				Name:       "plugin-1",
+				Version:    "1.0.0",
+				Repository: LockedPluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+				Binaries:   []LockedPluginBinary{{Platform: "linux64", URL: "https://example.com/plugin-1", Checksum: "sha256:some-sha256"}},
+			}}}
+
+			Expect(actor.WritePluginLock(lockPath, lock)).To(Succeed())
+
+			readLock, err := actor.ReadPluginLock(lockPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readLock).To(Equal(lock))
+
+			binary, found := readLock.Plugins[0].BinaryForPlatform("linux64")
+			Expect(found).To(BeTrue())
+			Expect(binary.URL).To(Equal("https://example.com/plugin-1"))
+
+			_, found = readLock.Plugins[0].BinaryForPlatform("win64")
+			Expect(found).To(BeFalse())
+		})
+
+		When("the lock file is not valid JSON", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(lockPath, []byte("not-json"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPluginLockFileError", func() {
+				_, err := actor.ReadPluginLock(lockPath)
+				Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidPluginLockFileError{}))
+			})
+		})
+
+		When("a locked plugin has no version", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(lockPath, []byte(`{"plugins": [{"name": "plugin-1"}]}`), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPluginLockFileError", func() {
+				_, err := actor.ReadPluginLock(lockPath)
+				Expect(err).To(MatchError(actionerror.InvalidPluginLockFileError{Path: lockPath}))
+			})
+		})
+	})
+})
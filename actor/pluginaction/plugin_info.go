@@ -4,15 +4,19 @@ import (
 	"runtime"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/generic"
 )
 
+// PluginInfo is a plugin binary available in a repository. Checksum is the
+// strongest checksum the repository provides for the binary.
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name      string
+	Version   string
+	URL       string
+	Checksum  string
+	Signature string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -74,10 +78,11 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:      plugin.Name,
+						Version:   plugin.Version,
+						URL:       pluginBinary.URL,
+						Checksum:  strongestChecksum(pluginBinary),
+						Signature: pluginBinary.Signature,
 					}, nil
 				}
 			}
@@ -94,3 +99,16 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 		RepositoryName: pluginRepo.Name,
 	}
 }
+
+// strongestChecksum returns the strongest checksum available for the binary,
+// prefixed with its algorithm when it is not the SHA-1 checksum.
+func strongestChecksum(pluginBinary plugin.PluginBinary) string {
+	switch {
+	case pluginBinary.SHA512 != "":
+		return SHA512ChecksumPrefix + pluginBinary.SHA512
+	case pluginBinary.SHA256 != "":
+		return SHA256ChecksumPrefix + pluginBinary.SHA256
+	default:
+		return pluginBinary.Checksum
+	}
+}
@@ -50,6 +50,8 @@ var _ = Describe("plugin info actions", func() {
 									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
+									{Platform: "linux32", URL: "http://some-linux32-url", Checksum: "lastchecksum", SHA256: "some-sha256", Signature: "some-signature"},
+									{Platform: "win32", URL: "http://some-windows32-url", Checksum: "lastchecksum", SHA256: "some-sha256", SHA512: "some-sha512"},
 								},
 							},
 							{
@@ -97,9 +99,23 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Checksum).To(Equal("somechecksum"))
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
 				})
+
+				When("the binary has stronger checksums and a signature", func() {
+					It("returns the strongest checksum with its algorithm and the signature", func() {
+						pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "linux32")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(pluginInfo.Checksum).To(Equal("sha256:some-sha256"))
+						Expect(pluginInfo.Signature).To(Equal("some-signature"))
+
+						pluginInfo, _, err = actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "win32")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(pluginInfo.Checksum).To(Equal("sha512:some-sha512"))
+					})
+				})
 			})
 		})
 
@@ -53,6 +53,16 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginTrustedKeysStub        func() []configv3.PluginTrustedKey
+	pluginTrustedKeysMutex       sync.RWMutex
+	pluginTrustedKeysArgsForCall []struct {
+	}
+	pluginTrustedKeysReturns struct {
+		result1 []configv3.PluginTrustedKey
+	}
+	pluginTrustedKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginTrustedKey
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -312,6 +322,58 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginTrustedKeys() []configv3.PluginTrustedKey {
+	fake.pluginTrustedKeysMutex.Lock()
+	ret, specificReturn := fake.pluginTrustedKeysReturnsOnCall[len(fake.pluginTrustedKeysArgsForCall)]
+	fake.pluginTrustedKeysArgsForCall = append(fake.pluginTrustedKeysArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PluginTrustedKeys", []interface{}{})
+	fake.pluginTrustedKeysMutex.Unlock()
+	if fake.PluginTrustedKeysStub != nil {
+		return fake.PluginTrustedKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pluginTrustedKeysReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCallCount() int {
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
+	return len(fake.pluginTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCalls(stub func() []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = stub
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturns(result1 []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = nil
+	fake.pluginTrustedKeysReturns = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturnsOnCall(i int, result1 []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = nil
+	if fake.pluginTrustedKeysReturnsOnCall == nil {
+		fake.pluginTrustedKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginTrustedKey
+		})
+	}
+	fake.pluginTrustedKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -460,6 +522,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
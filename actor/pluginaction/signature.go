@@ -0,0 +1,38 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"golang.org/x/crypto/ed25519"
+)
+
+// VerifyFileSignature verifies the base64 encoded ed25519 detached signature
+// of the file against the trusted plugin publisher keys. It returns the name
+// of the key that signed the file.
+func (actor Actor) VerifyFileSignature(path string, signature string) (string, error) {
+	decodedSignature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(decodedSignature) != ed25519.SignatureSize {
+		return "", actionerror.PluginSignatureInvalidError{}
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range actor.config.PluginTrustedKeys() {
+		publicKey, err := base64.StdEncoding.DecodeString(key.PublicKey)
+		if err != nil || len(publicKey) != ed25519.PublicKeySize {
+			continue
+		}
+
+		if ed25519.Verify(ed25519.PublicKey(publicKey), contents, decodedSignature) {
+			return key.Name, nil
+		}
+	}
+
+	return "", actionerror.PluginSignatureInvalidError{}
+}
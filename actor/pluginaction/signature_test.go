@@ -0,0 +1,113 @@
+package pluginaction_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signatures", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+	})
+
+	Describe("VerifyFileSignature", func() {
+		var (
+			file       *os.File
+			publicKey  ed25519.PublicKey
+			privateKey ed25519.PrivateKey
+			signature  string
+
+			keyName string
+			err     error
+		)
+
+		BeforeEach(func() {
+			file, err = ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo")))
+
+			otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConfig.PluginTrustedKeysReturns([]configv3.PluginTrustedKey{
+				{Name: "malformed-key", PublicKey: "not-base64!"},
+				{Name: "other-publisher", PublicKey: base64.StdEncoding.EncodeToString(otherPublicKey)},
+				{Name: "some-publisher", PublicKey: base64.StdEncoding.EncodeToString(publicKey)},
+			})
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(file.Name())).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			keyName, err = actor.VerifyFileSignature(file.Name(), signature)
+		})
+
+		When("the file is signed by a trusted key", func() {
+			BeforeEach(func() {
+				signature += "\n"
+			})
+
+			It("returns the name of the key", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyName).To(Equal("some-publisher"))
+			})
+		})
+
+		When("the file is signed by an untrusted key", func() {
+			BeforeEach(func() {
+				_, untrustedKey, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				signature = base64.StdEncoding.EncodeToString(ed25519.Sign(untrustedKey, []byte("foo")))
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		When("the file does not match the signature", func() {
+			BeforeEach(func() {
+				signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("bar")))
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		When("the signature is malformed", func() {
+			BeforeEach(func() {
+				signature = "c29tZS1zaWduYXR1cmU="
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+	})
+})
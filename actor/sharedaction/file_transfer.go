@@ -0,0 +1,33 @@
+package sharedaction
+
+import "code.cloudfoundry.org/cli/util/clissh"
+
+type FileTransferDirection int
+
+const (
+	UploadToApp FileTransferDirection = iota
+	DownloadFromApp
+)
+
+type FileTransfer struct {
+	Direction  FileTransferDirection
+	LocalPath  string
+	RemotePath string
+	Recursive  bool
+	Progress   clissh.TransferProgress
+}
+
+// TransferFiles connects to the app instance described by the SSH options and
+// copies files to or from it over SFTP.
+func (actor Actor) TransferFiles(sshClient SecureShellClient, sshOptions SSHOptions, transfer FileTransfer) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if transfer.Direction == DownloadFromApp {
+		return sshClient.Download(transfer.RemotePath, transfer.LocalPath, transfer.Recursive, transfer.Progress)
+	}
+	return sshClient.Upload(transfer.LocalPath, transfer.RemotePath, transfer.Recursive, transfer.Progress)
+}
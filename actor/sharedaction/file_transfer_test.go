@@ -0,0 +1,113 @@
+package sharedaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/progressbar"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File transfer actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+	})
+
+	Describe("TransferFiles", func() {
+		var (
+			sshOptions  SSHOptions
+			transfer    FileTransfer
+			progressBar *progressbar.TransferProgressBar
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+			}
+			progressBar = progressbar.NewTransferProgressBar()
+			transfer = FileTransfer{
+				LocalPath:  "some-local-path",
+				RemotePath: "some-remote-path",
+				Recursive:  true,
+				Progress:   progressBar,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.TransferFiles(fakeSecureShellClient, sshOptions, transfer)
+		})
+
+		It("connects with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+		})
+
+		When("uploading to the app", func() {
+			BeforeEach(func() {
+				transfer.Direction = UploadToApp
+				fakeSecureShellClient.UploadReturns(errors.New("some-upload-error"))
+			})
+
+			It("uploads the local path and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-upload-error"))
+
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(1))
+				localPath, remotePath, recursive, progress := fakeSecureShellClient.UploadArgsForCall(0)
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(progress).To(Equal(progressBar))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("downloading from the app", func() {
+			BeforeEach(func() {
+				transfer.Direction = DownloadFromApp
+			})
+
+			It("downloads the remote path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(1))
+				remotePath, localPath, recursive, _ := fakeSecureShellClient.DownloadArgsForCall(0)
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
@@ -9,5 +13,10 @@ type SecureShellClient interface {
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(localAddresses []string) error
+	RunCommand(commands []string, stdout io.Writer, stderr io.Writer) (int, error)
+	Upload(localPath string, remotePath string, recursive bool, progress clissh.TransferProgress) error
+	Download(remotePath string, localPath string, recursive bool, progress clissh.TransferProgress) error
 	Wait() error
 }
@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,16 +17,20 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                    []string
+	Username                    string
+	Passcode                    string
+	Endpoint                    string
+	HostKeyFingerprint          string
+	SkipHostValidation          bool
+	SkipRemoteExecution         bool
+	TTYOption                   TTYOption
+	LocalPortForwardSpecs       []LocalPortForward
+	RemotePortForwardSpecs      []RemotePortForward
+	DynamicPortForwardAddresses []string
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +45,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(sshOptions.DynamicPortForwardAddresses)
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +63,19 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureShellCommand connects to the app instance described by the SSH
+// options and runs its commands without a terminal, writing their output to
+// stdout and stderr, and returns their exit status.
+func (actor Actor) ExecuteSecureShellCommand(sshClient SecureShellClient, sshOptions SSHOptions, stdout io.Writer, stderr io.Writer) (int, error) {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return 0, err
+	}
+	defer sshClient.Close()
+
+	return sshClient.RunCommand(sshOptions.Commands, stdout, stderr)
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +85,13 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
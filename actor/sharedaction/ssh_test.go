@@ -1,6 +1,7 @@
 package sharedaction_test
 
 import (
+	"bytes"
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
@@ -73,6 +74,10 @@ var _ = Describe("SSH Actions", func() {
 					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
 					{LocalAddress: "local-address-2", RemoteAddress: "remote-address-2"},
 				}
+				sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+					{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+				}
+				sshOptions.DynamicPortForwardAddresses = []string{"local-address-4"}
 			})
 
 			AfterEach(func() {
@@ -89,6 +94,20 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("forwards the remote ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+					},
+				))
+			})
+
+			It("starts the SOCKS proxies", func() {
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal([]string{"local-address-4"}))
+			})
+
 			When("local port forwarding fails", func() {
 				BeforeEach(func() {
 					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
@@ -99,6 +118,28 @@ var _ = Describe("SSH Actions", func() {
 				})
 			})
 
+			When("remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error without starting the session", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			When("dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error without starting the session", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+					Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
 			When("local port forwarding succeeds", func() {
 				When("skipping remote execution", func() {
 					BeforeEach(func() {
@@ -163,4 +204,77 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellCommand", func() {
+		var (
+			sshOptions     SSHOptions
+			stdout, stderr *bytes.Buffer
+			exitStatus     int
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Commands:           []string{"some-command"},
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+			}
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			exitStatus, executeErr = actor.ExecuteSecureShellCommand(fakeSecureShellClient, sshOptions, stdout, stderr)
+		})
+
+		BeforeEach(func() {
+			fakeSecureShellClient.RunCommandReturns(3, nil)
+		})
+
+		It("connects, runs the commands and returns their exit status", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(exitStatus).To(Equal(3))
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+
+			Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(1))
+			commandsArg, stdoutArg, stderrArg := fakeSecureShellClient.RunCommandArgsForCall(0)
+			Expect(commandsArg).To(ConsistOf("some-command"))
+			Expect(stdoutArg).To(Equal(stdout))
+			Expect(stderrArg).To(Equal(stderr))
+
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without running the commands", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		When("running the commands fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns(0, errors.New("some-run-error"))
+			})
+
+			It("returns the error and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-run-error"))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -83,6 +83,25 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsBySpaceWithLabelSelector returns the applications in a space
+// whose labels match the label selector.
+func (actor Actor) GetApplicationsBySpaceWithLabelSelector(spaceGUID string, labelSelector string) ([]Application, Warnings, error) {
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+
+	if err != nil {
+		return []Application{}, Warnings(warnings), err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		apps = append(apps, actor.convertCCToActorApplication(ccApp))
+	}
+	return apps, Warnings(warnings), nil
+}
+
 // GetApplicationsByGUIDs returns all applications with the provided GUIDs.
 func (actor Actor) GetApplicationsByGUIDs(appGUIDs ...string) ([]Application, Warnings, error) {
 	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
@@ -245,6 +245,60 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpaceWithLabelSelector", func() {
+		When("there are matching applications in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							GUID: "some-app-guid-1",
+							Name: "some-app-1",
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid"},
+							},
+						},
+					},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("filters the space's applications by the label selector", func() {
+				apps, warnings, err := actor.GetApplicationsBySpaceWithLabelSelector("some-space-guid", "tier=web")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						GUID:      "some-app-guid-1",
+						Name:      "some-app-1",
+						SpaceGUID: "some-space-guid",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"tier=web"}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					errors.New("some-cc-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationsBySpaceWithLabelSelector("some-space-guid", "tier=web")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError("some-cc-error"))
+			})
+		})
+	})
+
 	Describe("GetApplicationsByGUIDs", func() {
 		When("there are applications that match provided guids", func() {
 			BeforeEach(func() {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 )
@@ -21,14 +22,7 @@ func (actor Actor) Authenticate(credentials map[string]string, origin string, gr
 		return err
 	}
 
-	accessToken = fmt.Sprintf("bearer %s", accessToken)
-	actor.Config.SetTokenInformation(accessToken, refreshToken, "")
-
-	if grantType == constant.GrantTypePassword {
-		actor.Config.SetUAAGrantType("")
-	} else {
-		actor.Config.SetUAAGrantType(string(grantType))
-	}
+	actor.saveTokens(accessToken, refreshToken, grantType)
 
 	if grantType == constant.GrantTypeClientCredentials {
 		actor.Config.SetUAAClientCredentials(credentials["client_id"], "")
@@ -37,6 +31,38 @@ func (actor Actor) Authenticate(credentials map[string]string, origin string, gr
 	return nil
 }
 
+// DeviceAuthorization is a pending login that the user approves on another
+// device.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// GetDeviceAuthorization starts a device login, returning the URI the user
+// visits and the code they enter there.
+func (actor Actor) GetDeviceAuthorization() (DeviceAuthorization, error) {
+	authorization, err := actor.UAAClient.RequestDeviceAuthorization()
+	return DeviceAuthorization(authorization), err
+}
+
+// AuthenticateWithDeviceAuthorization waits for the user to approve the device
+// login and then stores the tokens like a password login.
+func (actor Actor) AuthenticateWithDeviceAuthorization(authorization DeviceAuthorization) error {
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	accessToken, refreshToken, err := actor.UAAClient.PollDeviceAuthorization(uaa.DeviceAuthorization(authorization))
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	actor.saveTokens(accessToken, refreshToken, constant.GrantTypeDeviceCode)
+	return nil
+}
+
+// GetAuthorizationCodeURL returns the URL a browser should visit to log in and
+// have an authorization code sent to the redirect URI.
+func (actor Actor) GetAuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error) {
+	return actor.UAAClient.AuthorizationCodeURL(redirectURI, codeChallenge, state)
+}
+
 func (actor Actor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	rawPrompts := actor.UAAClient.LoginPrompts()
 	prompts := make(map[string]coreconfig.AuthPrompt)
@@ -49,6 +75,16 @@ func (actor Actor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	return prompts
 }
 
+func (actor Actor) saveTokens(accessToken string, refreshToken string, grantType constant.GrantType) {
+	actor.Config.SetTokenInformation(fmt.Sprintf("bearer %s", accessToken), refreshToken, "")
+
+	if grantType == constant.GrantTypePassword {
+		actor.Config.SetUAAGrantType("")
+	} else {
+		actor.Config.SetUAAGrantType(string(grantType))
+	}
+}
+
 var knownAuthPromptTypes = map[string]coreconfig.AuthPromptType{
 	"text":     coreconfig.AuthPromptTypeText,
 	"password": coreconfig.AuthPromptTypePassword,
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 )
@@ -103,6 +105,23 @@ var _ = Describe("Auth Actions", func() {
 				})
 			})
 
+			When("the grant type is authorization code", func() {
+				BeforeEach(func() {
+					grantType = constant.GrantTypeAuthorizationCode
+				})
+
+				It("stores the tokens and the grant type", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(accessToken).To(Equal("bearer some-access-token"))
+					Expect(refreshToken).To(Equal("some-refresh-token"))
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeAuthorizationCode)))
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				})
+			})
+
 			When("extra information is needed to authenticate, e.g., MFA", func() {
 				BeforeEach(func() {
 					creds = map[string]string{
@@ -149,6 +168,99 @@ var _ = Describe("Auth Actions", func() {
 		})
 	})
 
+	Describe("GetDeviceAuthorization", func() {
+		When("UAA starts the device authorization", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{
+					DeviceCode:      "some-device-code",
+					UserCode:        "WDJB-MJHT",
+					VerificationURI: "https://login.example.com/device",
+					Interval:        5 * time.Second,
+				}, nil)
+			})
+
+			It("returns the device authorization", func() {
+				authorization, err := actor.GetDeviceAuthorization()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authorization).To(Equal(DeviceAuthorization{
+					DeviceCode:      "some-device-code",
+					UserCode:        "WDJB-MJHT",
+					VerificationURI: "https://login.example.com/device",
+					Interval:        5 * time.Second,
+				}))
+			})
+		})
+
+		When("UAA returns an error", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{}, errors.New("some error"))
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetDeviceAuthorization()
+				Expect(err).To(MatchError("some error"))
+			})
+		})
+	})
+
+	Describe("AuthenticateWithDeviceAuthorization", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithDeviceAuthorization(DeviceAuthorization{DeviceCode: "some-device-code"})
+		})
+
+		When("the user approves the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.PollDeviceAuthorizationReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			It("stores the tokens and the grant type", func() {
+				Expect(actualErr).ToNot(HaveOccurred())
+
+				Expect(fakeUAAClient.PollDeviceAuthorizationCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.PollDeviceAuthorizationArgsForCall(0)).To(Equal(uaa.DeviceAuthorization{DeviceCode: "some-device-code"}))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeDeviceCode)))
+			})
+		})
+
+		When("the login is not approved", func() {
+			BeforeEach(func() {
+				fakeUAAClient.PollDeviceAuthorizationReturns("", "", uaa.AccessDeniedError{})
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(actualErr).To(MatchError(uaa.AccessDeniedError{}))
+
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetAuthorizationCodeURL", func() {
+		It("returns the authorize URL from the UAA client", func() {
+			fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?state=some-state", nil)
+
+			authorizeURL, err := actor.GetAuthorizationCodeURL("http://127.0.0.1:8080/callback", "some-code-challenge", "some-state")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorizeURL).To(Equal("https://login.example.com/oauth/authorize?state=some-state"))
+
+			Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, codeChallenge, state := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(Equal("http://127.0.0.1:8080/callback"))
+			Expect(codeChallenge).To(Equal("some-code-challenge"))
+			Expect(state).To(Equal("some-state"))
+		})
+	})
+
 	Describe("GetLoginPrompts", func() {
 		When("getting login prompts info from UAA", func() {
 			var (
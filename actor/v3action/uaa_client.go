@@ -1,11 +1,17 @@
 package v3action
 
-import "code.cloudfoundry.org/cli/api/uaa/constant"
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
 
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
+	AuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error)
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) (string, string, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	LoginPrompts() map[string][]string
+	PollDeviceAuthorization(authorization uaa.DeviceAuthorization) (string, string, error)
+	RequestDeviceAuthorization() (uaa.DeviceAuthorization, error)
 }
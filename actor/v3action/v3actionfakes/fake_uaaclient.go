@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
@@ -26,6 +27,21 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthorizationCodeURLStub        func(string, string, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetSSHPasscodeStub        func(string, string) (string, error)
 	getSSHPasscodeMutex       sync.RWMutex
 	getSSHPasscodeArgsForCall []struct {
@@ -50,6 +66,33 @@ type FakeUAAClient struct {
 	loginPromptsReturnsOnCall map[int]struct {
 		result1 map[string][]string
 	}
+	PollDeviceAuthorizationStub        func(uaa.DeviceAuthorization) (string, string, error)
+	pollDeviceAuthorizationMutex       sync.RWMutex
+	pollDeviceAuthorizationArgsForCall []struct {
+		arg1 uaa.DeviceAuthorization
+	}
+	pollDeviceAuthorizationReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	pollDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	RequestDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	requestDeviceAuthorizationMutex       sync.RWMutex
+	requestDeviceAuthorizationArgsForCall []struct {
+	}
+	requestDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	requestDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -122,6 +165,71 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.authorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) GetSSHPasscode(arg1 string, arg2 string) (string, error) {
 	fake.getSSHPasscodeMutex.Lock()
 	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
@@ -238,15 +346,142 @@ func (fake *FakeUAAClient) LoginPromptsReturnsOnCall(i int, result1 map[string][
 	}{result1}
 }
 
+func (fake *FakeUAAClient) PollDeviceAuthorization(arg1 uaa.DeviceAuthorization) (string, string, error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.pollDeviceAuthorizationReturnsOnCall[len(fake.pollDeviceAuthorizationArgsForCall)]
+	fake.pollDeviceAuthorizationArgsForCall = append(fake.pollDeviceAuthorizationArgsForCall, struct {
+		arg1 uaa.DeviceAuthorization
+	}{arg1})
+	fake.recordInvocation("PollDeviceAuthorization", []interface{}{arg1})
+	fake.pollDeviceAuthorizationMutex.Unlock()
+	if fake.PollDeviceAuthorizationStub != nil {
+		return fake.PollDeviceAuthorizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCallCount() int {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	return len(fake.pollDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCalls(stub func(uaa.DeviceAuthorization) (string, string, error)) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationArgsForCall(i int) uaa.DeviceAuthorization {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.pollDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturns(result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	fake.pollDeviceAuthorizationReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	if fake.pollDeviceAuthorizationReturnsOnCall == nil {
+		fake.pollDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.pollDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.requestDeviceAuthorizationReturnsOnCall[len(fake.requestDeviceAuthorizationArgsForCall)]
+	fake.requestDeviceAuthorizationArgsForCall = append(fake.requestDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestDeviceAuthorization", []interface{}{})
+	fake.requestDeviceAuthorizationMutex.Unlock()
+	if fake.RequestDeviceAuthorizationStub != nil {
+		return fake.RequestDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCallCount() int {
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
+	return len(fake.requestDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	fake.requestDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	if fake.requestDeviceAuthorizationReturnsOnCall == nil {
+		fake.requestDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.requestDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.loginPromptsMutex.RLock()
 	defer fake.loginPromptsMutex.RUnlock()
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,96 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+func (actor *Actor) GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	return actor.getAnnotations((*ccv3.Metadata)(resource.Metadata), warnings, err)
+}
+
+func (actor *Actor) GetOrganizationAnnotations(orgName string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetOrganizationByName(orgName)
+	return actor.getAnnotations((*ccv3.Metadata)(resource.Metadata), warnings, err)
+}
+
+func (actor *Actor) GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	return actor.getAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetStackAnnotations(stackName string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetStackByName(stackName)
+	return actor.getAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, buildpackStack)
+	return actor.getAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetRouteAnnotations(routeURL string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetRouteByURL(routeURL)
+	return actor.getAnnotations((*ccv3.Metadata)(resource.Metadata), warnings, err)
+}
+
+func (actor *Actor) getAnnotations(metadata *ccv3.Metadata, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
+	var annotations map[string]types.NullString
+
+	if err != nil {
+		return annotations, warnings, err
+	}
+	if metadata != nil {
+		annotations = metadata.Annotations
+	}
+	return annotations, warnings, nil
+}
+
+func (actor *Actor) UpdateApplicationAnnotationsByApplicationName(appName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("app", app.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(buildpackName string, stack string, annotations map[string]types.NullString) (Warnings, error) {
+	buildpack, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, stack)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("buildpack", buildpack.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateOrganizationAnnotationsByOrganizationName(orgName string, annotations map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("org", org.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateSpaceAnnotationsBySpaceName(spaceName string, orgGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("space", space.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateStackAnnotationsByStackName(stackName string, annotations map[string]types.NullString) (Warnings, error) {
+	stack, warnings, err := actor.GetStackByName(stackName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("stack", stack.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateRouteAnnotationsByRouteURL(routeURL string, annotations map[string]types.NullString) (Warnings, error) {
+	route, warnings, err := actor.GetRouteByURL(routeURL)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("route", route.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
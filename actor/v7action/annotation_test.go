@@ -0,0 +1,256 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Annotations", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+		annotations               map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil)
+		annotations = map[string]types.NullString{
+			"owner":   types.NewNullString("team-a"),
+			"old-key": types.NewNullString(),
+		}
+	})
+
+	Context("UpdateApplicationAnnotationsByApplicationName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationAnnotationsByApplicationName("some-app", "some-space-guid", annotations)
+		})
+
+		When("there are no client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					ccv3.ResourceMetadata{},
+					ccv3.Warnings{"set-app-annotations-warning"},
+					nil,
+				)
+			})
+
+			It("sets only the app annotations and aggregates warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "set-app-annotations-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resourceType, appGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("app"))
+				Expect(appGUID).To(Equal("some-guid"))
+				Expect(sentMetadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"warning-failure"},
+					errors.New("get-apps-error"),
+				)
+			})
+
+			It("returns the error and warnings without updating", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("warning-failure"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+
+		When("updating the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					ccv3.ResourceMetadata{},
+					ccv3.Warnings{"set-app-annotations-warning"},
+					errors.New("update-app-error"),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("update-app-error"))
+				Expect(warnings).To(ConsistOf("warning-1", "set-app-annotations-warning"))
+			})
+		})
+	})
+
+	Context("UpdateSpaceAnnotationsBySpaceName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{GUID: "some-guid"}},
+				ccv3.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateSpaceAnnotationsBySpaceName("some-space", "some-org-guid", annotations)
+		})
+
+		It("sets the space annotations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			resourceType, spaceGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resourceType).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("some-guid"))
+			Expect(sentMetadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+		})
+	})
+
+	Context("UpdateRouteAnnotationsByRouteURL", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]ccv3.Domain{{Name: "example.com", GUID: "domain-guid"}},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateRouteAnnotationsByRouteURL("example.com/path", annotations)
+		})
+
+		When("the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "route-guid"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					ccv3.ResourceMetadata{},
+					ccv3.Warnings{"set-route-annotations-warning"},
+					nil,
+				)
+			})
+
+			It("sets the route annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning", "set-route-annotations-warning"))
+
+				resourceType, routeGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("route"))
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(sentMetadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+			})
+		})
+
+		When("getting the route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings without updating", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("GetApplicationAnnotations", func() {
+		var appAnnotations map[string]types.NullString
+
+		JustBeforeEach(func() {
+			appAnnotations, warnings, executeErr = actor.GetApplicationAnnotations("some-app", "some-space-guid")
+		})
+
+		When("the app has annotations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						GUID: "some-guid",
+						Metadata: &ccv3.Metadata{
+							Labels:      map[string]types.NullString{"env": types.NewNullString("prod")},
+							Annotations: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+						},
+					}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns only the annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(appAnnotations).To(Equal(map[string]types.NullString{"owner": types.NewNullString("team-a")}))
+			})
+		})
+
+		When("the app has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-guid"}}, nil, nil)
+			})
+
+			It("returns no annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(appAnnotations).To(BeEmpty())
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Context("GetRouteAnnotations", func() {
+		var routeAnnotations map[string]types.NullString
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]ccv3.Domain{{Name: "example.com", GUID: "domain-guid"}},
+				nil,
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]ccv3.Route{{
+					GUID:     "route-guid",
+					Metadata: &ccv3.Metadata{Annotations: map[string]types.NullString{"runbook": types.NewNullString("https://example.com")}},
+				}},
+				ccv3.Warnings{"get-routes-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			routeAnnotations, warnings, executeErr = actor.GetRouteAnnotations("example.com")
+		})
+
+		It("returns the route annotations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-routes-warning"))
+			Expect(routeAnnotations).To(Equal(map[string]types.NullString{"runbook": types.NewNullString("https://example.com")}))
+		})
+	})
+})
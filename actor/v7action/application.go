@@ -1,7 +1,6 @@
 package v7action
 
 import (
-	"errors"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -423,12 +422,7 @@ func (actor Actor) getDeployment(deploymentGUID string) (ccv3.Deployment, Warnin
 	}
 
 	if deployment.StatusValue == constant.DeploymentStatusValueFinalized {
-		switch deployment.StatusReason {
-		case constant.DeploymentStatusReasonCanceled:
-			return deployment, allWarnings, errors.New("Deployment has been canceled")
-		case constant.DeploymentStatusReasonSuperseded:
-			return deployment, allWarnings, errors.New("Deployment has been superseded")
-		}
+		return deployment, allWarnings, finalizedDeploymentError(deployment)
 	}
 
 	return deployment, allWarnings, nil
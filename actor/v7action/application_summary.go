@@ -30,27 +30,35 @@ func (a ApplicationSummary) hasIsolationSegment() bool {
 		len(a.ProcessSummaries[0].InstanceDetails[0].IsolationSegment) > 0
 }
 
-func (actor Actor) GetAppSummariesForSpace(spaceGUID string) ([]ApplicationSummary, Warnings, error) {
+func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector string) ([]ApplicationSummary, Warnings, error) {
 	var allWarnings Warnings
 	var allSummaries []ApplicationSummary
 
-	apps, warnings, err := actor.CloudControllerClient.GetApplications(
-		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
-	)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return nil, allWarnings, err
+	queries := []ccv3.Query{
+		{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
 	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
 
-	for _, app := range apps {
-		summary, summaryWarnings, err := actor.createSummary(actor.convertCCToActorApplication(app), false)
-		allWarnings = append(allWarnings, summaryWarnings...)
-		if err != nil {
-			return nil, allWarnings, err
+	done := make(chan struct{})
+	defer close(done)
+
+	// Each page of applications is summarized as soon as it arrives, while
+	// the remaining pages are still being fetched.
+	for page := range actor.CloudControllerClient.StreamApplications(done, queries...) {
+		allWarnings = append(allWarnings, page.Warnings...)
+		if page.Err != nil {
+			return nil, allWarnings, page.Err
 		}
-		allSummaries = append(allSummaries, summary)
 
+		for _, app := range page.Applications {
+			summary, summaryWarnings, err := actor.createSummary(actor.convertCCToActorApplication(app), false)
+			allWarnings = append(allWarnings, summaryWarnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			allSummaries = append(allSummaries, summary)
+		}
 	}
 
 	return allSummaries, allWarnings, nil
@@ -104,6 +112,7 @@ func (actor Actor) createSummary(app Application, withObfuscatedValues bool) (Ap
 			State:               app.State,
 			LifecycleType:       app.LifecycleType,
 			LifecycleBuildpacks: app.LifecycleBuildpacks,
+			Metadata:            app.Metadata,
 		},
 		ProcessSummaries: processSummaries,
 		Routes:           appRoutes,
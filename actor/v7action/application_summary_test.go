@@ -68,7 +68,8 @@ var _ = Describe("Application Summary Actions", func() {
 
 	Describe("GetAppSummariesForSpace", func() {
 		var (
-			spaceGUID string
+			spaceGUID     string
+			labelSelector string
 
 			summaries  []ApplicationSummary
 			warnings   Warnings
@@ -77,25 +78,27 @@ var _ = Describe("Application Summary Actions", func() {
 
 		BeforeEach(func() {
 			spaceGUID = "some-space-guid"
+			labelSelector = ""
 		})
 
 		JustBeforeEach(func() {
-			summaries, warnings, executeErr = actor.GetAppSummariesForSpace(spaceGUID)
+			summaries, warnings, executeErr = actor.GetAppSummariesForSpace(spaceGUID, labelSelector)
 		})
 
 		When("getting the application is successful", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.GetApplicationsReturns(
-					[]ccv3.Application{
-						{
-							Name:  "some-app-name",
-							GUID:  "some-app-guid",
-							State: constant.ApplicationStarted,
+				fakeCloudControllerClient.StreamApplicationsReturns(applicationsPagesOf(
+					ccv3.ApplicationsPage{
+						Applications: []ccv3.Application{
+							{
+								Name:  "some-app-name",
+								GUID:  "some-app-guid",
+								State: constant.ApplicationStarted,
+							},
 						},
+						Warnings: ccv3.Warnings{"get-apps-warning"},
 					},
-					ccv3.Warnings{"get-apps-warning"},
-					nil,
-				)
+				))
 
 				listedProcesses := []ccv3.Process{
 					{
@@ -223,8 +226,9 @@ var _ = Describe("Application Summary Actions", func() {
 					"get-routes-warning",
 				))
 
-				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				Expect(fakeCloudControllerClient.StreamApplicationsCallCount()).To(Equal(1))
+				_, queries := fakeCloudControllerClient.StreamApplicationsArgsForCall(0)
+				Expect(queries).To(ConsistOf(
 					ccv3.Query{Key: ccv3.OrderBy, Values: []string{"name"}},
 					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
 				))
@@ -235,21 +239,78 @@ var _ = Describe("Application Summary Actions", func() {
 				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
 				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
 			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the applications by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, queries := fakeCloudControllerClient.StreamApplicationsArgsForCall(0)
+					Expect(queries).To(ConsistOf(
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{"name"}},
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					))
+				})
+			})
 		})
 
-		When("getting the application fails", func() {
+		When("the applications span several pages", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.GetApplicationsReturns(
-					[]ccv3.Application{
-						{
-							Name:  "some-app-name",
-							GUID:  "some-app-guid",
-							State: constant.ApplicationStarted,
+				fakeCloudControllerClient.StreamApplicationsReturns(applicationsPagesOf(
+					ccv3.ApplicationsPage{
+						Applications: []ccv3.Application{{Name: "app-1", GUID: "app-guid-1"}},
+						Warnings:     ccv3.Warnings{"page-1-warning"},
+					},
+					ccv3.ApplicationsPage{
+						Applications: []ccv3.Application{{Name: "app-2", GUID: "app-guid-2"}},
+						Warnings:     ccv3.Warnings{"page-2-warning"},
+					},
+				))
+			})
+
+			It("summarizes the applications of every page in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Name).To(Equal("app-1"))
+				Expect(summaries[1].Name).To(Equal("app-2"))
+				Expect(warnings).To(ConsistOf("page-1-warning", "page-2-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(1)).To(Equal("app-guid-2"))
+			})
+
+			When("a later page fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.StreamApplicationsReturns(applicationsPagesOf(
+						ccv3.ApplicationsPage{
+							Applications: []ccv3.Application{{Name: "app-1", GUID: "app-guid-1"}},
+							Warnings:     ccv3.Warnings{"page-1-warning"},
+						},
+						ccv3.ApplicationsPage{
+							Warnings: ccv3.Warnings{"page-2-warning"},
+							Err:      errors.New("failed to get page"),
 						},
+					))
+				})
+
+				It("returns the error and the warnings so far", func() {
+					Expect(executeErr).To(MatchError("failed to get page"))
+					Expect(warnings).To(ConsistOf("page-1-warning", "page-2-warning"))
+				})
+			})
+		})
+
+		When("getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.StreamApplicationsReturns(applicationsPagesOf(
+					ccv3.ApplicationsPage{
+						Warnings: ccv3.Warnings{"get-apps-warning"},
+						Err:      errors.New("failed to get app"),
 					},
-					ccv3.Warnings{"get-apps-warning"},
-					errors.New("failed to get app"),
-				)
+				))
 			})
 
 			It("returns the error and warnings", func() {
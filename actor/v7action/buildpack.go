@@ -21,11 +21,15 @@ type Downloader interface {
 	Download(url string, tmpDirPath string) (string, error)
 }
 
-func (actor Actor) GetBuildpacks() ([]Buildpack, Warnings, error) {
-	ccv3Buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(ccv3.Query{
-		Key:    ccv3.OrderBy,
-		Values: []string{ccv3.PositionOrder},
-	})
+// GetBuildpacks returns the buildpacks whose labels match the label
+// selector, or all buildpacks when the label selector is empty.
+func (actor Actor) GetBuildpacks(labelSelector string) ([]Buildpack, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.PositionOrder}},
+	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
+
+	ccv3Buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(queries...)
 
 	var buildpacks []Buildpack
 	for _, buildpack := range ccv3Buildpacks {
@@ -197,10 +197,16 @@ var _ = Describe("Buildpack", func() {
 			buildpacks []Buildpack
 			warnings   Warnings
 			executeErr error
+
+			labelSelector string
 		)
 
+		BeforeEach(func() {
+			labelSelector = ""
+		})
+
 		JustBeforeEach(func() {
-			buildpacks, warnings, executeErr = actor.GetBuildpacks()
+			buildpacks, warnings, executeErr = actor.GetBuildpacks(labelSelector)
 		})
 
 		When("getting buildpacks fails", func() {
@@ -244,6 +250,20 @@ var _ = Describe("Buildpack", func() {
 					Values: []string{ccv3.PositionOrder},
 				}))
 			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the buildpacks by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.PositionOrder}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					))
+				})
+			})
 		})
 	})
 
@@ -2,6 +2,7 @@ package v7action
 
 import (
 	"io"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
@@ -28,6 +29,8 @@ type CloudControllerClient interface {
 	CreatePackage(pkg ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
 	CreateRoute(route ccv3.Route) (ccv3.Route, ccv3.Warnings, error)
 	CreateServiceBroker(name, username, password, url, spaceGUID string) (ccv3.Warnings, error)
+	CreateServiceCredentialBinding(binding ccv3.ServiceCredentialBinding) (ccv3.JobURL, ccv3.Warnings, error)
+	CreateServiceInstance(serviceInstance ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
 	CreateSpace(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
 	DeleteApplication(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
@@ -38,6 +41,8 @@ type CloudControllerClient interface {
 	DeleteOrganization(orgGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteOrphanedRoutes(spaceGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteServiceCredentialBinding(serviceCredentialBindingGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error)
 	DeleteSpace(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
@@ -75,16 +80,23 @@ type CloudControllerClient interface {
 	GetRouteDestinations(routeGUID string) ([]ccv3.RouteDestination, ccv3.Warnings, error)
 	GetRoutes(query ...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error)
 	GetServiceBrokers() ([]ccv3.ServiceBroker, ccv3.Warnings, error)
+	GetServiceCredentialBindingDetails(serviceCredentialBindingGUID string) (ccv3.ServiceCredentialBindingDetails, ccv3.Warnings, error)
+	GetServiceCredentialBindings(query ...ccv3.Query) ([]ccv3.ServiceCredentialBinding, ccv3.Warnings, error)
 	GetServiceInstances(query ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
+	GetServiceOfferings(query ...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error)
+	GetServicePlans(query ...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	GetSpaces(query ...ccv3.Query) ([]ccv3.Space, ccv3.Warnings, error)
 	GetStacks(query ...ccv3.Query) ([]ccv3.Stack, ccv3.Warnings, error)
+	MakeRequestSendReceiveRaw(method string, path string, requestBody []byte) ([]byte, *http.Response, error)
 	MapRoute(routeGUID string, appGUID string) (ccv3.Warnings, error)
 	PollJob(jobURL ccv3.JobURL) (ccv3.Warnings, error)
 	ResourceMatch(resources []ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error)
 	SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	SharePrivateDomainToOrgs(domainGuid string, sharedOrgs ccv3.SharedOrgs) (ccv3.Warnings, error)
 	ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	StreamApplications(done <-chan struct{}, query ...ccv3.Query) <-chan ccv3.ApplicationsPage
+	StreamRoutes(done <-chan struct{}, query ...ccv3.Query) <-chan ccv3.RoutesPage
 	UnmapRoute(routeGUID string, destinationGUID string) (ccv3.Warnings, error)
 	UnsharePrivateDomainFromOrg(domainGUID string, sharedOrgGUID string) (ccv3.Warnings, error)
 	UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
@@ -99,6 +111,7 @@ type CloudControllerClient interface {
 	UpdateOrganizationDefaultIsolationSegmentRelationship(orgGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	UpdateResourceMetadata(resource string, resourceGUID string, metadata ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error)
+	UpdateServiceInstance(serviceInstanceGUID string, serviceInstance ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
 	UpdateSpace(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
 	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte, query ...ccv3.Query) (ccv3.JobURL, ccv3.Warnings, error)
 	UpdateSpaceIsolationSegmentRelationship(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
@@ -0,0 +1,14 @@
+package v7action
+
+// MakeCloudControllerRequest makes an authenticated request to the given Cloud
+// Controller path, refreshing the access token if necessary. Responses with an
+// error status are returned to the caller rather than treated as errors; an
+// error is only returned when no response was received.
+func (actor Actor) MakeCloudControllerRequest(method string, path string, requestBody []byte) ([]byte, int, error) {
+	responseBody, httpResponse, err := actor.CloudControllerClient.MakeRequestSendReceiveRaw(method, path, requestBody)
+	if httpResponse == nil {
+		return nil, 0, err
+	}
+
+	return responseBody, httpResponse.StatusCode, nil
+}
@@ -0,0 +1,67 @@
+package v7action_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cloud Controller Request Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("MakeCloudControllerRequest", func() {
+		var (
+			body       []byte
+			statusCode int
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			body, statusCode, executeErr = actor.MakeCloudControllerRequest(http.MethodPost, "/v3/apps", []byte(`{"name":"some-app"}`))
+		})
+
+		When("the cloud controller responds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRequestSendReceiveRawReturns(
+					[]byte(`{"errors":[]}`),
+					&http.Response{StatusCode: http.StatusUnprocessableEntity},
+					ccerror.UnprocessableEntityError{Message: "some-message"},
+				)
+			})
+
+			It("returns the body and status code, even for error statuses", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(body).To(Equal([]byte(`{"errors":[]}`)))
+				Expect(statusCode).To(Equal(http.StatusUnprocessableEntity))
+
+				method, path, requestBody := fakeCloudControllerClient.MakeRequestSendReceiveRawArgsForCall(0)
+				Expect(method).To(Equal(http.MethodPost))
+				Expect(path).To(Equal("/v3/apps"))
+				Expect(requestBody).To(Equal([]byte(`{"name":"some-app"}`)))
+			})
+		})
+
+		When("no response is received", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRequestSendReceiveRawReturns(nil, nil, errors.New("connection-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("connection-error"))
+				Expect(statusCode).To(Equal(0))
+			})
+		})
+	})
+})
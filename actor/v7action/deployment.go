@@ -1,6 +1,9 @@
 package v7action
 
 import (
+	"errors"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -8,6 +11,15 @@ import (
 
 type Deployment ccv3.Deployment
 
+// DeploymentSummary is a deployment with the instances of the web processes
+// it is rolling out and of the web processes it is replacing.
+type DeploymentSummary struct {
+	Deployment
+
+	NewProcessSummaries      ProcessSummaries
+	PreviousProcessSummaries ProcessSummaries
+}
+
 func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
 	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
 
@@ -32,6 +44,143 @@ func (actor Actor) GetLatestActiveDeploymentForApp(appGUID string) (Deployment,
 
 	return Deployment(ccDeployments[0]), Warnings(warnings), nil
 }
+
+// GetDeploymentsForApp returns all of the app's deployments, most recent
+// first.
+func (actor Actor) GetDeploymentsForApp(appGUID string) ([]Deployment, Warnings, error) {
+	ccDeployments, warnings, err := actor.CloudControllerClient.GetDeployments(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{"-created_at"}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"5000"}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var deployments []Deployment
+	for _, ccDeployment := range ccDeployments {
+		deployments = append(deployments, Deployment(ccDeployment))
+	}
+
+	return deployments, Warnings(warnings), nil
+}
+
+// GetLatestDeploymentForApp returns the app's most recent deployment,
+// whatever its status.
+func (actor Actor) GetLatestDeploymentForApp(appGUID string) (Deployment, Warnings, error) {
+	deployments, warnings, err := actor.GetDeploymentsForApp(appGUID)
+	if err != nil {
+		return Deployment{}, warnings, err
+	}
+
+	if len(deployments) == 0 {
+		return Deployment{}, warnings, actionerror.AppDeploymentNotFoundError{}
+	}
+
+	return deployments[0], warnings, nil
+}
+
+// GetDeploymentSummary returns the instances of the deployment's new web
+// processes and, while it is still deploying, of the app's previous web
+// processes. Canceled and superseded deployments have no processes left to
+// summarize.
+func (actor Actor) GetDeploymentSummary(deployment Deployment) (DeploymentSummary, Warnings, error) {
+	summary := DeploymentSummary{Deployment: deployment}
+
+	deploying := deployment.StatusValue != constant.DeploymentStatusValueFinalized
+	if !deploying && deployment.StatusReason != constant.DeploymentStatusReasonDeployed {
+		return summary, nil, nil
+	}
+
+	var allWarnings Warnings
+	newProcessGUIDs := map[string]bool{}
+	for _, process := range deployment.NewProcesses {
+		newProcessGUIDs[process.GUID] = true
+
+		processSummary, warnings, err := actor.getProcessSummary(Process(process))
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DeploymentSummary{}, allWarnings, err
+		}
+		summary.NewProcessSummaries = append(summary.NewProcessSummaries, processSummary)
+	}
+
+	if !deploying {
+		return summary, allWarnings, nil
+	}
+
+	appGUID := deployment.Relationships[constant.RelationshipTypeApplication].GUID
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DeploymentSummary{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		if process.Type != constant.ProcessTypeWeb || newProcessGUIDs[process.GUID] {
+			continue
+		}
+
+		processSummary, warnings, err := actor.getProcessSummary(Process(process))
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DeploymentSummary{}, allWarnings, err
+		}
+		summary.PreviousProcessSummaries = append(summary.PreviousProcessSummaries, processSummary)
+	}
+
+	return summary, allWarnings, nil
+}
+
+// PollDeployment polls the deployment until it is finalized, passing each
+// summary of it to handleSummary. It returns an error when the deployment is
+// canceled or superseded, or is still deploying after the startup timeout.
+func (actor Actor) PollDeployment(deploymentGUID string, handleSummary func(DeploymentSummary)) (Warnings, error) {
+	var allWarnings Warnings
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	timeout := actor.Clock.After(actor.Config.StartupTimeout())
+
+	for {
+		select {
+		case <-timeout:
+			return allWarnings, actionerror.DeploymentTimeoutError{}
+		case <-timer.C():
+			ccDeployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			summary, summaryWarnings, err := actor.GetDeploymentSummary(Deployment(ccDeployment))
+			allWarnings = append(allWarnings, summaryWarnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+			handleSummary(summary)
+
+			if ccDeployment.StatusValue == constant.DeploymentStatusValueFinalized {
+				return allWarnings, finalizedDeploymentError(ccDeployment)
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+// finalizedDeploymentError returns an error when a finalized deployment was
+// canceled or superseded rather than deployed.
+func finalizedDeploymentError(deployment ccv3.Deployment) error {
+	switch deployment.StatusReason {
+	case constant.DeploymentStatusReasonCanceled:
+		return errors.New("Deployment has been canceled")
+	case constant.DeploymentStatusReasonSuperseded:
+		return errors.New("Deployment has been superseded")
+	}
+	return nil
+}
+
 func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
 	return Warnings(warnings), err
@@ -2,12 +2,14 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/clock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -16,10 +18,11 @@ var _ = Describe("Deployment Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
-		actor, fakeCloudControllerClient, _, _, _, _ = NewTestActor()
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _ = NewTestActor()
 	})
 
 	Describe("CreateDeployment", func() {
@@ -162,4 +165,286 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDeploymentsForApp", func() {
+		var (
+			deployments []Deployment
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			deployments, warnings, executeErr = actor.GetDeploymentsForApp("some-app-guid")
+		})
+
+		When("the client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]ccv3.Deployment{{GUID: "newer-deployment-guid"}, {GUID: "older-deployment-guid"}},
+					ccv3.Warnings{"get-deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns the app's deployments, most recent first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+				Expect(deployments).To(Equal([]Deployment{{GUID: "newer-deployment-guid"}, {GUID: "older-deployment-guid"}}))
+
+				Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{"-created_at"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"5000"}},
+				))
+			})
+		})
+
+		When("the client fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, errors.New("get-deployments-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-deployments-error"))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+	})
+
+	Describe("GetLatestDeploymentForApp", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.GetLatestDeploymentForApp("some-app-guid")
+		})
+
+		When("the app has deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]ccv3.Deployment{{GUID: "newer-deployment-guid"}, {GUID: "older-deployment-guid"}},
+					ccv3.Warnings{"get-deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns the most recent deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+				Expect(deployment.GUID).To(Equal("newer-deployment-guid"))
+			})
+		})
+
+		When("the app has no deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, nil)
+			})
+
+			It("returns a not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppDeploymentNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+	})
+
+	Describe("GetDeploymentSummary", func() {
+		var (
+			deployment Deployment
+			summary    DeploymentSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			deployment = Deployment{
+				GUID:          "some-deployment-guid",
+				StatusValue:   constant.DeploymentStatusValueDeploying,
+				Relationships: ccv3.Relationships{constant.RelationshipTypeApplication: ccv3.Relationship{GUID: "some-app-guid"}},
+				NewProcesses:  []ccv3.Process{{GUID: "new-web-guid", Type: constant.ProcessTypeWeb}},
+			}
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]ccv3.Process{
+					{GUID: "old-web-guid", Type: constant.ProcessTypeWeb},
+					{GUID: "new-web-guid", Type: constant.ProcessTypeWeb},
+					{GUID: "worker-guid", Type: "worker"},
+				},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+				switch processGUID {
+				case "new-web-guid":
+					return []ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+						{State: constant.ProcessInstanceStarting},
+					}, ccv3.Warnings{"new-instances-warning"}, nil
+				default:
+					return []ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+					}, ccv3.Warnings{"old-instances-warning"}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetDeploymentSummary(deployment)
+		})
+
+		When("the deployment is deploying", func() {
+			It("summarizes the new and previous web processes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("new-instances-warning", "get-processes-warning", "old-instances-warning"))
+
+				Expect(summary.Deployment).To(Equal(deployment))
+				Expect(summary.NewProcessSummaries.String()).To(Equal("web:1/2"))
+				Expect(summary.PreviousProcessSummaries.String()).To(Equal("web:1/1"))
+				Expect(summary.PreviousProcessSummaries[0].GUID).To(Equal("old-web-guid"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the deployment has been deployed", func() {
+			BeforeEach(func() {
+				deployment.StatusValue = constant.DeploymentStatusValueFinalized
+				deployment.StatusReason = constant.DeploymentStatusReasonDeployed
+			})
+
+			It("only summarizes the new web processes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary.NewProcessSummaries.String()).To(Equal("web:1/2"))
+				Expect(summary.PreviousProcessSummaries).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the deployment has been canceled", func() {
+			BeforeEach(func() {
+				deployment.StatusValue = constant.DeploymentStatusValueFinalized
+				deployment.StatusReason = constant.DeploymentStatusReasonCanceled
+			})
+
+			It("does not summarize any processes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary).To(Equal(DeploymentSummary{Deployment: deployment}))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the process instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesStub = nil
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			summaries  []DeploymentSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, clock.NewClock())
+			fakeConfig.StartupTimeoutReturns(10 * time.Second)
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			summaries = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollDeployment("some-deployment-guid", func(summary DeploymentSummary) {
+				summaries = append(summaries, summary)
+			})
+		})
+
+		When("the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+					ccv3.Deployment{GUID: "some-deployment-guid", StatusValue: constant.DeploymentStatusValueDeploying},
+					ccv3.Warnings{"get-deployment-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusValue:  constant.DeploymentStatusValueFinalized,
+						StatusReason: constant.DeploymentStatusReasonDeployed,
+					},
+					ccv3.Warnings{"get-deployment-warning-2"},
+					nil,
+				)
+			})
+
+			It("passes each summary to the handler until the deployment is finalized", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployment-warning-1", "get-deployment-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].StatusValue).To(Equal(constant.DeploymentStatusValueDeploying))
+				Expect(summaries[1].StatusReason).To(Equal(constant.DeploymentStatusReasonDeployed))
+			})
+		})
+
+		When("the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusValue:  constant.DeploymentStatusValueFinalized,
+						StatusReason: constant.DeploymentStatusReasonCanceled,
+					},
+					ccv3.Warnings{"get-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("passes the summary to the handler and returns an error", func() {
+				Expect(executeErr).To(MatchError("Deployment has been canceled"))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				Expect(summaries).To(HaveLen(1))
+			})
+		})
+
+		When("getting the deployment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"get-deployment-warning"}, errors.New("get-deployment-error"))
+			})
+
+			It("returns the error without calling the handler", func() {
+				Expect(executeErr).To(MatchError("get-deployment-error"))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				Expect(summaries).To(BeEmpty())
+			})
+		})
+
+		When("the deployment does not finish before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(5 * time.Millisecond)
+				fakeConfig.PollingIntervalReturns(30 * time.Millisecond)
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid", StatusValue: constant.DeploymentStatusValueDeploying},
+					ccv3.Warnings{"get-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("returns a timeout error", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentTimeoutError{}))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+			})
+		})
+	})
 })
@@ -8,3 +8,14 @@ func (actor Actor) PollUploadBuildpackJob(jobURL ccv3.JobURL) (Warnings, error)
 	warnings, err := actor.CloudControllerClient.PollJob(jobURL)
 	return Warnings(warnings), err
 }
+
+// PollJob waits for the job at the given URL to finish. An empty job URL is
+// treated as a job that has already finished.
+func (actor Actor) PollJob(jobURL ccv3.JobURL) (Warnings, error) {
+	if jobURL == "" {
+		return nil, nil
+	}
+
+	warnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	return Warnings(warnings), err
+}
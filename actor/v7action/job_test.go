@@ -64,4 +64,28 @@ var _ = Describe("Job Actions", func() {
 			})
 		})
 	})
+
+	Describe("PollJob", func() {
+		When("the job URL is empty", func() {
+			It("does not poll", func() {
+				warnings, err := actor.PollJob("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the job URL is set", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-warnings"}, ccerror.V3JobFailedError{Detail: "some-err"})
+			})
+
+			It("polls the job and returns its error and warnings", func() {
+				warnings, err := actor.PollJob("http://example.com/the-job-url")
+				Expect(err).To(MatchError(ccerror.V3JobFailedError{Detail: "some-err"}))
+				Expect(warnings).To(ConsistOf("some-warnings"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("http://example.com/the-job-url")))
+			})
+		})
+	})
 })
@@ -86,3 +86,12 @@ func (actor *Actor) updateResourceMetadata(resourceType string, resourceGUID str
 	_, updateWarnings, err := actor.CloudControllerClient.UpdateResourceMetadata(resourceType, resourceGUID, payload)
 	return append(warnings, updateWarnings...), err
 }
+
+// appendLabelSelectorQuery adds a label_selector query to queries when the
+// label selector is not empty.
+func appendLabelSelectorQuery(queries []ccv3.Query, labelSelector string) []ccv3.Query {
+	if labelSelector == "" {
+		return queries
+	}
+	return append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+}
@@ -0,0 +1,15 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+)
+
+//go:generate counterfeiter . LogCacheClient
+
+// LogCacheClient is a client for reading logs from Log Cache.
+type LogCacheClient interface {
+	Read(sourceID string, options logcache.ReadOptions) ([]logcache.Envelope, error)
+	ReadAll(sourceID string, start time.Time, end time.Time, envelopeTypes ...string) ([]logcache.Envelope, error)
+}
@@ -0,0 +1,69 @@
+package v7action
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogFilter selects log messages. A message matches when it matches every
+// criterion that is set.
+type LogFilter struct {
+	// SourceTypes are source types such as APP, APP/PROC/WEB or RTR. A source
+	// type also matches the source types nested under it, so APP matches
+	// APP/PROC/WEB and APP/TASK/migrate.
+	SourceTypes []string
+	// Instances are source instance indexes.
+	Instances []string
+	// MessageType is either OUT or ERR.
+	MessageType string
+	// Pattern is matched against the message text.
+	Pattern *regexp.Regexp
+	// Since excludes messages logged before it.
+	Since time.Time
+}
+
+// Matches returns true if the log message satisfies the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !filter.matchesSourceType(message.SourceType()) {
+		return false
+	}
+
+	if len(filter.Instances) > 0 && !containsString(filter.Instances, message.SourceInstance()) {
+		return false
+	}
+
+	if filter.MessageType != "" && !strings.EqualFold(filter.MessageType, message.Type()) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	return true
+}
+
+func (filter LogFilter) matchesSourceType(sourceType string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, filterType := range filter.SourceTypes {
+		filterType = strings.ToUpper(filterType)
+		if sourceType == filterType || strings.HasPrefix(sourceType, filterType+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package v7action_test
+
+import (
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	var (
+		now     time.Time
+		message LogMessage
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		message = *NewLogMessage("GET /health 200", 0, now, "APP/PROC/WEB", "1")
+	})
+
+	DescribeTable("Matches",
+		func(filter func() LogFilter, expected bool) {
+			Expect(filter().Matches(message)).To(Equal(expected))
+		},
+
+		Entry("an empty filter matches everything", func() LogFilter { return LogFilter{} }, true),
+		Entry("a parent source type matches", func() LogFilter { return LogFilter{SourceTypes: []string{"app"}} }, true),
+		Entry("the exact source type matches", func() LogFilter { return LogFilter{SourceTypes: []string{"RTR", "APP/PROC/WEB"}} }, true),
+		Entry("a partial source type segment does not match", func() LogFilter { return LogFilter{SourceTypes: []string{"AP"}} }, false),
+		Entry("another source type does not match", func() LogFilter { return LogFilter{SourceTypes: []string{"RTR"}} }, false),
+		Entry("the instance matches", func() LogFilter { return LogFilter{Instances: []string{"0", "1"}} }, true),
+		Entry("another instance does not match", func() LogFilter { return LogFilter{Instances: []string{"0"}} }, false),
+		Entry("the message type matches", func() LogFilter { return LogFilter{MessageType: "err"} }, true),
+		Entry("another message type does not match", func() LogFilter { return LogFilter{MessageType: "OUT"} }, false),
+		Entry("the pattern matches", func() LogFilter { return LogFilter{Pattern: regexp.MustCompile(`/health\s+2\d\d`)} }, true),
+		Entry("the pattern does not match", func() LogFilter { return LogFilter{Pattern: regexp.MustCompile(`5\d\d$`)} }, false),
+		Entry("a message after since matches", func() LogFilter { return LogFilter{Since: now.Add(-time.Minute)} }, true),
+		Entry("a message before since does not match", func() LogFilter { return LogFilter{Since: now.Add(time.Minute)} }, false),
+	)
+})
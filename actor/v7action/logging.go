@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	log "github.com/sirupsen/logrus"
@@ -15,7 +18,16 @@ const StagingLog = "STG"
 
 var flushInterval = 300 * time.Millisecond
 
+// LogStreamReconnectDelay is how long to wait before reconnecting an
+// application's log stream that dropped while tailing several applications.
+const LogStreamReconnectDelay = 5 * time.Second
+
+// MaxLogStreamReconnects is the number of consecutive times an application's
+// log stream is reconnected before the error that dropped it is returned.
+const MaxLogStreamReconnects = 3
+
 type LogMessage struct {
+	appName        string
 	message        string
 	messageType    events.LogMessage_MessageType
 	timestamp      time.Time
@@ -50,6 +62,12 @@ func (log LogMessage) SourceInstance() string {
 	return log.sourceInstance
 }
 
+// AppName returns the name of the application that logged the message. It is
+// only set for messages streamed from several applications.
+func (log LogMessage) AppName() string {
+	return log.appName
+}
+
 func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
 	return &LogMessage{
 		message:        message,
@@ -81,11 +99,93 @@ func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *
 
 	outgoingLogStream, outgoingErrStream := actor.blockOnConnect(ready)
 
-	go actor.streamLogsBetween(incomingLogStream, incomingErrStream, outgoingLogStream, outgoingErrStream)
+	go actor.streamLogsBetween(incomingLogStream, incomingErrStream, outgoingLogStream, outgoingErrStream, nil)
 
 	return outgoingLogStream, outgoingErrStream
 }
 
+// GetStreamingLogsForApplications tails the logs of all the given applications
+// and merges them into one time ordered stream. Each message is tagged with
+// the name of the application that logged it. When an application's stream
+// drops it is reconnected; the error that dropped it is only returned after
+// MaxLogStreamReconnects failed reconnects in a row.
+func (actor Actor) GetStreamingLogsForApplications(apps []Application, client NOAAClient) (<-chan *LogMessage, <-chan error) {
+	log.WithField("apps", len(apps)).Info("Start Tailing Logs")
+
+	ready := actor.setOnConnectBlocker(client)
+
+	incomingLogStream := make(chan *events.LogMessage)
+	incomingErrStream := make(chan error)
+	appNames := map[string]string{}
+
+	var wg sync.WaitGroup
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+
+		wg.Add(1)
+		go func(appGUID string) {
+			defer wg.Done()
+			actor.tailLogsWithReconnect(appGUID, client, incomingLogStream, incomingErrStream)
+		}(app.GUID)
+	}
+
+	go func() {
+		wg.Wait()
+		close(incomingLogStream)
+		close(incomingErrStream)
+	}()
+
+	outgoingLogStream, outgoingErrStream := actor.blockOnConnect(ready)
+
+	go actor.streamLogsBetween(incomingLogStream, incomingErrStream, outgoingLogStream, outgoingErrStream, appNames)
+
+	return outgoingLogStream, outgoingErrStream
+}
+
+// GetStreamingLogsForApplicationsByNamesAndSpace tails the logs of the named
+// applications in the space. See GetStreamingLogsForApplications.
+func (actor Actor) GetStreamingLogsForApplicationsByNamesAndSpace(appNames []string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.NameFilter, Values: appNames},
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	var apps []Application
+	for _, appName := range appNames {
+		app, found := findApplicationByName(ccApps, appName)
+		if !found {
+			return nil, nil, allWarnings, actionerror.ApplicationNotFoundError{Name: appName}
+		}
+		apps = append(apps, actor.convertCCToActorApplication(app))
+	}
+
+	messages, logErrs := actor.GetStreamingLogsForApplications(apps, client)
+
+	return messages, logErrs, allWarnings, nil
+}
+
+// GetStreamingLogsForSpace tails the logs of every application in the space.
+// See GetStreamingLogsForApplications. It returns an
+// ApplicationsNotFoundError if the space has no applications.
+func (actor Actor) GetStreamingLogsForSpace(spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	if len(apps) == 0 {
+		return nil, nil, warnings, actionerror.ApplicationsNotFoundError{}
+	}
+
+	messages, logErrs := actor.GetStreamingLogsForApplications(apps, client)
+
+	return messages, logErrs, warnings, nil
+}
+
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
@@ -97,6 +197,82 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 	return messages, logErrs, allWarnings, err
 }
 
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	noaaMessages, err := client.RecentLogs(app.GUID, actor.Config.AccessToken())
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	noaaMessages = noaa.SortRecent(noaaMessages)
+
+	var logMessages []LogMessage
+
+	for _, message := range noaaMessages {
+		logMessages = append(logMessages, LogMessage{
+			message:        string(message.GetMessage()),
+			messageType:    message.GetMessageType(),
+			timestamp:      time.Unix(0, message.GetTimestamp()),
+			sourceType:     message.GetSourceType(),
+			sourceInstance: message.GetSourceInstance(),
+		})
+	}
+
+	return logMessages, allWarnings, nil
+}
+
+// GetRecentLogsForApplicationByNameAndSpaceFromLogCache returns the
+// application's logs from Log Cache, oldest first. If since is set, every log
+// since then is returned; otherwise the most recent logs a single read allows
+// are returned.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpaceFromLogCache(appName string, spaceGUID string, since time.Time, client LogCacheClient) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var envelopes []logcache.Envelope
+	if since.IsZero() {
+		envelopes, err = client.Read(app.GUID, logcache.ReadOptions{
+			EnvelopeTypes: []string{logcache.EnvelopeTypeLog},
+			Limit:         logcache.MaxReadLimit,
+			Descending:    true,
+		})
+		reverseEnvelopes(envelopes)
+	} else {
+		envelopes, err = client.ReadAll(app.GUID, since, actor.Clock.Now(), logcache.EnvelopeTypeLog)
+	}
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var logMessages []LogMessage
+	for _, envelope := range envelopes {
+		if envelope.Log == nil {
+			continue
+		}
+
+		messageType := events.LogMessage_OUT
+		if envelope.Log.Type == "ERR" {
+			messageType = events.LogMessage_ERR
+		}
+
+		logMessages = append(logMessages, LogMessage{
+			message:        string(envelope.Log.Payload),
+			messageType:    messageType,
+			timestamp:      envelope.Timestamp,
+			sourceType:     envelope.Tags["source_type"],
+			sourceInstance: envelope.InstanceID,
+		})
+	}
+
+	return logMessages, allWarnings, nil
+}
+
 func (actor Actor) blockOnConnect(ready <-chan bool) (chan *LogMessage, chan error) {
 	outgoingLogStream := make(chan *LogMessage)
 	outgoingErrStream := make(chan error, 1)
@@ -141,7 +317,10 @@ func (Actor) setOnConnectBlocker(client NOAAClient) <-chan bool {
 	return ready
 }
 
-func (actor Actor) streamLogsBetween(incomingLogStream <-chan *events.LogMessage, incomingErrStream <-chan error, outgoingLogStream chan<- *LogMessage, outgoingErrStream chan<- error) {
+// streamLogsBetween sorts and flushes the incoming log events to the outgoing
+// log stream. appNames maps application GUIDs to the names messages are tagged
+// with; it is nil when streaming a single application.
+func (actor Actor) streamLogsBetween(incomingLogStream <-chan *events.LogMessage, incomingErrStream <-chan error, outgoingLogStream chan<- *LogMessage, outgoingErrStream chan<- error, appNames map[string]string) {
 	log.Info("Processing Log Stream")
 
 	defer close(outgoingLogStream)
@@ -166,6 +345,7 @@ dance:
 			}
 
 			logsToBeSorted = append(logsToBeSorted, &LogMessage{
+				appName:        appNames[event.GetAppId()],
 				message:        string(event.GetMessage()),
 				messageType:    event.GetMessageType(),
 				timestamp:      time.Unix(0, event.GetTimestamp()),
@@ -197,3 +377,94 @@ dance:
 		}
 	}
 }
+
+// tailLogsWithReconnect forwards the application's log events and errors until
+// its stream is closed by the client. A stream that drops with an error is
+// reconnected after LogStreamReconnectDelay; once MaxLogStreamReconnects
+// reconnects in a row have failed, the error is forwarded instead.
+func (actor Actor) tailLogsWithReconnect(appGUID string, client NOAAClient, logStream chan<- *events.LogMessage, errStream chan<- error) {
+	var reconnects int
+	for {
+		incomingLogStream, incomingErrStream := client.TailingLogs(appGUID, actor.Config.AccessToken())
+		received, dropErr := forwardAppLogs(appGUID, incomingLogStream, incomingErrStream, logStream, errStream)
+		if dropErr == nil {
+			return
+		}
+
+		if received {
+			reconnects = 0
+		}
+
+		if reconnects == MaxLogStreamReconnects {
+			errStream <- dropErr
+			return
+		}
+		reconnects++
+
+		log.WithFields(log.Fields{
+			"appGUID": appGUID,
+			"attempt": reconnects,
+		}).Errorf("log stream dropped, reconnecting: %s", dropErr)
+		<-actor.Clock.After(LogStreamReconnectDelay)
+	}
+}
+
+// forwardAppLogs forwards the application's log events until both incoming
+// streams are closed. Events are tagged with the application's GUID if they
+// are missing it. Retry errors are forwarded as is; any other error is
+// returned as the reason the stream dropped.
+func forwardAppLogs(appGUID string, incomingLogStream <-chan *events.LogMessage, incomingErrStream <-chan error, logStream chan<- *events.LogMessage, errStream chan<- error) (bool, error) {
+	var (
+		received               bool
+		dropErr                error
+		eventClosed, errClosed bool
+	)
+
+	for !eventClosed || !errClosed {
+		select {
+		case event, ok := <-incomingLogStream:
+			if !ok {
+				eventClosed = true
+				incomingLogStream = nil
+				break
+			}
+
+			if event.AppId == nil {
+				event.AppId = &appGUID
+			}
+			received = true
+			logStream <- event
+		case err, ok := <-incomingErrStream:
+			if !ok {
+				errClosed = true
+				incomingErrStream = nil
+				break
+			}
+
+			switch err.(type) {
+			case nil:
+			case noaaErrors.RetryError:
+				errStream <- err
+			default:
+				dropErr = err
+			}
+		}
+	}
+
+	return received, dropErr
+}
+
+func findApplicationByName(apps []ccv3.Application, appName string) (ccv3.Application, bool) {
+	for _, app := range apps {
+		if app.Name == appName {
+			return app, true
+		}
+	}
+	return ccv3.Application{}, false
+}
+
+func reverseEnvelopes(envelopes []logcache.Envelope) {
+	for i, j := 0, len(envelopes)-1; i < j; i, j = i+1, j-1 {
+		envelopes[i], envelopes[j] = envelopes[j], envelopes[i]
+	}
+}
@@ -8,6 +8,8 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/clock/fakeclock"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
@@ -20,10 +22,11 @@ var _ = Describe("Logging Actions", func() {
 		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
 		fakeConfig                *v7actionfakes.FakeConfig
 		fakeNOAAClient            *v7actionfakes.FakeNOAAClient
+		fakeClock                 *fakeclock.FakeClock
 	)
 
 	BeforeEach(func() {
-		actor, fakeCloudControllerClient, fakeConfig, _, _, _ = NewTestActor()
+		actor, fakeCloudControllerClient, fakeConfig, _, _, fakeClock = NewTestActor()
 		fakeNOAAClient = new(v7actionfakes.FakeNOAAClient)
 		fakeConfig.AccessTokenReturns("AccessTokenForTest")
 	})
@@ -316,6 +319,206 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsForApplicationByNameAndSpace", func() {
+		When("the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+
+				outMessage := events.LogMessage_OUT
+				errMessage := events.LogMessage_ERR
+				ts1 := int64(10)
+				ts2 := int64(20)
+				sourceType := "some-source-type"
+				sourceInstance := "some-source-instance"
+
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					{
+						Message:        []byte("message-2"),
+						MessageType:    &errMessage,
+						Timestamp:      &ts2,
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					},
+					{
+						Message:        []byte("message-1"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts1,
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					},
+				}, nil)
+			})
+
+			It("returns the recent logs sorted by timestamp", func() {
+				messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				appGUID, authToken := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(authToken).To(Equal("AccessTokenForTest"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[0].Type()).To(Equal("OUT"))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+				Expect(messages[0].SourceType()).To(Equal("some-source-type"))
+				Expect(messages[0].SourceInstance()).To(Equal("some-source-instance"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+				Expect(messages[1].Type()).To(Equal("ERR"))
+			})
+
+			When("getting the recent logs errors", func() {
+				BeforeEach(func() {
+					fakeNOAAClient.RecentLogsReturns(nil, errors.New("some-recent-logs-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+					Expect(err).To(MatchError("some-recent-logs-error"))
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+				})
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRecentLogsForApplicationByNameAndSpaceFromLogCache", func() {
+		var (
+			fakeLogCacheClient *v7actionfakes.FakeLogCacheClient
+			since              time.Time
+
+			messages   []LogMessage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeLogCacheClient = new(v7actionfakes.FakeLogCacheClient)
+			since = time.Time{}
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"some-app-warnings"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetRecentLogsForApplicationByNameAndSpaceFromLogCache("some-app", "some-space-guid", since, fakeLogCacheClient)
+		})
+
+		When("no start time is given", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]logcache.Envelope{
+					{
+						Timestamp:  time.Unix(0, 20),
+						InstanceID: "1",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &logcache.Log{Payload: []byte("message-2"), Type: "ERR"},
+					},
+					{Timestamp: time.Unix(0, 15)},
+					{
+						Timestamp:  time.Unix(0, 10),
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "RTR"},
+						Log:        &logcache.Log{Payload: []byte("message-1"), Type: "OUT"},
+					},
+				}, nil)
+			})
+
+			It("reads the most recent logs and returns them oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				sourceID, options := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(options).To(Equal(logcache.ReadOptions{
+					EnvelopeTypes: []string{logcache.EnvelopeTypeLog},
+					Limit:         logcache.MaxReadLimit,
+					Descending:    true,
+				}))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[0].Type()).To(Equal("OUT"))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+				Expect(messages[0].SourceType()).To(Equal("RTR"))
+				Expect(messages[0].SourceInstance()).To(Equal("0"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+				Expect(messages[1].Type()).To(Equal("ERR"))
+				Expect(messages[1].SourceType()).To(Equal("APP/PROC/WEB"))
+				Expect(messages[1].SourceInstance()).To(Equal("1"))
+			})
+		})
+
+		When("a start time is given", func() {
+			BeforeEach(func() {
+				since = time.Unix(100, 0)
+				fakeLogCacheClient.ReadAllReturns([]logcache.Envelope{
+					{
+						Timestamp: time.Unix(101, 0),
+						Log:       &logcache.Log{Payload: []byte("message-1"), Type: "OUT"},
+					},
+				}, nil)
+			})
+
+			It("reads every log since then", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeLogCacheClient.ReadAllCallCount()).To(Equal(1))
+				sourceID, start, end, envelopeTypes := fakeLogCacheClient.ReadAllArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(since))
+				Expect(end).To(Equal(fakeClock.Now()))
+				Expect(envelopeTypes).To(ConsistOf(logcache.EnvelopeTypeLog))
+
+				Expect(messages).To(HaveLen(1))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+			})
+		})
+
+		When("reading from Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("ZOMG"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("ZOMG"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		When("the application can be found", func() {
 			var (
@@ -436,4 +639,214 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStreamingLogsForApplications", func() {
+		var (
+			apps []Application
+
+			messages <-chan *LogMessage
+			errs     <-chan error
+		)
+
+		newEvent := func(appGUID string, message string, timestamp int64) *events.LogMessage {
+			messageType := events.LogMessage_OUT
+			sourceType := "APP/PROC/WEB"
+			sourceInstance := "0"
+			event := &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &messageType,
+				Timestamp:      &timestamp,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+			if appGUID != "" {
+				event.AppId = &appGUID
+			}
+			return event
+		}
+
+		BeforeEach(func() {
+			apps = []Application{
+				{Name: "app-1", GUID: "app-guid-1"},
+				{Name: "app-2", GUID: "app-guid-2"},
+			}
+			fakeConfig.DialTimeoutReturns(60 * time.Minute)
+		})
+
+		JustBeforeEach(func() {
+			messages, errs = actor.GetStreamingLogsForApplications(apps, fakeNOAAClient)
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		When("every application streams logs", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+					Expect(authToken).To(Equal("AccessTokenForTest"))
+					onConnectOrOnRetry := fakeNOAAClient.SetOnConnectCallbackArgsForCall(0)
+
+					eventStream := make(chan *events.LogMessage)
+					errStream := make(chan error, 1)
+
+					go func() {
+						defer close(eventStream)
+						defer close(errStream)
+						onConnectOrOnRetry()
+
+						if appGUID == "app-guid-1" {
+							eventStream <- newEvent("app-guid-1", "message-1", 30)
+							eventStream <- newEvent("", "message-2", 10)
+						} else {
+							eventStream <- newEvent("app-guid-2", "message-3", 20)
+						}
+					}()
+
+					return eventStream, errStream
+				}
+			})
+
+			It("merges the logs into one time ordered stream tagged with the app names", func() {
+				var message *LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("message-2"))
+				Expect(message.AppName()).To(Equal("app-1"))
+
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("message-3"))
+				Expect(message.AppName()).To(Equal("app-2"))
+
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("message-1"))
+				Expect(message.AppName()).To(Equal("app-1"))
+
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				Expect(fakeNOAAClient.SetOnConnectCallbackCallCount()).To(Equal(1))
+			})
+		})
+
+		When("an application's stream drops", func() {
+			BeforeEach(func() {
+				apps = apps[:1]
+
+				fakeNOAAClient.TailingLogsStub = func(appGUID string, _ string) (<-chan *events.LogMessage, <-chan error) {
+					onConnectOrOnRetry := fakeNOAAClient.SetOnConnectCallbackArgsForCall(0)
+					attempt := fakeNOAAClient.TailingLogsCallCount()
+
+					eventStream := make(chan *events.LogMessage)
+					errStream := make(chan error, 1)
+
+					go func() {
+						defer close(eventStream)
+						defer close(errStream)
+						onConnectOrOnRetry()
+
+						if attempt == 1 {
+							errStream <- errors.New("dropped")
+							return
+						}
+						eventStream <- newEvent(appGUID, "message-1", 10)
+					}()
+
+					return eventStream, errStream
+				}
+			})
+
+			It("reconnects the stream after a delay", func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(1))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(1))
+				fakeClock.Increment(LogStreamReconnectDelay)
+
+				var message *LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("message-1"))
+				Expect(message.AppName()).To(Equal("app-1"))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				Consistently(errs).ShouldNot(Receive())
+			})
+		})
+
+		When("reconnecting keeps failing", func() {
+			BeforeEach(func() {
+				apps = apps[:1]
+
+				fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+					fakeNOAAClient.SetOnConnectCallbackArgsForCall(0)()
+
+					eventStream := make(chan *events.LogMessage)
+					errStream := make(chan error, 1)
+					errStream <- errors.New("dropped")
+					close(eventStream)
+					close(errStream)
+					return eventStream, errStream
+				}
+			})
+
+			It("returns the error once the reconnects are exhausted", func() {
+				for i := 0; i < MaxLogStreamReconnects; i++ {
+					fakeClock.WaitForWatcherAndIncrement(LogStreamReconnectDelay)
+				}
+
+				Eventually(errs).Should(Receive(MatchError("dropped")))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(MaxLogStreamReconnects + 1))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApplicationsByNamesAndSpace", func() {
+		When("one of the applications cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "app-1", GUID: "app-guid-1"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotFoundError for it and warnings", func() {
+				_, _, warnings, err := actor.GetStreamingLogsForApplicationsByNamesAndSpace([]string{"app-1", "app-2"}, "some-space-guid", fakeNOAAClient)
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "app-2"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"app-1", "app-2"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the applications errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("ZOMG"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, _, warnings, err := actor.GetStreamingLogsForApplicationsByNamesAndSpace([]string{"app-1"}, "some-space-guid", fakeNOAAClient)
+				Expect(err).To(MatchError("ZOMG"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForSpace", func() {
+		When("the space has no applications", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationsNotFoundError and warnings", func() {
+				_, _, warnings, err := actor.GetStreamingLogsForSpace("some-space-guid", fakeNOAAClient)
+				Expect(err).To(MatchError(actionerror.ApplicationsNotFoundError{}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -16,10 +16,15 @@ type Organization struct {
 	Metadata *Metadata
 }
 
-func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
-	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations(
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
-	)
+// GetOrganizations returns the organizations whose labels match the label
+// selector, or all organizations when the label selector is empty.
+func (actor Actor) GetOrganizations(labelSelector string) ([]Organization, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
+
+	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations(queries...)
 	if err != nil {
 		return []Organization{}, Warnings(warnings), err
 	}
@@ -39,9 +39,12 @@ var _ = Describe("Organization Actions", func() {
 
 			warnings   Warnings
 			executeErr error
+
+			labelSelector string
 		)
 
 		BeforeEach(func() {
+			labelSelector = ""
 			ccv3Organizations = []ccv3.Organization{
 				{Name: organization1Name, GUID: organization1GUID},
 				{Name: organization2Name, GUID: organization2GUID},
@@ -50,7 +53,7 @@ var _ = Describe("Organization Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			organizations, warnings, executeErr = actor.GetOrganizations()
+			organizations, warnings, executeErr = actor.GetOrganizations(labelSelector)
 		})
 
 		When("the API layer call is successful", func() {
@@ -81,6 +84,20 @@ var _ = Describe("Organization Actions", func() {
 				Expect(warnings).To(ConsistOf("some-organizations-warning"))
 
 			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the organizations by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal([]ccv3.Query{
+						{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+						{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					}))
+				})
+			})
 		})
 
 		When("when the API layer call returns an error", func() {
@@ -27,6 +27,7 @@ type Route struct {
 	DomainName string
 	SpaceName  string
 	URL        string
+	Metadata   *Metadata
 }
 
 type RouteSummary struct {
@@ -101,38 +102,22 @@ func (actor Actor) GetRouteDestinationByAppGUID(routeGUID string, appGUID string
 	}
 }
 
-func (actor Actor) GetRoutesBySpace(spaceGUID string) ([]Route, Warnings, error) {
-	allWarnings := Warnings{}
-
-	routes, warnings, err := actor.CloudControllerClient.GetRoutes(
-		ccv3.Query{
-			Key:    ccv3.SpaceGUIDFilter,
-			Values: []string{spaceGUID},
-		},
-	)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return nil, allWarnings, err
+func (actor Actor) GetRoutesBySpace(spaceGUID string, labelSelector string) ([]Route, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
 	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
 
-	ret, actor_warnings, err := actor.createActionRoutes(routes, allWarnings)
-
-	return ret, actor_warnings, err
+	return actor.streamActionRoutes(queries)
 }
 
-func (actor Actor) GetRoutesByOrg(orgGUID string) ([]Route, Warnings, error) {
-	allWarnings := Warnings{}
-
-	routes, warnings, err := actor.CloudControllerClient.GetRoutes(ccv3.Query{
-		Key:    ccv3.OrganizationGUIDFilter,
-		Values: []string{orgGUID},
-	})
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return nil, allWarnings, err
+func (actor Actor) GetRoutesByOrg(orgGUID string, labelSelector string) ([]Route, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
 	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
 
-	return actor.createActionRoutes(routes, allWarnings)
+	return actor.streamActionRoutes(queries)
 }
 
 func (actor Actor) GetRouteSummaries(routes []Route) ([]RouteSummary, Warnings, error) {
@@ -281,9 +266,43 @@ func (actor Actor) GetRouteByAttributes(domainName string, domainGUID string, ho
 		Path:       ccRoutes[0].Path,
 		SpaceGUID:  ccRoutes[0].SpaceGUID,
 		DomainGUID: ccRoutes[0].DomainGUID,
+		Metadata:   (*Metadata)(ccRoutes[0].Metadata),
 	}, Warnings(ccWarnings), nil
 }
 
+// GetRouteByURL returns the route for a URL of the form [HOST.]DOMAIN[/PATH].
+// The URL's host name is first looked up as a domain, for routes without a
+// host, before its first label is split off as the host.
+func (actor Actor) GetRouteByURL(routeURL string) (Route, Warnings, error) {
+	hostName, path := routeURL, ""
+	if index := strings.Index(routeURL, "/"); index >= 0 {
+		hostName, path = routeURL[:index], routeURL[index:]
+	}
+
+	host, domainName := "", hostName
+	domain, allWarnings, err := actor.GetDomainByName(domainName)
+	if _, ok := err.(actionerror.DomainNotFoundError); ok {
+		if parts := strings.SplitN(hostName, ".", 2); len(parts) == 2 {
+			var warnings Warnings
+			host, domainName = parts[0], parts[1]
+			domain, warnings, err = actor.GetDomainByName(domainName)
+			allWarnings = append(allWarnings, warnings...)
+		}
+	}
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	route, warnings, err := actor.GetRouteByAttributes(domainName, domain.GUID, host, path)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	route.DomainName = domainName
+	return route, allWarnings, nil
+}
+
 func (actor Actor) MapRoute(routeGUID string, appGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.MapRoute(routeGUID, appGUID)
 	return Warnings(warnings), err
@@ -310,6 +329,33 @@ func (actor Actor) GetApplicationRoutes(appGUID string) ([]Route, Warnings, erro
 	return actor.createActionRoutes(routes, allWarnings)
 }
 
+// streamActionRoutes returns the routes matching the queries. Each page of
+// routes is converted as soon as it arrives, while the remaining pages are
+// still being fetched.
+func (actor Actor) streamActionRoutes(queries []ccv3.Query) ([]Route, Warnings, error) {
+	var allWarnings Warnings
+	allRoutes := []Route{}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	for page := range actor.CloudControllerClient.StreamRoutes(done, queries...) {
+		allWarnings = append(allWarnings, page.Warnings...)
+		if page.Err != nil {
+			return nil, allWarnings, page.Err
+		}
+
+		routes, warnings, err := actor.createActionRoutes(page.Routes, nil)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		allRoutes = append(allRoutes, routes...)
+	}
+
+	return allRoutes, allWarnings, nil
+}
+
 func (actor Actor) createActionRoutes(routes []ccv3.Route, allWarnings Warnings) ([]Route, Warnings, error) {
 	spaceGUIDsSet := map[string]struct{}{}
 	spacesQuery := ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{}}
@@ -343,6 +389,7 @@ func (actor Actor) createActionRoutes(routes []ccv3.Route, allWarnings Warnings)
 			URL:        route.URL,
 			SpaceName:  spacesByGUID[route.SpaceGUID].Name,
 			DomainName: getDomainName(route.URL, route.Host, route.Path),
+			Metadata:   (*Metadata)(route.Metadata),
 		})
 	}
 
@@ -144,12 +144,14 @@ var _ = Describe("Route Actions", func() {
 
 	Describe("GetRoutesBySpace", func() {
 		var (
-			routes     []Route
-			warnings   Warnings
-			executeErr error
+			routes        []Route
+			warnings      Warnings
+			executeErr    error
+			labelSelector string
 		)
 
 		BeforeEach(func() {
+			labelSelector = ""
 			fakeCloudControllerClient.GetDomainsReturns(
 				[]ccv3.Domain{
 					{Name: "domain1-name", GUID: "domain1-guid"},
@@ -167,19 +169,20 @@ var _ = Describe("Route Actions", func() {
 				nil,
 			)
 
-			fakeCloudControllerClient.GetRoutesReturns(
-				[]ccv3.Route{
-					{GUID: "route1-guid", SpaceGUID: "space-guid", DomainGUID: "domain1-guid", Host: "hostname", URL: "hostname.domain1-name"},
-					{GUID: "route2-guid", SpaceGUID: "space-guid", DomainGUID: "domain2-guid", Path: "/my-path", URL: "domain2-name/my-path"},
-					{GUID: "route3-guid", SpaceGUID: "space-guid", DomainGUID: "domain1-guid", URL: "domain1-name"},
+			fakeCloudControllerClient.StreamRoutesReturns(routesPagesOf(
+				ccv3.RoutesPage{
+					Routes: []ccv3.Route{
+						{GUID: "route1-guid", SpaceGUID: "space-guid", DomainGUID: "domain1-guid", Host: "hostname", URL: "hostname.domain1-name"},
+						{GUID: "route2-guid", SpaceGUID: "space-guid", DomainGUID: "domain2-guid", Path: "/my-path", URL: "domain2-name/my-path"},
+						{GUID: "route3-guid", SpaceGUID: "space-guid", DomainGUID: "domain1-guid", URL: "domain1-name"},
+					},
+					Warnings: ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
 				},
-				ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
-				nil,
-			)
+			))
 		})
 
 		JustBeforeEach(func() {
-			routes, warnings, executeErr = actor.GetRoutesBySpace("space-guid")
+			routes, warnings, executeErr = actor.GetRoutesBySpace("space-guid", labelSelector)
 		})
 
 		When("the API layer calls are successful", func() {
@@ -198,22 +201,39 @@ var _ = Describe("Route Actions", func() {
 				Expect(query[0].Key).To(Equal(ccv3.GUIDFilter))
 				Expect(query[0].Values).To(ConsistOf("space-guid"))
 
-				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
-				query = fakeCloudControllerClient.GetRoutesArgsForCall(0)
+				Expect(fakeCloudControllerClient.StreamRoutesCallCount()).To(Equal(1))
+				_, query = fakeCloudControllerClient.StreamRoutesArgsForCall(0)
 				Expect(query).To(HaveLen(1))
 				Expect(query[0].Key).To(Equal(ccv3.SpaceGUIDFilter))
 				Expect(query[0].Values).To(ConsistOf("space-guid"))
 			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the routes by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, query := fakeCloudControllerClient.StreamRoutesArgsForCall(0)
+					Expect(query).To(Equal([]ccv3.Query{
+						{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+						{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					}))
+				})
+			})
 		})
 
 		When("getting routes fails", func() {
 			var err = errors.New("failed to get route")
 
 			BeforeEach(func() {
-				fakeCloudControllerClient.GetRoutesReturns(
-					nil,
-					ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
-					err)
+				fakeCloudControllerClient.StreamRoutesReturns(routesPagesOf(
+					ccv3.RoutesPage{
+						Warnings: ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
+						Err:      err,
+					},
+				))
 			})
 
 			It("returns the error and any warnings", func() {
@@ -222,6 +242,31 @@ var _ = Describe("Route Actions", func() {
 			})
 		})
 
+		When("the routes span several pages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.StreamRoutesReturns(routesPagesOf(
+					ccv3.RoutesPage{
+						Routes:   []ccv3.Route{{GUID: "route1-guid", SpaceGUID: "space-guid", URL: "domain1-name"}},
+						Warnings: ccv3.Warnings{"page-1-warning"},
+					},
+					ccv3.RoutesPage{
+						Routes:   []ccv3.Route{{GUID: "route2-guid", SpaceGUID: "space-guid", URL: "domain2-name"}},
+						Warnings: ccv3.Warnings{"page-2-warning"},
+					},
+				))
+			})
+
+			It("converts the routes of every page in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(Equal([]Route{
+					{GUID: "route1-guid", SpaceGUID: "space-guid", DomainName: "domain1-name", SpaceName: "space-name", URL: "domain1-name"},
+					{GUID: "route2-guid", SpaceGUID: "space-guid", DomainName: "domain2-name", SpaceName: "space-name", URL: "domain2-name"},
+				}))
+				Expect(warnings).To(ConsistOf("page-1-warning", "get-spaces-warning", "page-2-warning", "get-spaces-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+			})
+		})
+
 		When("getting spaces fails", func() {
 			var err = errors.New("failed to get spaces")
 
@@ -243,12 +288,14 @@ var _ = Describe("Route Actions", func() {
 
 	Describe("GetRoutesByOrg", func() {
 		var (
-			routes     []Route
-			warnings   Warnings
-			executeErr error
+			routes        []Route
+			warnings      Warnings
+			executeErr    error
+			labelSelector string
 		)
 
 		BeforeEach(func() {
+			labelSelector = ""
 			fakeCloudControllerClient.GetDomainsReturns(
 				[]ccv3.Domain{
 					{Name: "domain1-name", GUID: "domain1-guid"},
@@ -267,19 +314,20 @@ var _ = Describe("Route Actions", func() {
 				nil,
 			)
 
-			fakeCloudControllerClient.GetRoutesReturns(
-				[]ccv3.Route{
-					{GUID: "route1-guid", SpaceGUID: "space1-guid", URL: "hostname.domain1-name", DomainGUID: "domain1-guid", Host: "hostname"},
-					{GUID: "route2-guid", SpaceGUID: "space2-guid", URL: "domain2-name/my-path", DomainGUID: "domain2-guid", Path: "/my-path"},
-					{GUID: "route3-guid", SpaceGUID: "space1-guid", URL: "domain1-name", DomainGUID: "domain1-guid"},
+			fakeCloudControllerClient.StreamRoutesReturns(routesPagesOf(
+				ccv3.RoutesPage{
+					Routes: []ccv3.Route{
+						{GUID: "route1-guid", SpaceGUID: "space1-guid", URL: "hostname.domain1-name", DomainGUID: "domain1-guid", Host: "hostname"},
+						{GUID: "route2-guid", SpaceGUID: "space2-guid", URL: "domain2-name/my-path", DomainGUID: "domain2-guid", Path: "/my-path"},
+						{GUID: "route3-guid", SpaceGUID: "space1-guid", URL: "domain1-name", DomainGUID: "domain1-guid"},
+					},
+					Warnings: ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
 				},
-				ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
-				nil,
-			)
+			))
 		})
 
 		JustBeforeEach(func() {
-			routes, warnings, executeErr = actor.GetRoutesByOrg("org-guid")
+			routes, warnings, executeErr = actor.GetRoutesByOrg("org-guid", labelSelector)
 		})
 
 		When("the API layer calls are successful", func() {
@@ -315,8 +363,8 @@ var _ = Describe("Route Actions", func() {
 				Expect(warnings).To(ConsistOf("get-route-warning-1", "get-route-warning-2", "get-spaces-warning"))
 				Expect(executeErr).ToNot(HaveOccurred())
 
-				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
-				query := fakeCloudControllerClient.GetRoutesArgsForCall(0)
+				Expect(fakeCloudControllerClient.StreamRoutesCallCount()).To(Equal(1))
+				_, query := fakeCloudControllerClient.StreamRoutesArgsForCall(0)
 				Expect(query).To(HaveLen(1))
 				Expect(query[0].Key).To(Equal(ccv3.OrganizationGUIDFilter))
 				Expect(query[0].Values).To(ConsistOf("org-guid"))
@@ -327,16 +375,33 @@ var _ = Describe("Route Actions", func() {
 				Expect(query[0].Key).To(Equal(ccv3.GUIDFilter))
 				Expect(query[0].Values).To(ConsistOf("space1-guid", "space2-guid"))
 			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the routes by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, query := fakeCloudControllerClient.StreamRoutesArgsForCall(0)
+					Expect(query).To(Equal([]ccv3.Query{
+						{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+						{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					}))
+				})
+			})
 		})
 
 		When("getting routes fails", func() {
 			var err = errors.New("failed to get route")
 
 			BeforeEach(func() {
-				fakeCloudControllerClient.GetRoutesReturns(
-					nil,
-					ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
-					err)
+				fakeCloudControllerClient.StreamRoutesReturns(routesPagesOf(
+					ccv3.RoutesPage{
+						Warnings: ccv3.Warnings{"get-route-warning-1", "get-route-warning-2"},
+						Err:      err,
+					},
+				))
 			})
 
 			It("returns the error and any warnings", func() {
@@ -904,6 +969,125 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetRouteByURL", func() {
+		var (
+			routeURL string
+
+			executeErr error
+			warnings   Warnings
+			route      Route
+		)
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.GetRouteByURL(routeURL)
+		})
+
+		When("the URL's host name is a domain", func() {
+			BeforeEach(func() {
+				routeURL = "example.com/some-path"
+
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{{Name: "example.com", GUID: "domain-guid"}},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "route-guid", DomainGUID: "domain-guid", Path: "/some-path"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("returns the route without a host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+				Expect(route).To(Equal(Route{
+					GUID:       "route-guid",
+					DomainGUID: "domain-guid",
+					DomainName: "example.com",
+					Path:       "/some-path",
+				}))
+
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{""}},
+					ccv3.Query{Key: ccv3.PathsFilter, Values: []string{"/some-path"}},
+				))
+			})
+		})
+
+		When("the URL's host name includes a host", func() {
+			BeforeEach(func() {
+				routeURL = "some-host.example.com"
+
+				fakeCloudControllerClient.GetDomainsReturnsOnCall(0, nil, ccv3.Warnings{"get-domains-warning-1"}, nil)
+				fakeCloudControllerClient.GetDomainsReturnsOnCall(1,
+					[]ccv3.Domain{{Name: "example.com", GUID: "domain-guid"}},
+					ccv3.Warnings{"get-domains-warning-2"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "route-guid", DomainGUID: "domain-guid", Host: "some-host"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("looks up the rest of the host name as the domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning-1", "get-domains-warning-2", "get-routes-warning"))
+				Expect(route.GUID).To(Equal("route-guid"))
+				Expect(route.DomainName).To(Equal("example.com"))
+
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-host.example.com"}},
+				))
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(1)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"example.com"}},
+				))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{"some-host"}},
+					ccv3.Query{Key: ccv3.PathsFilter, Values: []string{""}},
+				))
+			})
+		})
+
+		When("no domain matches the URL", func() {
+			BeforeEach(func() {
+				routeURL = "some-host.example.com"
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, nil)
+			})
+
+			It("returns a domain not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-domains-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				routeURL = "example.com"
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{{Name: "example.com", GUID: "domain-guid"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a route not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{
+					DomainName: "example.com",
+					DomainGUID: "domain-guid",
+				}))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
 	Describe("MapRoute", func() {
 		var (
 			routeGUID string
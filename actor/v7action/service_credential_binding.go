@@ -0,0 +1,221 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// ServiceCredentialBinding represents either a binding between an app and a
+// service instance, or a service key.
+type ServiceCredentialBinding ccv3.ServiceCredentialBinding
+
+// ServiceCredentialBindingDetails are the credentials of a service
+// credential binding.
+type ServiceCredentialBindingDetails ccv3.ServiceCredentialBindingDetails
+
+// BindServiceInstanceToApp binds the service instance with the given name to
+// the app with the given name. bindingName defaults to the service instance
+// name when empty. The returned job URL can be polled to wait for the service
+// broker to finish creating the binding.
+func (actor Actor) BindServiceInstanceToApp(appName string, serviceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (ccv3.JobURL, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, ccWarnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(ccv3.ServiceCredentialBinding{
+		Type:                constant.AppBinding,
+		Name:                bindingName,
+		ServiceInstanceGUID: serviceInstance.GUID,
+		AppGUID:             app.GUID,
+		Parameters:          parameters,
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// UnbindServiceInstanceFromApp deletes the binding between the app and the
+// service instance with the given names. The returned job URL can be polled
+// to wait for the service broker to finish deleting the binding.
+func (actor Actor) UnbindServiceInstanceFromApp(appName string, serviceInstanceName string, spaceGUID string) (ccv3.JobURL, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	bindings, ccWarnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.AppBinding)}},
+		ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstance.GUID}},
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	if len(bindings) == 0 {
+		return "", allWarnings, actionerror.ServiceBindingNotFoundError{
+			AppGUID:             app.GUID,
+			ServiceInstanceGUID: serviceInstance.GUID,
+		}
+	}
+
+	jobURL, ccWarnings, err := actor.CloudControllerClient.DeleteServiceCredentialBinding(bindings[0].GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// GetBoundServiceInstanceNamesByApplication returns the names of the service
+// instances bound to the app with the given GUID, sorted alphabetically.
+func (actor Actor) GetBoundServiceInstanceNamesByApplication(appGUID string) ([]string, Warnings, error) {
+	bindings, allWarnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.AppBinding)}},
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+	)
+	if err != nil || len(bindings) == 0 {
+		return nil, Warnings(allWarnings), err
+	}
+
+	var serviceInstanceGUIDs []string
+	for _, binding := range bindings {
+		serviceInstanceGUIDs = append(serviceInstanceGUIDs, binding.ServiceInstanceGUID)
+	}
+
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: serviceInstanceGUIDs},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	var names []string
+	for _, serviceInstance := range serviceInstances {
+		names = append(names, serviceInstance.Name)
+	}
+	sort.Slice(names, func(i, j int) bool { return sorting.LessIgnoreCase(names[i], names[j]) })
+
+	return names, Warnings(allWarnings), nil
+}
+
+// CreateServiceKey creates a service key with the given name for the service
+// instance with the given name. The returned job URL can be polled to wait for
+// the service broker to finish creating the key.
+func (actor Actor) CreateServiceKey(serviceInstanceName string, serviceKeyName string, spaceGUID string, parameters map[string]interface{}) (ccv3.JobURL, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(ccv3.ServiceCredentialBinding{
+		Type:                constant.KeyBinding,
+		Name:                serviceKeyName,
+		ServiceInstanceGUID: serviceInstance.GUID,
+		Parameters:          parameters,
+	})
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// DeleteServiceKeyByServiceInstanceAndName deletes the service key with the
+// given name of the service instance with the given name. The returned job
+// URL can be polled to wait for the service broker to finish deleting the
+// key.
+func (actor Actor) DeleteServiceKeyByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (ccv3.JobURL, Warnings, error) {
+	serviceKey, allWarnings, err := actor.GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteServiceCredentialBinding(serviceKey.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// GetServiceKeyByServiceInstanceAndName returns the service key with the
+// given name of the service instance with the given name.
+func (actor Actor) GetServiceKeyByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (ServiceCredentialBinding, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceCredentialBinding{}, allWarnings, err
+	}
+
+	keys, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.KeyBinding)}},
+		ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstance.GUID}},
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{serviceKeyName}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceCredentialBinding{}, allWarnings, err
+	}
+
+	if len(keys) == 0 {
+		return ServiceCredentialBinding{}, allWarnings, actionerror.ServiceKeyNotFoundError{
+			Name:                serviceKeyName,
+			ServiceInstanceName: serviceInstanceName,
+		}
+	}
+
+	return ServiceCredentialBinding(keys[0]), allWarnings, nil
+}
+
+// GetServiceKeyDetailsByServiceInstanceAndName returns the credentials of
+// the service key with the given name of the service instance with the given
+// name.
+func (actor Actor) GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (ServiceCredentialBindingDetails, Warnings, error) {
+	serviceKey, allWarnings, err := actor.GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID)
+	if err != nil {
+		return ServiceCredentialBindingDetails{}, allWarnings, err
+	}
+
+	details, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindingDetails(serviceKey.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return ServiceCredentialBindingDetails(details), allWarnings, err
+}
+
+// GetServiceKeysByServiceInstance returns the service keys of the service
+// instance with the given name.
+func (actor Actor) GetServiceKeysByServiceInstance(serviceInstanceName string, spaceGUID string) ([]ServiceCredentialBinding, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	keys, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.KeyBinding)}},
+		ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstance.GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var serviceKeys []ServiceCredentialBinding
+	for _, key := range keys {
+		serviceKeys = append(serviceKeys, ServiceCredentialBinding(key))
+	}
+
+	return serviceKeys, allWarnings, nil
+}
@@ -0,0 +1,332 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Credential Binding Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+			ccv3.Warnings{"app-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceInstancesReturns(
+			[]ccv3.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+			ccv3.Warnings{"instance-warning"},
+			nil,
+		)
+	})
+
+	Describe("BindServiceInstanceToApp", func() {
+		var (
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.BindServiceInstanceToApp(
+				"some-app",
+				"some-service-instance",
+				"some-space-guid",
+				"some-binding",
+				map[string]interface{}{"some-key": "some-value"},
+			)
+		})
+
+		When("the binding is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceCredentialBindingReturns("some-job-url", ccv3.Warnings{"bind-warning"}, nil)
+			})
+
+			It("creates an app binding and returns the job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+				Expect(warnings).To(ConsistOf("app-warning", "instance-warning", "bind-warning"))
+
+				Expect(fakeCloudControllerClient.CreateServiceCredentialBindingArgsForCall(0)).To(Equal(ccv3.ServiceCredentialBinding{
+					Type:                constant.AppBinding,
+					Name:                "some-binding",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					AppGUID:             "some-app-guid",
+					Parameters:          map[string]interface{}{"some-key": "some-value"},
+				}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("app-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceCredentialBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnbindServiceInstanceFromApp", func() {
+		var (
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.UnbindServiceInstanceFromApp("some-app", "some-service-instance", "some-space-guid")
+		})
+
+		When("the binding exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]ccv3.ServiceCredentialBinding{{GUID: "some-binding-guid"}},
+					ccv3.Warnings{"bindings-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the binding", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+				Expect(warnings).To(ConsistOf("app-warning", "instance-warning", "bindings-warning", "delete-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"some-service-instance-guid"}},
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+				Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(0)).To(Equal("some-binding-guid"))
+			})
+		})
+
+		When("the binding does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"bindings-warning"}, nil)
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBindingNotFoundError{
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+				}))
+				Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetBoundServiceInstanceNamesByApplication", func() {
+		var (
+			names      []string
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			names, warnings, executeErr = actor.GetBoundServiceInstanceNamesByApplication("some-app-guid")
+		})
+
+		When("the app has bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]ccv3.ServiceCredentialBinding{
+						{ServiceInstanceGUID: "instance-guid-1"},
+						{ServiceInstanceGUID: "instance-guid-2"},
+					},
+					ccv3.Warnings{"binding-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{Name: "zebra"}, {Name: "Apple"}},
+					ccv3.Warnings{"instance-warning"},
+					nil,
+				)
+			})
+
+			It("returns the sorted names of the bound service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(names).To(Equal([]string{"Apple", "zebra"}))
+				Expect(warnings).To(ConsistOf("binding-warning", "instance-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"instance-guid-1", "instance-guid-2"}},
+				))
+			})
+		})
+
+		When("the app has no bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"binding-warning"}, nil)
+			})
+
+			It("returns no names without getting service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(names).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("binding-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"binding-warning"}, errors.New("bindings-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("bindings-error"))
+				Expect(warnings).To(ConsistOf("binding-warning"))
+			})
+		})
+	})
+
+	Describe("CreateServiceKey", func() {
+		It("creates a key binding for the service instance", func() {
+			fakeCloudControllerClient.CreateServiceCredentialBindingReturns("", ccv3.Warnings{"create-warning"}, nil)
+
+			_, warnings, err := actor.CreateServiceKey("some-service-instance", "some-key", "some-space-guid", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance-warning", "create-warning"))
+
+			Expect(fakeCloudControllerClient.CreateServiceCredentialBindingArgsForCall(0)).To(Equal(ccv3.ServiceCredentialBinding{
+				Type:                constant.KeyBinding,
+				Name:                "some-key",
+				ServiceInstanceGUID: "some-service-instance-guid",
+			}))
+		})
+	})
+
+	Describe("GetServiceKeysByServiceInstance", func() {
+		var (
+			keys       []ServiceCredentialBinding
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			keys, warnings, executeErr = actor.GetServiceKeysByServiceInstance("some-service-instance", "some-space-guid")
+		})
+
+		When("the keys are listed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]ccv3.ServiceCredentialBinding{{GUID: "key-guid-1", Name: "key-1"}, {GUID: "key-guid-2", Name: "key-2"}},
+					ccv3.Warnings{"keys-warning"},
+					nil,
+				)
+			})
+
+			It("returns the keys of the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(keys).To(ConsistOf(
+					ServiceCredentialBinding{GUID: "key-guid-1", Name: "key-1"},
+					ServiceCredentialBinding{GUID: "key-guid-2", Name: "key-2"},
+				))
+				Expect(warnings).To(ConsistOf("instance-warning", "keys-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"key"}},
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"some-service-instance-guid"}},
+				))
+			})
+		})
+
+		When("listing the keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"keys-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("instance-warning", "keys-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceKeyDetailsByServiceInstanceAndName", func() {
+		var (
+			details    ServiceCredentialBindingDetails
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = actor.GetServiceKeyDetailsByServiceInstanceAndName("some-service-instance", "some-key", "some-space-guid")
+		})
+
+		When("the key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]ccv3.ServiceCredentialBinding{{GUID: "key-guid", Name: "some-key"}},
+					ccv3.Warnings{"keys-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceCredentialBindingDetailsReturns(
+					ccv3.ServiceCredentialBindingDetails{Credentials: map[string]interface{}{"username": "admin"}},
+					ccv3.Warnings{"details-warning"},
+					nil,
+				)
+			})
+
+			It("returns the credentials of the key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(details.Credentials).To(Equal(map[string]interface{}{"username": "admin"}))
+				Expect(warnings).To(ConsistOf("instance-warning", "keys-warning", "details-warning"))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingDetailsArgsForCall(0)).To(Equal("key-guid"))
+			})
+		})
+
+		When("the key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(nil, ccv3.Warnings{"keys-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{
+					Name:                "some-key",
+					ServiceInstanceName: "some-service-instance",
+				}))
+				Expect(warnings).To(ConsistOf("instance-warning", "keys-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKeyByServiceInstanceAndName", func() {
+		It("deletes the key", func() {
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]ccv3.ServiceCredentialBinding{{GUID: "key-guid", Name: "some-key"}},
+				ccv3.Warnings{"keys-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteServiceCredentialBindingReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+
+			jobURL, warnings, err := actor.DeleteServiceKeyByServiceInstanceAndName("some-service-instance", "some-key", "some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("instance-warning", "keys-warning", "delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceCredentialBindingArgsForCall(0)).To(Equal("key-guid"))
+		})
+	})
+})
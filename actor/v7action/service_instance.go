@@ -1,12 +1,96 @@
 package v7action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/util/sorting"
 )
 
 type ServiceInstance ccv3.ServiceInstance
 
+// ManagedServiceInstanceParams are the settings used to create or update a
+// managed service instance. When updating, the service offering and broker
+// are ignored, an empty plan name leaves the plan unchanged, and nil tags are
+// left unchanged.
+type ManagedServiceInstanceParams struct {
+	ServiceOfferingName string
+	ServicePlanName     string
+	ServiceBrokerName   string
+	ServiceInstanceName string
+	SpaceGUID           string
+	Tags                []string
+	Parameters          map[string]interface{}
+}
+
+// ServiceInstanceSummary represents a service instance along with the names
+// of its service offering and plan, and the apps bound to it.
+type ServiceInstanceSummary struct {
+	ServiceInstance
+	ServiceOfferingName string
+	ServicePlanName     string
+	BoundAppNames       []string
+}
+
+// IsManaged returns true if the service instance was created from a service
+// offering.
+func (s ServiceInstance) IsManaged() bool {
+	return s.Type == constant.ManagedService
+}
+
+// IsUserProvided returns true if the service instance is a user-provided
+// service instance.
+func (s ServiceInstance) IsUserProvided() bool {
+	return s.Type == constant.UserProvidedService
+}
+
+// CreateManagedServiceInstance creates a service instance of the given
+// service offering and plan. The returned job URL can be polled to wait for
+// the service broker to finish provisioning the service instance.
+func (actor Actor) CreateManagedServiceInstance(params ManagedServiceInstanceParams) (ccv3.JobURL, Warnings, error) {
+	plan, allWarnings, err := actor.getServicePlanByNameOfferingAndBroker(params.ServicePlanName, params.ServiceOfferingName, params.ServiceBrokerName)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceInstance(ccv3.ServiceInstance{
+		Type:            constant.ManagedService,
+		Name:            params.ServiceInstanceName,
+		SpaceGUID:       params.SpaceGUID,
+		ServicePlanGUID: plan.GUID,
+		Tags:            params.Tags,
+		Parameters:      params.Parameters,
+	})
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// CreateUserProvidedServiceInstance creates a user-provided service instance.
+func (actor Actor) CreateUserProvidedServiceInstance(serviceInstance ServiceInstance) (Warnings, error) {
+	serviceInstance.Type = constant.UserProvidedService
+	_, warnings, err := actor.CloudControllerClient.CreateServiceInstance(ccv3.ServiceInstance(serviceInstance))
+	return Warnings(warnings), err
+}
+
+// DeleteServiceInstance deletes the service instance with the given name in
+// the given space. The returned job URL can be polled to wait for the service
+// broker to finish deprovisioning the service instance.
+func (actor Actor) DeleteServiceInstance(serviceInstanceName string, spaceGUID string) (ccv3.JobURL, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
 func (actor Actor) GetServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (ServiceInstance, Warnings, error) {
 	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
 		ccv3.Query{Key: ccv3.NameFilter, Values: []string{serviceInstanceName}},
@@ -25,7 +109,392 @@ func (actor Actor) GetServiceInstanceByNameAndSpace(serviceInstanceName string,
 	return ServiceInstance(serviceInstances[0]), Warnings(warnings), nil
 }
 
+// GetServiceInstanceSummaryByNameAndSpace returns the summary of the service
+// instance with the given name in the given space.
+func (actor Actor) GetServiceInstanceSummaryByNameAndSpace(serviceInstanceName string, spaceGUID string) (ServiceInstanceSummary, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+
+	summaries, warnings, err := actor.getServiceInstanceSummaries([]ccv3.ServiceInstance{ccv3.ServiceInstance(serviceInstance)})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+
+	return summaries[0], allWarnings, nil
+}
+
+// GetServiceInstancesSummaryBySpace returns the summaries of all the service
+// instances in the given space, sorted by name.
+func (actor Actor) GetServiceInstancesSummaryBySpace(spaceGUID string) ([]ServiceInstanceSummary, Warnings, error) {
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil || len(serviceInstances) == 0 {
+		return nil, allWarnings, err
+	}
+
+	summaries, summaryWarnings, err := actor.getServiceInstanceSummaries(serviceInstances)
+	allWarnings = append(allWarnings, summaryWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return sorting.LessIgnoreCase(summaries[i].Name, summaries[j].Name)
+	})
+
+	return summaries, allWarnings, nil
+}
+
+// RenameServiceInstance changes the name of the service instance with the
+// given name in the given space.
+func (actor Actor) RenameServiceInstance(currentServiceInstanceName string, spaceGUID string, newServiceInstanceName string) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(currentServiceInstanceName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(serviceInstance.GUID, ccv3.ServiceInstance{Name: newServiceInstanceName})
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// UpdateManagedServiceInstance updates the plan, tags and parameters of the
+// managed service instance with the given name. The new plan must belong to
+// the same service offering as the current plan. The returned job URL can be
+// polled to wait for the service broker to finish updating the service
+// instance.
+func (actor Actor) UpdateManagedServiceInstance(params ManagedServiceInstanceParams) (ccv3.JobURL, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(params.ServiceInstanceName, params.SpaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	update := ccv3.ServiceInstance{
+		Tags:       params.Tags,
+		Parameters: params.Parameters,
+	}
+
+	if params.ServicePlanName != "" {
+		planGUID, warnings, err := actor.getServicePlanGUIDForUpdate(serviceInstance, params.ServicePlanName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+		update.ServicePlanGUID = planGUID
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(serviceInstance.GUID, update)
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// UpgradeManagedServiceInstance updates the managed service instance with the
+// given name to the maintenance info of its service plan. It returns a
+// ServiceUpgradeNotAvailableError if the service instance is already on the
+// plan's version.
+func (actor Actor) UpgradeManagedServiceInstance(serviceInstanceName string, spaceGUID string) (ccv3.JobURL, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	if !serviceInstance.IsManaged() {
+		return "", allWarnings, actionerror.ServiceUpgradeNotAvailableError{}
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{serviceInstance.ServicePlanGUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	if len(plans) == 0 || plans[0].MaintenanceInfo.Version == "" || plans[0].MaintenanceInfo.Version == serviceInstance.MaintenanceInfo.Version {
+		return "", allWarnings, actionerror.ServiceUpgradeNotAvailableError{}
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(serviceInstance.GUID, ccv3.ServiceInstance{
+		MaintenanceInfo: plans[0].MaintenanceInfo,
+	})
+	allWarnings = append(allWarnings, warnings...)
+
+	return jobURL, allWarnings, err
+}
+
+// UpdateUserProvidedServiceInstance updates the set fields of the
+// user-provided service instance with the given name.
+func (actor Actor) UpdateUserProvidedServiceInstance(serviceInstanceName string, spaceGUID string, update ServiceInstance) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if !serviceInstance.IsUserProvided() {
+		return allWarnings, actionerror.ServiceInstanceNotUserProvidedError{Name: serviceInstanceName}
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(serviceInstance.GUID, ccv3.ServiceInstance(update))
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// ShareServiceInstanceToSpaceAndOrg shares the service instance with the given
+// name in the source space into the space with the given name in the given
+// org.
+func (actor Actor) ShareServiceInstanceToSpaceAndOrg(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgName string, sharedToSpaceName string) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, sourceSpaceGUID)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceInstanceNotFoundError); ok {
+			return allWarnings, actionerror.SharedServiceInstanceNotFoundError{}
+		}
+		return allWarnings, err
+	}
+
+	space, warnings, err := actor.getSpaceByNameAndOrganizationName(sharedToSpaceName, sharedToOrgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err = actor.ShareServiceInstanceToSpaces(serviceInstance.GUID, []string{space.GUID})
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// UnshareServiceInstanceFromSpaceAndOrg unshares the service instance with the
+// given name in the source space from the space with the given name in the
+// given org.
+func (actor Actor) UnshareServiceInstanceFromSpaceAndOrg(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgName string, sharedToSpaceName string) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, sourceSpaceGUID)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceInstanceNotFoundError); ok {
+			return allWarnings, actionerror.SharedServiceInstanceNotFoundError{}
+		}
+		return allWarnings, err
+	}
+
+	space, warnings, err := actor.getSpaceByNameAndOrganizationName(sharedToSpaceName, sharedToOrgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		switch err.(type) {
+		case actionerror.OrganizationNotFoundError, actionerror.SpaceNotFoundError:
+			return allWarnings, actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: serviceInstanceName}
+		}
+		return allWarnings, err
+	}
+
+	warnings, err = actor.UnshareServiceInstanceByServiceInstanceAndSpace(serviceInstance.GUID, space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return allWarnings, actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: serviceInstanceName}
+	}
+
+	return allWarnings, err
+}
+
 func (actor Actor) UnshareServiceInstanceByServiceInstanceAndSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID, sharedToSpaceGUID)
 	return Warnings(warnings), err
 }
+
+// getServicePlanByNameOfferingAndBroker returns the plan with the given name
+// of the service offering with the given name. The broker name is only
+// required when several brokers provide a service offering with that name.
+func (actor Actor) getServicePlanByNameOfferingAndBroker(planName string, offeringName string, brokerName string) (ccv3.ServicePlan, Warnings, error) {
+	query := []ccv3.Query{
+		{Key: ccv3.NameFilter, Values: []string{offeringName}},
+	}
+	if brokerName != "" {
+		query = append(query, ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{brokerName}})
+	}
+
+	offerings, warnings, err := actor.CloudControllerClient.GetServiceOfferings(query...)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return ccv3.ServicePlan{}, allWarnings, err
+	}
+
+	switch {
+	case len(offerings) == 0 && brokerName != "":
+		return ccv3.ServicePlan{}, allWarnings, actionerror.ServiceAndBrokerCombinationNotFoundError{
+			BrokerName:  brokerName,
+			ServiceName: offeringName,
+		}
+	case len(offerings) == 0:
+		return ccv3.ServicePlan{}, allWarnings, actionerror.ServiceNotFoundError{Name: offeringName}
+	case len(offerings) > 1:
+		return ccv3.ServicePlan{}, allWarnings, actionerror.DuplicateServiceError{Name: offeringName}
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{planName}},
+		ccv3.Query{Key: ccv3.ServiceOfferingGUIDFilter, Values: []string{offerings[0].GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ccv3.ServicePlan{}, allWarnings, err
+	}
+
+	if len(plans) == 0 {
+		return ccv3.ServicePlan{}, allWarnings, actionerror.ServicePlanNotFoundError{
+			PlanName:    planName,
+			ServiceName: offeringName,
+		}
+	}
+
+	return plans[0], allWarnings, nil
+}
+
+// getServicePlanGUIDForUpdate returns the GUID of the plan with the given name
+// of the service offering the service instance was created from.
+func (actor Actor) getServicePlanGUIDForUpdate(serviceInstance ServiceInstance, planName string) (string, Warnings, error) {
+	if !serviceInstance.IsManaged() {
+		return "", nil, actionerror.ServicePlanNotFoundError{PlanName: planName}
+	}
+
+	currentPlans, warnings, err := actor.CloudControllerClient.GetServicePlans(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{serviceInstance.ServicePlanGUID}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	if len(currentPlans) == 0 {
+		return "", allWarnings, actionerror.ServicePlanNotFoundError{PlanName: planName}
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{planName}},
+		ccv3.Query{Key: ccv3.ServiceOfferingGUIDFilter, Values: []string{currentPlans[0].ServiceOfferingGUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	if len(plans) == 0 {
+		return "", allWarnings, actionerror.ServicePlanNotFoundError{PlanName: planName}
+	}
+
+	return plans[0].GUID, allWarnings, nil
+}
+
+// getServiceInstanceSummaries fetches the plans, offerings and app bindings of
+// the given service instances with one request each.
+func (actor Actor) getServiceInstanceSummaries(serviceInstances []ccv3.ServiceInstance) ([]ServiceInstanceSummary, Warnings, error) {
+	var (
+		allWarnings          Warnings
+		planGUIDs            []string
+		serviceInstanceGUIDs []string
+	)
+
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceGUIDs = append(serviceInstanceGUIDs, serviceInstance.GUID)
+		if serviceInstance.ServicePlanGUID != "" {
+			planGUIDs = append(planGUIDs, serviceInstance.ServicePlanGUID)
+		}
+	}
+
+	plansByGUID := map[string]ccv3.ServicePlan{}
+	offeringNamesByGUID := map[string]string{}
+	if len(planGUIDs) > 0 {
+		plans, warnings, err := actor.CloudControllerClient.GetServicePlans(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: planGUIDs},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var offeringGUIDs []string
+		for _, plan := range plans {
+			plansByGUID[plan.GUID] = plan
+			offeringGUIDs = append(offeringGUIDs, plan.ServiceOfferingGUID)
+		}
+
+		offerings, warnings, err := actor.CloudControllerClient.GetServiceOfferings(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: offeringGUIDs},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, offering := range offerings {
+			offeringNamesByGUID[offering.GUID] = offering.Name
+		}
+	}
+
+	bindings, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.AppBinding)}},
+		ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: serviceInstanceGUIDs},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appNamesByGUID := map[string]string{}
+	if len(bindings) > 0 {
+		var appGUIDs []string
+		for _, binding := range bindings {
+			appGUIDs = append(appGUIDs, binding.AppGUID)
+		}
+
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: appGUIDs},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			appNamesByGUID[app.GUID] = app.Name
+		}
+	}
+
+	boundAppNames := map[string][]string{}
+	for _, binding := range bindings {
+		if appName, ok := appNamesByGUID[binding.AppGUID]; ok {
+			boundAppNames[binding.ServiceInstanceGUID] = append(boundAppNames[binding.ServiceInstanceGUID], appName)
+		}
+	}
+
+	var summaries []ServiceInstanceSummary
+	for _, serviceInstance := range serviceInstances {
+		plan := plansByGUID[serviceInstance.ServicePlanGUID]
+		appNames := boundAppNames[serviceInstance.GUID]
+		sort.Strings(appNames)
+
+		summaries = append(summaries, ServiceInstanceSummary{
+			ServiceInstance:     ServiceInstance(serviceInstance),
+			ServiceOfferingName: offeringNamesByGUID[plan.ServiceOfferingGUID],
+			ServicePlanName:     plan.Name,
+			BoundAppNames:       appNames,
+		})
+	}
+
+	return summaries, allWarnings, nil
+}
+
+func (actor Actor) getSpaceByNameAndOrganizationName(spaceName string, orgName string) (Space, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Space{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return space, allWarnings, err
+}
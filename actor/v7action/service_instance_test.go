@@ -7,6 +7,8 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -149,4 +151,595 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateManagedServiceInstance", func() {
+		var (
+			params ManagedServiceInstanceParams
+
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			params = ManagedServiceInstanceParams{
+				ServiceOfferingName: "some-offering",
+				ServicePlanName:     "some-plan",
+				ServiceInstanceName: "some-service-instance",
+				SpaceGUID:           "some-space-guid",
+				Tags:                []string{"tag-1"},
+				Parameters:          map[string]interface{}{"some-key": "some-value"},
+			}
+
+			fakeCloudControllerClient.GetServiceOfferingsReturns(
+				[]ccv3.ServiceOffering{{GUID: "some-offering-guid", Name: "some-offering"}},
+				ccv3.Warnings{"offering-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv3.ServicePlan{{GUID: "some-plan-guid", Name: "some-plan"}},
+				ccv3.Warnings{"plan-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceInstanceReturns(
+				ccv3.JobURL("some-job-url"),
+				ccv3.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.CreateManagedServiceInstance(params)
+		})
+
+		It("creates the service instance with the plan of the offering", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("offering-warning", "plan-warning", "create-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-offering"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-plan"}},
+				ccv3.Query{Key: ccv3.ServiceOfferingGUIDFilter, Values: []string{"some-offering-guid"}},
+			))
+			Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(ccv3.ServiceInstance{
+				Type:            constant.ManagedService,
+				Name:            "some-service-instance",
+				SpaceGUID:       "some-space-guid",
+				ServicePlanGUID: "some-plan-guid",
+				Tags:            []string{"tag-1"},
+				Parameters:      map[string]interface{}{"some-key": "some-value"},
+			}))
+		})
+
+		When("a broker name is provided", func() {
+			BeforeEach(func() {
+				params.ServiceBrokerName = "some-broker"
+			})
+
+			It("filters the offerings by broker", func() {
+				Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-offering"}},
+					ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"some-broker"}},
+				))
+			})
+
+			When("the broker does not provide the offering", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceOfferingsReturns(nil, ccv3.Warnings{"offering-warning"}, nil)
+				})
+
+				It("returns a ServiceAndBrokerCombinationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceAndBrokerCombinationNotFoundError{
+						BrokerName:  "some-broker",
+						ServiceName: "some-offering",
+					}))
+					Expect(warnings).To(ConsistOf("offering-warning"))
+				})
+			})
+		})
+
+		When("the offering does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingsReturns(nil, ccv3.Warnings{"offering-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "some-offering"}))
+				Expect(warnings).To(ConsistOf("offering-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("several brokers provide the offering", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingsReturns(
+					[]ccv3.ServiceOffering{{GUID: "offering-guid-1"}, {GUID: "offering-guid-2"}},
+					ccv3.Warnings{"offering-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DuplicateServiceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DuplicateServiceError{Name: "some-offering"}))
+			})
+		})
+
+		When("the plan does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plan-warning"}, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{
+					PlanName:    "some-plan",
+					ServiceName: "some-offering",
+				}))
+				Expect(warnings).To(ConsistOf("offering-warning", "plan-warning"))
+			})
+		})
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		It("creates a user-provided service instance", func() {
+			fakeCloudControllerClient.CreateServiceInstanceReturns("", ccv3.Warnings{"create-warning"}, nil)
+
+			warnings, err := actor.CreateUserProvidedServiceInstance(ServiceInstance{
+				Name:        "some-ups",
+				SpaceGUID:   "some-space-guid",
+				Credentials: map[string]interface{}{"username": "admin"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(ccv3.ServiceInstance{
+				Type:        constant.UserProvidedService,
+				Name:        "some-ups",
+				SpaceGUID:   "some-space-guid",
+				Credentials: map[string]interface{}{"username": "admin"},
+			}))
+		})
+	})
+
+	Describe("UpdateManagedServiceInstance", func() {
+		var (
+			params ManagedServiceInstanceParams
+
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			params = ManagedServiceInstanceParams{
+				ServiceInstanceName: "some-service-instance",
+				SpaceGUID:           "some-space-guid",
+				ServicePlanName:     "new-plan",
+			}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{
+					GUID:            "some-service-instance-guid",
+					Type:            constant.ManagedService,
+					ServicePlanGUID: "current-plan-guid",
+				}},
+				ccv3.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturnsOnCall(0,
+				[]ccv3.ServicePlan{{GUID: "current-plan-guid", ServiceOfferingGUID: "some-offering-guid"}},
+				ccv3.Warnings{"current-plan-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturnsOnCall(1,
+				[]ccv3.ServicePlan{{GUID: "new-plan-guid"}},
+				ccv3.Warnings{"new-plan-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceReturns("some-job-url", ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.UpdateManagedServiceInstance(params)
+		})
+
+		It("updates the plan to the plan of the same offering", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("instance-warning", "current-plan-warning", "new-plan-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(1)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"new-plan"}},
+				ccv3.Query{Key: ccv3.ServiceOfferingGUIDFilter, Values: []string{"some-offering-guid"}},
+			))
+
+			guid, update := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+			Expect(guid).To(Equal("some-service-instance-guid"))
+			Expect(update).To(Equal(ccv3.ServiceInstance{ServicePlanGUID: "new-plan-guid"}))
+		})
+
+		When("the new plan does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturnsOnCall(1, nil, ccv3.Warnings{"new-plan-warning"}, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "new-plan"}))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no plan is given", func() {
+			BeforeEach(func() {
+				params.ServicePlanName = ""
+				params.Tags = []string{"tag-1"}
+			})
+
+			It("only updates the given fields", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+
+				_, update := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+				Expect(update).To(Equal(ccv3.ServiceInstance{Tags: []string{"tag-1"}}))
+			})
+		})
+	})
+
+	Describe("UpgradeManagedServiceInstance", func() {
+		var (
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{
+					GUID:            "some-service-instance-guid",
+					Type:            constant.ManagedService,
+					ServicePlanGUID: "some-plan-guid",
+					MaintenanceInfo: ccv3.MaintenanceInfo{Version: "1.0.0"},
+				}},
+				ccv3.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv3.ServicePlan{{
+					GUID:            "some-plan-guid",
+					MaintenanceInfo: ccv3.MaintenanceInfo{Version: "2.0.0", Description: "new version"},
+				}},
+				ccv3.Warnings{"plan-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceReturns("some-job-url", ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.UpgradeManagedServiceInstance("some-service-instance", "some-space-guid")
+		})
+
+		It("updates the service instance to the maintenance info of its plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("instance-warning", "plan-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-plan-guid"}},
+			))
+
+			guid, update := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+			Expect(guid).To(Equal("some-service-instance-guid"))
+			Expect(update).To(Equal(ccv3.ServiceInstance{
+				MaintenanceInfo: ccv3.MaintenanceInfo{Version: "2.0.0", Description: "new version"},
+			}))
+		})
+
+		When("the service instance is already on the plan's version", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv3.ServicePlan{{GUID: "some-plan-guid", MaintenanceInfo: ccv3.MaintenanceInfo{Version: "1.0.0"}}},
+					ccv3.Warnings{"plan-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceUpgradeNotAvailableError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceUpgradeNotAvailableError{}))
+				Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the plan has no maintenance info", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv3.ServicePlan{{GUID: "some-plan-guid"}},
+					ccv3.Warnings{"plan-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceUpgradeNotAvailableError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceUpgradeNotAvailableError{}))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the service instance is user-provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{GUID: "some-ups-guid", Type: constant.UserProvidedService}},
+					ccv3.Warnings{"instance-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceUpgradeNotAvailableError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceUpgradeNotAvailableError{}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the service plan fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plan-warning"}, errors.New("plan-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("plan-error"))
+				Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateUserProvidedServiceInstance", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateUserProvidedServiceInstance("some-ups", "some-space-guid", ServiceInstance{
+				SyslogDrainURL: types.NewNullString("syslog://example.com"),
+			})
+		})
+
+		When("the service instance is user-provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{GUID: "some-ups-guid", Type: constant.UserProvidedService}},
+					ccv3.Warnings{"instance-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateServiceInstanceReturns("", ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instance-warning", "update-warning"))
+
+				guid, update := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+				Expect(guid).To(Equal("some-ups-guid"))
+				Expect(update).To(Equal(ccv3.ServiceInstance{SyslogDrainURL: types.NewNullString("syslog://example.com")}))
+			})
+		})
+
+		When("the service instance is managed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{GUID: "some-guid", Type: constant.ManagedService}},
+					ccv3.Warnings{"instance-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceNotUserProvidedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotUserProvidedError{Name: "some-ups"}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RenameServiceInstance", func() {
+		It("updates the name of the service instance", func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{GUID: "some-guid"}},
+				ccv3.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceReturns("", ccv3.Warnings{"update-warning"}, nil)
+
+			warnings, err := actor.RenameServiceInstance("old-name", "some-space-guid", "new-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance-warning", "update-warning"))
+
+			guid, update := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+			Expect(guid).To(Equal("some-guid"))
+			Expect(update).To(Equal(ccv3.ServiceInstance{Name: "new-name"}))
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		var (
+			jobURL     ccv3.JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = actor.DeleteServiceInstance("some-service-instance", "some-space-guid")
+		})
+
+		When("the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{GUID: "some-guid"}},
+					ccv3.Warnings{"instance-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteServiceInstanceReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes it and returns the job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
+				Expect(warnings).To(ConsistOf("instance-warning", "delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetServiceInstancesSummaryBySpace", func() {
+		var (
+			summaries  []ServiceInstanceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetServiceInstancesSummaryBySpace("some-space-guid")
+		})
+
+		When("there are service instances in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{
+						{GUID: "ups-guid", Name: "z-ups", Type: constant.UserProvidedService},
+						{GUID: "managed-guid", Name: "a-managed", Type: constant.ManagedService, ServicePlanGUID: "plan-guid"},
+					},
+					ccv3.Warnings{"instances-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv3.ServicePlan{{GUID: "plan-guid", Name: "some-plan", ServiceOfferingGUID: "offering-guid"}},
+					ccv3.Warnings{"plans-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceOfferingsReturns(
+					[]ccv3.ServiceOffering{{GUID: "offering-guid", Name: "some-offering"}},
+					ccv3.Warnings{"offerings-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]ccv3.ServiceCredentialBinding{
+						{ServiceInstanceGUID: "managed-guid", AppGUID: "app-guid-2"},
+						{ServiceInstanceGUID: "managed-guid", AppGUID: "app-guid-1"},
+					},
+					ccv3.Warnings{"bindings-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "app-guid-1", Name: "app-1"}, {GUID: "app-guid-2", Name: "app-2"}},
+					ccv3.Warnings{"apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the summaries sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning", "plans-warning", "offerings-warning", "bindings-warning", "apps-warning"))
+
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Name).To(Equal("a-managed"))
+				Expect(summaries[0].ServiceOfferingName).To(Equal("some-offering"))
+				Expect(summaries[0].ServicePlanName).To(Equal("some-plan"))
+				Expect(summaries[0].BoundAppNames).To(Equal([]string{"app-1", "app-2"}))
+				Expect(summaries[1].Name).To(Equal("z-ups"))
+				Expect(summaries[1].ServiceOfferingName).To(BeEmpty())
+				Expect(summaries[1].BoundAppNames).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+					ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"ups-guid", "managed-guid"}},
+				))
+			})
+		})
+
+		When("there are no service instances in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, nil)
+			})
+
+			It("returns no summaries", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("instances-warning"))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpaceAndOrg", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{GUID: "some-guid"}},
+				ccv3.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{GUID: "org-guid"}},
+				ccv3.Warnings{"org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{GUID: "space-guid"}},
+				ccv3.Warnings{"space-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UnshareServiceInstanceFromSpaceAndOrg("some-service-instance", "source-space-guid", "other-org", "other-space")
+		})
+
+		It("deletes the shared space relationship", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance-warning", "org-warning", "space-warning"))
+
+			serviceInstanceGUID, spaceGUID := fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceArgsForCall(0)
+			Expect(serviceInstanceGUID).To(Equal("some-guid"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"space-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotSharedToSpaceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"instance-warning"}, nil)
+			})
+
+			It("returns a SharedServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SharedServiceInstanceNotFoundError{}))
+			})
+		})
+	})
 })
@@ -184,10 +184,20 @@ func (actor Actor) GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUI
 
 // GetOrganizationSpaces returns a list of spaces in the specified org
 func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]Space, Warnings, error) {
-	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
-		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
-	)
+	return actor.GetOrganizationSpacesWithLabelSelector(orgGUID, "")
+}
+
+// GetOrganizationSpacesWithLabelSelector returns the spaces in the specified
+// org whose labels match the label selector, or all of the org's spaces when
+// the label selector is empty.
+func (actor Actor) GetOrganizationSpacesWithLabelSelector(orgGUID string, labelSelector string) ([]Space, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	queries = appendLabelSelectorQuery(queries, labelSelector)
+
+	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(queries...)
 	if err != nil {
 		return []Space{}, Warnings(warnings), err
 	}
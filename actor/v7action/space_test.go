@@ -341,6 +341,30 @@ var _ = Describe("Space", func() {
 		})
 	})
 
+	Describe("GetOrganizationSpacesWithLabelSelector", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{GUID: "space-1-guid", Name: "space-1"}},
+				ccv3.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("filters the org's spaces by the label selector", func() {
+			spaces, warnings, err := actor.GetOrganizationSpacesWithLabelSelector("some-org-guid", "env=prod")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(spaces).To(Equal([]Space{{GUID: "space-1-guid", Name: "space-1"}}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(
+				[]ccv3.Query{
+					{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+					{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+				}))
+		})
+	})
+
 	Describe("DeleteSpaceByNameAndOrganizationName", func() {
 		var (
 			warnings Warnings
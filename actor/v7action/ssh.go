@@ -2,6 +2,7 @@ package v7action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 )
@@ -59,22 +60,49 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
-func (actor Actor) getUsername(application Application, processType string, processIndex uint) (string, Warnings, error) {
-	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+// GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType returns
+// the indexes, in order, of the running instances of the application's process.
+func (actor Actor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType(
+	appName string, spaceGUID string, processType string,
+) ([]uint, Warnings, error) {
+	var allWarnings Warnings
+
+	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
 	if err != nil {
-		return "", processWarnings, err
+		return nil, allWarnings, err
 	}
 
-	var processSummary ProcessSummary
-	for _, appProcessSummary := range processSummaries {
-		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+	if !application.Started() {
+		return nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var indexes []uint
+	for _, instance := range processSummary.InstanceDetails {
+		if instance.Running() {
+			indexes = append(indexes, uint(instance.Index))
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	if len(indexes) == 0 {
+		return nil, allWarnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+	}
+
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	return indexes, allWarnings, nil
+}
+
+func (actor Actor) getUsername(application Application, processType string, processIndex uint) (string, Warnings, error) {
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	if err != nil {
+		return "", processWarnings, err
 	}
 
 	var processInstance ProcessInstance
@@ -95,3 +123,18 @@ func (actor Actor) getUsername(application Application, processType string, proc
 
 	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
 }
+
+func (actor Actor) getProcessSummaryByType(application Application, processType string) (ProcessSummary, Warnings, error) {
+	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	if err != nil {
+		return ProcessSummary{}, processWarnings, err
+	}
+
+	for _, appProcessSummary := range processSummaries {
+		if appProcessSummary.Type == processType {
+			return appProcessSummary, processWarnings, nil
+		}
+	}
+
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
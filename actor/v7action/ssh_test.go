@@ -205,4 +205,86 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType", func() {
+		var indexes []uint
+
+		JustBeforeEach(func() {
+			indexes, warnings, executeErr = actor.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type")
+		})
+
+		When("getting the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("some-application-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-application-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("the application is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns a ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("the application is started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			When("the process does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{}, ccv3.Warnings{"some-process-warnings"}, nil)
+				})
+
+				It("returns all warnings and a ProcessNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+					Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+				})
+			})
+
+			When("the process exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+				})
+
+				When("some instances are running", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+							{State: constant.ProcessInstanceRunning, Index: 2},
+							{State: constant.ProcessInstanceCrashed, Index: 1},
+							{State: constant.ProcessInstanceRunning, Index: 0},
+						}, ccv3.Warnings{"some-instance-warnings"}, nil)
+					})
+
+					It("returns the indexes of the running instances in order", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+						Expect(indexes).To(Equal([]uint{0, 2}))
+					})
+				})
+
+				When("no instances are running", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+							{State: constant.ProcessInstanceDown, Index: 0},
+						}, ccv3.Warnings{"some-instance-warnings"}, nil)
+					})
+
+					It("returns a NoRunningProcessInstancesError", func() {
+						Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+						Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+					})
+				})
+			})
+		})
+	})
 })
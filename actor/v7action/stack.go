@@ -23,8 +23,10 @@ func (actor *Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
 	return Stack(stacks[0]), Warnings(warnings), nil
 }
 
-func (actor Actor) GetStacks() ([]Stack, Warnings, error) {
-	ccv3Stacks, warnings, err := actor.CloudControllerClient.GetStacks()
+// GetStacks returns the stacks whose labels match the label selector, or all
+// stacks when the label selector is empty.
+func (actor Actor) GetStacks(labelSelector string) ([]Stack, Warnings, error) {
+	ccv3Stacks, warnings, err := actor.CloudControllerClient.GetStacks(appendLabelSelectorQuery(nil, labelSelector)...)
 	if err != nil {
 		return nil, Warnings(warnings), err
 	}
@@ -113,9 +113,12 @@ var _ = Describe("Stack", func() {
 
 			warnings   Warnings
 			executeErr error
+
+			labelSelector string
 		)
 
 		BeforeEach(func() {
+			labelSelector = ""
 			ccv3Stacks = []ccv3.Stack{
 				{Name: stack1Name, Description: stack1Description},
 				{Name: stack2Name, Description: stack2Description},
@@ -123,7 +126,7 @@ var _ = Describe("Stack", func() {
 		})
 
 		JustBeforeEach(func() {
-			stacks, warnings, executeErr = actor.GetStacks()
+			stacks, warnings, executeErr = actor.GetStacks(labelSelector)
 		})
 
 		When("getting stacks returns an error", func() {
@@ -155,6 +158,20 @@ var _ = Describe("Stack", func() {
 					Expect(stacks).To(ConsistOf(Stack{Name: stack1Name, Description: stack1Description}, Stack{Name: stack2Name, Description: stack2Description}))
 					Expect(warnings).To(ConsistOf("some-stack-warning"))
 					Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(BeEmpty())
+				})
+
+				When("a label selector is provided", func() {
+					BeforeEach(func() {
+						labelSelector = "env=prod"
+					})
+
+					It("filters the stacks by the label selector", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(Equal([]ccv3.Query{
+							{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+						}))
+					})
 				})
 			})
 
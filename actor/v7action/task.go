@@ -2,12 +2,14 @@ package v7action
 
 import (
 	"strconv"
+	"time"
 
 	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 // Task represents a V3 actor Task.
@@ -69,3 +71,60 @@ func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return Task(task), Warnings(warnings), err
 }
+
+// PollTask polls the task of the provided application until it has succeeded
+// or failed. A failed task is returned with a TaskFailedError. If timeout is
+// greater than zero and the task is still running after it, the task is
+// terminated and a TaskTimeoutError is returned.
+func (actor Actor) PollTask(appGUID string, task Task, timeout time.Duration) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timeoutChan = actor.Clock.After(timeout)
+	}
+
+	for {
+		select {
+		case <-timer.C():
+			polledTask, warnings, err := actor.GetTaskBySequenceIDAndApplication(int(task.SequenceID), appGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return task, allWarnings, err
+			}
+			task = polledTask
+
+			switch task.State {
+			case constant.TaskSucceeded:
+				return task, allWarnings, nil
+			case constant.TaskFailed:
+				var failureReason string
+				if task.Result != nil {
+					failureReason = task.Result.FailureReason
+				}
+				return task, allWarnings, actionerror.TaskFailedError{
+					SequenceID:    int(task.SequenceID),
+					Name:          task.Name,
+					FailureReason: failureReason,
+				}
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		case <-timeoutChan:
+			terminatedTask, warnings, err := actor.TerminateTask(task.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return task, allWarnings, err
+			}
+
+			return terminatedTask, allWarnings, actionerror.TaskTimeoutError{
+				SequenceID: int(task.SequenceID),
+				Name:       task.Name,
+				Timeout:    timeout,
+			}
+		}
+	}
+}
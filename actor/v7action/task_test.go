@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/clock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -304,4 +306,119 @@ var _ = Describe("Task Actions", func() {
 			})
 		})
 	})
+
+	Describe("PollTask", func() {
+		var (
+			fakeConfig *v7actionfakes.FakeConfig
+			timeout    time.Duration
+
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v7actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, clock.NewClock())
+			timeout = 0
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.PollTask("some-app-guid", Task{GUID: "some-task-guid", SequenceID: 3, Name: "some-task"}, timeout)
+		})
+
+		When("the task succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0,
+					[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", State: constant.TaskRunning}},
+					ccv3.Warnings{"get-tasks-warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1,
+					[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", State: constant.TaskSucceeded}},
+					ccv3.Warnings{"get-tasks-warning-2"}, nil)
+			})
+
+			It("polls the task until it has succeeded", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-tasks-warning-1", "get-tasks-warning-2"))
+				Expect(task.State).To(Equal(constant.TaskSucceeded))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(2))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.SequenceIDFilter, Values: []string{"3"}}))
+			})
+		})
+
+		When("the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{
+						GUID:       "some-task-guid",
+						SequenceID: 3,
+						Name:       "some-task",
+						State:      constant.TaskFailed,
+						Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					}},
+					ccv3.Warnings{"get-tasks-warning"}, nil)
+			})
+
+			It("returns a TaskFailedError with the failure reason", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskFailedError{
+					SequenceID:    3,
+					Name:          "some-task",
+					FailureReason: "Exited with status 1",
+				}))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+				Expect(task.State).To(Equal(constant.TaskFailed))
+			})
+		})
+
+		When("getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"get-tasks-warning"}, errors.New("get-tasks-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-tasks-error"))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+			})
+		})
+
+		When("the task does not finish within the timeout", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", State: constant.TaskRunning}},
+					nil, nil)
+				fakeCloudControllerClient.UpdateTaskCancelReturns(
+					ccv3.Task{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", State: constant.TaskCanceling},
+					ccv3.Warnings{"cancel-task-warning"}, nil)
+			})
+
+			It("terminates the task and returns a TaskTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskTimeoutError{
+					SequenceID: 3,
+					Name:       "some-task",
+					Timeout:    50 * time.Millisecond,
+				}))
+				Expect(warnings).To(ContainElement("cancel-task-warning"))
+				Expect(task.State).To(Equal(constant.TaskCanceling))
+
+				Expect(fakeCloudControllerClient.UpdateTaskCancelCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelArgsForCall(0)).To(Equal("some-task-guid"))
+			})
+
+			When("terminating the task fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateTaskCancelReturns(ccv3.Task{}, ccv3.Warnings{"cancel-task-warning"}, errors.New("cancel-task-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("cancel-task-error"))
+					Expect(warnings).To(ContainElement("cancel-task-warning"))
+				})
+			})
+		})
+	})
 })
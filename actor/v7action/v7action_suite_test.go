@@ -10,6 +10,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,6 +39,28 @@ func NewTestActor() (*Actor, *v7actionfakes.FakeCloudControllerClient, *v7action
 	return actor, fakeCloudControllerClient, fakeConfig, fakeSharedActor, fakeUAAClient, fakeClock
 }
 
+// applicationsPagesOf returns a closed channel holding the given pages, for
+// stubbing StreamApplications.
+func applicationsPagesOf(pages ...ccv3.ApplicationsPage) <-chan ccv3.ApplicationsPage {
+	stream := make(chan ccv3.ApplicationsPage, len(pages))
+	for _, page := range pages {
+		stream <- page
+	}
+	close(stream)
+	return stream
+}
+
+// routesPagesOf returns a closed channel holding the given pages, for stubbing
+// StreamRoutes.
+func routesPagesOf(pages ...ccv3.RoutesPage) <-chan ccv3.RoutesPage {
+	stream := make(chan ccv3.RoutesPage, len(pages))
+	for _, page := range pages {
+		stream <- page
+	}
+	close(stream)
+	return stream
+}
+
 // Thanks to Svett Ralchev
 // http://blog.ralch.com/tutorial/golang-working-with-zip/
 func zipit(source, target, prefix string) error {
@@ -3,6 +3,7 @@ package v7actionfakes
 
 import (
 	"io"
+	"net/http"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -270,6 +271,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	CreateServiceCredentialBindingStub        func(ccv3.ServiceCredentialBinding) (ccv3.JobURL, ccv3.Warnings, error)
+	createServiceCredentialBindingMutex       sync.RWMutex
+	createServiceCredentialBindingArgsForCall []struct {
+		arg1 ccv3.ServiceCredentialBinding
+	}
+	createServiceCredentialBindingReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createServiceCredentialBindingReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateServiceInstanceStub        func(ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		arg1 ccv3.ServiceInstance
+	}
+	createServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateSpaceStub        func(ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
 	createSpaceMutex       sync.RWMutex
 	createSpaceArgsForCall []struct {
@@ -417,6 +448,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeleteServiceCredentialBindingStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteServiceCredentialBindingMutex       sync.RWMutex
+	deleteServiceCredentialBindingArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceCredentialBindingReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteServiceCredentialBindingReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DeleteServiceInstanceStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(string, string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -974,6 +1035,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceCredentialBindingDetailsStub        func(string) (ccv3.ServiceCredentialBindingDetails, ccv3.Warnings, error)
+	getServiceCredentialBindingDetailsMutex       sync.RWMutex
+	getServiceCredentialBindingDetailsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceCredentialBindingDetailsReturns struct {
+		result1 ccv3.ServiceCredentialBindingDetails
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceCredentialBindingDetailsReturnsOnCall map[int]struct {
+		result1 ccv3.ServiceCredentialBindingDetails
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServiceCredentialBindingsStub        func(...ccv3.Query) ([]ccv3.ServiceCredentialBinding, ccv3.Warnings, error)
+	getServiceCredentialBindingsMutex       sync.RWMutex
+	getServiceCredentialBindingsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceCredentialBindingsReturns struct {
+		result1 []ccv3.ServiceCredentialBinding
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceCredentialBindingsReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceCredentialBinding
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -989,6 +1080,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceOfferingsStub        func(...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error)
+	getServiceOfferingsMutex       sync.RWMutex
+	getServiceOfferingsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceOfferingsReturns struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceOfferingsReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -1034,6 +1155,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	MakeRequestSendReceiveRawStub        func(string, string, []byte) ([]byte, *http.Response, error)
+	makeRequestSendReceiveRawMutex       sync.RWMutex
+	makeRequestSendReceiveRawArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}
+	makeRequestSendReceiveRawReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	makeRequestSendReceiveRawReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
 	MapRouteStub        func(string, string) (ccv3.Warnings, error)
 	mapRouteMutex       sync.RWMutex
 	mapRouteArgsForCall []struct {
@@ -1122,6 +1260,30 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	StreamApplicationsStub        func(<-chan struct{}, ...ccv3.Query) <-chan ccv3.ApplicationsPage
+	streamApplicationsMutex       sync.RWMutex
+	streamApplicationsArgsForCall []struct {
+		arg1 <-chan struct{}
+		arg2 []ccv3.Query
+	}
+	streamApplicationsReturns struct {
+		result1 <-chan ccv3.ApplicationsPage
+	}
+	streamApplicationsReturnsOnCall map[int]struct {
+		result1 <-chan ccv3.ApplicationsPage
+	}
+	StreamRoutesStub        func(<-chan struct{}, ...ccv3.Query) <-chan ccv3.RoutesPage
+	streamRoutesMutex       sync.RWMutex
+	streamRoutesArgsForCall []struct {
+		arg1 <-chan struct{}
+		arg2 []ccv3.Query
+	}
+	streamRoutesReturns struct {
+		result1 <-chan ccv3.RoutesPage
+	}
+	streamRoutesReturnsOnCall map[int]struct {
+		result1 <-chan ccv3.RoutesPage
+	}
 	UnmapRouteStub        func(string, string) (ccv3.Warnings, error)
 	unmapRouteMutex       sync.RWMutex
 	unmapRouteArgsForCall []struct {
@@ -1335,6 +1497,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateServiceInstanceStub        func(string, ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 ccv3.ServiceInstance
+	}
+	updateServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceStub        func(ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
 	updateSpaceMutex       sync.RWMutex
 	updateSpaceArgsForCall []struct {
@@ -2621,6 +2799,138 @@ func (fake *FakeCloudControllerClient) CreateServiceBrokerReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBinding(arg1 ccv3.ServiceCredentialBinding) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.createServiceCredentialBindingMutex.Lock()
+	ret, specificReturn := fake.createServiceCredentialBindingReturnsOnCall[len(fake.createServiceCredentialBindingArgsForCall)]
+	fake.createServiceCredentialBindingArgsForCall = append(fake.createServiceCredentialBindingArgsForCall, struct {
+		arg1 ccv3.ServiceCredentialBinding
+	}{arg1})
+	fake.recordInvocation("CreateServiceCredentialBinding", []interface{}{arg1})
+	fake.createServiceCredentialBindingMutex.Unlock()
+	if fake.CreateServiceCredentialBindingStub != nil {
+		return fake.CreateServiceCredentialBindingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createServiceCredentialBindingReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBindingCallCount() int {
+	fake.createServiceCredentialBindingMutex.RLock()
+	defer fake.createServiceCredentialBindingMutex.RUnlock()
+	return len(fake.createServiceCredentialBindingArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBindingCalls(stub func(ccv3.ServiceCredentialBinding) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.createServiceCredentialBindingMutex.Lock()
+	defer fake.createServiceCredentialBindingMutex.Unlock()
+	fake.CreateServiceCredentialBindingStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBindingArgsForCall(i int) ccv3.ServiceCredentialBinding {
+	fake.createServiceCredentialBindingMutex.RLock()
+	defer fake.createServiceCredentialBindingMutex.RUnlock()
+	argsForCall := fake.createServiceCredentialBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBindingReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceCredentialBindingMutex.Lock()
+	defer fake.createServiceCredentialBindingMutex.Unlock()
+	fake.CreateServiceCredentialBindingStub = nil
+	fake.createServiceCredentialBindingReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceCredentialBindingReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceCredentialBindingMutex.Lock()
+	defer fake.createServiceCredentialBindingMutex.Unlock()
+	fake.CreateServiceCredentialBindingStub = nil
+	if fake.createServiceCredentialBindingReturnsOnCall == nil {
+		fake.createServiceCredentialBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceCredentialBindingReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstance(arg1 ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		arg1 ccv3.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{arg1})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCalls(stub func(ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceArgsForCall(i int) ccv3.ServiceInstance {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	argsForCall := fake.createServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateSpace(arg1 ccv3.Space) (ccv3.Space, ccv3.Warnings, error) {
 	fake.createSpaceMutex.Lock()
 	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
@@ -3275,6 +3585,138 @@ func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBinding(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteServiceCredentialBindingMutex.Lock()
+	ret, specificReturn := fake.deleteServiceCredentialBindingReturnsOnCall[len(fake.deleteServiceCredentialBindingArgsForCall)]
+	fake.deleteServiceCredentialBindingArgsForCall = append(fake.deleteServiceCredentialBindingArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceCredentialBinding", []interface{}{arg1})
+	fake.deleteServiceCredentialBindingMutex.Unlock()
+	if fake.DeleteServiceCredentialBindingStub != nil {
+		return fake.DeleteServiceCredentialBindingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteServiceCredentialBindingReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBindingCallCount() int {
+	fake.deleteServiceCredentialBindingMutex.RLock()
+	defer fake.deleteServiceCredentialBindingMutex.RUnlock()
+	return len(fake.deleteServiceCredentialBindingArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBindingCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteServiceCredentialBindingMutex.Lock()
+	defer fake.deleteServiceCredentialBindingMutex.Unlock()
+	fake.DeleteServiceCredentialBindingStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBindingArgsForCall(i int) string {
+	fake.deleteServiceCredentialBindingMutex.RLock()
+	defer fake.deleteServiceCredentialBindingMutex.RUnlock()
+	argsForCall := fake.deleteServiceCredentialBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBindingReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteServiceCredentialBindingMutex.Lock()
+	defer fake.deleteServiceCredentialBindingMutex.Unlock()
+	fake.DeleteServiceCredentialBindingStub = nil
+	fake.deleteServiceCredentialBindingReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceCredentialBindingReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteServiceCredentialBindingMutex.Lock()
+	defer fake.deleteServiceCredentialBindingMutex.Unlock()
+	fake.DeleteServiceCredentialBindingStub = nil
+	if fake.deleteServiceCredentialBindingReturnsOnCall == nil {
+		fake.deleteServiceCredentialBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceCredentialBindingReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{arg1})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	argsForCall := fake.deleteServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -5709,6 +6151,138 @@ func (fake *FakeCloudControllerClient) GetServiceBrokersReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetails(arg1 string) (ccv3.ServiceCredentialBindingDetails, ccv3.Warnings, error) {
+	fake.getServiceCredentialBindingDetailsMutex.Lock()
+	ret, specificReturn := fake.getServiceCredentialBindingDetailsReturnsOnCall[len(fake.getServiceCredentialBindingDetailsArgsForCall)]
+	fake.getServiceCredentialBindingDetailsArgsForCall = append(fake.getServiceCredentialBindingDetailsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceCredentialBindingDetails", []interface{}{arg1})
+	fake.getServiceCredentialBindingDetailsMutex.Unlock()
+	if fake.GetServiceCredentialBindingDetailsStub != nil {
+		return fake.GetServiceCredentialBindingDetailsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceCredentialBindingDetailsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetailsCallCount() int {
+	fake.getServiceCredentialBindingDetailsMutex.RLock()
+	defer fake.getServiceCredentialBindingDetailsMutex.RUnlock()
+	return len(fake.getServiceCredentialBindingDetailsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetailsCalls(stub func(string) (ccv3.ServiceCredentialBindingDetails, ccv3.Warnings, error)) {
+	fake.getServiceCredentialBindingDetailsMutex.Lock()
+	defer fake.getServiceCredentialBindingDetailsMutex.Unlock()
+	fake.GetServiceCredentialBindingDetailsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetailsArgsForCall(i int) string {
+	fake.getServiceCredentialBindingDetailsMutex.RLock()
+	defer fake.getServiceCredentialBindingDetailsMutex.RUnlock()
+	argsForCall := fake.getServiceCredentialBindingDetailsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetailsReturns(result1 ccv3.ServiceCredentialBindingDetails, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceCredentialBindingDetailsMutex.Lock()
+	defer fake.getServiceCredentialBindingDetailsMutex.Unlock()
+	fake.GetServiceCredentialBindingDetailsStub = nil
+	fake.getServiceCredentialBindingDetailsReturns = struct {
+		result1 ccv3.ServiceCredentialBindingDetails
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingDetailsReturnsOnCall(i int, result1 ccv3.ServiceCredentialBindingDetails, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceCredentialBindingDetailsMutex.Lock()
+	defer fake.getServiceCredentialBindingDetailsMutex.Unlock()
+	fake.GetServiceCredentialBindingDetailsStub = nil
+	if fake.getServiceCredentialBindingDetailsReturnsOnCall == nil {
+		fake.getServiceCredentialBindingDetailsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServiceCredentialBindingDetails
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceCredentialBindingDetailsReturnsOnCall[i] = struct {
+		result1 ccv3.ServiceCredentialBindingDetails
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindings(arg1 ...ccv3.Query) ([]ccv3.ServiceCredentialBinding, ccv3.Warnings, error) {
+	fake.getServiceCredentialBindingsMutex.Lock()
+	ret, specificReturn := fake.getServiceCredentialBindingsReturnsOnCall[len(fake.getServiceCredentialBindingsArgsForCall)]
+	fake.getServiceCredentialBindingsArgsForCall = append(fake.getServiceCredentialBindingsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceCredentialBindings", []interface{}{arg1})
+	fake.getServiceCredentialBindingsMutex.Unlock()
+	if fake.GetServiceCredentialBindingsStub != nil {
+		return fake.GetServiceCredentialBindingsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceCredentialBindingsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingsCallCount() int {
+	fake.getServiceCredentialBindingsMutex.RLock()
+	defer fake.getServiceCredentialBindingsMutex.RUnlock()
+	return len(fake.getServiceCredentialBindingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingsCalls(stub func(...ccv3.Query) ([]ccv3.ServiceCredentialBinding, ccv3.Warnings, error)) {
+	fake.getServiceCredentialBindingsMutex.Lock()
+	defer fake.getServiceCredentialBindingsMutex.Unlock()
+	fake.GetServiceCredentialBindingsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingsArgsForCall(i int) []ccv3.Query {
+	fake.getServiceCredentialBindingsMutex.RLock()
+	defer fake.getServiceCredentialBindingsMutex.RUnlock()
+	argsForCall := fake.getServiceCredentialBindingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingsReturns(result1 []ccv3.ServiceCredentialBinding, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceCredentialBindingsMutex.Lock()
+	defer fake.getServiceCredentialBindingsMutex.Unlock()
+	fake.GetServiceCredentialBindingsStub = nil
+	fake.getServiceCredentialBindingsReturns = struct {
+		result1 []ccv3.ServiceCredentialBinding
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCredentialBindingsReturnsOnCall(i int, result1 []ccv3.ServiceCredentialBinding, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceCredentialBindingsMutex.Lock()
+	defer fake.getServiceCredentialBindingsMutex.Unlock()
+	fake.GetServiceCredentialBindingsStub = nil
+	if fake.getServiceCredentialBindingsReturnsOnCall == nil {
+		fake.getServiceCredentialBindingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceCredentialBinding
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceCredentialBindingsReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceCredentialBinding
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstances(arg1 ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
 	fake.getServiceInstancesMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
@@ -5775,6 +6349,138 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceOfferings(arg1 ...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error) {
+	fake.getServiceOfferingsMutex.Lock()
+	ret, specificReturn := fake.getServiceOfferingsReturnsOnCall[len(fake.getServiceOfferingsArgsForCall)]
+	fake.getServiceOfferingsArgsForCall = append(fake.getServiceOfferingsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceOfferings", []interface{}{arg1})
+	fake.getServiceOfferingsMutex.Unlock()
+	if fake.GetServiceOfferingsStub != nil {
+		return fake.GetServiceOfferingsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceOfferingsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsCallCount() int {
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	return len(fake.getServiceOfferingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsCalls(stub func(...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error)) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsArgsForCall(i int) []ccv3.Query {
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	argsForCall := fake.getServiceOfferingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsReturns(result1 []ccv3.ServiceOffering, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = nil
+	fake.getServiceOfferingsReturns = struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsReturnsOnCall(i int, result1 []ccv3.ServiceOffering, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = nil
+	if fake.getServiceOfferingsReturnsOnCall == nil {
+		fake.getServiceOfferingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceOffering
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceOfferingsReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(arg1 ...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error) {
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServicePlans", []interface{}{arg1})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServicePlansReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCalls(stub func(...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error)) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv3.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	argsForCall := fake.getServicePlansArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServicePlan
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(arg1 string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.getSpaceIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
@@ -5973,6 +6679,79 @@ func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRaw(arg1 string, arg2 string, arg3 []byte) ([]byte, *http.Response, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	ret, specificReturn := fake.makeRequestSendReceiveRawReturnsOnCall[len(fake.makeRequestSendReceiveRawArgsForCall)]
+	fake.makeRequestSendReceiveRawArgsForCall = append(fake.makeRequestSendReceiveRawArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("MakeRequestSendReceiveRaw", []interface{}{arg1, arg2, arg3Copy})
+	fake.makeRequestSendReceiveRawMutex.Unlock()
+	if fake.MakeRequestSendReceiveRawStub != nil {
+		return fake.MakeRequestSendReceiveRawStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.makeRequestSendReceiveRawReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRawCallCount() int {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	return len(fake.makeRequestSendReceiveRawArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRawCalls(stub func(string, string, []byte) ([]byte, *http.Response, error)) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = stub
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRawArgsForCall(i int) (string, string, []byte) {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	argsForCall := fake.makeRequestSendReceiveRawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRawReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	fake.makeRequestSendReceiveRawReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestSendReceiveRawReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	if fake.makeRequestSendReceiveRawReturnsOnCall == nil {
+		fake.makeRequestSendReceiveRawReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.makeRequestSendReceiveRawReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) MapRoute(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.mapRouteMutex.Lock()
 	ret, specificReturn := fake.mapRouteReturnsOnCall[len(fake.mapRouteArgsForCall)]
@@ -6374,6 +7153,128 @@ func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) StreamApplications(arg1 <-chan struct{}, arg2 ...ccv3.Query) <-chan ccv3.ApplicationsPage {
+	fake.streamApplicationsMutex.Lock()
+	ret, specificReturn := fake.streamApplicationsReturnsOnCall[len(fake.streamApplicationsArgsForCall)]
+	fake.streamApplicationsArgsForCall = append(fake.streamApplicationsArgsForCall, struct {
+		arg1 <-chan struct{}
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("StreamApplications", []interface{}{arg1, arg2})
+	fake.streamApplicationsMutex.Unlock()
+	if fake.StreamApplicationsStub != nil {
+		return fake.StreamApplicationsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.streamApplicationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) StreamApplicationsCallCount() int {
+	fake.streamApplicationsMutex.RLock()
+	defer fake.streamApplicationsMutex.RUnlock()
+	return len(fake.streamApplicationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) StreamApplicationsCalls(stub func(<-chan struct{}, ...ccv3.Query) <-chan ccv3.ApplicationsPage) {
+	fake.streamApplicationsMutex.Lock()
+	defer fake.streamApplicationsMutex.Unlock()
+	fake.StreamApplicationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) StreamApplicationsArgsForCall(i int) (<-chan struct{}, []ccv3.Query) {
+	fake.streamApplicationsMutex.RLock()
+	defer fake.streamApplicationsMutex.RUnlock()
+	argsForCall := fake.streamApplicationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) StreamApplicationsReturns(result1 <-chan ccv3.ApplicationsPage) {
+	fake.streamApplicationsMutex.Lock()
+	defer fake.streamApplicationsMutex.Unlock()
+	fake.StreamApplicationsStub = nil
+	fake.streamApplicationsReturns = struct {
+		result1 <-chan ccv3.ApplicationsPage
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) StreamApplicationsReturnsOnCall(i int, result1 <-chan ccv3.ApplicationsPage) {
+	fake.streamApplicationsMutex.Lock()
+	defer fake.streamApplicationsMutex.Unlock()
+	fake.StreamApplicationsStub = nil
+	if fake.streamApplicationsReturnsOnCall == nil {
+		fake.streamApplicationsReturnsOnCall = make(map[int]struct {
+			result1 <-chan ccv3.ApplicationsPage
+		})
+	}
+	fake.streamApplicationsReturnsOnCall[i] = struct {
+		result1 <-chan ccv3.ApplicationsPage
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutes(arg1 <-chan struct{}, arg2 ...ccv3.Query) <-chan ccv3.RoutesPage {
+	fake.streamRoutesMutex.Lock()
+	ret, specificReturn := fake.streamRoutesReturnsOnCall[len(fake.streamRoutesArgsForCall)]
+	fake.streamRoutesArgsForCall = append(fake.streamRoutesArgsForCall, struct {
+		arg1 <-chan struct{}
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("StreamRoutes", []interface{}{arg1, arg2})
+	fake.streamRoutesMutex.Unlock()
+	if fake.StreamRoutesStub != nil {
+		return fake.StreamRoutesStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.streamRoutesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutesCallCount() int {
+	fake.streamRoutesMutex.RLock()
+	defer fake.streamRoutesMutex.RUnlock()
+	return len(fake.streamRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutesCalls(stub func(<-chan struct{}, ...ccv3.Query) <-chan ccv3.RoutesPage) {
+	fake.streamRoutesMutex.Lock()
+	defer fake.streamRoutesMutex.Unlock()
+	fake.StreamRoutesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutesArgsForCall(i int) (<-chan struct{}, []ccv3.Query) {
+	fake.streamRoutesMutex.RLock()
+	defer fake.streamRoutesMutex.RUnlock()
+	argsForCall := fake.streamRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutesReturns(result1 <-chan ccv3.RoutesPage) {
+	fake.streamRoutesMutex.Lock()
+	defer fake.streamRoutesMutex.Unlock()
+	fake.StreamRoutesStub = nil
+	fake.streamRoutesReturns = struct {
+		result1 <-chan ccv3.RoutesPage
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) StreamRoutesReturnsOnCall(i int, result1 <-chan ccv3.RoutesPage) {
+	fake.streamRoutesMutex.Lock()
+	defer fake.streamRoutesMutex.Unlock()
+	fake.StreamRoutesStub = nil
+	if fake.streamRoutesReturnsOnCall == nil {
+		fake.streamRoutesReturnsOnCall = make(map[int]struct {
+			result1 <-chan ccv3.RoutesPage
+		})
+	}
+	fake.streamRoutesReturnsOnCall[i] = struct {
+		result1 <-chan ccv3.RoutesPage
+	}{result1}
+}
+
 func (fake *FakeCloudControllerClient) UnmapRoute(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.unmapRouteMutex.Lock()
 	ret, specificReturn := fake.unmapRouteReturnsOnCall[len(fake.unmapRouteArgsForCall)]
@@ -7304,6 +8205,73 @@ func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateServiceInstance(arg1 string, arg2 ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 ccv3.ServiceInstance
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{arg1, arg2})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceCalls(stub func(string, ccv3.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.updateServiceInstanceMutex.Lock()
+	defer fake.updateServiceInstanceMutex.Unlock()
+	fake.UpdateServiceInstanceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceArgsForCall(i int) (string, ccv3.ServiceInstance) {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	argsForCall := fake.updateServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.updateServiceInstanceMutex.Lock()
+	defer fake.updateServiceInstanceMutex.Unlock()
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.updateServiceInstanceMutex.Lock()
+	defer fake.updateServiceInstanceMutex.Unlock()
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpace(arg1 ccv3.Space) (ccv3.Space, ccv3.Warnings, error) {
 	fake.updateSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
@@ -7894,6 +8862,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBrokerMutex.RLock()
 	defer fake.createServiceBrokerMutex.RUnlock()
+	fake.createServiceCredentialBindingMutex.RLock()
+	defer fake.createServiceCredentialBindingMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
 	fake.createSpaceMutex.RLock()
 	defer fake.createSpaceMutex.RUnlock()
 	fake.deleteApplicationMutex.RLock()
@@ -7914,6 +8886,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteOrphanedRoutesMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteServiceCredentialBindingMutex.RLock()
+	defer fake.deleteServiceCredentialBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
@@ -7988,14 +8964,24 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getRoutesMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
 	defer fake.getServiceBrokersMutex.RUnlock()
+	fake.getServiceCredentialBindingDetailsMutex.RLock()
+	defer fake.getServiceCredentialBindingDetailsMutex.RUnlock()
+	fake.getServiceCredentialBindingsMutex.RLock()
+	defer fake.getServiceCredentialBindingsMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
 	defer fake.mapRouteMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -8008,6 +8994,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.sharePrivateDomainToOrgsMutex.RUnlock()
 	fake.shareServiceInstanceToSpacesMutex.RLock()
 	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	fake.streamApplicationsMutex.RLock()
+	defer fake.streamApplicationsMutex.RUnlock()
+	fake.streamRoutesMutex.RLock()
+	defer fake.streamRoutesMutex.RUnlock()
 	fake.unmapRouteMutex.RLock()
 	defer fake.unmapRouteMutex.RUnlock()
 	fake.unsharePrivateDomainFromOrgMutex.RLock()
@@ -8036,6 +9026,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateProcessMutex.RUnlock()
 	fake.updateResourceMetadataMutex.RLock()
 	defer fake.updateResourceMetadataMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
 	fake.updateSpaceMutex.RLock()
 	defer fake.updateSpaceMutex.RUnlock()
 	fake.updateSpaceApplyManifestMutex.RLock()
@@ -0,0 +1,203 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+)
+
+type FakeLogCacheClient struct {
+	ReadStub        func(string, logcache.ReadOptions) ([]logcache.Envelope, error)
+	readMutex       sync.RWMutex
+	readArgsForCall []struct {
+		arg1 string
+		arg2 logcache.ReadOptions
+	}
+	readReturns struct {
+		result1 []logcache.Envelope
+		result2 error
+	}
+	readReturnsOnCall map[int]struct {
+		result1 []logcache.Envelope
+		result2 error
+	}
+	ReadAllStub        func(string, time.Time, time.Time, ...string) ([]logcache.Envelope, error)
+	readAllMutex       sync.RWMutex
+	readAllArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+		arg4 []string
+	}
+	readAllReturns struct {
+		result1 []logcache.Envelope
+		result2 error
+	}
+	readAllReturnsOnCall map[int]struct {
+		result1 []logcache.Envelope
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLogCacheClient) Read(arg1 string, arg2 logcache.ReadOptions) ([]logcache.Envelope, error) {
+	fake.readMutex.Lock()
+	ret, specificReturn := fake.readReturnsOnCall[len(fake.readArgsForCall)]
+	fake.readArgsForCall = append(fake.readArgsForCall, struct {
+		arg1 string
+		arg2 logcache.ReadOptions
+	}{arg1, arg2})
+	fake.recordInvocation("Read", []interface{}{arg1, arg2})
+	fake.readMutex.Unlock()
+	if fake.ReadStub != nil {
+		return fake.ReadStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.readReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLogCacheClient) ReadCallCount() int {
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	return len(fake.readArgsForCall)
+}
+
+func (fake *FakeLogCacheClient) ReadCalls(stub func(string, logcache.ReadOptions) ([]logcache.Envelope, error)) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = stub
+}
+
+func (fake *FakeLogCacheClient) ReadArgsForCall(i int) (string, logcache.ReadOptions) {
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	argsForCall := fake.readArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLogCacheClient) ReadReturns(result1 []logcache.Envelope, result2 error) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = nil
+	fake.readReturns = struct {
+		result1 []logcache.Envelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) ReadReturnsOnCall(i int, result1 []logcache.Envelope, result2 error) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = nil
+	if fake.readReturnsOnCall == nil {
+		fake.readReturnsOnCall = make(map[int]struct {
+			result1 []logcache.Envelope
+			result2 error
+		})
+	}
+	fake.readReturnsOnCall[i] = struct {
+		result1 []logcache.Envelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) ReadAll(arg1 string, arg2 time.Time, arg3 time.Time, arg4 ...string) ([]logcache.Envelope, error) {
+	fake.readAllMutex.Lock()
+	ret, specificReturn := fake.readAllReturnsOnCall[len(fake.readAllArgsForCall)]
+	fake.readAllArgsForCall = append(fake.readAllArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+		arg4 []string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ReadAll", []interface{}{arg1, arg2, arg3, arg4})
+	fake.readAllMutex.Unlock()
+	if fake.ReadAllStub != nil {
+		return fake.ReadAllStub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.readAllReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLogCacheClient) ReadAllCallCount() int {
+	fake.readAllMutex.RLock()
+	defer fake.readAllMutex.RUnlock()
+	return len(fake.readAllArgsForCall)
+}
+
+func (fake *FakeLogCacheClient) ReadAllCalls(stub func(string, time.Time, time.Time, ...string) ([]logcache.Envelope, error)) {
+	fake.readAllMutex.Lock()
+	defer fake.readAllMutex.Unlock()
+	fake.ReadAllStub = stub
+}
+
+func (fake *FakeLogCacheClient) ReadAllArgsForCall(i int) (string, time.Time, time.Time, []string) {
+	fake.readAllMutex.RLock()
+	defer fake.readAllMutex.RUnlock()
+	argsForCall := fake.readAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLogCacheClient) ReadAllReturns(result1 []logcache.Envelope, result2 error) {
+	fake.readAllMutex.Lock()
+	defer fake.readAllMutex.Unlock()
+	fake.ReadAllStub = nil
+	fake.readAllReturns = struct {
+		result1 []logcache.Envelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) ReadAllReturnsOnCall(i int, result1 []logcache.Envelope, result2 error) {
+	fake.readAllMutex.Lock()
+	defer fake.readAllMutex.Unlock()
+	fake.ReadAllStub = nil
+	if fake.readAllReturnsOnCall == nil {
+		fake.readAllReturnsOnCall = make(map[int]struct {
+			result1 []logcache.Envelope
+			result2 error
+		})
+	}
+	fake.readAllReturnsOnCall[i] = struct {
+		result1 []logcache.Envelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	fake.readAllMutex.RLock()
+	defer fake.readAllMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLogCacheClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.LogCacheClient = new(FakeLogCacheClient)
@@ -1,12 +1,14 @@
 package v7pushaction
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 // CreatePushPlans returns a set of PushPlan objects based off the inputs
 // provided. It's assumed that all flag and argument and manifest combinations
-// have been validated prior to calling this function.
+// have been validated prior to calling this function. The plans are ordered so
+// that every plan comes after the plans it depends on.
 func (actor Actor) CreatePushPlans(
 	appNameArg string,
 	spaceGUID string,
@@ -20,6 +22,7 @@ func (actor Actor) CreatePushPlans(
 		plan := PushPlan{
 			OrgGUID:   orgGUID,
 			SpaceGUID: spaceGUID,
+			DependsOn: manifestApplication.DependsOn,
 		}
 
 		// List of PreparePushPlanSequence is defined in NewActor
@@ -34,7 +37,53 @@ func (actor Actor) CreatePushPlans(
 		pushPlans = append(pushPlans, plan)
 	}
 
-	return pushPlans, nil
+	return sortPushPlansByDependencies(pushPlans)
+}
+
+// sortPushPlansByDependencies orders the plans so that each plan comes after
+// the plans it depends on, otherwise keeping the manifest order. Dependencies
+// on apps that are not being pushed are ignored.
+func sortPushPlansByDependencies(pushPlans []PushPlan) ([]PushPlan, error) {
+	pending := map[string]bool{}
+	for _, plan := range pushPlans {
+		pending[plan.Application.Name] = true
+	}
+
+	var sorted []PushPlan
+	for len(sorted) < len(pushPlans) {
+		var ready []PushPlan
+		for _, plan := range pushPlans {
+			if pending[plan.Application.Name] && !hasPendingDependency(plan, pending) {
+				ready = append(ready, plan)
+			}
+		}
+
+		if len(ready) == 0 {
+			var cycle []string
+			for _, plan := range pushPlans {
+				if pending[plan.Application.Name] {
+					cycle = append(cycle, plan.Application.Name)
+				}
+			}
+			return nil, actionerror.PushPlanDependencyCycleError{AppNames: cycle}
+		}
+
+		for _, plan := range ready {
+			delete(pending, plan.Application.Name)
+		}
+		sorted = append(sorted, ready...)
+	}
+
+	return sorted, nil
+}
+
+func hasPendingDependency(plan PushPlan, pending map[string]bool) bool {
+	for _, dependency := range plan.DependsOn {
+		if pending[dependency] {
+			return true
+		}
+	}
+	return false
 }
 
 func getEligibleApplications(parser ManifestParser, appName string) []manifestparser.Application {
@@ -3,6 +3,7 @@ package v7pushaction_test
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
 	"code.cloudfoundry.org/cli/util/manifestparser"
@@ -92,6 +93,42 @@ var _ = Describe("CreatePushPlans", func() {
 		})
 	})
 
+	Describe("Dependencies", func() {
+		BeforeEach(func() {
+			fakeManifestParser.AppsReturns([]manifestparser.Application{
+				{ApplicationModel: manifestparser.ApplicationModel{Name: "web", DependsOn: []string{"migrations"}}},
+				{ApplicationModel: manifestparser.ApplicationModel{Name: "worker", DependsOn: []string{"other-app"}}},
+				{ApplicationModel: manifestparser.ApplicationModel{Name: "migrations"}},
+			})
+			fakeManifestParser.ContainsManifestReturns(true)
+
+			appNameArg = ""
+		})
+
+		AssertNoExecuteErr()
+
+		It("orders the pushPlans after the apps they depend on", func() {
+			Expect(pushPlans[0].Application.Name).To(Equal("worker"))
+			Expect(pushPlans[1].Application.Name).To(Equal("migrations"))
+			Expect(pushPlans[2].Application.Name).To(Equal("web"))
+			Expect(pushPlans[2].DependsOn).To(Equal([]string{"migrations"}))
+		})
+
+		When("the apps depend on each other", func() {
+			BeforeEach(func() {
+				fakeManifestParser.AppsReturns([]manifestparser.Application{
+					{ApplicationModel: manifestparser.ApplicationModel{Name: "web", DependsOn: []string{"migrations"}}},
+					{ApplicationModel: manifestparser.ApplicationModel{Name: "worker"}},
+					{ApplicationModel: manifestparser.ApplicationModel{Name: "migrations", DependsOn: []string{"web"}}},
+				})
+			})
+
+			It("returns a dependency cycle error", func() {
+				Expect(executeErr).To(MatchError(actionerror.PushPlanDependencyCycleError{AppNames: []string{"web", "migrations"}}))
+			})
+		})
+	})
+
 	Describe("Org and Space GUID", func() {
 		It("creates pushPlans with org and space GUIDs", func() {
 			Expect(pushPlans[0].SpaceGUID).To(Equal(spaceGUID))
@@ -0,0 +1,524 @@
+package v7pushaction
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// ManifestFieldDiff is a field of an app whose current value differs from
+// the value applying the manifest would give it.
+type ManifestFieldDiff struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+// ManifestAppDiff lists what applying a manifest would change about an app.
+type ManifestAppDiff struct {
+	AppName string
+	// Create is true when the app does not exist in the space and would be
+	// created.
+	Create bool
+	Fields []ManifestFieldDiff
+}
+
+// HasDrift returns true when applying the manifest would change the app.
+func (diff ManifestAppDiff) HasDrift() bool {
+	return diff.Create || len(diff.Fields) > 0
+}
+
+// DiffManifest compares every app in the parsed manifest with its current
+// state in the space without changing anything. Routes, environment variables
+// and services are only compared for the entries listed in the manifest,
+// since pushing does not remove the ones missing from it.
+func (actor Actor) DiffManifest(spaceGUID string, parser ManifestParser) ([]ManifestAppDiff, Warnings, error) {
+	var (
+		diffs       []ManifestAppDiff
+		allWarnings Warnings
+	)
+
+	for _, manifestApp := range parser.Apps() {
+		diff, warnings, err := actor.diffManifestApp(spaceGUID, manifestApp)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, allWarnings, nil
+}
+
+func (actor Actor) diffManifestApp(spaceGUID string, manifestApp manifestparser.Application) (ManifestAppDiff, Warnings, error) {
+	diff := ManifestAppDiff{AppName: manifestApp.Name}
+
+	app, v7Warnings, err := actor.V7Actor.GetApplicationByNameAndSpace(manifestApp.Name, spaceGUID)
+	allWarnings := Warnings(v7Warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+			diff.Create = true
+			return diff, allWarnings, nil
+		}
+		return diff, allWarnings, err
+	}
+
+	plan, err := actor.createDiffPushPlan(spaceGUID, manifestApp)
+	if err != nil {
+		return diff, allWarnings, err
+	}
+	diff.Fields = append(diff.Fields, diffApplication(app, plan)...)
+
+	if plan.DockerImageCredentialsNeedsUpdate {
+		droplet, v7Warnings, err := actor.V7Actor.GetCurrentDropletByApplication(app.GUID)
+		allWarnings = append(allWarnings, v7Warnings...)
+		if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+			return diff, allWarnings, err
+		}
+		diff.Fields = append(diff.Fields, diffField("docker.image", droplet.Image, plan.DockerImageCredentials.Path)...)
+	}
+
+	processDiffs, warnings, err := actor.diffProcesses(app, plan, manifestApp)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return diff, allWarnings, err
+	}
+	diff.Fields = append(diff.Fields, processDiffs...)
+
+	manifestFields := manifestApp.FullUnmarshalledApplication
+
+	routeDiffs, warnings, err := actor.diffRoutes(app, manifestApp)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return diff, allWarnings, err
+	}
+	diff.Fields = append(diff.Fields, routeDiffs...)
+
+	if manifestEnv := manifestMap(manifestFields["env"]); len(manifestEnv) > 0 {
+		env, v7Warnings, err := actor.V7Actor.GetEnvironmentVariablesByApplicationNameAndSpace(app.Name, spaceGUID)
+		allWarnings = append(allWarnings, v7Warnings...)
+		if err != nil {
+			return diff, allWarnings, err
+		}
+		diff.Fields = append(diff.Fields, diffEnvironmentVariables(env, manifestEnv)...)
+	}
+
+	if manifestServices := manifestServiceNames(manifestFields["services"]); len(manifestServices) > 0 {
+		boundServices, v7Warnings, err := actor.V7Actor.GetBoundServiceInstanceNamesByApplication(app.GUID)
+		allWarnings = append(allWarnings, v7Warnings...)
+		if err != nil {
+			return diff, allWarnings, err
+		}
+		diff.Fields = append(diff.Fields, diffAddedItems("services", boundServices, manifestServices)...)
+	}
+
+	return diff, allWarnings, nil
+}
+
+// createDiffPushPlan creates the push plan push would create for the manifest
+// app if the manifest settings were given as flags. The steps that read the
+// app bits are left out, since they do not change the app settings.
+func (actor Actor) createDiffPushPlan(spaceGUID string, manifestApp manifestparser.Application) (PushPlan, error) {
+	overrides, err := manifestFlagOverrides(manifestApp.Name, webProcessManifestFields(manifestApp.FullUnmarshalledApplication))
+	if err != nil {
+		return PushPlan{}, err
+	}
+
+	planner := actor
+	planner.PreparePushPlanSequence = []UpdatePushPlanFunc{
+		SetupApplicationForPushPlan,
+		SetupDockerImageCredentialsForPushPlan,
+		SetupScaleWebProcessForPushPlan,
+		SetupUpdateWebProcessForPushPlan,
+	}
+
+	plans, err := planner.CreatePushPlans(manifestApp.Name, spaceGUID, "", singleAppManifest{app: manifestApp}, overrides)
+	if err != nil {
+		return PushPlan{}, err
+	}
+	return plans[0], nil
+}
+
+// diffProcesses compares the processes listed in the manifest with the
+// processes of the app. The web process is compared with the push plan; the
+// other processes are planned the same way push plans the web process.
+func (actor Actor) diffProcesses(app v7action.Application, webPlan PushPlan, manifestApp manifestparser.Application) ([]ManifestFieldDiff, Warnings, error) {
+	processTypes := []string{constant.ProcessTypeWeb}
+	plans := map[string]PushPlan{constant.ProcessTypeWeb: webPlan}
+
+	processes, _ := manifestApp.FullUnmarshalledApplication["processes"].([]interface{})
+	for _, process := range processes {
+		processFields := manifestMap(process)
+		processType := fmt.Sprint(processFields["type"])
+		if processType == constant.ProcessTypeWeb {
+			continue
+		}
+
+		overrides, err := manifestFlagOverrides(manifestApp.Name, processFields)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		plan, _ := SetupScaleWebProcessForPushPlan(PushPlan{}, overrides, manifestApp)
+		plan, _ = SetupUpdateWebProcessForPushPlan(plan, overrides, manifestApp)
+		processTypes = append(processTypes, processType)
+		plans[processType] = plan
+	}
+
+	var (
+		diffs       []ManifestFieldDiff
+		allWarnings Warnings
+	)
+	for _, processType := range processTypes {
+		plan := plans[processType]
+		if !plan.ScaleWebProcessNeedsUpdate && !plan.UpdateWebProcessNeedsUpdate {
+			continue
+		}
+
+		process, warnings, err := actor.V7Actor.GetProcessByTypeAndApplication(processType, app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.ProcessNotFoundError); err != nil && !ok {
+			return nil, allWarnings, err
+		}
+
+		fieldPrefix := ""
+		if processType != constant.ProcessTypeWeb {
+			fieldPrefix = processType + "."
+		}
+		diffs = append(diffs, diffProcess(fieldPrefix, process, plan)...)
+	}
+
+	return diffs, allWarnings, nil
+}
+
+func (actor Actor) diffRoutes(app v7action.Application, manifestApp manifestparser.Application) ([]ManifestFieldDiff, Warnings, error) {
+	manifestRoutes := manifestRouteURLs(manifestApp.FullUnmarshalledApplication["routes"])
+	if !manifestApp.NoRoute && len(manifestRoutes) == 0 {
+		return nil, nil, nil
+	}
+
+	routes, v7Warnings, err := actor.V7Actor.GetApplicationRoutes(app.GUID)
+	if err != nil {
+		return nil, Warnings(v7Warnings), err
+	}
+
+	var currentRoutes []string
+	for _, route := range routes {
+		currentRoutes = append(currentRoutes, route.URL)
+	}
+	sort.Strings(currentRoutes)
+
+	if manifestApp.NoRoute {
+		if len(currentRoutes) == 0 {
+			return nil, Warnings(v7Warnings), nil
+		}
+		return []ManifestFieldDiff{{
+			Field:   "routes",
+			Current: strings.Join(currentRoutes, ", "),
+		}}, Warnings(v7Warnings), nil
+	}
+
+	return diffAddedItems("routes", currentRoutes, manifestRoutes), Warnings(v7Warnings), nil
+}
+
+// diffApplication compares the app with the app settings of the push plan.
+func diffApplication(app v7action.Application, plan PushPlan) []ManifestFieldDiff {
+	desired := plan.Application
+
+	var diffs []ManifestFieldDiff
+	if desired.LifecycleType != "" {
+		diffs = append(diffs, diffField("lifecycle", string(app.LifecycleType), string(desired.LifecycleType))...)
+	}
+
+	if plan.ApplicationNeedsUpdate {
+		if len(desired.LifecycleBuildpacks) > 0 {
+			diffs = append(diffs, diffField("buildpacks", strings.Join(app.LifecycleBuildpacks, ", "), strings.Join(desired.LifecycleBuildpacks, ", "))...)
+		}
+		if desired.StackName != "" {
+			diffs = append(diffs, diffField("stack", app.StackName, desired.StackName)...)
+		}
+	}
+
+	return diffs
+}
+
+// diffProcess compares the process with the process settings of the push
+// plan.
+func diffProcess(fieldPrefix string, process v7action.Process, plan PushPlan) []ManifestFieldDiff {
+	var diffs []ManifestFieldDiff
+
+	if plan.ScaleWebProcessNeedsUpdate {
+		desired := plan.ScaleWebProcess
+		if desired.Instances.IsSet {
+			current := ""
+			if process.Instances.IsSet {
+				current = fmt.Sprint(process.Instances.Value)
+			}
+			diffs = append(diffs, diffField(fieldPrefix+"instances", current, fmt.Sprint(desired.Instances.Value))...)
+		}
+		if desired.MemoryInMB.IsSet {
+			diffs = append(diffs, diffField(fieldPrefix+"memory", formatMegabytes(process.MemoryInMB), formatMegabytes(desired.MemoryInMB))...)
+		}
+		if desired.DiskInMB.IsSet {
+			diffs = append(diffs, diffField(fieldPrefix+"disk_quota", formatMegabytes(process.DiskInMB), formatMegabytes(desired.DiskInMB))...)
+		}
+	}
+
+	if plan.UpdateWebProcessNeedsUpdate {
+		desired := plan.UpdateWebProcess
+		if desired.Command.IsSet {
+			diffs = append(diffs, diffField(fieldPrefix+"command", process.Command.Value, desired.Command.Value)...)
+		}
+		if desired.HealthCheckType != "" {
+			diffs = append(diffs, diffField(fieldPrefix+"health-check-type", string(process.HealthCheckType), string(desired.HealthCheckType))...)
+		}
+		if desired.HealthCheckEndpoint != "" {
+			diffs = append(diffs, diffField(fieldPrefix+"health-check-http-endpoint", process.HealthCheckEndpoint, desired.HealthCheckEndpoint)...)
+		}
+		if desired.HealthCheckTimeout != 0 {
+			current := ""
+			if process.HealthCheckTimeout != 0 {
+				current = fmt.Sprint(process.HealthCheckTimeout)
+			}
+			diffs = append(diffs, diffField(fieldPrefix+"timeout", current, fmt.Sprint(desired.HealthCheckTimeout))...)
+		}
+	}
+
+	return diffs
+}
+
+// diffField returns a diff when current and desired differ.
+func diffField(field string, current string, desired string) []ManifestFieldDiff {
+	if current == desired {
+		return nil
+	}
+	return []ManifestFieldDiff{{Field: field, Current: current, Desired: desired}}
+}
+
+func formatMegabytes(megabytes types.NullUint64) string {
+	if !megabytes.IsSet {
+		return ""
+	}
+	return fmt.Sprintf("%dM", megabytes.Value)
+}
+
+func diffEnvironmentVariables(env v7action.EnvironmentVariableGroups, manifestEnv map[string]interface{}) []ManifestFieldDiff {
+	var names []string
+	for name := range manifestEnv {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diffs []ManifestFieldDiff
+	for _, name := range names {
+		desired := fmt.Sprint(manifestEnv[name])
+		current := ""
+		if value, ok := env.EnvironmentVariables[name]; ok {
+			current = fmt.Sprint(value)
+			if current == desired {
+				continue
+			}
+		}
+		diffs = append(diffs, ManifestFieldDiff{Field: "env." + name, Current: current, Desired: desired})
+	}
+	return diffs
+}
+
+// diffAddedItems returns a diff when the manifest lists items that are not in
+// current. The desired value is current with the missing items added.
+func diffAddedItems(field string, current []string, manifestItems []string) []ManifestFieldDiff {
+	currentSet := map[string]bool{}
+	for _, item := range current {
+		currentSet[item] = true
+	}
+
+	desired := append([]string{}, current...)
+	for _, item := range manifestItems {
+		if !currentSet[item] {
+			currentSet[item] = true
+			desired = append(desired, item)
+		}
+	}
+
+	if len(desired) == len(current) {
+		return nil
+	}
+
+	sort.Strings(desired)
+	return []ManifestFieldDiff{{
+		Field:   field,
+		Current: strings.Join(current, ", "),
+		Desired: strings.Join(desired, ", "),
+	}}
+}
+
+// manifestFlagOverrides returns the flag overrides that set an app or process
+// the way the given manifest fields do.
+func manifestFlagOverrides(appName string, manifestFields map[string]interface{}) (FlagOverrides, error) {
+	var overrides FlagOverrides
+
+	if buildpacks, ok := manifestFields["buildpacks"].([]interface{}); ok {
+		for _, buildpack := range buildpacks {
+			overrides.Buildpacks = append(overrides.Buildpacks, fmt.Sprint(buildpack))
+		}
+	} else if buildpack, ok := manifestFields["buildpack"]; ok {
+		overrides.Buildpacks = []string{fmt.Sprint(buildpack)}
+	}
+
+	if stack, ok := manifestFields["stack"]; ok {
+		overrides.Stack = fmt.Sprint(stack)
+	}
+
+	if instances, ok := manifestFields["instances"]; ok {
+		value, err := strconv.Atoi(fmt.Sprint(instances))
+		if err != nil {
+			return FlagOverrides{}, invalidManifestValueError(appName, "instances", instances)
+		}
+		overrides.Instances = types.NullInt{IsSet: true, Value: value}
+	}
+
+	sizes := []struct {
+		field    string
+		override *types.NullUint64
+	}{
+		{"memory", &overrides.Memory},
+		{"disk_quota", &overrides.Disk},
+		{"disk-quota", &overrides.Disk},
+	}
+	for _, size := range sizes {
+		value, ok := manifestFields[size.field]
+		if !ok {
+			continue
+		}
+		megabytes, err := bytefmt.ToMegabytes(fmt.Sprint(value))
+		if err != nil {
+			return FlagOverrides{}, invalidManifestValueError(appName, size.field, value)
+		}
+		*size.override = types.NullUint64{IsSet: true, Value: megabytes}
+	}
+
+	if command, ok := manifestFields["command"]; ok {
+		overrides.StartCommand = types.FilteredString{IsSet: true, Value: fmt.Sprint(command)}
+	}
+
+	if healthCheckType, ok := manifestFields["health-check-type"]; ok {
+		overrides.HealthCheckType = constant.HealthCheckType(fmt.Sprint(healthCheckType))
+	}
+
+	if endpoint, ok := manifestFields["health-check-http-endpoint"]; ok {
+		overrides.HealthCheckEndpoint = fmt.Sprint(endpoint)
+	}
+
+	if timeout, ok := manifestFields["timeout"]; ok {
+		value, err := strconv.ParseInt(fmt.Sprint(timeout), 10, 64)
+		if err != nil {
+			return FlagOverrides{}, invalidManifestValueError(appName, "timeout", timeout)
+		}
+		overrides.HealthCheckTimeout = value
+	}
+
+	return overrides, nil
+}
+
+func invalidManifestValueError(appName string, field string, value interface{}) error {
+	return actionerror.ApplicationManifestError{
+		Message: fmt.Sprintf("Invalid %s '%v' for app %s.", field, value, appName),
+	}
+}
+
+// webProcessManifestFields returns the app level fields of a manifest app,
+// overridden by the fields of its web process when the manifest lists one.
+func webProcessManifestFields(manifestFields map[string]interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for key, value := range manifestFields {
+		fields[key] = value
+	}
+
+	processes, _ := manifestFields["processes"].([]interface{})
+	for _, process := range processes {
+		processFields := manifestMap(process)
+		if fmt.Sprint(processFields["type"]) != constant.ProcessTypeWeb {
+			continue
+		}
+		for key, value := range processFields {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
+
+func manifestRouteURLs(value interface{}) []string {
+	routes, _ := value.([]interface{})
+
+	var urls []string
+	for _, route := range routes {
+		url := fmt.Sprint(manifestMap(route)["route"])
+		for _, scheme := range []string{"http://", "https://"} {
+			url = strings.TrimPrefix(url, scheme)
+		}
+		urls = append(urls, strings.TrimSuffix(url, "/"))
+	}
+	return urls
+}
+
+func manifestServiceNames(value interface{}) []string {
+	services, _ := value.([]interface{})
+
+	var names []string
+	for _, service := range services {
+		if name, ok := service.(string); ok {
+			names = append(names, name)
+			continue
+		}
+		if name, ok := manifestMap(service)["name"]; ok {
+			names = append(names, fmt.Sprint(name))
+		}
+	}
+	return names
+}
+
+// manifestMap converts a map parsed from YAML into a map with string keys.
+func manifestMap(value interface{}) map[string]interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		return typedValue
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, value := range typedValue {
+			converted[fmt.Sprint(key)] = value
+		}
+		return converted
+	}
+	return nil
+}
+
+// singleAppManifest is a manifest holding a single app of a parsed manifest,
+// which lets CreatePushPlans plan the app on its own.
+type singleAppManifest struct {
+	app manifestparser.Application
+}
+
+func (manifest singleAppManifest) Apps() []manifestparser.Application {
+	return []manifestparser.Application{manifest.app}
+}
+
+func (singleAppManifest) ContainsManifest() bool {
+	return true
+}
+
+func (singleAppManifest) FullRawManifest() []byte {
+	return nil
+}
+
+func (singleAppManifest) RawAppManifest(string) ([]byte, error) {
+	return nil, nil
+}
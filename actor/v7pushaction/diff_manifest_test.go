@@ -0,0 +1,313 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("DiffManifest", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+		fakeParser  *v7pushactionfakes.FakeManifestParser
+
+		diffs      []ManifestAppDiff
+		warnings   Warnings
+		executeErr error
+	)
+
+	parseApp := func(rawApp string) manifestparser.Application {
+		var app manifestparser.Application
+		Expect(yaml.Unmarshal([]byte(rawApp), &app)).To(Succeed())
+		return app
+	}
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+		fakeParser = new(v7pushactionfakes.FakeManifestParser)
+
+		fakeV7Actor.GetApplicationByNameAndSpaceReturns(
+			v7action.Application{
+				Name:                "some-app",
+				GUID:                "some-app-guid",
+				StackName:           "cflinuxfs3",
+				LifecycleType:       constant.AppLifecycleTypeBuildpack,
+				LifecycleBuildpacks: []string{"ruby_buildpack"},
+			},
+			v7action.Warnings{"app-warning"},
+			nil,
+		)
+		fakeV7Actor.GetProcessByTypeAndApplicationReturns(
+			v7action.Process{
+				Command:         types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+				HealthCheckType: constant.Port,
+				Instances:       types.NullInt{IsSet: true, Value: 1},
+				MemoryInMB:      types.NullUint64{IsSet: true, Value: 256},
+				DiskInMB:        types.NullUint64{IsSet: true, Value: 1024},
+			},
+			v7action.Warnings{"process-warning"},
+			nil,
+		)
+		fakeV7Actor.GetApplicationRoutesReturns(
+			[]v7action.Route{{URL: "some-app.example.com"}},
+			v7action.Warnings{"routes-warning"},
+			nil,
+		)
+		fakeV7Actor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+			v7action.EnvironmentVariableGroups{EnvironmentVariables: map[string]interface{}{"SAME": "value", "CHANGED": "old"}},
+			v7action.Warnings{"env-warning"},
+			nil,
+		)
+		fakeV7Actor.GetBoundServiceInstanceNamesByApplicationReturns(
+			[]string{"some-db"},
+			v7action.Warnings{"services-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		diffs, warnings, executeErr = actor.DiffManifest("some-space-guid", fakeParser)
+	})
+
+	When("the app matches the manifest", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+instances: 1
+memory: 256M
+disk_quota: 1G
+command: bundle exec rackup
+health-check-type: port
+buildpacks: [ruby_buildpack]
+stack: cflinuxfs3
+routes:
+- route: some-app.example.com
+env:
+  SAME: value
+services:
+- some-db
+`)})
+		})
+
+		It("returns a diff without drift", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs).To(HaveLen(1))
+			Expect(diffs[0].AppName).To(Equal("some-app"))
+			Expect(diffs[0].HasDrift()).To(BeFalse())
+
+			Expect(warnings).To(ConsistOf("app-warning", "process-warning", "routes-warning", "env-warning", "services-warning"))
+
+			appName, spaceGUID := fakeV7Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			processType, appGUID := fakeV7Actor.GetProcessByTypeAndApplicationArgsForCall(0)
+			Expect(processType).To(Equal("web"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+	})
+
+	When("the app differs from the manifest", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+instances: 1
+memory: 1G
+disk_quota: 2G
+health-check-type: http
+health-check-http-endpoint: /health
+timeout: 60
+buildpack: go_buildpack
+stack: cflinuxfs4
+routes:
+- route: https://other.example.com/
+env:
+  SAME: value
+  CHANGED: new
+  ADDED: 42
+services:
+- name: other-db
+processes:
+- type: web
+  instances: 3
+`)})
+		})
+
+		It("returns a diff of every changed field", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs).To(HaveLen(1))
+			Expect(diffs[0].HasDrift()).To(BeTrue())
+			Expect(diffs[0].Fields).To(Equal([]ManifestFieldDiff{
+				{Field: "buildpacks", Current: "ruby_buildpack", Desired: "go_buildpack"},
+				{Field: "stack", Current: "cflinuxfs3", Desired: "cflinuxfs4"},
+				{Field: "instances", Current: "1", Desired: "3"},
+				{Field: "memory", Current: "256M", Desired: "1024M"},
+				{Field: "disk_quota", Current: "1024M", Desired: "2048M"},
+				{Field: "health-check-type", Current: "port", Desired: "http"},
+				{Field: "health-check-http-endpoint", Current: "", Desired: "/health"},
+				{Field: "timeout", Current: "", Desired: "60"},
+				{Field: "routes", Current: "some-app.example.com", Desired: "other.example.com, some-app.example.com"},
+				{Field: "env.ADDED", Current: "", Desired: "42"},
+				{Field: "env.CHANGED", Current: "old", Desired: "new"},
+				{Field: "services", Current: "some-db", Desired: "other-db, some-db"},
+			}))
+		})
+	})
+
+	When("the manifest lists other process types", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+processes:
+- type: worker
+  instances: 2
+  command: bundle exec sidekiq
+- type: clock
+  instances: 1
+`)})
+			fakeV7Actor.GetProcessByTypeAndApplicationStub = func(processType string, appGUID string) (v7action.Process, v7action.Warnings, error) {
+				if processType == "clock" {
+					return v7action.Process{}, v7action.Warnings{"clock-warning"}, actionerror.ProcessNotFoundError{ProcessType: "clock"}
+				}
+				return v7action.Process{
+					Command:   types.FilteredString{IsSet: true, Value: "bundle exec sidekiq"},
+					Instances: types.NullInt{IsSet: true, Value: 1},
+				}, v7action.Warnings{"worker-warning"}, nil
+			}
+		})
+
+		It("compares each of them with the process of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs[0].Fields).To(Equal([]ManifestFieldDiff{
+				{Field: "worker.instances", Current: "1", Desired: "2"},
+				{Field: "clock.instances", Current: "", Desired: "1"},
+			}))
+			Expect(warnings).To(ContainElement("worker-warning"))
+			Expect(warnings).To(ContainElement("clock-warning"))
+
+			Expect(fakeV7Actor.GetProcessByTypeAndApplicationCallCount()).To(Equal(2))
+			processType, appGUID := fakeV7Actor.GetProcessByTypeAndApplicationArgsForCall(0)
+			Expect(processType).To(Equal("worker"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+	})
+
+	When("the manifest pushes a docker image", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+docker:
+  image: some-org/some-image:v2
+`)})
+			fakeV7Actor.GetCurrentDropletByApplicationReturns(
+				v7action.Droplet{Image: "some-org/some-image:v1"},
+				v7action.Warnings{"droplet-warning"},
+				nil,
+			)
+		})
+
+		It("compares the lifecycle and the image of the current droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs[0].Fields).To(Equal([]ManifestFieldDiff{
+				{Field: "lifecycle", Current: "buildpack", Desired: "docker"},
+				{Field: "docker.image", Current: "some-org/some-image:v1", Desired: "some-org/some-image:v2"},
+			}))
+			Expect(warnings).To(ContainElement("droplet-warning"))
+			Expect(fakeV7Actor.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+
+		When("the app has no droplet", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetCurrentDropletByApplicationReturns(v7action.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+			})
+
+			It("shows the image as added", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diffs[0].Fields).To(ContainElement(ManifestFieldDiff{Field: "docker.image", Current: "", Desired: "some-org/some-image:v2"}))
+			})
+		})
+	})
+
+	When("the manifest has an invalid value", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+memory: lots
+`)})
+		})
+
+		It("returns an ApplicationManifestError", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationManifestError{Message: "Invalid memory 'lots' for app some-app."}))
+		})
+	})
+
+	When("the manifest sets no-route", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`
+name: some-app
+no-route: true
+`)})
+		})
+
+		It("returns a diff removing the current routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs[0].Fields).To(Equal([]ManifestFieldDiff{
+				{Field: "routes", Current: "some-app.example.com", Desired: ""},
+			}))
+		})
+	})
+
+	When("the manifest only names the app", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`name: some-app`)})
+		})
+
+		It("does not look up processes, routes, env or services", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs[0].HasDrift()).To(BeFalse())
+
+			Expect(fakeV7Actor.GetProcessByTypeAndApplicationCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.GetCurrentDropletByApplicationCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.GetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.GetBoundServiceInstanceNamesByApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app does not exist", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`name: new-app`)})
+			fakeV7Actor.GetApplicationByNameAndSpaceReturns(v7action.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "new-app"})
+		})
+
+		It("returns a diff creating the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diffs).To(Equal([]ManifestAppDiff{{AppName: "new-app", Create: true}}))
+			Expect(diffs[0].HasDrift()).To(BeTrue())
+			Expect(warnings).To(ConsistOf("app-warning"))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeParser.AppsReturns([]manifestparser.Application{parseApp(`name: some-app`)})
+			fakeV7Actor.GetApplicationByNameAndSpaceReturns(v7action.Application{}, v7action.Warnings{"app-warning"}, errors.New("app-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("app-error"))
+			Expect(warnings).To(ConsistOf("app-warning"))
+		})
+	})
+})
@@ -13,6 +13,9 @@ type PushPlan struct {
 	SpaceGUID string
 	OrgGUID   string
 
+	// DependsOn lists the apps that must finish pushing before this one.
+	DependsOn []string
+
 	Application            v7action.Application
 	ApplicationRoutes      []v7action.Route
 	ApplicationNeedsUpdate bool
@@ -20,8 +20,12 @@ type V7Actor interface {
 	GetApplicationDroplets(appName string, spaceGUID string) ([]v7action.Droplet, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]v7action.Route, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]v7action.Application, v7action.Warnings, error)
+	GetBoundServiceInstanceNamesByApplication(appGUID string) ([]string, v7action.Warnings, error)
+	GetCurrentDropletByApplication(appGUID string) (v7action.Droplet, v7action.Warnings, error)
 	GetDefaultDomain(orgGUID string) (v7action.Domain, v7action.Warnings, error)
 	GetDomain(domainGUID string) (v7action.Domain, v7action.Warnings, error)
+	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
+	GetProcessByTypeAndApplication(processType string, appGUID string) (v7action.Process, v7action.Warnings, error)
 	GetRouteByAttributes(domainName, domainGUID, hostname, path string) (v7action.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(routeGUID string, appGUID string) (v7action.RouteDestination, v7action.Warnings, error)
 	MapRoute(routeGUID string, appGUID string) (v7action.Warnings, error)
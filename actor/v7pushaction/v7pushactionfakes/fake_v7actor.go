@@ -170,6 +170,36 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetBoundServiceInstanceNamesByApplicationStub        func(string) ([]string, v7action.Warnings, error)
+	getBoundServiceInstanceNamesByApplicationMutex       sync.RWMutex
+	getBoundServiceInstanceNamesByApplicationArgsForCall []struct {
+		arg1 string
+	}
+	getBoundServiceInstanceNamesByApplicationReturns struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBoundServiceInstanceNamesByApplicationReturnsOnCall map[int]struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetCurrentDropletByApplicationStub        func(string) (v7action.Droplet, v7action.Warnings, error)
+	getCurrentDropletByApplicationMutex       sync.RWMutex
+	getCurrentDropletByApplicationArgsForCall []struct {
+		arg1 string
+	}
+	getCurrentDropletByApplicationReturns struct {
+		result1 v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentDropletByApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDefaultDomainStub        func(string) (v7action.Domain, v7action.Warnings, error)
 	getDefaultDomainMutex       sync.RWMutex
 	getDefaultDomainArgsForCall []struct {
@@ -200,6 +230,38 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEnvironmentVariablesByApplicationNameAndSpaceStub        func(string, string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
+	getEnvironmentVariablesByApplicationNameAndSpaceMutex       sync.RWMutex
+	getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getEnvironmentVariablesByApplicationNameAndSpaceReturns struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetProcessByTypeAndApplicationStub        func(string, string) (v7action.Process, v7action.Warnings, error)
+	getProcessByTypeAndApplicationMutex       sync.RWMutex
+	getProcessByTypeAndApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getProcessByTypeAndApplicationReturns struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
+	getProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(string, string, string, string) (v7action.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -1178,6 +1240,138 @@ func (fake *FakeV7Actor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplication(arg1 string) ([]string, v7action.Warnings, error) {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getBoundServiceInstanceNamesByApplicationReturnsOnCall[len(fake.getBoundServiceInstanceNamesByApplicationArgsForCall)]
+	fake.getBoundServiceInstanceNamesByApplicationArgsForCall = append(fake.getBoundServiceInstanceNamesByApplicationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetBoundServiceInstanceNamesByApplication", []interface{}{arg1})
+	fake.getBoundServiceInstanceNamesByApplicationMutex.Unlock()
+	if fake.GetBoundServiceInstanceNamesByApplicationStub != nil {
+		return fake.GetBoundServiceInstanceNamesByApplicationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getBoundServiceInstanceNamesByApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplicationCallCount() int {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.RLock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.RUnlock()
+	return len(fake.getBoundServiceInstanceNamesByApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplicationCalls(stub func(string) ([]string, v7action.Warnings, error)) {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.Lock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.Unlock()
+	fake.GetBoundServiceInstanceNamesByApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplicationArgsForCall(i int) string {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.RLock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.RUnlock()
+	argsForCall := fake.getBoundServiceInstanceNamesByApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplicationReturns(result1 []string, result2 v7action.Warnings, result3 error) {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.Lock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.Unlock()
+	fake.GetBoundServiceInstanceNamesByApplicationStub = nil
+	fake.getBoundServiceInstanceNamesByApplicationReturns = struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetBoundServiceInstanceNamesByApplicationReturnsOnCall(i int, result1 []string, result2 v7action.Warnings, result3 error) {
+	fake.getBoundServiceInstanceNamesByApplicationMutex.Lock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.Unlock()
+	fake.GetBoundServiceInstanceNamesByApplicationStub = nil
+	if fake.getBoundServiceInstanceNamesByApplicationReturnsOnCall == nil {
+		fake.getBoundServiceInstanceNamesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBoundServiceInstanceNamesByApplicationReturnsOnCall[i] = struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplication(arg1 string) (v7action.Droplet, v7action.Warnings, error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	ret, specificReturn := fake.getCurrentDropletByApplicationReturnsOnCall[len(fake.getCurrentDropletByApplicationArgsForCall)]
+	fake.getCurrentDropletByApplicationArgsForCall = append(fake.getCurrentDropletByApplicationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetCurrentDropletByApplication", []interface{}{arg1})
+	fake.getCurrentDropletByApplicationMutex.Unlock()
+	if fake.GetCurrentDropletByApplicationStub != nil {
+		return fake.GetCurrentDropletByApplicationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getCurrentDropletByApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplicationCallCount() int {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	return len(fake.getCurrentDropletByApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplicationCalls(stub func(string) (v7action.Droplet, v7action.Warnings, error)) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplicationArgsForCall(i int) string {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	argsForCall := fake.getCurrentDropletByApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplicationReturns(result1 v7action.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	fake.getCurrentDropletByApplicationReturns = struct {
+		result1 v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetCurrentDropletByApplicationReturnsOnCall(i int, result1 v7action.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	if fake.getCurrentDropletByApplicationReturnsOnCall == nil {
+		fake.getCurrentDropletByApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentDropletByApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetDefaultDomain(arg1 string) (v7action.Domain, v7action.Warnings, error) {
 	fake.getDefaultDomainMutex.Lock()
 	ret, specificReturn := fake.getDefaultDomainReturnsOnCall[len(fake.getDefaultDomainArgsForCall)]
@@ -1310,6 +1504,140 @@ func (fake *FakeV7Actor) GetDomainReturnsOnCall(i int, result1 v7action.Domain,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpace(arg1 string, arg2 string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[len(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)]
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall = append(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetEnvironmentVariablesByApplicationNameAndSpace", []interface{}{arg1, arg2})
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	if fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub != nil {
+		return fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpaceCallCount() int {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpaceCalls(stub func(string, string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = stub
+}
+
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	if fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.EnvironmentVariableGroups
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (v7action.Process, v7action.Warnings, error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
+	fake.getProcessByTypeAndApplicationArgsForCall = append(fake.getProcessByTypeAndApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetProcessByTypeAndApplication", []interface{}{arg1, arg2})
+	fake.getProcessByTypeAndApplicationMutex.Unlock()
+	if fake.GetProcessByTypeAndApplicationStub != nil {
+		return fake.GetProcessByTypeAndApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProcessByTypeAndApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCallCount() int {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.getProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCalls(stub func(string, string) (v7action.Process, v7action.Warnings, error)) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationArgsForCall(i int) (string, string) {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	argsForCall := fake.getProcessByTypeAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturns(result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	fake.getProcessByTypeAndApplicationReturns = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	if fake.getProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.getProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Process
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetRouteByAttributes(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -2661,10 +2989,18 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getBoundServiceInstanceNamesByApplicationMutex.RLock()
+	defer fake.getBoundServiceInstanceNamesByApplicationMutex.RUnlock()
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
 	defer fake.getDefaultDomainMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
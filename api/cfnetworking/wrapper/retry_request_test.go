@@ -0,0 +1,90 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking"
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/networkerror"
+	. "code.cloudfoundry.org/cli/api/cfnetworking/wrapper"
+	"code.cloudfoundry.org/cli/api/cfnetworking/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ = Describe("Retry Request", func() {
+	DescribeTable("number of retries",
+		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
+			rawRequestBody := "banana pants"
+			body := strings.NewReader(rawRequestBody)
+
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", body)
+			Expect(err).NotTo(HaveOccurred())
+			request := cfnetworking.NewRequest(req, body)
+
+			response := &cfnetworking.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: responseStatusCode,
+				},
+			}
+
+			fakeConnection := new(wrapperfakes.FakeConnection)
+			expectedErr := networkerror.RawHTTPStatusError{
+				StatusCode: responseStatusCode,
+			}
+			fakeConnection.MakeStub = func(req *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+				body, readErr := ioutil.ReadAll(req.Body)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(rawRequestBody))
+				return expectedErr
+			}
+
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+			err = wrapper.Make(request, response)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Get (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
+		Entry("maxRetries for Get (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
+		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusBadRequest, 1),
+	)
+
+	It("retries when the request timed out", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection := new(wrapperfakes.FakeConnection)
+		expectedErr := networkerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: timeoutError{}}}
+		fakeConnection.MakeReturns(expectedErr)
+
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+		err = wrapper.Make(cfnetworking.NewRequest(req, nil), &cfnetworking.Response{})
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+	})
+
+	It("does not retry on success", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection := new(wrapperfakes.FakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+
+		err = wrapper.Make(cfnetworking.NewRequest(req, nil), &cfnetworking.Response{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+})
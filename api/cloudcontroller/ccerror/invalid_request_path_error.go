@@ -0,0 +1,14 @@
+package ccerror
+
+import "fmt"
+
+// InvalidRequestPathError is returned when a raw request path does not name
+// a path on the targeted Cloud Controller, so the request would take the
+// user's token to another host.
+type InvalidRequestPathError struct {
+	Path string
+}
+
+func (e InvalidRequestPathError) Error() string {
+	return fmt.Sprintf("request path %q must be an absolute path on the Cloud Controller", e.Path)
+}
@@ -178,6 +178,52 @@ func (client *Client) GetApplications(query ...Query) ([]Application, Warnings,
 	return fullAppsList, warnings, err
 }
 
+// ApplicationsPage is a page of applications sent by StreamApplications.
+type ApplicationsPage struct {
+	Applications []Application
+	Warnings     Warnings
+	Err          error
+}
+
+// StreamApplications sends each page of applications matching the queries on
+// the returned channel as soon as the page has been fetched. The channel is
+// closed after the last page, or after the first page with an error. Closing
+// done stops any further pages from being fetched or sent.
+func (client *Client) StreamApplications(done <-chan struct{}, query ...Query) <-chan ApplicationsPage {
+	applicationsPages := make(chan ApplicationsPage)
+
+	go func() {
+		defer close(applicationsPages)
+
+		client.streamResources(requestOptions{
+			RequestName: internal.GetApplicationsRequest,
+			Query:       query,
+		}, Application{}, func(page ResourcePage) bool {
+			applicationsPage := ApplicationsPage{Warnings: page.Warnings, Err: page.Err}
+			for _, item := range page.Resources {
+				app, ok := item.(Application)
+				if !ok {
+					applicationsPage.Err = ccerror.UnknownObjectInListError{
+						Expected:   Application{},
+						Unexpected: item,
+					}
+					break
+				}
+				applicationsPage.Applications = append(applicationsPage.Applications, app)
+			}
+
+			select {
+			case applicationsPages <- applicationsPage:
+				return applicationsPage.Err == nil
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	return applicationsPages
+}
+
 // UpdateApplication updates an application with the given settings.
 func (client *Client) UpdateApplication(app Application) (Application, Warnings, error) {
 	bodyBytes, err := json.Marshal(app)
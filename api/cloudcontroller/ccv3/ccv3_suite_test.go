@@ -108,9 +108,18 @@ func SetupV3Response() {
 			"service_brokers": {
 				"href": "SERVER_URL/v3/service_brokers"
 			},
+			"service_credential_bindings": {
+				"href": "SERVER_URL/v3/service_credential_bindings"
+			},
 			"service_instances": {
 				"href": "SERVER_URL/v3/service_instances"
 			},
+			"service_offerings": {
+				"href": "SERVER_URL/v3/service_offerings"
+			},
+			"service_plans": {
+				"href": "SERVER_URL/v3/service_plans"
+			},
 			"spaces": {
 				"href": "SERVER_URL/v3/spaces"
 			},
@@ -98,6 +98,8 @@ type Client struct {
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
 
+	paginationWorkers int
+
 	clock Clock
 }
 
@@ -115,6 +117,10 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationWorkers is the maximum number of pages fetched concurrently
+	// for a paginated request. Defaults to DefaultPaginationWorkers.
+	PaginationWorkers int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -122,11 +128,18 @@ type Config struct {
 // NewClient returns a new Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	paginationWorkers := config.PaginationWorkers
+	if paginationWorkers <= 0 {
+		paginationWorkers = DefaultPaginationWorkers
+	}
+
 	return &Client{
 		clock:              new(internal.RealTime),
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
+		paginationWorkers:  paginationWorkers,
 		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
@@ -15,4 +15,16 @@ const (
 	// RelationshipTypeOrganization is a relationship with a Cloud Controller
 	// organization.
 	RelationshipTypeOrganization RelationshipType = "organization"
+
+	// RelationshipTypeServiceInstance is a relationship with a Cloud Controller
+	// service instance.
+	RelationshipTypeServiceInstance RelationshipType = "service_instance"
+
+	// RelationshipTypeServiceOffering is a relationship with a Cloud
+	// Controller service offering.
+	RelationshipTypeServiceOffering RelationshipType = "service_offering"
+
+	// RelationshipTypeServicePlan is a relationship with a Cloud Controller
+	// service plan.
+	RelationshipTypeServicePlan RelationshipType = "service_plan"
 )
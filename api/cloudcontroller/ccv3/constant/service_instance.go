@@ -0,0 +1,48 @@
+package constant
+
+// ServiceInstanceType is the type of a service instance.
+type ServiceInstanceType string
+
+const (
+	// ManagedService is a service instance provided by a service broker.
+	ManagedService ServiceInstanceType = "managed"
+	// UserProvidedService is a service instance whose credentials are provided
+	// by the user.
+	UserProvidedService ServiceInstanceType = "user-provided"
+)
+
+// ServiceCredentialBindingType is the type of a service credential binding.
+type ServiceCredentialBindingType string
+
+const (
+	// AppBinding is a binding between a service instance and an app.
+	AppBinding ServiceCredentialBindingType = "app"
+	// KeyBinding is a service key.
+	KeyBinding ServiceCredentialBindingType = "key"
+)
+
+// LastOperationType is the type of the last operation performed on a service
+// instance or service credential binding.
+type LastOperationType string
+
+const (
+	// LastOperationCreate is when the resource is being created.
+	LastOperationCreate LastOperationType = "create"
+	// LastOperationUpdate is when the resource is being updated.
+	LastOperationUpdate LastOperationType = "update"
+	// LastOperationDelete is when the resource is being deleted.
+	LastOperationDelete LastOperationType = "delete"
+)
+
+// LastOperationState is the state of the last operation performed on a
+// service instance or service credential binding.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress is when the operation is still running.
+	LastOperationInProgress LastOperationState = "in progress"
+	// LastOperationSucceeded is when the operation completed successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+	// LastOperationFailed is when the operation failed.
+	LastOperationFailed LastOperationState = "failed"
+)
@@ -11,15 +11,18 @@ import (
 )
 
 type Deployment struct {
-	GUID          string
-	State         constant.DeploymentState
-	StatusValue   constant.DeploymentStatusValue
-	StatusReason  constant.DeploymentStatusReason
-	DropletGUID   string
-	CreatedAt     string
-	UpdatedAt     string
-	Relationships Relationships
-	NewProcesses  []Process
+	GUID                string
+	State               constant.DeploymentState
+	StatusValue         constant.DeploymentStatusValue
+	StatusReason        constant.DeploymentStatusReason
+	LastStatusChange    string
+	Strategy            constant.DeploymentStrategy
+	DropletGUID         string
+	PreviousDropletGUID string
+	CreatedAt           string
+	UpdatedAt           string
+	Relationships       Relationships
+	NewProcesses        []Process
 }
 
 // MarshalJSON converts a Deployment into a Cloud Controller Deployment.
@@ -45,16 +48,22 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
 func (d *Deployment) UnmarshalJSON(data []byte) error {
 	var ccDeployment struct {
-		GUID          string                   `json:"guid,omitempty"`
-		CreatedAt     string                   `json:"created_at,omitempty"`
-		Relationships Relationships            `json:"relationships,omitempty"`
-		State         constant.DeploymentState `json:"state,omitempty"`
+		GUID          string                      `json:"guid,omitempty"`
+		CreatedAt     string                      `json:"created_at,omitempty"`
+		UpdatedAt     string                      `json:"updated_at,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
+		State         constant.DeploymentState    `json:"state,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
 		Status        struct {
-			Value  constant.DeploymentStatusValue  `json:"value"`
-			Reason constant.DeploymentStatusReason `json:"reason"`
+			Value   constant.DeploymentStatusValue  `json:"value"`
+			Reason  constant.DeploymentStatusReason `json:"reason"`
+			Details struct {
+				LastStatusChange string `json:"last_status_change"`
+			} `json:"details"`
 		} `json:"status"`
-		Droplet      Droplet   `json:"droplet,omitempty"`
-		NewProcesses []Process `json:"new_processes,omitempty"`
+		Droplet         Droplet   `json:"droplet,omitempty"`
+		PreviousDroplet Droplet   `json:"previous_droplet,omitempty"`
+		NewProcesses    []Process `json:"new_processes,omitempty"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
 	if err != nil {
@@ -63,11 +72,15 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 
 	d.GUID = ccDeployment.GUID
 	d.CreatedAt = ccDeployment.CreatedAt
+	d.UpdatedAt = ccDeployment.UpdatedAt
 	d.Relationships = ccDeployment.Relationships
 	d.State = ccDeployment.State
 	d.StatusValue = ccDeployment.Status.Value
 	d.StatusReason = ccDeployment.Status.Reason
+	d.LastStatusChange = ccDeployment.Status.Details.LastStatusChange
+	d.Strategy = ccDeployment.Strategy
 	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.PreviousDropletGUID = ccDeployment.PreviousDroplet.GUID
 	d.NewProcesses = ccDeployment.NewProcesses
 
 	return nil
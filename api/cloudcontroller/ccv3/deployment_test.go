@@ -193,9 +193,13 @@ var _ = Describe("Task", func() {
 				response = `{
 				    "guid": "some-deployment-guid",
 					"state": "DEPLOYED",
+					"strategy": "rolling",
 					"status": {
 						"value": "FINALIZED",
-						"reason": "SUPERSEDED"
+						"reason": "SUPERSEDED",
+						"details": {
+							"last_status_change": "some-status-change-time"
+						}
 					},
 					"droplet": {
  					  "guid": "some-droplet-guid"
@@ -229,6 +233,12 @@ var _ = Describe("Task", func() {
 				Expect(deployment.State).To(Equal(constant.DeploymentDeployed))
 				Expect(deployment.StatusValue).To(Equal(constant.DeploymentStatusValueFinalized))
 				Expect(deployment.StatusReason).To(Equal(constant.DeploymentStatusReasonSuperseded))
+				Expect(deployment.LastStatusChange).To(Equal("some-status-change-time"))
+				Expect(deployment.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+				Expect(deployment.DropletGUID).To(Equal("some-droplet-guid"))
+				Expect(deployment.PreviousDropletGUID).To(Equal("some-other-droplet-guid"))
+				Expect(deployment.CreatedAt).To(Equal("some-time"))
+				Expect(deployment.UpdatedAt).To(Equal("some-later-time"))
 			})
 		})
 
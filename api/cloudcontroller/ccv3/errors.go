@@ -144,6 +144,15 @@ func handleUnprocessableEntity(errorResponse ccerror.V3Error) error {
 		return ccerror.InvalidBuildpackError{}
 	case orgNameTakenRegexp.MatchString(errorString):
 		return ccerror.OrganizationNameTakenError{UnprocessableEntityError: err}
+	case strings.Contains(errorString,
+		"The service instance name is taken"):
+		return ccerror.ServiceInstanceNameTakenError{Message: errorString}
+	case strings.Contains(errorString,
+		"The app is already bound to the service instance"):
+		return ccerror.ServiceBindingTakenError{Message: errorString}
+	case strings.Contains(errorString,
+		"Key binding names must be unique"):
+		return ccerror.ServiceKeyTakenError{Message: errorString}
 	default:
 		return err
 	}
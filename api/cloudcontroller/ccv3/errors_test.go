@@ -293,6 +293,63 @@ var _ = Describe("Error Wrapper", func() {
 						})
 					})
 
+					When("the service instance name is taken", func() {
+						BeforeEach(func() {
+							serverResponse = `
+{
+  "errors": [
+    {
+      "code": 60002,
+      "detail": "The service instance name is taken: some-instance",
+      "title": "CF-ServiceInstanceNameTaken"
+    }
+  ]
+}`
+						})
+
+						It("returns a ServiceInstanceNameTakenError", func() {
+							Expect(makeError).To(MatchError(ccerror.ServiceInstanceNameTakenError{Message: "The service instance name is taken: some-instance"}))
+						})
+					})
+
+					When("the app is already bound to the service instance", func() {
+						BeforeEach(func() {
+							serverResponse = `
+{
+  "errors": [
+    {
+      "code": 90003,
+      "detail": "The app is already bound to the service instance.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+						})
+
+						It("returns a ServiceBindingTakenError", func() {
+							Expect(makeError).To(MatchError(ccerror.ServiceBindingTakenError{Message: "The app is already bound to the service instance."}))
+						})
+					})
+
+					When("the service key name is taken", func() {
+						BeforeEach(func() {
+							serverResponse = `
+{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "The binding name is invalid. Key binding names must be unique. The service instance already has a key binding with name 'some-key'.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+						})
+
+						It("returns a ServiceKeyTakenError", func() {
+							Expect(makeError).To(MatchError(ccerror.ServiceKeyTakenError{Message: "The binding name is invalid. Key binding names must be unique. The service instance already has a key binding with name 'some-key'."}))
+						})
+					})
+
 					When("the detail describes something else", func() {
 						BeforeEach(func() {
 							serverResponse = `
@@ -75,6 +75,9 @@ var _ = Describe("Info", func() {
 					"logging": {
 						"href": "wss://doppler.bosh-lite.com:443"
 					},
+					"log_cache": {
+						"href": "https://log-cache.bosh-lite.com"
+					},
 					"app_ssh": {
 						"href": "ssh.bosh-lite.com:2222",
 						"meta": {
@@ -114,6 +117,7 @@ var _ = Describe("Info", func() {
 			Expect(executeErr).NotTo(HaveOccurred())
 			Expect(apis.UAA()).To(Equal("https://uaa.bosh-lite.com"))
 			Expect(apis.Logging()).To(Equal("wss://doppler.bosh-lite.com:443"))
+			Expect(apis.LogCache()).To(Equal("https://log-cache.bosh-lite.com"))
 			Expect(apis.NetworkPolicyV1()).To(Equal(fmt.Sprintf("%s/networking/v1/external", server.URL())))
 			Expect(apis.AppSSHHostKeyFingerprint()).To(Equal("some-fingerprint"))
 			Expect(apis.AppSSHEndpoint()).To(Equal("ssh.bosh-lite.com:2222"))
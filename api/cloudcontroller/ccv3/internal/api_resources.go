@@ -2,22 +2,25 @@ package internal
 
 // When adding a resource, also add it to the api/cloudcontroller/ccv3/ccv3_suite_test.go resources response
 const (
-	AppsResource              = "apps"
-	BuildpacksResource        = "buildpacks"
-	BuildsResource            = "builds"
-	DeploymentsResource       = "deployments"
-	DomainsResource           = "domains"
-	DropletsResource          = "droplets"
-	FeatureFlagsResource      = "feature_flags"
-	IsolationSegmentsResource = "isolation_segments"
-	OrgsResource              = "organizations"
-	PackagesResource          = "packages"
-	ProcessesResource         = "processes"
-	ResourceMatches           = "resource_matches"
-	ServiceBrokersResource    = "service_brokers"
-	RoutesResource            = "routes"
-	ServiceInstancesResource  = "service_instances"
-	SpacesResource            = "spaces"
-	StacksResource            = "stacks"
-	TasksResource             = "tasks"
+	AppsResource                      = "apps"
+	BuildpacksResource                = "buildpacks"
+	BuildsResource                    = "builds"
+	DeploymentsResource               = "deployments"
+	DomainsResource                   = "domains"
+	DropletsResource                  = "droplets"
+	FeatureFlagsResource              = "feature_flags"
+	IsolationSegmentsResource         = "isolation_segments"
+	OrgsResource                      = "organizations"
+	PackagesResource                  = "packages"
+	ProcessesResource                 = "processes"
+	ResourceMatches                   = "resource_matches"
+	ServiceBrokersResource            = "service_brokers"
+	RoutesResource                    = "routes"
+	ServiceCredentialBindingsResource = "service_credential_bindings"
+	ServiceInstancesResource          = "service_instances"
+	ServiceOfferingsResource          = "service_offerings"
+	ServicePlansResource              = "service_plans"
+	SpacesResource                    = "spaces"
+	StacksResource                    = "stacks"
+	TasksResource                     = "tasks"
 )
@@ -12,7 +12,8 @@ import (
 
 // Metadata is used for custom tagging of API resources
 type Metadata struct {
-	Labels map[string]types.NullString `json:"labels,omitempty"`
+	Labels      map[string]types.NullString `json:"labels,omitempty"`
+	Annotations map[string]types.NullString `json:"annotations,omitempty"`
 }
 
 type ResourceMetadata struct {
@@ -46,6 +47,12 @@ func (client *Client) UpdateResourceMetadata(resource string, resourceGUID strin
 			Body:        bytes.NewReader(metadataBytes),
 			URIParams:   map[string]string{"organization_guid": resourceGUID},
 		})
+	case "route":
+		request, err = client.newHTTPRequest(requestOptions{
+			RequestName: internal.PatchRouteRequest,
+			Body:        bytes.NewReader(metadataBytes),
+			URIParams:   map[string]string{"route_guid": resourceGUID},
+		})
 	case "space":
 		request, err = client.newHTTPRequest(requestOptions{
 			RequestName: internal.PatchSpaceRequest,
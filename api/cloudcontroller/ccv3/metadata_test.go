@@ -139,7 +139,61 @@ var _ = Describe("Metadata", func() {
 		testForResourceType("app", "")
 		testForResourceType("buildpack", "")
 		testForResourceType("org", "organization")
+		testForResourceType("route", "")
 		testForResourceType("space", "")
+
+		When("updating annotations", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-guid",
+					"metadata": {
+						"labels": {},
+						"annotations": {
+							"owner": "team-a",
+							"runbook": "https://example.com/runbook?q=\"app\""
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"owner":   "team-a",
+							"runbook": `https://example.com/runbook?q="app"`,
+							"old-key": nil,
+						},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{}),
+					),
+				)
+
+				metadataToUpdate = Metadata{
+					Annotations: map[string]types.NullString{
+						"owner":   types.NewNullString("team-a"),
+						"runbook": types.NewNullString(`https://example.com/runbook?q="app"`),
+						"old-key": types.NewNullString(),
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				updatedMetadata, warnings, executeErr = client.UpdateResourceMetadata("app", "some-guid", metadataToUpdate)
+			})
+
+			It("sends the annotations and returns the updated annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(updatedMetadata.Metadata.Annotations).To(Equal(map[string]types.NullString{
+					"owner":   types.NewNullString("team-a"),
+					"runbook": types.NewNullString(`https://example.com/runbook?q="app"`),
+				}))
+			})
+		})
 		testForResourceType("stack", "")
 	})
 })
@@ -2,49 +2,204 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// DefaultPaginationWorkers is the default maximum number of pages fetched
+// concurrently for a paginated request.
+const DefaultPaginationWorkers = 4
+
+// ResourcePage is a single page of resources returned by a paginated request.
+// If Err is set, it is the last page sent.
+type ResourcePage struct {
+	Resources []interface{}
+	Warnings  Warnings
+	Err       error
+}
+
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	done := make(chan struct{})
+	defer close(done)
+
 	fullWarningsList := Warnings{}
 
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			DecodeJSONResponseInto: &wrapper,
+	for page := range client.streamPages(request, obj, done) {
+		fullWarningsList = append(fullWarningsList, page.Warnings...)
+		if page.Err != nil {
+			return fullWarningsList, page.Err
 		}
 
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
-		if err != nil {
-			return fullWarningsList, err
+		for _, item := range page.Resources {
+			err := appendToExternalList(item)
+			if err != nil {
+				return fullWarningsList, err
+			}
 		}
+	}
 
-		list, err := wrapper.Resources()
-		if err != nil {
-			return fullWarningsList, err
+	return fullWarningsList, nil
+}
+
+// streamResources calls sendPage with every page of the paginated request
+// built from options, until sendPage returns false or there are no more
+// pages.
+func (client Client) streamResources(options requestOptions, obj interface{}, sendPage func(ResourcePage) bool) {
+	request, err := client.newHTTPRequest(options)
+	if err != nil {
+		sendPage(ResourcePage{Err: err})
+		return
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	for page := range client.streamPages(request, obj, stop) {
+		if !sendPage(page) {
+			return
 		}
+	}
+}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
+// streamPages sends every page of the paginated request on the returned
+// channel, in page order, and closes the channel after the last page or the
+// first error. The first page is always fetched on its own. When it reports
+// the total number of pages, the remaining pages are fetched concurrently by
+// at most paginationWorkers workers; otherwise the next links are followed
+// one page at a time. Closing done stops any further pages from being sent.
+func (client Client) streamPages(request *cloudcontroller.Request, obj interface{}, done <-chan struct{}) <-chan ResourcePage {
+	pages := make(chan ResourcePage)
+
+	go func() {
+		defer close(pages)
+
+		send := func(page ResourcePage) bool {
+			select {
+			case pages <- page:
+				return page.Err == nil
+			case <-done:
+				return false
 			}
 		}
 
-		if wrapper.NextPage() == "" {
-			break
+		page, nextPage, totalPages := client.fetchPage(request, obj)
+		if !send(page) || nextPage == "" {
+			return
+		}
+
+		if pageURLs, ok := remainingPageURLs(nextPage, totalPages); ok && client.paginationWorkers > 1 {
+			client.streamPagesConcurrently(pageURLs, obj, send, done)
+			return
 		}
 
-		request, err = client.newHTTPRequest(requestOptions{
-			URL:    wrapper.NextPage(),
-			Method: http.MethodGet,
-		})
-		if err != nil {
-			return fullWarningsList, err
+		for nextPage != "" {
+			page, nextPage, _ = client.fetchPageURL(nextPage, obj)
+			if !send(page) {
+				return
+			}
 		}
+	}()
+
+	return pages
+}
+
+// streamPagesConcurrently fetches the given pages with at most
+// paginationWorkers requests in flight, and sends them in order. A worker
+// does not start on a page until there are fewer than paginationWorkers
+// fetched pages waiting to be sent.
+func (client Client) streamPagesConcurrently(pageURLs []string, obj interface{}, send func(ResourcePage) bool, done <-chan struct{}) {
+	results := make([]chan ResourcePage, len(pageURLs))
+	for i := range results {
+		results[i] = make(chan ResourcePage, 1)
 	}
 
-	return fullWarningsList, nil
+	fetch := func(i int) {
+		page, _, _ := client.fetchPageURL(pageURLs[i], obj)
+		results[i] <- page
+	}
+
+	started := 0
+	for ; started < len(pageURLs) && started < client.paginationWorkers; started++ {
+		go fetch(started)
+	}
+
+	for i := range pageURLs {
+		var page ResourcePage
+		select {
+		case page = <-results[i]:
+		case <-done:
+			return
+		}
+
+		if started < len(pageURLs) {
+			go fetch(started)
+			started++
+		}
+
+		if !send(page) {
+			return
+		}
+	}
+}
+
+func (client Client) fetchPageURL(pageURL string, obj interface{}) (ResourcePage, string, int) {
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return ResourcePage{Err: err}, "", 0
+	}
+
+	return client.fetchPage(request, obj)
+}
+
+// fetchPage returns the page of resources for the request, along with the
+// next page link and the total number of pages.
+func (client Client) fetchPage(request *cloudcontroller.Request, obj interface{}) (ResourcePage, string, int) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	page := ResourcePage{Warnings: response.Warnings}
+	if err != nil {
+		page.Err = err
+		return page, "", 0
+	}
+
+	page.Resources, page.Err = wrapper.Resources()
+	return page, wrapper.NextPage(), wrapper.TotalPages()
+}
+
+// remainingPageURLs builds the links for the second through the last page
+// from the link to the second page. It returns false when the links cannot be
+// built, in which case the next links should be followed instead.
+func remainingPageURLs(secondPage string, totalPages int) ([]string, bool) {
+	if totalPages <= 2 {
+		return nil, false
+	}
+
+	pageURL, err := url.Parse(secondPage)
+	if err != nil {
+		return nil, false
+	}
+
+	query := pageURL.Query()
+	if query.Get("page") != "2" {
+		return nil, false
+	}
+
+	pageURLs := make([]string, 0, totalPages-1)
+	pageURLs = append(pageURLs, secondPage)
+	for page := 3; page <= totalPages; page++ {
+		query.Set("page", strconv.Itoa(page))
+		pageURL.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, pageURL.String())
+	}
+
+	return pageURLs, true
 }
@@ -0,0 +1,223 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	var (
+		client    *Client
+		serverURL string
+
+		totalPages       int
+		reportTotalPages bool
+		failingPage      int
+		requestedPages   chan int
+		inFlight         int32
+		maxInFlight      int32
+	)
+
+	pageHandler := func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		page := 1
+		if r.URL.Query().Get("page") != "" {
+			page, _ = strconv.Atoi(r.URL.Query().Get("page"))
+		}
+		requestedPages <- page
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set("X-Cf-Warnings", fmt.Sprintf("warning-%d", page))
+		if page == failingPage {
+			w.WriteHeader(http.StatusTeapot)
+			fmt.Fprint(w, `{"errors": [{"code": 1, "detail": "page failed", "title": "CF-Teapot"}]}`)
+			return
+		}
+
+		next := "null"
+		if page < totalPages {
+			next = fmt.Sprintf(`{"href": "%s/v3/apps?names=some-app&page=%d&per_page=1"}`, serverURL, page+1)
+		}
+
+		total := ""
+		if reportTotalPages {
+			total = fmt.Sprintf(`"total_pages": %d,`, totalPages)
+		}
+
+		fmt.Fprintf(w, `{
+			"pagination": {
+				%s
+				"next": %s
+			},
+			"resources": [
+				{"name": "app-name-%d", "guid": "app-guid-%d"}
+			]
+		}`, total, next, page, page)
+	}
+
+	BeforeEach(func() {
+		client, _ = NewTestClient(Config{
+			AppName:           "CF CLI API V3 Test",
+			AppVersion:        "Unknown",
+			PaginationWorkers: 3,
+		})
+
+		serverURL = server.URL()
+		totalPages = 8
+		reportTotalPages = true
+		failingPage = 0
+		requestedPages = make(chan int, 100)
+		atomic.StoreInt32(&inFlight, 0)
+		atomic.StoreInt32(&maxInFlight, 0)
+
+		server.RouteToHandler(http.MethodGet, "/v3/apps", pageHandler)
+	})
+
+	expectedApps := func(pages int) []Application {
+		var apps []Application
+		for page := 1; page <= pages; page++ {
+			apps = append(apps, Application{
+				Name: fmt.Sprintf("app-name-%d", page),
+				GUID: fmt.Sprintf("app-guid-%d", page),
+			})
+		}
+		return apps
+	}
+
+	expectedWarnings := func(pages int) Warnings {
+		var warnings Warnings
+		for page := 1; page <= pages; page++ {
+			warnings = append(warnings, fmt.Sprintf("warning-%d", page))
+		}
+		return warnings
+	}
+
+	Describe("GetApplications", func() {
+		var (
+			apps       []Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = client.GetApplications(Query{Key: NameFilter, Values: []string{"some-app"}})
+		})
+
+		When("the first page reports the total number of pages", func() {
+			It("fetches the remaining pages concurrently and returns them in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal(expectedApps(8)))
+				Expect(warnings).To(Equal(expectedWarnings(8)))
+				Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically(">", 1))
+			})
+
+			It("does not exceed the configured number of workers", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 3))
+			})
+
+			When("one of the pages fails", func() {
+				BeforeEach(func() {
+					failingPage = 5
+				})
+
+				It("returns the error with the warnings up to the failed page", func() {
+					Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+						ResponseCode: http.StatusTeapot,
+						V3ErrorResponse: ccerror.V3ErrorResponse{
+							Errors: []ccerror.V3Error{{Code: 1, Detail: "page failed", Title: "CF-Teapot"}},
+						},
+					}))
+					Expect(warnings).To(Equal(expectedWarnings(5)))
+					Expect(apps).To(Equal(expectedApps(4)))
+				})
+			})
+		})
+
+		When("the first page does not report the total number of pages", func() {
+			BeforeEach(func() {
+				reportTotalPages = false
+				totalPages = 3
+			})
+
+			It("follows the next links one page at a time", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal(expectedApps(3)))
+				Expect(warnings).To(Equal(expectedWarnings(3)))
+				Expect(atomic.LoadInt32(&maxInFlight)).To(BeEquivalentTo(1))
+			})
+		})
+	})
+
+	Describe("StreamApplications", func() {
+		var (
+			done  chan struct{}
+			pages <-chan ApplicationsPage
+		)
+
+		BeforeEach(func() {
+			done = make(chan struct{})
+		})
+
+		JustBeforeEach(func() {
+			pages = client.StreamApplications(done, Query{Key: NameFilter, Values: []string{"some-app"}})
+		})
+
+		It("sends every page in order and closes the channel", func() {
+			var (
+				apps     []Application
+				warnings Warnings
+			)
+			for page := range pages {
+				Expect(page.Err).ToNot(HaveOccurred())
+				apps = append(apps, page.Applications...)
+				warnings = append(warnings, page.Warnings...)
+			}
+
+			Expect(apps).To(Equal(expectedApps(8)))
+			Expect(warnings).To(Equal(expectedWarnings(8)))
+		})
+
+		It("stops fetching pages once done is closed", func() {
+			var firstPage ApplicationsPage
+			Eventually(pages).Should(Receive(&firstPage))
+			Expect(firstPage.Applications).To(Equal(expectedApps(1)))
+
+			close(done)
+			Eventually(pages).Should(BeClosed())
+			Consistently(func() int { return len(requestedPages) }).Should(BeNumerically("<", 8))
+		})
+
+		When("a page fails", func() {
+			BeforeEach(func() {
+				failingPage = 2
+			})
+
+			It("sends the error and closes the channel", func() {
+				var lastPage ApplicationsPage
+				for page := range pages {
+					lastPage = page
+				}
+
+				Expect(lastPage.Err).To(HaveOccurred())
+				Expect(lastPage.Warnings).To(ConsistOf("warning-2"))
+			})
+		})
+	})
+})
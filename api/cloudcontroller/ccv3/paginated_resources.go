@@ -18,6 +18,8 @@ func NewPaginatedResources(exampleResource interface{}) *PaginatedResources {
 type PaginatedResources struct {
 	// Pagination represents information about the paginated resource.
 	Pagination struct {
+		// TotalPages is the total number of pages for the request.
+		TotalPages int `json:"total_pages"`
 		// Next represents a link to the next page.
 		Next struct {
 			// HREF is the HREF of the next page.
@@ -34,6 +36,12 @@ func (pr PaginatedResources) NextPage() string {
 	return pr.Pagination.Next.HREF
 }
 
+// TotalPages returns the total number of pages of results. It returns 0 when
+// the Cloud Controller did not provide the total.
+func (pr PaginatedResources) TotalPages() int {
+	return pr.Pagination.TotalPages
+}
+
 // Resources unmarshals JSON representing a page of resources and returns a
 // slice of the given resource type.
 func (pr PaginatedResources) Resources() ([]interface{}, error) {
@@ -39,6 +39,23 @@ const (
 	StackFilter QueryKey = "stacks"
 	// Unmapped filter is a query parameter specifying unmapped routes
 	UnmappedFilter QueryKey = "unmapped"
+	// ServiceBrokerNamesFilter is a query parameter for listing objects by
+	// service broker name
+	ServiceBrokerNamesFilter QueryKey = "service_broker_names"
+	// ServiceInstanceGUIDFilter is a query parameter for listing objects by
+	// service instance GUID
+	ServiceInstanceGUIDFilter QueryKey = "service_instance_guids"
+	// ServiceOfferingGUIDFilter is a query parameter for listing objects by
+	// service offering GUID
+	ServiceOfferingGUIDFilter QueryKey = "service_offering_guids"
+	// ServiceOfferingNamesFilter is a query parameter for listing objects by
+	// service offering name
+	ServiceOfferingNamesFilter QueryKey = "service_offering_names"
+	// TypeFilter is a query parameter for listing objects by type
+	TypeFilter QueryKey = "type"
+	// LabelSelectorFilter is a query parameter for listing objects whose
+	// labels match a label selector
+	LabelSelectorFilter QueryKey = "label_selector"
 
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
@@ -0,0 +1,63 @@
+package ccv3
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// MakeRequestSendReceiveRaw makes an authenticated request to the given Cloud
+// Controller path and returns the raw response body and HTTP response. The
+// body and HTTP response are returned even when the Cloud Controller responds
+// with an error status. The path must be an absolute path on the Cloud
+// Controller; anything that would send the request to another host returns
+// an InvalidRequestPathError.
+func (client *Client) MakeRequestSendReceiveRaw(method string, path string, requestBody []byte) ([]byte, *http.Response, error) {
+	requestURL, err := client.rawRequestURL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var body io.ReadSeeker
+	if len(requestBody) > 0 {
+		body = bytes.NewReader(requestBody)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: method,
+		URL:    requestURL,
+		Body:   body,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.RawResponse, response.HTTPResponse, err
+}
+
+func (client *Client) rawRequestURL(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return "", ccerror.InvalidRequestPathError{Path: path}
+	}
+
+	ccURL, err := url.Parse(client.cloudControllerURL)
+	if err != nil {
+		return "", err
+	}
+
+	rawURL := client.cloudControllerURL + path
+	requestURL, err := url.Parse(rawURL)
+	if err != nil || requestURL.Scheme != ccURL.Scheme || requestURL.User != nil || requestURL.Host != ccURL.Host {
+		return "", ccerror.InvalidRequestPathError{Path: path}
+	}
+
+	return rawURL, nil
+}
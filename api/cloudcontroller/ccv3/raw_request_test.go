@@ -0,0 +1,94 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Raw Request", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("MakeRequestSendReceiveRaw", func() {
+		var (
+			path         string
+			requestBody  []byte
+			body         []byte
+			httpResponse *http.Response
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			path = "/v3/apps/some-app-guid"
+			requestBody = nil
+		})
+
+		JustBeforeEach(func() {
+			body, httpResponse, executeErr = client.MakeRequestSendReceiveRaw(http.MethodPatch, path, requestBody)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				requestBody = []byte(`{"name":"new-name"}`)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						VerifyJSON(`{"name":"new-name"}`),
+						RespondWith(http.StatusOK, `{"guid":"some-app-guid"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the raw body and HTTP response", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"guid":"some-app-guid"}`))
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the cloud controller returns an error status", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, `{"errors":[{"code":10010,"title":"CF-ResourceNotFound","detail":"App not found"}]}`),
+					),
+				)
+			})
+
+			It("returns the raw body and HTTP response along with the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"errors":[{"code":10010,"title":"CF-ResourceNotFound","detail":"App not found"}]}`))
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		for _, unsafePath := range []string{"v3/apps", "@evil.example.com/v3/apps", ".evil.example.com/v3/apps", ":8443@evil.example.com/v3/apps", ""} {
+			unsafePath := unsafePath
+
+			When(fmt.Sprintf("the path %q does not name a path on the Cloud Controller", unsafePath), func() {
+				var requestsBefore int
+
+				BeforeEach(func() {
+					path = unsafePath
+					requestsBefore = len(server.ReceivedRequests())
+				})
+
+				It("returns an InvalidRequestPathError without making the request", func() {
+					Expect(executeErr).To(MatchError(ccerror.InvalidRequestPathError{Path: unsafePath}))
+					Expect(httpResponse).To(BeNil())
+					Expect(server.ReceivedRequests()).To(HaveLen(requestsBefore))
+				})
+			})
+		}
+	})
+})
@@ -16,6 +16,8 @@ type Route struct {
 	Host       string
 	Path       string
 	URL        string
+	// Metadata is used for custom tagging of API resources
+	Metadata *Metadata
 }
 
 func (r Route) MarshalJSON() ([]byte, error) {
@@ -60,6 +62,8 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 		Path string `json:"path,omitempty"`
 		URL  string `json:"url,omitempty"`
 
+		Metadata *Metadata `json:"metadata,omitempty"`
+
 		Relationships struct {
 			Space struct {
 				Data struct {
@@ -85,6 +89,7 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 	r.DomainGUID = alias.Relationships.Domain.Data.GUID
 	r.Path = alias.Path
 	r.URL = alias.URL
+	r.Metadata = alias.Metadata
 
 	return nil
 }
@@ -233,6 +238,52 @@ func (client Client) GetRoutes(query ...Query) ([]Route, Warnings, error) {
 	return fullRoutesList, warnings, err
 }
 
+// RoutesPage is a page of routes sent by StreamRoutes.
+type RoutesPage struct {
+	Routes   []Route
+	Warnings Warnings
+	Err      error
+}
+
+// StreamRoutes sends each page of routes matching the queries on the returned
+// channel as soon as the page has been fetched. The channel is closed after
+// the last page, or after the first page with an error. Closing done stops any
+// further pages from being fetched or sent.
+func (client *Client) StreamRoutes(done <-chan struct{}, query ...Query) <-chan RoutesPage {
+	routesPages := make(chan RoutesPage)
+
+	go func() {
+		defer close(routesPages)
+
+		client.streamResources(requestOptions{
+			RequestName: internal.GetRoutesRequest,
+			Query:       query,
+		}, Route{}, func(page ResourcePage) bool {
+			routesPage := RoutesPage{Warnings: page.Warnings, Err: page.Err}
+			for _, item := range page.Resources {
+				route, ok := item.(Route)
+				if !ok {
+					routesPage.Err = ccerror.UnknownObjectInListError{
+						Expected:   Route{},
+						Unexpected: item,
+					}
+					break
+				}
+				routesPage.Routes = append(routesPage.Routes, route)
+			}
+
+			select {
+			case routesPages <- routesPage:
+				return routesPage.Err == nil
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	return routesPages
+}
+
 func (client Client) MapRoute(routeGUID string, appGUID string) (Warnings, error) {
 	type destinationProcess struct {
 		ProcessType string `json:"process_type"`
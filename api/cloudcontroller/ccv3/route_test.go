@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -281,7 +282,10 @@ var _ = Describe("Route", func() {
 						},
 						{
 							"guid": "route-2-guid",
-							"url": "bye"
+							"url": "bye",
+							"metadata": {
+								"annotations": {"owner": "team-a"}
+							}
 						}
 					]
 				}`, server.URL())
@@ -329,6 +333,9 @@ var _ = Describe("Route", func() {
 						Route{
 							GUID: "route-2-guid",
 							URL:  "bye",
+							Metadata: &Metadata{
+								Annotations: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+							},
 						},
 						Route{
 							GUID: "route-3-guid",
@@ -367,6 +374,9 @@ var _ = Describe("Route", func() {
 						Route{
 							GUID: "route-2-guid",
 							URL:  "bye",
+							Metadata: &Metadata{
+								Annotations: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+							},
 						},
 						Route{
 							GUID: "route-3-guid",
@@ -0,0 +1,179 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceCredentialBinding represents a Cloud Controller V3 Service
+// Credential Binding, which is either a binding between a service instance
+// and an app, or a service key.
+type ServiceCredentialBinding struct {
+	// GUID is a unique service credential binding identifier.
+	GUID string
+	// Name is the name of the binding. It is required for service keys.
+	Name string
+	// Type is either app or key.
+	Type constant.ServiceCredentialBindingType
+	// ServiceInstanceGUID is the GUID of the bound service instance.
+	ServiceInstanceGUID string
+	// AppGUID is the GUID of the bound app. It is empty for service keys.
+	AppGUID string
+	// Parameters are the configuration parameters sent to the service broker
+	// when creating the binding.
+	Parameters map[string]interface{}
+	// LastOperation is the last operation performed on the binding.
+	LastOperation LastOperation
+}
+
+// ServiceCredentialBindingDetails are the credentials of a service credential
+// binding.
+type ServiceCredentialBindingDetails struct {
+	// Credentials are the credentials provided by the service broker.
+	Credentials map[string]interface{} `json:"credentials"`
+	// SyslogDrainURL is the URL logs of the bound app are streamed to.
+	SyslogDrainURL string `json:"syslog_drain_url"`
+}
+
+// MarshalJSON converts a ServiceCredentialBinding into a Cloud Controller
+// Service Credential Binding.
+func (b ServiceCredentialBinding) MarshalJSON() ([]byte, error) {
+	var ccBinding struct {
+		Type          constant.ServiceCredentialBindingType `json:"type"`
+		Name          string                                `json:"name,omitempty"`
+		Parameters    map[string]interface{}                `json:"parameters,omitempty"`
+		Relationships Relationships                         `json:"relationships"`
+	}
+
+	ccBinding.Type = b.Type
+	ccBinding.Name = b.Name
+	ccBinding.Parameters = b.Parameters
+	ccBinding.Relationships = Relationships{
+		constant.RelationshipTypeServiceInstance: Relationship{GUID: b.ServiceInstanceGUID},
+	}
+	if b.AppGUID != "" {
+		ccBinding.Relationships[constant.RelationshipTypeApplication] = Relationship{GUID: b.AppGUID}
+	}
+
+	return json.Marshal(ccBinding)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Credential Binding
+// response.
+func (b *ServiceCredentialBinding) UnmarshalJSON(data []byte) error {
+	var ccBinding struct {
+		GUID          string                                `json:"guid"`
+		Name          string                                `json:"name"`
+		Type          constant.ServiceCredentialBindingType `json:"type"`
+		LastOperation LastOperation                         `json:"last_operation"`
+		Relationships Relationships                         `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccBinding)
+	if err != nil {
+		return err
+	}
+
+	b.GUID = ccBinding.GUID
+	b.Name = ccBinding.Name
+	b.Type = ccBinding.Type
+	b.LastOperation = ccBinding.LastOperation
+	b.ServiceInstanceGUID = ccBinding.Relationships[constant.RelationshipTypeServiceInstance].GUID
+	b.AppGUID = ccBinding.Relationships[constant.RelationshipTypeApplication].GUID
+
+	return nil
+}
+
+// CreateServiceCredentialBinding creates a binding between a service instance
+// and an app, or a service key. Bindings to managed service instances are
+// created asynchronously, and the returned job URL can be polled to wait for
+// the service broker to finish.
+func (client *Client) CreateServiceCredentialBinding(binding ServiceCredentialBinding) (JobURL, Warnings, error) {
+	bodyBytes, err := json.Marshal(binding)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceCredentialBindingRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// DeleteServiceCredentialBinding deletes the service credential binding with
+// the given GUID. Deleting a binding to a managed service instance returns a
+// job URL to poll.
+func (client *Client) DeleteServiceCredentialBinding(bindingGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceCredentialBindingRequest,
+		URIParams:   internal.Params{"service_credential_binding_guid": bindingGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// GetServiceCredentialBindingDetails returns the credentials of the service
+// credential binding with the given GUID.
+func (client *Client) GetServiceCredentialBindingDetails(bindingGUID string) (ServiceCredentialBindingDetails, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceCredentialBindingDetailsRequest,
+		URIParams:   internal.Params{"service_credential_binding_guid": bindingGUID},
+	})
+	if err != nil {
+		return ServiceCredentialBindingDetails{}, nil, err
+	}
+
+	var details ServiceCredentialBindingDetails
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &details,
+	}
+	err = client.connection.Make(request, &response)
+
+	return details, response.Warnings, err
+}
+
+// GetServiceCredentialBindings lists service credential bindings with
+// optional filters.
+func (client *Client) GetServiceCredentialBindings(query ...Query) ([]ServiceCredentialBinding, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceCredentialBindingsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBindingList []ServiceCredentialBinding
+	warnings, err := client.paginate(request, ServiceCredentialBinding{}, func(item interface{}) error {
+		if binding, ok := item.(ServiceCredentialBinding); ok {
+			fullBindingList = append(fullBindingList, binding)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceCredentialBinding{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBindingList, warnings, err
+}
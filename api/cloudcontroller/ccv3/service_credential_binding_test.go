@@ -0,0 +1,262 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Credential Binding", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("CreateServiceCredentialBinding", func() {
+		var (
+			binding ServiceCredentialBinding
+
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.CreateServiceCredentialBinding(binding)
+		})
+
+		When("binding an app", func() {
+			BeforeEach(func() {
+				binding = ServiceCredentialBinding{
+					Type:                constant.AppBinding,
+					Name:                "some-binding",
+					ServiceInstanceGUID: "service-instance-guid",
+					AppGUID:             "app-guid",
+					Parameters:          map[string]interface{}{"some-key": "some-value"},
+				}
+
+				expectedBody := map[string]interface{}{
+					"type":       "app",
+					"name":       "some-binding",
+					"parameters": map[string]interface{}{"some-key": "some-value"},
+					"relationships": map[string]interface{}{
+						"service_instance": map[string]interface{}{"data": map[string]string{"guid": "service-instance-guid"}},
+						"app":              map[string]interface{}{"data": map[string]string{"guid": "app-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_credential_bindings"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"https://api.test.com/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("creating a service key", func() {
+			BeforeEach(func() {
+				binding = ServiceCredentialBinding{
+					Type:                constant.KeyBinding,
+					Name:                "some-key",
+					ServiceInstanceGUID: "service-instance-guid",
+				}
+
+				expectedBody := map[string]interface{}{
+					"type": "key",
+					"name": "some-key",
+					"relationships": map[string]interface{}{
+						"service_instance": map[string]interface{}{"data": map[string]string{"guid": "service-instance-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_credential_bindings"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "key-guid"}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("does not send an app relationship and returns no job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the app is already bound", func() {
+			BeforeEach(func() {
+				binding = ServiceCredentialBinding{Type: constant.AppBinding}
+
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The app is already bound to the service instance.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_credential_bindings"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceBindingTakenError and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceBindingTakenError{Message: "The app is already bound to the service instance."}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceCredentialBinding", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteServiceCredentialBinding("binding-guid")
+		})
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/service_credential_bindings/binding-guid"),
+					RespondWith(http.StatusAccepted, "", http.Header{
+						"X-Cf-Warnings": {"warning-1"},
+						"Location":      {"https://api.test.com/v3/jobs/some-job-guid"},
+					}),
+				),
+			)
+		})
+
+		It("returns the job URL and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("GetServiceCredentialBindings", func() {
+		var (
+			bindings   []ServiceCredentialBinding
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			bindings, warnings, executeErr = client.GetServiceCredentialBindings(
+				Query{Key: TypeFilter, Values: []string{"app"}},
+				Query{Key: ServiceInstanceGUIDFilter, Values: []string{"service-instance-guid"}},
+			)
+		})
+
+		BeforeEach(func() {
+			response := `{
+				"pagination": {
+					"next": null
+				},
+				"resources": [
+					{
+						"guid": "binding-guid",
+						"name": "some-binding",
+						"type": "app",
+						"last_operation": {
+							"type": "create",
+							"state": "succeeded"
+						},
+						"relationships": {
+							"app": {"data": {"guid": "app-guid"}},
+							"service_instance": {"data": {"guid": "service-instance-guid"}}
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/service_credential_bindings", "type=app&service_instance_guids=service-instance-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the bindings and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(bindings).To(ConsistOf(ServiceCredentialBinding{
+				GUID:                "binding-guid",
+				Name:                "some-binding",
+				Type:                constant.AppBinding,
+				ServiceInstanceGUID: "service-instance-guid",
+				AppGUID:             "app-guid",
+				LastOperation: LastOperation{
+					Type:  constant.LastOperationCreate,
+					State: constant.LastOperationSucceeded,
+				},
+			}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("GetServiceCredentialBindingDetails", func() {
+		var (
+			details    ServiceCredentialBindingDetails
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = client.GetServiceCredentialBindingDetails("key-guid")
+		})
+
+		BeforeEach(func() {
+			response := `{
+				"credentials": {
+					"username": "admin",
+					"password": "secret"
+				}
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/service_credential_bindings/key-guid/details"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the credentials and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(details.Credentials).To(Equal(map[string]interface{}{
+				"username": "admin",
+				"password": "secret",
+			}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+})
@@ -1,16 +1,195 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // ServiceInstance represents a Cloud Controller V3 Service Instance.
 type ServiceInstance struct {
 	// GUID is a unique service instance identifier.
-	GUID string `json:"guid"`
+	GUID string
 	// Name is the name of the service instance.
-	Name string `json:"name"`
+	Name string
+	// Type is either managed or user-provided.
+	Type constant.ServiceInstanceType
+	// SpaceGUID is the GUID of the space the service instance is in.
+	SpaceGUID string
+	// ServicePlanGUID is the GUID of the service plan of a managed service
+	// instance.
+	ServicePlanGUID string
+	// Tags are the user provided tags. When updating, nil tags are left
+	// unchanged.
+	Tags []string
+	// Parameters are the configuration parameters sent to the service broker
+	// when creating or updating a managed service instance.
+	Parameters map[string]interface{}
+	// Credentials are the credentials of a user-provided service instance.
+	// They are only sent when creating or updating.
+	Credentials map[string]interface{}
+	// SyslogDrainURL is the URL logs of bound apps are streamed to.
+	SyslogDrainURL types.NullString
+	// RouteServiceURL is the URL requests for bound routes are forwarded to.
+	RouteServiceURL types.NullString
+	// DashboardURL is the URL of the service instance's dashboard.
+	DashboardURL string
+	// LastOperation is the last operation performed on the service instance.
+	LastOperation LastOperation
+	// MaintenanceInfo is the version of the service plan the managed service
+	// instance is on. When updating, it is only sent if the version is set.
+	MaintenanceInfo MaintenanceInfo
+}
+
+// MaintenanceInfo is the version of a service plan. Service instances are
+// upgraded by updating them to the maintenance info of their plan.
+type MaintenanceInfo struct {
+	// Version is the semantic version of the service plan.
+	Version string `json:"version,omitempty"`
+	// Description describes the changes in the version.
+	Description string `json:"description,omitempty"`
+}
+
+// LastOperation is the last operation performed on a service instance or a
+// service credential binding.
+type LastOperation struct {
+	// Type is either create, update or delete.
+	Type constant.LastOperationType `json:"type"`
+	// State is either in progress, succeeded or failed.
+	State constant.LastOperationState `json:"state"`
+	// Description is a message from the service broker about the operation.
+	Description string `json:"description"`
+	// CreatedAt is the time the operation started.
+	CreatedAt string `json:"created_at"`
+	// UpdatedAt is the time the operation was last updated.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// MarshalJSON converts a ServiceInstance into a Cloud Controller Service
+// Instance.
+func (s ServiceInstance) MarshalJSON() ([]byte, error) {
+	var ccServiceInstance struct {
+		Type            constant.ServiceInstanceType `json:"type,omitempty"`
+		Name            string                       `json:"name,omitempty"`
+		Tags            *[]string                    `json:"tags,omitempty"`
+		Parameters      map[string]interface{}       `json:"parameters,omitempty"`
+		Credentials     map[string]interface{}       `json:"credentials,omitempty"`
+		SyslogDrainURL  *string                      `json:"syslog_drain_url,omitempty"`
+		RouteServiceURL *string                      `json:"route_service_url,omitempty"`
+		MaintenanceInfo *MaintenanceInfo             `json:"maintenance_info,omitempty"`
+		Relationships   Relationships                `json:"relationships,omitempty"`
+	}
+
+	ccServiceInstance.Type = s.Type
+	ccServiceInstance.Name = s.Name
+	ccServiceInstance.Parameters = s.Parameters
+	ccServiceInstance.Credentials = s.Credentials
+
+	if s.Tags != nil {
+		ccServiceInstance.Tags = &s.Tags
+	}
+	if s.SyslogDrainURL.IsSet {
+		ccServiceInstance.SyslogDrainURL = &s.SyslogDrainURL.Value
+	}
+	if s.RouteServiceURL.IsSet {
+		ccServiceInstance.RouteServiceURL = &s.RouteServiceURL.Value
+	}
+	if s.MaintenanceInfo.Version != "" {
+		ccServiceInstance.MaintenanceInfo = &s.MaintenanceInfo
+	}
+
+	if s.SpaceGUID != "" || s.ServicePlanGUID != "" {
+		ccServiceInstance.Relationships = Relationships{}
+		if s.SpaceGUID != "" {
+			ccServiceInstance.Relationships[constant.RelationshipTypeSpace] = Relationship{GUID: s.SpaceGUID}
+		}
+		if s.ServicePlanGUID != "" {
+			ccServiceInstance.Relationships[constant.RelationshipTypeServicePlan] = Relationship{GUID: s.ServicePlanGUID}
+		}
+	}
+
+	return json.Marshal(ccServiceInstance)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
+func (s *ServiceInstance) UnmarshalJSON(data []byte) error {
+	var ccServiceInstance struct {
+		GUID            string                       `json:"guid"`
+		Name            string                       `json:"name"`
+		Type            constant.ServiceInstanceType `json:"type"`
+		Tags            []string                     `json:"tags"`
+		SyslogDrainURL  types.NullString             `json:"syslog_drain_url"`
+		RouteServiceURL types.NullString             `json:"route_service_url"`
+		DashboardURL    string                       `json:"dashboard_url"`
+		LastOperation   LastOperation                `json:"last_operation"`
+		MaintenanceInfo MaintenanceInfo              `json:"maintenance_info"`
+		Relationships   Relationships                `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccServiceInstance)
+	if err != nil {
+		return err
+	}
+
+	s.GUID = ccServiceInstance.GUID
+	s.Name = ccServiceInstance.Name
+	s.Type = ccServiceInstance.Type
+	s.Tags = ccServiceInstance.Tags
+	s.SyslogDrainURL = ccServiceInstance.SyslogDrainURL
+	s.RouteServiceURL = ccServiceInstance.RouteServiceURL
+	s.DashboardURL = ccServiceInstance.DashboardURL
+	s.LastOperation = ccServiceInstance.LastOperation
+	s.MaintenanceInfo = ccServiceInstance.MaintenanceInfo
+	s.SpaceGUID = ccServiceInstance.Relationships[constant.RelationshipTypeSpace].GUID
+	s.ServicePlanGUID = ccServiceInstance.Relationships[constant.RelationshipTypeServicePlan].GUID
+
+	return nil
+}
+
+// CreateServiceInstance creates a service instance. A managed service
+// instance is created asynchronously, and the returned job URL can be polled
+// to wait for the service broker to finish. The job URL is empty for
+// user-provided service instances.
+func (client *Client) CreateServiceInstance(serviceInstance ServiceInstance) (JobURL, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstance)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// DeleteServiceInstance deletes the service instance with the given GUID.
+// Deleting a managed service instance returns a job URL to poll.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   internal.Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
 }
 
 // GetServiceInstances lists service instances with optional filters.
@@ -38,3 +217,27 @@ func (client *Client) GetServiceInstances(query ...Query) ([]ServiceInstance, Wa
 
 	return fullServiceInstanceList, warnings, err
 }
+
+// UpdateServiceInstance updates the service instance with the given GUID
+// using the set fields of serviceInstance. Updating a managed service instance
+// returns a job URL to poll, unless only its name or tags were changed.
+func (client *Client) UpdateServiceInstance(serviceInstanceGUID string, serviceInstance ServiceInstance) (JobURL, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstance)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchServiceInstanceRequest,
+		URIParams:   internal.Params{"service_instance_guid": serviceInstanceGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
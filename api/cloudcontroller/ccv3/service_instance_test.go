@@ -6,6 +6,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -106,6 +108,72 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 
+		When("the service instance has a plan, tags, a last operation and maintenance info", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "service-instance-guid",
+							"name": "service-instance-name",
+							"type": "managed",
+							"tags": ["tag-1", "tag-2"],
+							"dashboard_url": "https://dashboard.example.com",
+							"last_operation": {
+								"type": "create",
+								"state": "in progress",
+								"description": "creating",
+								"created_at": "2019-01-01T00:00:00Z",
+								"updated_at": "2019-01-01T00:01:00Z"
+							},
+							"maintenance_info": {
+								"version": "1.0.0",
+								"description": "first version"
+							},
+							"relationships": {
+								"space": {"data": {"guid": "space-guid"}},
+								"service_plan": {"data": {"guid": "plan-guid"}}
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_instances"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns all the fields of the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(instances).To(ConsistOf(ServiceInstance{
+					GUID:            "service-instance-guid",
+					Name:            "service-instance-name",
+					Type:            constant.ManagedService,
+					SpaceGUID:       "space-guid",
+					ServicePlanGUID: "plan-guid",
+					Tags:            []string{"tag-1", "tag-2"},
+					DashboardURL:    "https://dashboard.example.com",
+					LastOperation: LastOperation{
+						Type:        constant.LastOperationCreate,
+						State:       constant.LastOperationInProgress,
+						Description: "creating",
+						CreatedAt:   "2019-01-01T00:00:00Z",
+						UpdatedAt:   "2019-01-01T00:01:00Z",
+					},
+					MaintenanceInfo: MaintenanceInfo{
+						Version:     "1.0.0",
+						Description: "first version",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
 		When("the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -150,4 +218,255 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("CreateServiceInstance", func() {
+		var (
+			serviceInstance ServiceInstance
+
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.CreateServiceInstance(serviceInstance)
+		})
+
+		When("creating a managed service instance", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{
+					Type:            constant.ManagedService,
+					Name:            "service-instance-name",
+					SpaceGUID:       "space-guid",
+					ServicePlanGUID: "plan-guid",
+					Tags:            []string{"tag-1"},
+					Parameters:      map[string]interface{}{"some-key": "some-value"},
+				}
+
+				expectedBody := map[string]interface{}{
+					"type":       "managed",
+					"name":       "service-instance-name",
+					"tags":       []string{"tag-1"},
+					"parameters": map[string]interface{}{"some-key": "some-value"},
+					"relationships": map[string]interface{}{
+						"space":        map[string]interface{}{"data": map[string]string{"guid": "space-guid"}},
+						"service_plan": map[string]interface{}{"data": map[string]string{"guid": "plan-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"https://api.test.com/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("creating a user-provided service instance", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{
+					Type:           constant.UserProvidedService,
+					Name:           "service-instance-name",
+					SpaceGUID:      "space-guid",
+					Credentials:    map[string]interface{}{"username": "admin"},
+					SyslogDrainURL: types.NewNullString("syslog://example.com"),
+				}
+
+				expectedBody := map[string]interface{}{
+					"type":             "user-provided",
+					"name":             "service-instance-name",
+					"credentials":      map[string]interface{}{"username": "admin"},
+					"syslog_drain_url": "syslog://example.com",
+					"relationships": map[string]interface{}{
+						"space": map[string]interface{}{"data": map[string]string{"guid": "space-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "service-instance-guid"}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns no job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 60002,
+							"detail": "The service instance name is taken: service-instance-name",
+							"title": "CF-ServiceInstanceNameTaken"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceInstanceNameTakenError and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceInstanceNameTakenError{
+					Message: "The service instance name is taken: service-instance-name",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstance", func() {
+		var (
+			serviceInstance ServiceInstance
+
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.UpdateServiceInstance("service-instance-guid", serviceInstance)
+		})
+
+		When("only some fields are set", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{
+					ServicePlanGUID: "new-plan-guid",
+					Tags:            []string{},
+				}
+
+				expectedBody := map[string]interface{}{
+					"tags": []string{},
+					"relationships": map[string]interface{}{
+						"service_plan": map[string]interface{}{"data": map[string]string{"guid": "new-plan-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/service-instance-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"https://api.test.com/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("only sends the set fields and returns the job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the maintenance info is set", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{
+					MaintenanceInfo: MaintenanceInfo{Version: "2.0.0"},
+				}
+
+				expectedBody := map[string]interface{}{
+					"maintenance_info": map[string]string{"version": "2.0.0"},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/service-instance-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"Location": {"https://api.test.com/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("sends the maintenance info", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteServiceInstance("service-instance-guid")
+		})
+
+		When("the service instance exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_instances/service-instance-guid"),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"https://api.test.com/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("https://api.test.com/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Service instance not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_instances/service-instance-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Service instance not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,70 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceOffering represents a Cloud Controller V3 Service Offering.
+type ServiceOffering struct {
+	// GUID is a unique service offering identifier.
+	GUID string
+	// Name is the name of the service offering.
+	Name string
+	// Description of the service offering.
+	Description string
+	// ServiceBrokerGUID is the GUID of the service broker that provides the
+	// service offering.
+	ServiceBrokerGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Offering response.
+func (o *ServiceOffering) UnmarshalJSON(data []byte) error {
+	var ccServiceOffering struct {
+		GUID          string `json:"guid"`
+		Name          string `json:"name"`
+		Description   string `json:"description"`
+		Relationships struct {
+			ServiceBroker Relationship `json:"service_broker"`
+		} `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccServiceOffering)
+	if err != nil {
+		return err
+	}
+
+	o.GUID = ccServiceOffering.GUID
+	o.Name = ccServiceOffering.Name
+	o.Description = ccServiceOffering.Description
+	o.ServiceBrokerGUID = ccServiceOffering.Relationships.ServiceBroker.GUID
+
+	return nil
+}
+
+// GetServiceOfferings lists service offerings with optional filters.
+func (client *Client) GetServiceOfferings(query ...Query) ([]ServiceOffering, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceOfferingsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServiceOfferingList []ServiceOffering
+	warnings, err := client.paginate(request, ServiceOffering{}, func(item interface{}) error {
+		if serviceOffering, ok := item.(ServiceOffering); ok {
+			fullServiceOfferingList = append(fullServiceOfferingList, serviceOffering)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceOffering{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServiceOfferingList, warnings, err
+}
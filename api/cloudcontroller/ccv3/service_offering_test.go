@@ -0,0 +1,77 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Offering", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServiceOfferings", func() {
+		var (
+			offerings  []ServiceOffering
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			offerings, warnings, executeErr = client.GetServiceOfferings(
+				Query{Key: GUIDFilter, Values: []string{"offering-guid-1", "offering-guid-2"}},
+			)
+		})
+
+		When("service offerings exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "offering-guid-1",
+							"name": "offering-1",
+							"description": "first offering",
+							"relationships": {
+								"service_broker": {"data": {"guid": "broker-guid"}}
+							}
+						},
+						{
+							"guid": "offering-guid-2",
+							"name": "offering-2",
+							"description": "second offering",
+							"relationships": {
+								"service_broker": {"data": {"guid": "broker-guid"}}
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_offerings", "guids=offering-guid-1,offering-guid-2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the service offerings and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(offerings).To(ConsistOf(
+					ServiceOffering{GUID: "offering-guid-1", Name: "offering-1", Description: "first offering", ServiceBrokerGUID: "broker-guid"},
+					ServiceOffering{GUID: "offering-guid-2", Name: "offering-2", Description: "second offering", ServiceBrokerGUID: "broker-guid"},
+				))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServicePlan represents a Cloud Controller V3 Service Plan.
+type ServicePlan struct {
+	// GUID is a unique service plan identifier.
+	GUID string
+	// Name is the name of the service plan.
+	Name string
+	// Description of the service plan.
+	Description string
+	// Free is true if the service plan is free of charge.
+	Free bool
+	// ServiceOfferingGUID is the GUID of the service offering the plan belongs
+	// to.
+	ServiceOfferingGUID string
+	// MaintenanceInfo is the current version of the service plan.
+	MaintenanceInfo MaintenanceInfo
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (p *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		GUID            string          `json:"guid"`
+		Name            string          `json:"name"`
+		Description     string          `json:"description"`
+		Free            bool            `json:"free"`
+		MaintenanceInfo MaintenanceInfo `json:"maintenance_info"`
+		Relationships   Relationships   `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccServicePlan)
+	if err != nil {
+		return err
+	}
+
+	p.GUID = ccServicePlan.GUID
+	p.Name = ccServicePlan.Name
+	p.Description = ccServicePlan.Description
+	p.Free = ccServicePlan.Free
+	p.MaintenanceInfo = ccServicePlan.MaintenanceInfo
+	p.ServiceOfferingGUID = ccServicePlan.Relationships[constant.RelationshipTypeServiceOffering].GUID
+
+	return nil
+}
+
+// GetServicePlans lists service plans with optional filters.
+func (client *Client) GetServicePlans(query ...Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlanList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if servicePlan, ok := item.(ServicePlan); ok {
+			fullServicePlanList = append(fullServicePlanList, servicePlan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlanList, warnings, err
+}
@@ -0,0 +1,112 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServicePlans", func() {
+		var (
+			plans      []ServicePlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			plans, warnings, executeErr = client.GetServicePlans(
+				Query{Key: NameFilter, Values: []string{"some-plan"}},
+				Query{Key: ServiceOfferingNamesFilter, Values: []string{"some-offering"}},
+			)
+		})
+
+		When("service plans exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "plan-guid",
+							"name": "some-plan",
+							"description": "a plan",
+							"free": true,
+							"maintenance_info": {"version": "2.0.0"},
+							"relationships": {
+								"service_offering": {"data": {"guid": "offering-guid"}}
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans", "names=some-plan&service_offering_names=some-offering"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the service plans and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plans).To(ConsistOf(ServicePlan{
+					GUID:                "plan-guid",
+					Name:                "some-plan",
+					Description:         "a plan",
+					Free:                true,
+					ServiceOfferingGUID: "offering-guid",
+					MaintenanceInfo:     MaintenanceInfo{Version: "2.0.0"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 42424,
+							"detail": "Some detailed error message",
+							"title": "CF-SomeErrorTitle"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{{
+							Code:   42424,
+							Detail: "Some detailed error message",
+							Title:  "CF-SomeErrorTitle",
+						}},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -30,6 +30,15 @@ type Task struct {
 	SequenceID int64 `json:"sequence_id,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
+	// Result contains the reason a FAILED task failed.
+	Result *TaskResult `json:"result,omitempty"`
+}
+
+// TaskResult represents the outcome of a Cloud Controller V3 Task.
+type TaskResult struct {
+	// FailureReason is why the task failed, such as its exit status or
+	// having been cancelled.
+	FailureReason string `json:"failure_reason,omitempty"`
 }
 
 // CreateApplicationTask runs a command in the Application environment
@@ -217,7 +217,10 @@ var _ = Describe("Task", func() {
 							"name": "task-2",
 							"command": "some-command",
 							"state": "FAILED",
-							"created_at": "2016-11-07T06:59:01Z"
+							"created_at": "2016-11-07T06:59:01Z",
+							"result": {
+								"failure_reason": "Exited with status 1"
+							}
 						}
 					]
 				}`, server.URL())
@@ -271,6 +274,7 @@ var _ = Describe("Task", func() {
 						State:      constant.TaskFailed,
 						CreatedAt:  "2016-11-07T06:59:01Z",
 						Command:    "some-command",
+						Result:     &TaskResult{FailureReason: "Exited with status 1"},
 					},
 					Task{
 						GUID:       "task-3-guid",
@@ -2,14 +2,18 @@ package wrapper_test
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/util/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -43,7 +47,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -59,9 +63,72 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Get (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+
+		Entry("1 for Patch (502) Bad Gateway", http.MethodPatch, http.StatusBadGateway, 1),
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	When("no response is received", func() {
+		var (
+			request        *cloudcontroller.Request
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		})
+
+		It("retries when the connection was reset", func() {
+			expectedErr := ccerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: io.ErrUnexpectedEOF}}
+			fakeConnection.MakeReturns(expectedErr)
+
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+			err := wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+		})
+
+		It("does not retry other request errors", func() {
+			expectedErr := ccerror.UnverifiedServerError{URL: "https://foo.bar.com"}
+			fakeConnection.MakeReturns(expectedErr)
+
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+			err := wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+
+	It("waits for the Retry-After delay before retrying", func() {
+		req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request := cloudcontroller.NewRequest(req, nil)
+
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{"Retry-After": {"1"}},
+				}
+				return ccerror.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+			}
+			return nil
+		}
+
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2, MaxBackoff: 5 * time.Second}).Wrap(fakeConnection)
+		start := time.Now()
+		err = wrapper.Make(request, &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+	})
+
 	It("does not retry on success", func() {
 		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -73,7 +140,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
@@ -105,7 +172,7 @@ var _ = Describe("Retry Request", func() {
 			expectedErr = errors.New("oh noes")
 			fakeConnection.MakeReturns(expectedErr)
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
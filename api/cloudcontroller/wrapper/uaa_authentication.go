@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -21,12 +22,15 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SyncTokens calls
+// refresh with the tokens stored by any other process sharing the cache, and
+// stores the tokens refresh sets before other processes can read them.
 type TokenCache interface {
 	AccessToken() string
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -35,6 +39,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// refreshLock prevents concurrent requests, such as pages of a paginated
+	// request, from refreshing the same token more than once.
+	refreshLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -79,27 +87,34 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 }
 
 // refreshToken refreshes the JWT access token if it is expired or about to expire.
-// If the access token is not yet expired, no action is performed.
+// If the access token is not yet expired, no action is performed. The refresh
+// happens through SyncTokens, so that the token another cf process sharing the
+// config has already refreshed is used instead, and the refreshed tokens are
+// stored before any other process looks for them.
 func (t *UAAAuthentication) refreshToken() error {
-	var expiresIn time.Duration
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
 
-	tokenStr := strings.TrimPrefix(t.cache.AccessToken(), "bearer ")
-	token, err := jws.ParseJWT([]byte(tokenStr))
+	if !accessTokenExpiring(t.cache.AccessToken()) {
+		return nil
+	}
 
-	if err == nil {
-		expiration, ok := token.Claims().Expiration()
-		if ok {
-			expiresIn = time.Until(expiration)
-		}
+	return uaa.RefreshSyncedTokens(t.client, t.cache, accessTokenExpiring)
+}
+
+// accessTokenExpiring returns true if the access token cannot be parsed, or
+// expires within accessTokenExpirationMargin.
+func accessTokenExpiring(accessToken string) bool {
+	tokenStr := strings.TrimPrefix(accessToken, "bearer ")
+	token, err := jws.ParseJWT([]byte(tokenStr))
+	if err != nil {
+		return true
 	}
 
-	if err != nil || expiresIn < accessTokenExpirationMargin {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
-		if err != nil {
-			return err
-		}
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
+	var expiresIn time.Duration
+	expiration, ok := token.Claims().Expiration()
+	if ok {
+		expiresIn = time.Until(expiration)
 	}
-	return nil
+	return expiresIn < accessTokenExpirationMargin
 }
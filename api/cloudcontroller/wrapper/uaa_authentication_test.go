@@ -214,6 +214,77 @@ var _ = Describe("UAA Authentication", func() {
 			})
 
 		})
+
+		When("the access token is expired and another process has already refreshed it", func() {
+			var (
+				fakeCache      *wrapperfakes.FakeTokenCache
+				newAccessToken string
+				executeErr     error
+			)
+
+			BeforeEach(func() {
+				expiredAccessToken, err := buildTokenString(time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+				newAccessToken, err = buildTokenString(time.Now().AddDate(0, 0, 1))
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeCache = new(wrapperfakes.FakeTokenCache)
+				accessToken := expiredAccessToken
+				fakeCache.AccessTokenStub = func() string {
+					return accessToken
+				}
+				fakeCache.SyncTokensStub = func(refresh func() error) error {
+					accessToken = newAccessToken
+					return refresh()
+				}
+
+				inner = NewUAAAuthentication(fakeClient, fakeCache)
+				wrapper = inner.Wrap(fakeConnection)
+			})
+
+			JustBeforeEach(func() {
+				executeErr = wrapper.Make(request, nil)
+			})
+
+			It("uses the reloaded token instead of refreshing it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCache.SyncTokensCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal(newAccessToken))
+			})
+
+			When("syncing the tokens fails", func() {
+				BeforeEach(func() {
+					fakeCache.SyncTokensReturns(errors.New("sync error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("sync error"))
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the access token is valid and the cache can be synced", func() {
+			var fakeCache *wrapperfakes.FakeTokenCache
+
+			BeforeEach(func() {
+				accessToken, err := buildTokenString(time.Now().AddDate(0, 0, 1))
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeCache = new(wrapperfakes.FakeTokenCache)
+				fakeCache.AccessTokenReturns(accessToken)
+				inner = NewUAAAuthentication(fakeClient, fakeCache)
+				wrapper = inner.Wrap(fakeConnection)
+			})
+
+			It("does not sync the tokens", func() {
+				Expect(wrapper.Make(request, nil)).To(Succeed())
+				Expect(fakeCache.SyncTokensCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
 
@@ -21,6 +21,10 @@ func (c *InMemoryCache) SetRefreshToken(token string) {
 	c.refreshToken = token
 }
 
+func (c *InMemoryCache) SyncTokens(refresh func() error) error {
+	return refresh()
+}
+
 func NewInMemoryTokenCache() *InMemoryCache {
 	return new(InMemoryCache)
 }
@@ -0,0 +1,9 @@
+// Package logcache contains utilities to make calls to the Log Cache API
+package logcache
+
+//go:generate counterfeiter . Connection
+
+// Connection creates and executes http requests
+type Connection interface {
+	Make(request *Request, passedResponse *Response) error
+}
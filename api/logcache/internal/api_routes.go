@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"net/http"
+
+	"github.com/tedsuo/rata"
+)
+
+// Naming convention:
+//
+// Method + non-parameter parts of the path
+//
+// The const name should always be the const value + Request.
+const (
+	GetReadRequest = "GetRead"
+)
+
+// APIRoutes is a list of routes used by the rata library to construct request
+// URLs.
+var APIRoutes = rata.Routes{
+	{Path: "/api/v1/read/:source_id", Method: http.MethodGet, Name: GetReadRequest},
+}
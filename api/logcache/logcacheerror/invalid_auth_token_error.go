@@ -0,0 +1,11 @@
+package logcacheerror
+
+// InvalidAuthTokenError is returned when the client has an invalid
+// authorization header.
+type InvalidAuthTokenError struct {
+	Message string
+}
+
+func (e InvalidAuthTokenError) Error() string {
+	return e.Message
+}
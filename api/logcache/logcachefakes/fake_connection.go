@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package logcachefakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+)
+
+type FakeConnection struct {
+	MakeStub        func(*logcache.Request, *logcache.Response) error
+	makeMutex       sync.RWMutex
+	makeArgsForCall []struct {
+		arg1 *logcache.Request
+		arg2 *logcache.Response
+	}
+	makeReturns struct {
+		result1 error
+	}
+	makeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConnection) Make(arg1 *logcache.Request, arg2 *logcache.Response) error {
+	fake.makeMutex.Lock()
+	ret, specificReturn := fake.makeReturnsOnCall[len(fake.makeArgsForCall)]
+	fake.makeArgsForCall = append(fake.makeArgsForCall, struct {
+		arg1 *logcache.Request
+		arg2 *logcache.Response
+	}{arg1, arg2})
+	fake.recordInvocation("Make", []interface{}{arg1, arg2})
+	fake.makeMutex.Unlock()
+	if fake.MakeStub != nil {
+		return fake.MakeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.makeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConnection) MakeCallCount() int {
+	fake.makeMutex.RLock()
+	defer fake.makeMutex.RUnlock()
+	return len(fake.makeArgsForCall)
+}
+
+func (fake *FakeConnection) MakeCalls(stub func(*logcache.Request, *logcache.Response) error) {
+	fake.makeMutex.Lock()
+	defer fake.makeMutex.Unlock()
+	fake.MakeStub = stub
+}
+
+func (fake *FakeConnection) MakeArgsForCall(i int) (*logcache.Request, *logcache.Response) {
+	fake.makeMutex.RLock()
+	defer fake.makeMutex.RUnlock()
+	argsForCall := fake.makeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConnection) MakeReturns(result1 error) {
+	fake.makeMutex.Lock()
+	defer fake.makeMutex.Unlock()
+	fake.MakeStub = nil
+	fake.makeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConnection) MakeReturnsOnCall(i int, result1 error) {
+	fake.makeMutex.Lock()
+	defer fake.makeMutex.Unlock()
+	fake.MakeStub = nil
+	if fake.makeReturnsOnCall == nil {
+		fake.makeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.makeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConnection) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.makeMutex.RLock()
+	defer fake.makeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConnection) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ logcache.Connection = new(FakeConnection)
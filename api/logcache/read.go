@@ -0,0 +1,221 @@
+package logcache
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/internal"
+)
+
+// EnvelopeTypeLog is the envelope type of log messages.
+const EnvelopeTypeLog = "LOG"
+
+// MaxReadLimit is the most envelopes Log Cache returns from a single read.
+const MaxReadLimit = 1000
+
+// Envelope is a Loggregator envelope read from Log Cache. Only log envelopes
+// are decoded; Log is nil for other envelope types.
+type Envelope struct {
+	// Timestamp is when the envelope was emitted.
+	Timestamp time.Time
+	// SourceID is the GUID of the application or component that emitted the
+	// envelope.
+	SourceID string
+	// InstanceID is the index of the instance that emitted the envelope.
+	InstanceID string
+	// Tags are the envelope's tags, such as source_type.
+	Tags map[string]string
+	// Log is the log message carried by the envelope.
+	Log *Log
+}
+
+// Log is the log message carried by an Envelope.
+type Log struct {
+	// Payload is the message logged.
+	Payload []byte
+	// Type is either OUT or ERR.
+	Type string
+}
+
+// UnmarshalJSON helps unmarshal a Log Cache envelope.
+func (envelope *Envelope) UnmarshalJSON(data []byte) error {
+	var rawEnvelope struct {
+		Timestamp  json.Number       `json:"timestamp"`
+		SourceID   string            `json:"source_id"`
+		InstanceID string            `json:"instance_id"`
+		Tags       map[string]string `json:"tags"`
+		Log        *struct {
+			Payload []byte `json:"payload"`
+			Type    string `json:"type"`
+		} `json:"log"`
+	}
+
+	err := json.Unmarshal(data, &rawEnvelope)
+	if err != nil {
+		return err
+	}
+
+	var timestamp int64
+	if rawEnvelope.Timestamp != "" {
+		timestamp, err = rawEnvelope.Timestamp.Int64()
+		if err != nil {
+			return err
+		}
+	}
+
+	envelope.Timestamp = time.Unix(0, timestamp)
+	envelope.SourceID = rawEnvelope.SourceID
+	envelope.InstanceID = rawEnvelope.InstanceID
+	envelope.Tags = rawEnvelope.Tags
+
+	if rawEnvelope.Log != nil {
+		// Log Cache omits the type of OUT logs since it is the default.
+		logType := rawEnvelope.Log.Type
+		if logType == "" {
+			logType = "OUT"
+		}
+
+		envelope.Log = &Log{
+			Payload: rawEnvelope.Log.Payload,
+			Type:    logType,
+		}
+	}
+
+	return nil
+}
+
+// ReadOptions narrow down the envelopes returned by a read.
+type ReadOptions struct {
+	// StartTime excludes envelopes emitted before it.
+	StartTime time.Time
+	// EndTime excludes envelopes emitted at or after it.
+	EndTime time.Time
+	// EnvelopeTypes only includes envelopes of these types.
+	EnvelopeTypes []string
+	// Limit is the most envelopes returned. It defaults to 100 and can be at
+	// most MaxReadLimit.
+	Limit int
+	// Descending returns the newest envelopes first.
+	Descending bool
+}
+
+func (options ReadOptions) query() url.Values {
+	query := url.Values{}
+
+	if !options.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(options.StartTime.UnixNano(), 10))
+	}
+	if !options.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(options.EndTime.UnixNano(), 10))
+	}
+	for _, envelopeType := range options.EnvelopeTypes {
+		query.Add("envelope_types", envelopeType)
+	}
+	if options.Limit > 0 {
+		query.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if options.Descending {
+		query.Set("descending", "true")
+	}
+
+	return query
+}
+
+// Read returns one page of the envelopes emitted by the source, as narrowed
+// down by the options.
+func (client *Client) Read(sourceID string, options ReadOptions) ([]Envelope, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetReadRequest,
+		URIParams:   Params{"source_id": sourceID},
+		Query:       options.query(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Envelopes struct {
+			Batch []Envelope `json:"batch"`
+		} `json:"envelopes"`
+	}
+
+	response := Response{
+		Result: &result,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Envelopes.Batch, nil
+}
+
+// ReadAll returns every envelope of the given types emitted by the source
+// between start and end, oldest first. It pages through Log Cache until the
+// window is exhausted. Each page starts at the timestamp of the last envelope
+// read, since several envelopes can share it, and the envelopes already read
+// at that timestamp are skipped.
+func (client *Client) ReadAll(sourceID string, start time.Time, end time.Time, envelopeTypes ...string) ([]Envelope, error) {
+	var (
+		allEnvelopes []Envelope
+		readAtStart  []Envelope
+	)
+
+	for {
+		envelopes, err := client.Read(sourceID, ReadOptions{
+			StartTime:     start,
+			EndTime:       end,
+			EnvelopeTypes: envelopeTypes,
+			Limit:         MaxReadLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		newEnvelopes := 0
+		for _, envelope := range envelopes {
+			if envelope.Timestamp.Equal(start) {
+				var alreadyRead bool
+				readAtStart, alreadyRead = removeEnvelope(readAtStart, envelope)
+				if alreadyRead {
+					continue
+				}
+			}
+			allEnvelopes = append(allEnvelopes, envelope)
+			newEnvelopes++
+		}
+
+		if len(envelopes) < MaxReadLimit {
+			return allEnvelopes, nil
+		}
+
+		if newEnvelopes == 0 {
+			// A full page of envelopes shares the start timestamp, so reading
+			// from it again would return the same page.
+			start = start.Add(time.Nanosecond)
+			readAtStart = nil
+			continue
+		}
+
+		start = envelopes[len(envelopes)-1].Timestamp
+		readAtStart = nil
+		for i := len(envelopes) - 1; i >= 0 && envelopes[i].Timestamp.Equal(start); i-- {
+			readAtStart = append(readAtStart, envelopes[i])
+		}
+	}
+}
+
+// removeEnvelope removes one envelope equal to envelope from envelopes, and
+// reports whether there was one.
+func removeEnvelope(envelopes []Envelope, envelope Envelope) ([]Envelope, bool) {
+	for i := range envelopes {
+		if reflect.DeepEqual(envelopes[i], envelope) {
+			return append(envelopes[:i:i], envelopes[i+1:]...), true
+		}
+	}
+	return envelopes, false
+}
@@ -0,0 +1,287 @@
+package logcache_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Read", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("Read", func() {
+		var (
+			envelopes  []Envelope
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envelopes, executeErr = client.Read("some-app-guid", ReadOptions{
+				StartTime:     time.Unix(0, 100),
+				EndTime:       time.Unix(0, 200),
+				EnvelopeTypes: []string{EnvelopeTypeLog},
+				Limit:         10,
+				Descending:    true,
+			})
+		})
+
+		When("the read succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"envelopes": {
+						"batch": [
+							{
+								"timestamp": "150",
+								"source_id": "some-app-guid",
+								"instance_id": "1",
+								"tags": {"source_type": "APP/PROC/WEB"},
+								"log": {"payload": "aGVsbG8=", "type": "ERR"}
+							},
+							{
+								"timestamp": "120",
+								"source_id": "some-app-guid",
+								"instance_id": "0",
+								"tags": {"source_type": "RTR"},
+								"log": {"payload": "d29ybGQ="}
+							},
+							{
+								"timestamp": "110",
+								"source_id": "some-app-guid",
+								"gauge": {}
+							}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=200&envelope_types=LOG&limit=10&descending=true"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the envelopes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(Equal([]Envelope{
+					{
+						Timestamp:  time.Unix(0, 150),
+						SourceID:   "some-app-guid",
+						InstanceID: "1",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &Log{Payload: []byte("hello"), Type: "ERR"},
+					},
+					{
+						Timestamp:  time.Unix(0, 120),
+						SourceID:   "some-app-guid",
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "RTR"},
+						Log:        &Log{Payload: []byte("world"), Type: "OUT"},
+					},
+					{
+						Timestamp: time.Unix(0, 110),
+						SourceID:  "some-app-guid",
+					},
+				}))
+			})
+		})
+
+		When("the token is invalid", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusUnauthorized, `{"error": "invalid_token"}`),
+					))
+			})
+
+			It("returns an InvalidAuthTokenError", func() {
+				Expect(executeErr).To(MatchError(logcacheerror.InvalidAuthTokenError{Message: `{"error": "invalid_token"}`}))
+			})
+		})
+
+		When("Log Cache returns another error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusNotFound, `not found`),
+					))
+			})
+
+			It("returns a RawHTTPStatusError", func() {
+				Expect(executeErr).To(MatchError(logcacheerror.RawHTTPStatusError{
+					StatusCode:  http.StatusNotFound,
+					RawResponse: []byte("not found"),
+				}))
+			})
+		})
+	})
+
+	Describe("ReadAll", func() {
+		var (
+			envelopes  []Envelope
+			executeErr error
+		)
+
+		envelopeAt := func(timestamp int64, payload string) string {
+			return fmt.Sprintf(`{"timestamp": "%d", "source_id": "some-app-guid", "log": {"payload": "%s"}}`, timestamp, base64.StdEncoding.EncodeToString([]byte(payload)))
+		}
+
+		batchOf := func(envelopes ...string) string {
+			return fmt.Sprintf(`{"envelopes": {"batch": [%s]}}`, strings.Join(envelopes, ","))
+		}
+
+		envelopesFrom := func(first int64, count int) []string {
+			var envelopes []string
+			for i := int64(0); i < int64(count); i++ {
+				envelopes = append(envelopes, envelopeAt(first+i, "hello"))
+			}
+			return envelopes
+		}
+
+		payloadsOf := func(envelopes []Envelope) []string {
+			var payloads []string
+			for _, envelope := range envelopes {
+				payloads = append(payloads, string(envelope.Log.Payload))
+			}
+			return payloads
+		}
+
+		JustBeforeEach(func() {
+			envelopes, executeErr = client.ReadAll("some-app-guid", time.Unix(0, 100), time.Unix(0, 5000), EnvelopeTypeLog)
+		})
+
+		When("the window fits in one page", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(envelopesFrom(100, 2)...)),
+					),
+				)
+			})
+
+			It("reads a single page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(HaveLen(2))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		When("the window spans several pages", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(envelopesFrom(100, MaxReadLimit)...)),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=1099&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(envelopesFrom(1099, 3)...)),
+					),
+				)
+			})
+
+			It("pages through the window starting at the last envelope read, without repeating it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(HaveLen(MaxReadLimit + 2))
+				Expect(envelopes[0].Timestamp).To(Equal(time.Unix(0, 100)))
+				Expect(envelopes[MaxReadLimit-1].Timestamp).To(Equal(time.Unix(0, 1099)))
+				Expect(envelopes[MaxReadLimit].Timestamp).To(Equal(time.Unix(0, 1100)))
+				Expect(envelopes[MaxReadLimit+1].Timestamp).To(Equal(time.Unix(0, 1101)))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		When("envelopes that share the last timestamp span two pages", func() {
+			BeforeEach(func() {
+				firstPage := append(envelopesFrom(100, MaxReadLimit-2), envelopeAt(2000, "first"), envelopeAt(2000, "second"))
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(firstPage...)),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=2000&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(
+							envelopeAt(2000, "first"),
+							envelopeAt(2000, "second"),
+							envelopeAt(2000, "third"),
+							envelopeAt(2001, "fourth"),
+						)),
+					),
+				)
+			})
+
+			It("returns every envelope at that timestamp exactly once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(HaveLen(MaxReadLimit + 2))
+				Expect(payloadsOf(envelopes[MaxReadLimit-2:])).To(Equal([]string{"first", "second", "third", "fourth"}))
+			})
+		})
+
+		When("identical envelopes share the last timestamp", func() {
+			BeforeEach(func() {
+				firstPage := append(envelopesFrom(100, MaxReadLimit-1), envelopeAt(2000, "same"))
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(firstPage...)),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=2000&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(envelopeAt(2000, "same"), envelopeAt(2000, "same"))),
+					),
+				)
+			})
+
+			It("only skips as many of them as were already read", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(payloadsOf(envelopes[MaxReadLimit-1:])).To(Equal([]string{"same", "same"}))
+			})
+		})
+
+		When("a full page of envelopes shares one timestamp", func() {
+			BeforeEach(func() {
+				var samePage []string
+				for i := 0; i < MaxReadLimit; i++ {
+					samePage = append(samePage, envelopeAt(100, fmt.Sprintf("message-%d", i)))
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(samePage...)),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=100&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(samePage...)),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "start_time=101&end_time=5000&envelope_types=LOG&limit=1000"),
+						RespondWith(http.StatusOK, batchOf(envelopesFrom(101, 1)...)),
+					),
+				)
+			})
+
+			It("moves past the timestamp instead of reading the same page forever", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(HaveLen(MaxReadLimit + 1))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package wrapper_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+	. "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/util/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retry Request", func() {
+	DescribeTable("number of retries",
+		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request := &logcache.Request{Request: req}
+
+			response := &logcache.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: responseStatusCode,
+				},
+			}
+
+			fakeConnection := new(logcachefakes.FakeConnection)
+			expectedErr := logcacheerror.RawHTTPStatusError{
+				StatusCode: responseStatusCode,
+			}
+			fakeConnection.MakeReturns(expectedErr)
+
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+			err = wrapper.Make(request, response)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Get (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
+		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+	)
+
+	It("retries when the connection was reset", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection := new(logcachefakes.FakeConnection)
+		expectedErr := ccerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: io.EOF}}
+		fakeConnection.MakeReturns(expectedErr)
+
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+		err = wrapper.Make(&logcache.Request{Request: req}, &logcache.Response{})
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+	})
+
+	It("does not retry on success", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection := new(logcachefakes.FakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+
+		err = wrapper.Make(&logcache.Request{Request: req}, &logcache.Response{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,117 @@
+package wrapper
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+//go:generate counterfeiter . UAAClient
+
+const accessTokenExpirationMargin = time.Minute
+
+// UAAClient is the interface for getting a valid access token
+type UAAClient interface {
+	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+}
+
+//go:generate counterfeiter . TokenCache
+
+// TokenCache is where the UAA token information is stored. SyncTokens calls
+// refresh with the tokens stored by any other process sharing the cache, and
+// stores the tokens refresh sets before other processes can read them.
+type TokenCache interface {
+	AccessToken() string
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
+}
+
+// UAAAuthentication wraps connections and adds authentication headers to all
+// requests
+type UAAAuthentication struct {
+	connection logcache.Connection
+	client     UAAClient
+	cache      TokenCache
+
+	// refreshLock prevents concurrent requests, such as pages of a paginated
+	// read, from refreshing the same token more than once.
+	refreshLock sync.Mutex
+}
+
+// NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
+// the client and a token cache.
+func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+	return &UAAAuthentication{
+		client: client,
+		cache:  cache,
+	}
+}
+
+// Make adds authentication headers to the passed in request and then calls the
+// wrapped connection's Make. If the client is not set on the wrapper, it will
+// not add any header or handle any authentication errors.
+func (t *UAAAuthentication) Make(request *logcache.Request, passedResponse *logcache.Response) error {
+	if t.client == nil {
+		return t.connection.Make(request, passedResponse)
+	}
+
+	if t.cache.AccessToken() != "" || t.cache.RefreshToken() != "" {
+		// assert a valid access token for authenticated requests
+		err := t.refreshToken()
+		if err != nil {
+			return err
+		}
+		request.Header.Set("Authorization", t.cache.AccessToken())
+	}
+
+	return t.connection.Make(request, passedResponse)
+}
+
+// SetClient sets the UAA client that the wrapper will use.
+func (t *UAAAuthentication) SetClient(client UAAClient) {
+	t.client = client
+}
+
+// Wrap sets the connection on the UAAAuthentication and returns itself
+func (t *UAAAuthentication) Wrap(innerconnection logcache.Connection) logcache.Connection {
+	t.connection = innerconnection
+	return t
+}
+
+// refreshToken refreshes the JWT access token if it is expired or about to
+// expire, through SyncTokens so that the token another cf process has already
+// refreshed is used instead.
+func (t *UAAAuthentication) refreshToken() error {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	if !accessTokenExpiring(t.cache.AccessToken()) {
+		return nil
+	}
+
+	return uaa.RefreshSyncedTokens(t.client, t.cache, accessTokenExpiring)
+}
+
+// accessTokenExpiring returns true if the access token cannot be parsed, or
+// expires within accessTokenExpirationMargin.
+func accessTokenExpiring(accessToken string) bool {
+	tokenStr := strings.TrimPrefix(accessToken, "bearer ")
+	token, err := jws.ParseJWT([]byte(tokenStr))
+	if err != nil {
+		return true
+	}
+
+	var expiresIn time.Duration
+	expiration, ok := token.Claims().Expiration()
+	if ok {
+		expiresIn = time.Until(expiration)
+	}
+	return expiresIn < accessTokenExpirationMargin
+}
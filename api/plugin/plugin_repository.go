@@ -11,10 +11,17 @@ type PluginRepository struct {
 	Plugins []Plugin `json:"plugins"`
 }
 
+// PluginBinary is a platform specific build of a plugin. Checksum is the
+// SHA-1 of the binary; SHA256 and SHA512 are optional stronger checksums.
+// Signature is an optional base64 encoded ed25519 detached signature of the
+// binary.
 type PluginBinary struct {
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
-	Checksum string `json:"checksum"`
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Checksum  string `json:"checksum"`
+	SHA256    string `json:"sha256,omitempty"`
+	SHA512    string `json:"sha512,omitempty"`
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -0,0 +1,61 @@
+package wrapper
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/util/retry"
+)
+
+// RetryRequest is a wrapper that retries failed requests according to a
+// retry.Policy.
+type RetryRequest struct {
+	policy     retry.Policy
+	connection router.Connection
+}
+
+// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+func NewRetryRequest(policy retry.Policy) *RetryRequest {
+	return &RetryRequest{
+		policy: policy,
+	}
+}
+
+// Make retries the request, waiting between attempts, for as long as the
+// policy allows it.
+func (retryRequest *RetryRequest) Make(request *router.Request, passedResponse *router.Response) error {
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = retryRequest.connection.Make(request, passedResponse)
+		if err == nil {
+			return nil
+		}
+
+		requestErr := err
+		if e, ok := err.(ccerror.RequestError); ok {
+			requestErr = e.Err
+		}
+
+		delay, shouldRetry := retryRequest.policy.Delay(attempt, request.Method, passedResponse.HTTPResponse, requestErr)
+		if !shouldRetry {
+			break
+		}
+
+		// Reset the request body prior to the next retry
+		resetErr := request.ResetBody()
+		if resetErr != nil {
+			return resetErr
+		}
+
+		time.Sleep(delay)
+	}
+	return err
+}
+
+// Wrap sets the connection in the RetryRequest and returns itself.
+func (retryRequest *RetryRequest) Wrap(innerconnection router.Connection) router.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
+}
@@ -15,12 +15,15 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SyncTokens calls
+// refresh with the tokens stored by any other process sharing the cache, and
+// stores the tokens refresh sets before other processes can read them.
 type TokenCache interface {
 	AccessToken() string
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -52,14 +55,15 @@ func (t *UAAAuthentication) Make(request *router.Request, passedResponse *router
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(routererror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		// Another cf process may have refreshed the rejected token already.
+		rejectedToken := request.Header.Get("Authorization")
+		err := uaa.RefreshSyncedTokens(t.client, t.cache, func(accessToken string) bool {
+			return accessToken == rejectedToken
+		})
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
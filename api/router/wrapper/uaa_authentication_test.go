@@ -168,6 +168,27 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
+
+			When("another process has already refreshed the token", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *router.Request, response *router.Response) error {
+						if fakeConnection.MakeCallCount() == 1 {
+							inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+							return routererror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with the other process's token without refreshing", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+					requestArg, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(requestArg.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+				})
+			})
 		})
 	})
 })
@@ -38,6 +38,17 @@ type FakeTokenCache struct {
 	setRefreshTokenArgsForCall []struct {
 		arg1 string
 	}
+	SyncTokensStub        func(func() error) error
+	syncTokensMutex       sync.RWMutex
+	syncTokensArgsForCall []struct {
+		arg1 func() error
+	}
+	syncTokensReturns struct {
+		result1 error
+	}
+	syncTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -208,6 +219,66 @@ func (fake *FakeTokenCache) SetRefreshTokenArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTokenCache) SyncTokens(arg1 func() error) error {
+	fake.syncTokensMutex.Lock()
+	ret, specificReturn := fake.syncTokensReturnsOnCall[len(fake.syncTokensArgsForCall)]
+	fake.syncTokensArgsForCall = append(fake.syncTokensArgsForCall, struct {
+		arg1 func() error
+	}{arg1})
+	fake.recordInvocation("SyncTokens", []interface{}{arg1})
+	fake.syncTokensMutex.Unlock()
+	if fake.SyncTokensStub != nil {
+		return fake.SyncTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.syncTokensReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTokenCache) SyncTokensCallCount() int {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	return len(fake.syncTokensArgsForCall)
+}
+
+func (fake *FakeTokenCache) SyncTokensCalls(stub func(func() error) error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = stub
+}
+
+func (fake *FakeTokenCache) SyncTokensArgsForCall(i int) func() error {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	argsForCall := fake.syncTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTokenCache) SyncTokensReturns(result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	fake.syncTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTokenCache) SyncTokensReturnsOnCall(i int, result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	if fake.syncTokensReturnsOnCall == nil {
+		fake.syncTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTokenCache) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -219,6 +290,8 @@ func (fake *FakeTokenCache) Invocations() map[string][][]interface{} {
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,30 @@
+package uaa
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// AuthorizationCodeURL returns the URL a browser should visit to log in and
+// have an authorization code returned to the redirect URI. The code challenge
+// is the S256 PKCE challenge (RFC 7636) for the verifier that will later be
+// sent along with the code to Authenticate.
+func (client *Client) AuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query: url.Values{
+			"client_id":             {client.config.UAAOAuthClient()},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+			"redirect_uri":          {redirectURI},
+			"response_type":         {"code"},
+			"state":                 {state},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
@@ -0,0 +1,44 @@
+package uaa_test
+
+import (
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuthorizationCode", func() {
+	var (
+		client *Client
+
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("AuthorizationCodeURL", func() {
+		It("returns the authorize URL of the login server with a PKCE challenge", func() {
+			authorizeURL, err := client.AuthorizationCodeURL("http://127.0.0.1:8080/callback", "some-code-challenge", "some-state")
+			Expect(err).ToNot(HaveOccurred())
+
+			parsedURL, err := url.Parse(authorizeURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedURL.Host).To(Equal(TestAuthorizationResource))
+			Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+			Expect(parsedURL.Query()).To(Equal(url.Values{
+				"client_id":             {"client-id"},
+				"code_challenge":        {"some-code-challenge"},
+				"code_challenge_method": {"S256"},
+				"redirect_uri":          {"http://127.0.0.1:8080/callback"},
+				"response_type":         {"code"},
+				"state":                 {"some-state"},
+			}))
+		})
+	})
+})
@@ -21,6 +21,8 @@ type Client struct {
 	connection Connection
 	router     *internal.Router
 	userAgent  string
+
+	clock Clock
 }
 
 // NewClient returns a new UAA Client with the provided configuration
@@ -34,6 +36,7 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
+		clock:  new(internal.RealTime),
 		config: config,
 
 		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
@@ -43,3 +46,11 @@ func NewClient(config Config) *Client {
 
 	return &client
 }
+
+// TestClient returns a new client explicitly meant for internal testing. This
+// should not be used for production code.
+func TestClient(config Config, clock Clock) *Client {
+	client := NewClient(config)
+	client.clock = clock
+	return client
+}
@@ -0,0 +1,11 @@
+package uaa
+
+import "time"
+
+//go:generate counterfeiter . Clock
+
+// Clock is the source of time for polling.
+type Clock interface {
+	Now() time.Time
+	Sleep(duration time.Duration)
+}
@@ -16,6 +16,10 @@ type Config interface {
 	// infinite.
 	DialTimeout() time.Duration
 
+	// OverallPollingTimeout is the longest the client will poll for the
+	// outcome of an asynchronous operation, such as a device authorization.
+	OverallPollingTimeout() time.Duration
+
 	// SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 	// <AuthorizationEndpoint>/login.
 	SetUAAEndpoint(uaaEndpoint string)
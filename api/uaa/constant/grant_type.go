@@ -4,6 +4,12 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for exchanging the code returned to a
+	// browser login's redirect URI.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
+	// GrantTypeDeviceCode is used for polling for the tokens of a device
+	// authorization (RFC 8628) once the user has approved it elsewhere.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
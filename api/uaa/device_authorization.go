@@ -0,0 +1,130 @@
+package uaa
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+const (
+	// defaultDeviceAuthorizationInterval is how long to wait between polls
+	// when the server does not say (RFC 8628 section 3.2).
+	defaultDeviceAuthorizationInterval = 5 * time.Second
+
+	// deviceAuthorizationSlowDown is how much longer to wait between polls
+	// after each slow_down response (RFC 8628 section 3.5).
+	deviceAuthorizationSlowDown = 5 * time.Second
+)
+
+// DeviceAuthorization is a pending login that the user approves by visiting
+// the verification URI on any device and entering the user code.
+type DeviceAuthorization struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresIn               time.Duration
+	Interval                time.Duration
+}
+
+// UnmarshalJSON helps unmarshal a UAA device authorization response.
+func (authorization *DeviceAuthorization) UnmarshalJSON(data []byte) error {
+	var uaaDeviceAuthorization struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete"`
+		ExpiresIn               int    `json:"expires_in"`
+		Interval                int    `json:"interval"`
+	}
+	err := json.Unmarshal(data, &uaaDeviceAuthorization)
+	if err != nil {
+		return err
+	}
+
+	authorization.DeviceCode = uaaDeviceAuthorization.DeviceCode
+	authorization.UserCode = uaaDeviceAuthorization.UserCode
+	authorization.VerificationURI = uaaDeviceAuthorization.VerificationURI
+	authorization.VerificationURIComplete = uaaDeviceAuthorization.VerificationURIComplete
+	authorization.ExpiresIn = time.Duration(uaaDeviceAuthorization.ExpiresIn) * time.Second
+	authorization.Interval = time.Duration(uaaDeviceAuthorization.Interval) * time.Second
+	if authorization.Interval <= 0 {
+		authorization.Interval = defaultDeviceAuthorizationInterval
+	}
+
+	return nil
+}
+
+// RequestDeviceAuthorization starts a device authorization (RFC 8628) for
+// the configured client.
+func (client *Client) RequestDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
+
+// PollDeviceAuthorization polls the token endpoint until the user approves
+// or denies the device authorization, it expires, or
+// config.OverallPollingTimeout is reached. In the last case, a
+// DeviceAuthorizationTimeoutError is returned. On approval it returns an
+// access token and a refresh token.
+func (client *Client) PollDeviceAuthorization(authorization DeviceAuthorization) (string, string, error) {
+	timeout := client.config.OverallPollingTimeout()
+	expires := authorization.ExpiresIn > 0 && authorization.ExpiresIn <= timeout
+	if expires {
+		timeout = authorization.ExpiresIn
+	}
+
+	credentials := map[string]string{
+		"client_id":   client.config.UAAOAuthClient(),
+		"device_code": authorization.DeviceCode,
+	}
+	interval := authorization.Interval
+
+	startTime := client.clock.Now()
+	for client.clock.Now().Sub(startTime) < timeout {
+		client.clock.Sleep(interval)
+
+		accessToken, refreshToken, err := client.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return accessToken, refreshToken, nil
+		case AuthorizationPendingError:
+		case SlowDownError:
+			interval += deviceAuthorizationSlowDown
+		default:
+			return "", "", err
+		}
+	}
+
+	if expires {
+		return "", "", ExpiredTokenError{}
+	}
+	return "", "", DeviceAuthorizationTimeoutError{Timeout: timeout}
+}
@@ -83,6 +83,50 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("authorization pending", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"authorization_pending","error_description":"The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AuthorizationPendingError", func() {
+						Expect(makeErr).To(MatchError(AuthorizationPendingError{}))
+					})
+				})
+
+				Context("slow down", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"slow_down","error_description":"The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a SlowDownError", func() {
+						Expect(makeErr).To(MatchError(SlowDownError{}))
+					})
+				})
+
+				Context("access denied", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"access_denied","error_description":"The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AccessDeniedError", func() {
+						Expect(makeErr).To(MatchError(AccessDeniedError{Message: "The user denied the request"}))
+					})
+				})
+
+				Context("expired token", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"expired_token","error_description":"The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an ExpiredTokenError", func() {
+						Expect(makeErr).To(MatchError(ExpiredTokenError{}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
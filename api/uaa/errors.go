@@ -1,6 +1,9 @@
 package uaa
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // RawHTTPStatusError represents any response with a 4xx or 5xx status code.
 type RawHTTPStatusError struct {
@@ -96,3 +99,49 @@ type AccountLockedError struct {
 func (e AccountLockedError) Error() string {
 	return ""
 }
+
+// AuthorizationPendingError is returned when polling for the tokens of a
+// device authorization the user has not yet approved.
+type AuthorizationPendingError struct{}
+
+func (AuthorizationPendingError) Error() string {
+	return "The device authorization is pending approval."
+}
+
+// SlowDownError is returned when a device authorization is polled more often
+// than the server allows.
+type SlowDownError struct{}
+
+func (SlowDownError) Error() string {
+	return "The device authorization was polled too often."
+}
+
+// AccessDeniedError is returned when the user denies a device authorization.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	if e.Message == "" {
+		return "The device login was denied."
+	}
+	return e.Message
+}
+
+// ExpiredTokenError is returned when a device authorization expires before
+// the user approves it.
+type ExpiredTokenError struct{}
+
+func (ExpiredTokenError) Error() string {
+	return "The device code expired before the login was completed."
+}
+
+// DeviceAuthorizationTimeoutError is returned when a device authorization is
+// not approved before the overall polling timeout.
+type DeviceAuthorizationTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e DeviceAuthorizationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for the device login to be completed.", e.Timeout)
+}
@@ -0,0 +1,13 @@
+package internal
+
+import "time"
+
+type RealTime struct{}
+
+func (RealTime) Now() time.Time {
+	return time.Now()
+}
+
+func (RealTime) Sleep(duration time.Duration) {
+	time.Sleep(duration)
+}
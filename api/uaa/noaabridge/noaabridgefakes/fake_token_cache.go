@@ -8,6 +8,16 @@ import (
 )
 
 type FakeTokenCache struct {
+	AccessTokenStub        func() string
+	accessTokenMutex       sync.RWMutex
+	accessTokenArgsForCall []struct {
+	}
+	accessTokenReturns struct {
+		result1 string
+	}
+	accessTokenReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -28,10 +38,73 @@ type FakeTokenCache struct {
 	setRefreshTokenArgsForCall []struct {
 		arg1 string
 	}
+	SyncTokensStub        func(func() error) error
+	syncTokensMutex       sync.RWMutex
+	syncTokensArgsForCall []struct {
+		arg1 func() error
+	}
+	syncTokensReturns struct {
+		result1 error
+	}
+	syncTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTokenCache) AccessToken() string {
+	fake.accessTokenMutex.Lock()
+	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
+	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AccessToken", []interface{}{})
+	fake.accessTokenMutex.Unlock()
+	if fake.AccessTokenStub != nil {
+		return fake.AccessTokenStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.accessTokenReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTokenCache) AccessTokenCallCount() int {
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
+	return len(fake.accessTokenArgsForCall)
+}
+
+func (fake *FakeTokenCache) AccessTokenCalls(stub func() string) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = stub
+}
+
+func (fake *FakeTokenCache) AccessTokenReturns(result1 string) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = nil
+	fake.accessTokenReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTokenCache) AccessTokenReturnsOnCall(i int, result1 string) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = nil
+	if fake.accessTokenReturnsOnCall == nil {
+		fake.accessTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.accessTokenReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeTokenCache) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -146,15 +219,79 @@ func (fake *FakeTokenCache) SetRefreshTokenArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTokenCache) SyncTokens(arg1 func() error) error {
+	fake.syncTokensMutex.Lock()
+	ret, specificReturn := fake.syncTokensReturnsOnCall[len(fake.syncTokensArgsForCall)]
+	fake.syncTokensArgsForCall = append(fake.syncTokensArgsForCall, struct {
+		arg1 func() error
+	}{arg1})
+	fake.recordInvocation("SyncTokens", []interface{}{arg1})
+	fake.syncTokensMutex.Unlock()
+	if fake.SyncTokensStub != nil {
+		return fake.SyncTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.syncTokensReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTokenCache) SyncTokensCallCount() int {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	return len(fake.syncTokensArgsForCall)
+}
+
+func (fake *FakeTokenCache) SyncTokensCalls(stub func(func() error) error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = stub
+}
+
+func (fake *FakeTokenCache) SyncTokensArgsForCall(i int) func() error {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	argsForCall := fake.syncTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTokenCache) SyncTokensReturns(result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	fake.syncTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTokenCache) SyncTokensReturnsOnCall(i int, result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	if fake.syncTokensReturnsOnCall == nil {
+		fake.syncTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTokenCache) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
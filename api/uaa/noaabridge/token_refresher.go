@@ -13,11 +13,15 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SyncTokens calls
+// refresh with the tokens stored by any other process sharing the cache, and
+// stores the tokens refresh sets before other processes can read them.
 type TokenCache interface {
+	AccessToken() string
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
 }
 
 // TokenRefresher implements the TokenRefresher interface. It requires a UAA
@@ -37,14 +41,17 @@ func NewTokenRefresher(uaaClient UAAClient, cache TokenCache) *TokenRefresher {
 
 // RefreshAuthToken refreshes the current Authorization Token and stores the
 // Access and Refresh token in it's cache. The returned Authorization Token
-// includes the type prefixed by a space.
+// includes the type prefixed by a space. When another cf process has already
+// refreshed the current Authorization Token, its new token is returned
+// instead.
 func (t *TokenRefresher) RefreshAuthToken() (string, error) {
-	tokens, err := t.uaaClient.RefreshAccessToken(t.cache.RefreshToken())
+	rejectedToken := t.cache.AccessToken()
+	err := uaa.RefreshSyncedTokens(t.uaaClient, t.cache, func(accessToken string) bool {
+		return accessToken == rejectedToken
+	})
 	if err != nil {
 		return "", err
 	}
 
-	t.cache.SetAccessToken(tokens.AuthorizationToken())
-	t.cache.SetRefreshToken(tokens.RefreshToken)
-	return tokens.AuthorizationToken(), nil
+	return t.cache.AccessToken(), nil
 }
@@ -16,12 +16,24 @@ var _ = Describe("TokenRefresher", func() {
 			fakeUAAClient  *noaabridgefakes.FakeUAAClient
 			fakeTokenCache *noaabridgefakes.FakeTokenCache
 			tokenRefresher *TokenRefresher
+			accessToken    string
 		)
 
 		BeforeEach(func() {
 			fakeUAAClient = new(noaabridgefakes.FakeUAAClient)
 			fakeTokenCache = new(noaabridgefakes.FakeTokenCache)
 			tokenRefresher = NewTokenRefresher(fakeUAAClient, fakeTokenCache)
+
+			accessToken = "bearer old-access-token"
+			fakeTokenCache.AccessTokenStub = func() string {
+				return accessToken
+			}
+			fakeTokenCache.SetAccessTokenStub = func(token string) {
+				accessToken = token
+			}
+			fakeTokenCache.SyncTokensStub = func(refresh func() error) error {
+				return refresh()
+			}
 		})
 
 		When("UAA communication is successful", func() {
@@ -41,6 +53,7 @@ var _ = Describe("TokenRefresher", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(token).To(Equal("bearer some-access-token"))
 
+				Expect(fakeTokenCache.SyncTokensCallCount()).To(Equal(1))
 				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(1))
 				Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("old-refresh-token"))
 			})
@@ -56,6 +69,23 @@ var _ = Describe("TokenRefresher", func() {
 			})
 		})
 
+		When("another process has already refreshed the token", func() {
+			BeforeEach(func() {
+				fakeTokenCache.SyncTokensStub = func(refresh func() error) error {
+					accessToken = "bearer other-access-token"
+					return refresh()
+				}
+			})
+
+			It("returns the token refreshed by the other process", func() {
+				token, err := tokenRefresher.RefreshAuthToken()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer other-access-token"))
+
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(0))
+			})
+		})
+
 		When("UAA communication returns an error", func() {
 			var expectedErr error
 
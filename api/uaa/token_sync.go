@@ -0,0 +1,43 @@
+package uaa
+
+//go:generate counterfeiter . AccessTokenRefresher
+
+// AccessTokenRefresher gets new tokens for a refresh token, as the Client
+// does.
+type AccessTokenRefresher interface {
+	RefreshAccessToken(refreshToken string) (RefreshedTokens, error)
+}
+
+//go:generate counterfeiter . SyncedTokenCache
+
+// SyncedTokenCache is a token cache shared with other cf processes. SyncTokens
+// calls refresh with the tokens last stored by any process sharing the cache,
+// and stores the tokens refresh sets before other processes can read them.
+type SyncedTokenCache interface {
+	AccessToken() string
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
+}
+
+// RefreshSyncedTokens refreshes the tokens in the cache through SyncTokens if
+// stale returns true for the access token SyncTokens reloaded. When another
+// cf process has already refreshed the tokens, its tokens are used instead of
+// spending the same refresh token again.
+func RefreshSyncedTokens(client AccessTokenRefresher, cache SyncedTokenCache, stale func(accessToken string) bool) error {
+	return cache.SyncTokens(func() error {
+		if !stale(cache.AccessToken()) {
+			return nil
+		}
+
+		tokens, err := client.RefreshAccessToken(cache.RefreshToken())
+		if err != nil {
+			return err
+		}
+
+		cache.SetAccessToken(tokens.AuthorizationToken())
+		cache.SetRefreshToken(tokens.RefreshToken)
+		return nil
+	})
+}
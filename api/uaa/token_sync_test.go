@@ -0,0 +1,107 @@
+package uaa_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RefreshSyncedTokens", func() {
+	var (
+		fakeClient *uaafakes.FakeAccessTokenRefresher
+		fakeCache  *uaafakes.FakeSyncedTokenCache
+
+		accessToken  string
+		staleToken   string
+		executeErr   error
+		staleChecked []string
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(uaafakes.FakeAccessTokenRefresher)
+		fakeCache = new(uaafakes.FakeSyncedTokenCache)
+
+		accessToken = "bearer old-access-token"
+		staleToken = "bearer old-access-token"
+		staleChecked = nil
+
+		fakeCache.AccessTokenStub = func() string {
+			return accessToken
+		}
+		fakeCache.RefreshTokenReturns("old-refresh-token")
+		fakeCache.SyncTokensStub = func(refresh func() error) error {
+			return refresh()
+		}
+
+		fakeClient.RefreshAccessTokenReturns(RefreshedTokens{
+			AccessToken:  "new-access-token",
+			RefreshToken: "new-refresh-token",
+			Type:         "bearer",
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = RefreshSyncedTokens(fakeClient, fakeCache, func(token string) bool {
+			staleChecked = append(staleChecked, token)
+			return token == staleToken
+		})
+	})
+
+	When("the synced access token is stale", func() {
+		It("refreshes and stores the tokens inside SyncTokens", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeCache.SyncTokensCallCount()).To(Equal(1))
+			Expect(staleChecked).To(ConsistOf("bearer old-access-token"))
+
+			Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+			Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("old-refresh-token"))
+
+			Expect(fakeCache.SetAccessTokenCallCount()).To(Equal(1))
+			Expect(fakeCache.SetAccessTokenArgsForCall(0)).To(Equal("bearer new-access-token"))
+			Expect(fakeCache.SetRefreshTokenCallCount()).To(Equal(1))
+			Expect(fakeCache.SetRefreshTokenArgsForCall(0)).To(Equal("new-refresh-token"))
+		})
+
+		When("refreshing fails", func() {
+			BeforeEach(func() {
+				fakeClient.RefreshAccessTokenReturns(RefreshedTokens{}, errors.New("refresh failed"))
+			})
+
+			It("returns the error without storing any tokens", func() {
+				Expect(executeErr).To(MatchError("refresh failed"))
+				Expect(fakeCache.SetAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeCache.SetRefreshTokenCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("another process has already refreshed the tokens", func() {
+		BeforeEach(func() {
+			fakeCache.SyncTokensStub = func(refresh func() error) error {
+				accessToken = "bearer refreshed-elsewhere"
+				return refresh()
+			}
+		})
+
+		It("keeps the other process's tokens", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(staleChecked).To(ConsistOf("bearer refreshed-elsewhere"))
+			Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+			Expect(fakeCache.SetAccessTokenCallCount()).To(Equal(0))
+		})
+	})
+
+	When("syncing the tokens fails", func() {
+		BeforeEach(func() {
+			fakeCache.SyncTokensReturns(errors.New("sync failed"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("sync failed"))
+		})
+	})
+})
@@ -18,12 +18,15 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SyncTokens calls
+// refresh with the tokens stored by any other process sharing the cache, and
+// stores the tokens refresh sets before other processes can read them.
 type TokenCache interface {
 	AccessToken() string
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	SyncTokens(refresh func() error) error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -71,14 +74,15 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		// Another cf process may have refreshed the rejected token already.
+		rejectedToken := request.Header.Get("Authorization")
+		refreshErr := uaa.RefreshSyncedTokens(t.client, t.cache, func(accessToken string) bool {
+			return accessToken == rejectedToken
+		})
 		if refreshErr != nil {
 			return refreshErr
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
@@ -105,9 +109,15 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	stringBody := string(body)
 
+	if strings.Contains(request.URL.String(), "/oauth/device_authorization") && request.Method == http.MethodPost {
+		return true
+	}
+
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
 			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=authorization_code") ||
+			strings.Contains(stringBody, "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code") ||
 			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
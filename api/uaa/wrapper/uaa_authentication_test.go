@@ -162,6 +162,34 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		When("the token is invalid but another process has already refreshed it", func() {
+			BeforeEach(func() {
+				request, err := http.NewRequest(http.MethodGet, server.URL(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					if fakeConnection.MakeCallCount() == 1 {
+						inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				inMemoryCache.SetAccessToken("what")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("resends the request with the other process's token without refreshing", func() {
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+			})
+		})
+
 		When("refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -232,6 +260,81 @@ var _ = Describe("UAA Authentication", func() {
 					Expect(err).ToNot(HaveOccurred())
 				})
 
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
+			Context("with authorization_code grant_type", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"grant_type": {"authorization_code"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
+			Context("with device_code grant_type", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"grant_type": {"urn:ietf:params:oauth:grant-type:device_code"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
+			Context("requesting a device authorization", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"client_id": {"cf"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/device_authorization", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
 				It("does not change the 'Authorization' header", func() {
 					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 
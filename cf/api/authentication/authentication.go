@@ -190,35 +190,52 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 }
 
 func (uaa UAARepository) RefreshToken(t string) (string, error) {
-	tokenStr := strings.TrimPrefix(t, "bearer ")
-	token, err := jws.ParseJWT([]byte(tokenStr))
+	expiring, err := accessTokenExpiring(t)
 	if err != nil {
 		return "", err
 	}
-	expiration, ok := token.Claims().Expiration()
-	if ok && expiration.Sub(time.Now()) > accessTokenExpirationMargin {
+	if !expiring {
 		return t, nil
 	}
 
-	data := url.Values{}
-
-	switch uaa.config.UAAGrantType() {
-	case "client_credentials":
-		data.Add("client_id", uaa.config.UAAOAuthClient())
-		data.Add("client_secret", uaa.config.UAAOAuthClientSecret())
-		data.Add("grant_type", "client_credentials")
-	case "", "password": // CLI used to leave field blank for password; preserve compatibility with old files
-		data.Add("grant_type", "refresh_token")
-		data.Add("refresh_token", uaa.config.RefreshToken())
-		data.Add("scope", "")
-	}
+	// Another cf process may have refreshed the token since this one read the
+	// config, in which case its token is used instead of spending the same
+	// refresh token again.
+	apiErr := uaa.config.SyncTokens(func() error {
+		if expiring, parseErr := accessTokenExpiring(uaa.config.AccessToken()); parseErr == nil && !expiring {
+			return nil
+		}
+
+		data := url.Values{}
+
+		switch uaa.config.UAAGrantType() {
+		case "client_credentials":
+			data.Add("client_id", uaa.config.UAAOAuthClient())
+			data.Add("client_secret", uaa.config.UAAOAuthClientSecret())
+			data.Add("grant_type", "client_credentials")
+		case "", "password", "authorization_code", "urn:ietf:params:oauth:grant-type:device_code": // CLI used to leave field blank for password; preserve compatibility with old files
+			data.Add("grant_type", "refresh_token")
+			data.Add("refresh_token", uaa.config.RefreshToken())
+			data.Add("scope", "")
+		}
 
-	apiErr := uaa.getAuthToken(data)
+		return uaa.getAuthToken(data)
+	})
 	updatedToken := uaa.config.AccessToken()
 
 	return updatedToken, apiErr
 }
 
+func accessTokenExpiring(t string) (bool, error) {
+	tokenStr := strings.TrimPrefix(t, "bearer ")
+	token, err := jws.ParseJWT([]byte(tokenStr))
+	if err != nil {
+		return false, err
+	}
+	expiration, ok := token.Claims().Expiration()
+	return !ok || expiration.Sub(time.Now()) <= accessTokenExpirationMargin, nil
+}
+
 func (uaa UAARepository) getAuthToken(data url.Values) error {
 	var accessToken string
 
@@ -267,6 +267,49 @@ var _ = Describe("AuthenticationRepository", func() {
 						Expect(accessToken).To(Equal(existingToken))
 					})
 				})
+
+				When("another cf process has already refreshed the access token", func() {
+					var refreshedToken string
+
+					BeforeEach(func() {
+						expiringAnHourFromNow := time.Now().Add(time.Hour)
+						t := testconfig.BuildTokenString(expiringAnHourFromNow)
+						refreshedToken = fmt.Sprintf("bearer %s", t)
+						auth = NewUAARepository(gateway, refreshedElsewhereConfig{ReadWriter: config, accessToken: refreshedToken}, dumper)
+					})
+
+					It("returns the other process's access token without refreshing", func() {
+						Expect(apiErr).ToNot(HaveOccurred())
+						Expect(handler.CallCount).To(Equal(0))
+						Expect(accessToken).To(Equal(refreshedToken))
+					})
+				})
+			})
+
+			Context("when the user is authenticated with authorization code grant", func() {
+				BeforeEach(func() {
+					config.SetUAAGrantType("authorization_code")
+					config.SetAccessToken(testAccessToken)
+					setupTestServer(successfulPasswordRefreshTokenRequest)
+				})
+
+				It("uses the refresh token to refresh the access token", func() {
+					Expect(apiErr).ToNot(HaveOccurred())
+					Expect(accessToken).To(Equal(fmt.Sprintf("BEARER %s", testAccessToken)))
+				})
+			})
+
+			Context("when the user is authenticated with device code grant", func() {
+				BeforeEach(func() {
+					config.SetUAAGrantType("urn:ietf:params:oauth:grant-type:device_code")
+					config.SetAccessToken(testAccessToken)
+					setupTestServer(successfulPasswordRefreshTokenRequest)
+				})
+
+				It("uses the refresh token to refresh the access token", func() {
+					Expect(apiErr).ToNot(HaveOccurred())
+					Expect(accessToken).To(Equal(fmt.Sprintf("BEARER %s", testAccessToken)))
+				})
 			})
 
 			Context("when the refresh token has expired", func() {
@@ -592,3 +635,15 @@ var uaaServerLoginRequest = testnet.TestRequest{
 }`,
 	},
 }
+
+// refreshedElsewhereConfig stores an access token when the tokens are synced,
+// as another cf process refreshing the token first would.
+type refreshedElsewhereConfig struct {
+	coreconfig.ReadWriter
+	accessToken string
+}
+
+func (config refreshedElsewhereConfig) SyncTokens(refresh func() error) error {
+	config.SetAccessToken(config.accessToken)
+	return config.ReadWriter.SyncTokens(refresh)
+}
@@ -3,6 +3,10 @@ package configuration
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 const (
@@ -16,6 +20,7 @@ type Persistor interface {
 	Delete()
 	Exists() bool
 	Load(DataInterface) error
+	Lock() (func(), error)
 	Save(DataInterface) error
 }
 
@@ -28,11 +33,27 @@ type DataInterface interface {
 
 type DiskPersistor struct {
 	filePath string
+
+	// loaded is the file as this process last read or wrote it, which Save
+	// compares against to find the settings this process changed.
+	loaded *[]byte
+
+	lock *configLock
+}
+
+// configLock counts the holders of the config lock in this process, so that
+// it is only taken once.
+type configLock struct {
+	mutex   sync.Mutex
+	holders int
+	unlock  func()
 }
 
 func NewDiskPersistor(path string) DiskPersistor {
 	return DiskPersistor{
 		filePath: path,
+		loaded:   new([]byte),
+		lock:     new(configLock),
 	}
 }
 
@@ -64,6 +85,34 @@ func (dp DiskPersistor) Save(data DataInterface) error {
 	return dp.write(data)
 }
 
+// Lock takes the same config lock as the configv3 config until the returned
+// function is called, so that other files in the config directory can be
+// changed along with the config file. Save does not wait for the lock while
+// this process holds it.
+func (dp DiskPersistor) Lock() (func(), error) {
+	dp.lock.mutex.Lock()
+	defer dp.lock.mutex.Unlock()
+
+	if dp.lock.holders == 0 {
+		unlock, err := configv3.LockConfigDirectory(filepath.Dir(dp.filePath))
+		if err != nil {
+			return nil, err
+		}
+		dp.lock.unlock = unlock
+	}
+	dp.lock.holders++
+
+	return func() {
+		dp.lock.mutex.Lock()
+		defer dp.lock.mutex.Unlock()
+
+		dp.lock.holders--
+		if dp.lock.holders == 0 {
+			dp.lock.unlock()
+		}
+	}, nil
+}
+
 func (dp DiskPersistor) read(data DataInterface) error {
 	err := dp.makeDirectory()
 	if err != nil {
@@ -76,15 +125,54 @@ func (dp DiskPersistor) read(data DataInterface) error {
 	}
 
 	err = data.JSONUnmarshalV3(jsonBytes)
-	return err
+	if err != nil {
+		return err
+	}
+
+	dp.setLoaded(jsonBytes)
+	return nil
 }
 
+// write saves the data while holding the same config lock as the configv3
+// config, so that cf processes sharing the directory do not overwrite each
+// other's changes. When the file has been read before, only the settings
+// changed since then are applied to the file on disk.
 func (dp DiskPersistor) write(data DataInterface) error {
 	bytes, err := data.JSONMarshalV3()
 	if err != nil {
 		return err
 	}
 
-	err = ioutil.WriteFile(dp.filePath, bytes, filePermissions)
-	return err
+	unlock, err := dp.Lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	fileBytes := bytes
+	if dp.loaded != nil && len(*dp.loaded) > 0 {
+		onDisk, readErr := ioutil.ReadFile(dp.filePath)
+		if readErr != nil && !os.IsNotExist(readErr) {
+			return readErr
+		}
+
+		fileBytes, err = mergeJSONChanges(onDisk, *dp.loaded, bytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ioutil.WriteFile(dp.filePath, fileBytes, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	dp.setLoaded(bytes)
+	return nil
+}
+
+func (dp DiskPersistor) setLoaded(bytes []byte) {
+	if dp.loaded != nil {
+		*dp.loaded = bytes
+	}
 }
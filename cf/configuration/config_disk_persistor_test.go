@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"time"
 
 	. "code.cloudfoundry.org/cli/cf/configuration"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -54,6 +57,90 @@ var _ = Describe("DiskPersistor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(string(dataBytes)).To(ContainSubstring(d.Info))
 		})
+
+		When("the file was loaded and then changed by another process", func() {
+			var d *data
+
+			BeforeEach(func() {
+				err := ioutil.WriteFile(tmpFile.Name(), []byte(`{"Info":"loaded info","Target":"loaded target"}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+
+				d = &data{}
+				Expect(diskPersistor.Load(d)).To(Succeed())
+
+				err = ioutil.WriteFile(tmpFile.Name(), []byte(`{"Info":"loaded info","Target":"other target"}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("writes only the settings changed by this process", func() {
+				d.Info = "new info"
+				Expect(diskPersistor.Save(d)).To(Succeed())
+
+				dataBytes, err := ioutil.ReadFile(tmpFile.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataBytes).To(MatchJSON(`{"Info":"new info","Target":"other target"}`))
+			})
+		})
+
+		When("another process holds the config lock", func() {
+			var unlock func()
+
+			BeforeEach(func() {
+				var err error
+				unlock, err = configv3.LockConfigDirectory(filepath.Dir(tmpFile.Name()))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("waits for the lock before writing", func() {
+				saved := make(chan struct{})
+				go func() {
+					defer GinkgoRecover()
+					Expect(diskPersistor.Save(&data{Info: "locked save"})).To(Succeed())
+					close(saved)
+				}()
+
+				Consistently(saved, 100*time.Millisecond).ShouldNot(BeClosed())
+				unlock()
+				Eventually(saved).Should(BeClosed())
+			})
+		})
+
+		When("this process holds the config lock", func() {
+			It("writes without waiting for the lock", func() {
+				unlock, err := diskPersistor.Lock()
+				Expect(err).ToNot(HaveOccurred())
+				defer unlock()
+
+				Expect(diskPersistor.Save(&data{Info: "locked save"})).To(Succeed())
+
+				dataBytes, err := ioutil.ReadFile(tmpFile.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(dataBytes)).To(ContainSubstring("locked save"))
+			})
+		})
+	})
+
+	Describe(".Lock", func() {
+		It("holds the config lock until every holder has unlocked", func() {
+			firstUnlock, err := diskPersistor.Lock()
+			Expect(err).ToNot(HaveOccurred())
+			secondUnlock, err := diskPersistor.Lock()
+			Expect(err).ToNot(HaveOccurred())
+
+			locked := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				unlock, lockErr := configv3.LockConfigDirectory(filepath.Dir(tmpFile.Name()))
+				Expect(lockErr).ToNot(HaveOccurred())
+				unlock()
+				close(locked)
+			}()
+
+			firstUnlock()
+			Consistently(locked, 100*time.Millisecond).ShouldNot(BeClosed())
+			secondUnlock()
+			Eventually(locked).Should(BeClosed())
+		})
 	})
 
 	Describe(".Load", func() {
@@ -79,7 +166,8 @@ var _ = Describe("DiskPersistor", func() {
 })
 
 type data struct {
-	Info string
+	Info   string
+	Target string `json:",omitempty"`
 }
 
 func (d *data) JSONMarshalV3() ([]byte, error) {
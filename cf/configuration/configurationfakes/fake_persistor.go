@@ -33,6 +33,18 @@ type FakePersistor struct {
 	loadReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LockStub        func() (func(), error)
+	lockMutex       sync.RWMutex
+	lockArgsForCall []struct {
+	}
+	lockReturns struct {
+		result1 func()
+		result2 error
+	}
+	lockReturnsOnCall map[int]struct {
+		result1 func()
+		result2 error
+	}
 	SaveStub        func(configuration.DataInterface) error
 	saveMutex       sync.RWMutex
 	saveArgsForCall []struct {
@@ -183,6 +195,61 @@ func (fake *FakePersistor) LoadReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePersistor) Lock() (func(), error) {
+	fake.lockMutex.Lock()
+	ret, specificReturn := fake.lockReturnsOnCall[len(fake.lockArgsForCall)]
+	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Lock", []interface{}{})
+	fake.lockMutex.Unlock()
+	if fake.LockStub != nil {
+		return fake.LockStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.lockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistor) LockCallCount() int {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return len(fake.lockArgsForCall)
+}
+
+func (fake *FakePersistor) LockCalls(stub func() (func(), error)) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = stub
+}
+
+func (fake *FakePersistor) LockReturns(result1 func(), result2 error) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = nil
+	fake.lockReturns = struct {
+		result1 func()
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistor) LockReturnsOnCall(i int, result1 func(), result2 error) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = nil
+	if fake.lockReturnsOnCall == nil {
+		fake.lockReturnsOnCall = make(map[int]struct {
+			result1 func()
+			result2 error
+		})
+	}
+	fake.lockReturnsOnCall[i] = struct {
+		result1 func()
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePersistor) Save(arg1 configuration.DataInterface) error {
 	fake.saveMutex.Lock()
 	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
@@ -252,6 +319,8 @@ func (fake *FakePersistor) Invocations() map[string][][]interface{} {
 	defer fake.existsMutex.RUnlock()
 	fake.loadMutex.RLock()
 	defer fake.loadMutex.RUnlock()
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
 	fake.saveMutex.RLock()
 	defer fake.saveMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
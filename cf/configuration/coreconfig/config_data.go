@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type AuthPromptType string
@@ -25,18 +26,26 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	CredentialHelper         string `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
 	OrganizationFields       models.OrganizationFields
 	PluginRepos              []models.PluginRepo
+	PluginRequireSigned      bool            `json:",omitempty"`
+	PluginTrustedKeys        json.RawMessage `json:",omitempty"`
 	RefreshToken             string
+	RequestRetryBackoff      string `json:",omitempty"`
+	RequestRetryCount        *int   `json:",omitempty"`
+	RequestRetryMaxBackoff   string `json:",omitempty"`
 	RoutingAPIEndpoint       string
 	SpaceFields              models.SpaceFields
 	SSHOAuthClient           string
 	SSLDisabled              bool
 	Target                   string
+	TokenStore               string `json:",omitempty"`
+	TokenStoreKeyFile        string `json:",omitempty"`
 	Trace                    string
 	UaaEndpoint              string
 	UAAGrantType             string
@@ -53,6 +62,7 @@ func NewData() *Data {
 	return data
 }
 
+// JSONMarshalV3 writes the config in the current config file format.
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
 	return json.MarshalIndent(d, "", "  ")
@@ -71,3 +81,28 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 
 	return nil
 }
+
+// profile returns the settings of the profile in use. The config file uses
+// the same names for them as the profiles file.
+func (d *Data) profile() (configv3.Profile, error) {
+	var profile configv3.Profile
+
+	rawData, err := json.Marshal(d)
+	if err != nil {
+		return profile, err
+	}
+
+	err = json.Unmarshal(rawData, &profile)
+	return profile, err
+}
+
+// setProfile replaces the settings of the profile in use with the given
+// profile's.
+func (d *Data) setProfile(profile configv3.Profile) error {
+	rawProfile, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(rawProfile, d)
+}
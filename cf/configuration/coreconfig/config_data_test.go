@@ -1,6 +1,8 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -54,7 +56,68 @@ var _ = Describe("V3 Config files", func() {
 		}
 		],
 		"MinCLIVersion": "6.0.0",
-		"MinRecommendedCLIVersion": "6.9.0"
+		"MinRecommendedCLIVersion": "6.9.0",
+		"RequestRetryCount": 5,
+		"RequestRetryBackoff": "1s",
+		"RequestRetryMaxBackoff": "1m"
+	}`
+
+	var examplePluginSigningJSON = `
+	{
+		"ConfigVersion": 3,
+		"Target": "api.example.com",
+		"APIVersion": "3",
+		"AuthorizationEndpoint": "auth.example.com",
+		"DopplerEndPoint": "doppler.example.com",
+		"UaaEndpoint": "uaa.example.com",
+		"RoutingAPIEndpoint": "routing-api.example.com",
+		"AccessToken": "the-access-token",
+		"UAAGrantType": "",
+		"UAAOAuthClient": "cf-oauth-client-id",
+		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"SSHOAuthClient": "ssh-oauth-client-id",
+		"RefreshToken": "the-refresh-token",
+		"OrganizationFields": {
+			"GUID": "the-org-guid",
+			"Name": "the-org",
+			"QuotaDefinition": {
+				"name":"",
+				"memory_limit":0,
+				"instance_memory_limit":0,
+				"total_routes":0,
+				"total_services":0,
+				"non_basic_services_allowed": false,
+				"app_instance_limit":0
+			}
+		},
+		"SpaceFields": {
+			"GUID": "the-space-guid",
+			"Name": "the-space",
+			"AllowSSH": false
+		},
+		"SSLDisabled": true,
+		"AsyncTimeout": 1000,
+		"Trace": "path/to/some/file",
+		"ColorEnabled": "true",
+		"Locale": "fr_FR",
+		"PluginRepos": [
+		{
+			"Name": "repo1",
+			"URL": "http://repo.com"
+		}
+		],
+		"MinCLIVersion": "6.0.0",
+		"MinRecommendedCLIVersion": "6.9.0",
+		"RequestRetryCount": 5,
+		"RequestRetryBackoff": "1s",
+		"RequestRetryMaxBackoff": "1m",
+		"PluginRequireSigned": true,
+		"PluginTrustedKeys": [
+			{
+				"Name": "some-publisher",
+				"PublicKey": "some-public-key"
+			}
+		]
 	}`
 
 	// V2 by virtue of ConfigVersion only
@@ -115,6 +178,7 @@ var _ = Describe("V3 Config files", func() {
 
 	Describe("JSONMarshalV3", func() {
 		It("creates a JSON string from the config object", func() {
+			retryCount := 5
 			data := &coreconfig.Data{
 				Target:                   "api.example.com",
 				APIVersion:               "3",
@@ -130,6 +194,9 @@ var _ = Describe("V3 Config files", func() {
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
+				RequestRetryCount:        &retryCount,
+				RequestRetryBackoff:      "1s",
+				RequestRetryMaxBackoff:   "1m",
 				OrganizationFields: models.OrganizationFields{
 					GUID: "the-org-guid",
 					Name: "the-org",
@@ -149,12 +216,14 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				PluginRequireSigned: true,
+				PluginTrustedKeys:   json.RawMessage(`[{"Name": "some-publisher", "PublicKey": "some-public-key"}]`),
 			}
 
 			jsonData, err := data.JSONMarshalV3()
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(jsonData).To(MatchJSON(exampleV3JSON))
+			Expect(jsonData).To(MatchJSON(examplePluginSigningJSON))
 		})
 	})
 
@@ -166,6 +235,7 @@ var _ = Describe("V3 Config files", func() {
 		})
 
 		It("creates a config object from valid V3 JSON", func() {
+			retryCount := 5
 			expectedData := &coreconfig.Data{
 				ConfigVersion:            3,
 				Target:                   "api.example.com",
@@ -181,6 +251,9 @@ var _ = Describe("V3 Config files", func() {
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
+				RequestRetryCount:        &retryCount,
+				RequestRetryBackoff:      "1s",
+				RequestRetryMaxBackoff:   "1m",
 				OrganizationFields: models.OrganizationFields{
 					GUID: "the-org-guid",
 					Name: "the-org",
@@ -209,7 +282,18 @@ var _ = Describe("V3 Config files", func() {
 			Expect(actualData).To(Equal(expectedData))
 		})
 
-		It("returns an empty Data object for non-V3 JSON", func() {
+		It("creates a config object from valid V3 JSON with plugin signing settings", func() {
+			actualData := coreconfig.NewData()
+			err := actualData.JSONUnmarshalV3([]byte(examplePluginSigningJSON))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualData.ConfigVersion).To(Equal(3))
+			Expect(actualData.Target).To(Equal("api.example.com"))
+			Expect(actualData.PluginRequireSigned).To(BeTrue())
+			Expect(actualData.PluginTrustedKeys).To(MatchJSON(`[{"Name": "some-publisher", "PublicKey": "some-public-key"}]`))
+		})
+
+		It("returns an empty Data object for V2 JSON", func() {
 			actualData := coreconfig.NewData()
 			err := actualData.JSONUnmarshalV3([]byte(exampleV2JSON))
 			Expect(err).NotTo(HaveOccurred())
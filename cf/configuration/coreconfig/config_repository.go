@@ -1,11 +1,14 @@
 package coreconfig
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -17,6 +20,16 @@ type ConfigRepository struct {
 	initOnce     *sync.Once
 	persistor    configuration.Persistor
 	onError      func(error)
+
+	// configDir holds the profiles file. It is empty when the config is not
+	// read from a file.
+	configDir        string
+	profile          string
+	persistedProfile *configv3.Profile
+	loadedProfile    configv3.Profile
+
+	tokenStore        configv3.TokenStore
+	storedCredentials configv3.Credentials
 }
 
 type CCInfo struct {
@@ -29,11 +42,14 @@ type CCInfo struct {
 	RoutingAPIEndpoint       string `json:"routing_endpoint"`
 }
 
-func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Repository {
+func NewRepositoryFromFilepath(configPath string, errorHandler func(error)) Repository {
 	if errorHandler == nil {
 		return nil
 	}
-	return NewRepositoryFromPersistor(configuration.NewDiskPersistor(filepath), errorHandler)
+
+	repository := NewRepositoryFromPersistor(configuration.NewDiskPersistor(configPath), errorHandler).(*ConfigRepository)
+	repository.configDir = filepath.Dir(configPath)
+	return repository
 }
 
 func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler func(error)) Repository {
@@ -125,6 +141,7 @@ type ReadWriter interface {
 	SetUAAGrantType(string)
 	SetUAAOAuthClient(string)
 	SetUAAOAuthClientSecret(string)
+	SyncTokens(refresh func() error) error
 	UAAGrantType() string
 	UnSetPluginRepo(int)
 }
@@ -141,6 +158,12 @@ type Repository interface {
 func (c *ConfigRepository) init() {
 	c.initOnce.Do(func() {
 		err := c.persistor.Load(c.data)
+		if err == nil {
+			err = c.loadProfile()
+		}
+		if err == nil {
+			err = c.loadCredentials()
+		}
 		if err != nil {
 			c.onError(err)
 		}
@@ -162,12 +185,244 @@ func (c *ConfigRepository) write(cb func()) {
 
 	cb()
 
-	err := c.persistor.Save(c.data)
+	err := c.save()
 	if err != nil {
 		c.onError(err)
 	}
 }
 
+// SyncTokens calls refresh while holding the config lock, after reloading the
+// config so that it has the tokens last saved by any cf process. The tokens
+// refresh sets are saved before the lock is released, so cf processes sharing
+// the config that find the same expired token refresh it once, as configv3's
+// SyncTokens does.
+func (c *ConfigRepository) SyncTokens(refresh func() error) error {
+	unlock, err := c.persistor.Lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = c.reload()
+	if err != nil {
+		return err
+	}
+
+	return refresh()
+}
+
+// reload replaces the config with the one on disk. Every change is saved as it
+// is made, so this only picks up the changes of other cf processes.
+func (c *ConfigRepository) reload() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.init()
+
+	if !c.persistor.Exists() {
+		return nil
+	}
+
+	data := NewData()
+	err := c.persistor.Load(data)
+	if err != nil {
+		return err
+	}
+
+	c.data = data
+	c.persistedProfile = nil
+	c.loadedProfile = configv3.Profile{}
+	c.tokenStore = nil
+	c.storedCredentials = configv3.Credentials{}
+
+	err = c.loadProfile()
+	if err != nil {
+		return err
+	}
+	return c.loadCredentials()
+}
+
+// loadProfile reads the name of the profile in use from the profiles file.
+// When $CF_PROFILE names another profile, its settings are used instead of
+// the ones in the config file, which keeps the settings of the profile it was
+// written with.
+func (c *ConfigRepository) loadProfile() error {
+	c.profile = configv3.DefaultProfileName
+	if c.configDir == "" {
+		return nil
+	}
+
+	profilesFile, err := configv3.ReadProfilesFile(c.configDir)
+	if err != nil {
+		return err
+	}
+	if profilesFile.CurrentProfile != "" {
+		c.profile = profilesFile.CurrentProfile
+	}
+
+	name := os.Getenv("CF_PROFILE")
+	if name == "" || name == c.profile {
+		return nil
+	}
+
+	profile, exists := profilesFile.Profiles[name]
+	if !exists {
+		return configv3.ProfileNotFoundError{Name: name}
+	}
+
+	persistedProfile, err := c.data.profile()
+	if err != nil {
+		return err
+	}
+
+	c.profile = name
+	c.persistedProfile = &persistedProfile
+	c.loadedProfile = profile
+	return c.data.setProfile(profile)
+}
+
+// loadCredentials reads the tokens of the profile in use from the token
+// store, if one is configured. Tokens still in the config file are kept when
+// the store has none, so that they are moved into the store on the next save.
+func (c *ConfigRepository) loadCredentials() error {
+	store, err := configv3.NewTokenStore(c.tokenStoreSettings())
+	if err != nil || store == nil {
+		return err
+	}
+
+	credentials, err := store.Get(c.profile)
+	if err != nil {
+		return err
+	}
+	if !credentials.IsEmpty() {
+		c.setCredentials(credentials)
+	}
+
+	c.tokenStore = store
+	c.storedCredentials = credentials
+	return nil
+}
+
+// save writes the config file, and the profile in use to the profiles file
+// when $CF_PROFILE selected it. When a token store is configured, the tokens
+// are written to it instead of the config file. Everything is written while
+// holding the config lock, as configv3's WriteConfig does.
+func (c *ConfigRepository) save() error {
+	unlock, err := c.persistor.Lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if c.tokenStore != nil {
+		credentials := c.credentials()
+		if credentials != c.storedCredentials {
+			if credentials.IsEmpty() {
+				err = c.tokenStore.Erase(c.profile)
+			} else {
+				err = c.tokenStore.Store(c.profile, credentials)
+			}
+			if err != nil {
+				return err
+			}
+			c.storedCredentials = credentials
+		}
+
+		c.setCredentials(configv3.Credentials{})
+		defer c.setCredentials(credentials)
+	}
+
+	err = c.saveProfile()
+	if err != nil {
+		return err
+	}
+
+	fileData, err := c.fileData()
+	if err != nil {
+		return err
+	}
+	return c.persistor.Save(fileData)
+}
+
+// fileData returns the data to write to the config file, which keeps the
+// settings of the profile it was written with when $CF_PROFILE selected
+// another one.
+func (c *ConfigRepository) fileData() (*Data, error) {
+	if c.persistedProfile == nil {
+		return c.data, nil
+	}
+
+	data := *c.data
+	err := data.setProfile(*c.persistedProfile)
+	return &data, err
+}
+
+// saveProfile writes the settings of the profile selected by $CF_PROFILE to
+// the profiles file, if they changed. The config lock must be held.
+func (c *ConfigRepository) saveProfile() error {
+	if c.persistedProfile == nil {
+		return nil
+	}
+
+	profile, err := c.data.profile()
+	if err != nil || profile == c.loadedProfile {
+		return err
+	}
+
+	profilesFile, err := configv3.ReadProfilesFile(c.configDir)
+	if err != nil {
+		return err
+	}
+	if profilesFile.Profiles == nil {
+		profilesFile.Profiles = map[string]configv3.Profile{}
+	}
+	profilesFile.Profiles[c.profile] = profile
+
+	err = configv3.WriteProfilesFile(c.configDir, profilesFile)
+	if err != nil {
+		return err
+	}
+
+	c.loadedProfile = profile
+	return nil
+}
+
+// tokenStoreSettings mirrors configv3's Config.TokenStoreSettings, which
+// prefers the environment variables over the config file.
+func (c *ConfigRepository) tokenStoreSettings() configv3.TokenStoreSettings {
+	settings := configv3.TokenStoreSettings{
+		Type:             c.data.TokenStore,
+		KeyFile:          c.data.TokenStoreKeyFile,
+		Passphrase:       os.Getenv("CF_TOKEN_STORE_PASSPHRASE"),
+		CredentialHelper: c.data.CredentialHelper,
+	}
+
+	if value := os.Getenv("CF_TOKEN_STORE"); value != "" {
+		settings.Type = value
+	}
+	if value := os.Getenv("CF_TOKEN_STORE_KEY_FILE"); value != "" {
+		settings.KeyFile = value
+	}
+	if value := os.Getenv("CF_CREDENTIAL_HELPER"); value != "" {
+		settings.CredentialHelper = value
+	}
+
+	return settings
+}
+
+func (c *ConfigRepository) credentials() configv3.Credentials {
+	return configv3.Credentials{
+		AccessToken:          c.data.AccessToken,
+		RefreshToken:         c.data.RefreshToken,
+		UAAOAuthClientSecret: c.data.UAAOAuthClientSecret,
+	}
+}
+
+func (c *ConfigRepository) setCredentials(credentials configv3.Credentials) {
+	c.data.AccessToken = credentials.AccessToken
+	c.data.RefreshToken = credentials.RefreshToken
+	c.data.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+}
+
 // CLOSERS
 
 func (c *ConfigRepository) Close() {
@@ -1,6 +1,7 @@
 package coreconfig_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 
@@ -32,9 +34,27 @@ var _ = Describe("Configuration Repository", func() {
 	BeforeEach(func() {
 		persistor = new(configurationfakes.FakePersistor)
 		persistor.ExistsReturns(true)
+		persistor.LockReturns(func() {}, nil)
 		config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
 	})
 
+	It("saves while holding the config lock", func() {
+		locked := false
+		persistor.LockStub = func() (func(), error) {
+			locked = true
+			return func() { locked = false }, nil
+		}
+		persistor.SaveStub = func(configuration.DataInterface) error {
+			Expect(locked).To(BeTrue())
+			return nil
+		}
+
+		config.SetAPIEndpoint("foo")
+
+		Expect(persistor.SaveCallCount()).To(Equal(1))
+		Expect(locked).To(BeFalse())
+	})
+
 	It("is threadsafe", func() {
 		performSaveCh := make(chan struct{})
 		beginSaveCh := make(chan struct{})
@@ -68,6 +88,60 @@ var _ = Describe("Configuration Repository", func() {
 		Eventually(finishReadCh).Should(BeClosed())
 	})
 
+	Describe("SyncTokens", func() {
+		var locked bool
+
+		BeforeEach(func() {
+			config.SetAccessToken("bearer stale-access-token")
+			config.SetRefreshToken("stale-refresh-token")
+
+			persistor.LockStub = func() (func(), error) {
+				locked = true
+				return func() { locked = false }, nil
+			}
+			persistor.LoadStub = func(data configuration.DataInterface) error {
+				data.(*coreconfig.Data).AccessToken = "bearer other-access-token"
+				data.(*coreconfig.Data).RefreshToken = "other-refresh-token"
+				return nil
+			}
+		})
+
+		It("calls refresh with the tokens on disk while holding the config lock", func() {
+			err := config.SyncTokens(func() error {
+				Expect(locked).To(BeTrue())
+				Expect(config.AccessToken()).To(Equal("bearer other-access-token"))
+				Expect(config.RefreshToken()).To(Equal("other-refresh-token"))
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(locked).To(BeFalse())
+		})
+
+		It("saves the tokens refresh sets before releasing the config lock", func() {
+			saveCount := persistor.SaveCallCount()
+			persistor.SaveStub = func(data configuration.DataInterface) error {
+				Expect(locked).To(BeTrue())
+				return nil
+			}
+
+			err := config.SyncTokens(func() error {
+				config.SetAccessToken("bearer new-access-token")
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(persistor.SaveCallCount()).To(Equal(saveCount + 1))
+			Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+		})
+
+		It("returns the error refresh returns", func() {
+			err := config.SyncTokens(func() error {
+				return errors.New("refresh failed")
+			})
+			Expect(err).To(MatchError("refresh failed"))
+		})
+	})
+
 	It("has acccessor methods for all config fields", func() {
 		config.SetAPIEndpoint("http://api.the-endpoint")
 		Expect(config.APIEndpoint()).To(Equal("http://api.the-endpoint"))
@@ -331,6 +405,154 @@ var _ = Describe("Configuration Repository", func() {
 			})
 		})
 
+		Context("when a token store is configured", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "test-config")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.Setenv("CF_HOME", tmpDir)).To(Succeed())
+				Expect(os.Setenv("CF_TOKEN_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+				configPath = filepath.Join(tmpDir, ".cf", "config.json")
+				Expect(os.MkdirAll(filepath.Dir(configPath), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(configPath, []byte(`{
+					"ConfigVersion": 3,
+					"TokenStore": "encrypted-file",
+					"AccessToken": "bearer some-access-token",
+					"RefreshToken": "some-refresh-token"
+				}`), 0600)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+				Expect(os.Unsetenv("CF_TOKEN_STORE_PASSPHRASE")).To(Succeed())
+				Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+			})
+
+			It("keeps the tokens in the token store instead of the config file", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+				config.SetRefreshToken("some-new-refresh-token")
+
+				rawConfig, err := ioutil.ReadFile(configPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(rawConfig)).To(ContainSubstring(`"AccessToken": ""`))
+				Expect(string(rawConfig)).To(ContainSubstring(`"RefreshToken": ""`))
+				Expect(string(rawConfig)).To(ContainSubstring(`"TokenStore": "encrypted-file"`))
+				Expect(config.AccessToken()).To(Equal("bearer some-access-token"))
+
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+				Expect(config.AccessToken()).To(Equal("bearer some-access-token"))
+				Expect(config.RefreshToken()).To(Equal("some-new-refresh-token"))
+			})
+		})
+
+		Context("when $CF_PROFILE is set", func() {
+			var (
+				tmpDir       string
+				profilesPath string
+			)
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "test-config")
+				Expect(err).NotTo(HaveOccurred())
+
+				configPath = filepath.Join(tmpDir, ".cf", "config.json")
+				profilesPath = filepath.Join(tmpDir, ".cf", configv3.ProfilesFileName)
+				Expect(os.MkdirAll(filepath.Dir(configPath), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(configPath, []byte(`{
+					"ConfigVersion": 3,
+					"Target": "https://api.default.com",
+					"AccessToken": "bearer default-access-token",
+					"SpaceFields": {"Name": "default-space"}
+				}`), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(profilesPath, []byte(`{
+					"CurrentProfile": "default",
+					"Profiles": {
+						"staging": {
+							"Target": "https://api.staging.com",
+							"AccessToken": "bearer staging-access-token",
+							"SpaceFields": {"Name": "staging-space"}
+						}
+					}
+				}`), 0600)).To(Succeed())
+
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+				Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+			})
+
+			It("uses the settings of the given profile", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+
+				Expect(config.APIEndpoint()).To(Equal("https://api.staging.com"))
+				Expect(config.AccessToken()).To(Equal("bearer staging-access-token"))
+				Expect(config.SpaceFields().Name).To(Equal("staging-space"))
+			})
+
+			It("writes changes to the given profile, keeping the profile in use", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+				config.SetSpaceFields(models.SpaceFields{Name: "other-staging-space"})
+				config.SetColorEnabled("false")
+
+				rawConfig, err := ioutil.ReadFile(configPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(rawConfig)).To(ContainSubstring(`"Target": "https://api.default.com"`))
+				Expect(string(rawConfig)).To(ContainSubstring(`"Name": "default-space"`))
+				Expect(string(rawConfig)).To(ContainSubstring(`"ColorEnabled": "false"`))
+
+				profilesFile, err := configv3.ReadProfilesFile(filepath.Dir(configPath))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(profilesFile.CurrentProfile).To(Equal("default"))
+				Expect(profilesFile.Profiles["staging"].Target).To(Equal("https://api.staging.com"))
+				Expect(profilesFile.Profiles["staging"].TargetedSpace.Name).To(Equal("other-staging-space"))
+			})
+
+			When("it names the profile in use", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_PROFILE", "default")).To(Succeed())
+				})
+
+				It("uses the settings in the config file", func() {
+					config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+						panic(err)
+					})
+
+					Expect(config.APIEndpoint()).To(Equal("https://api.default.com"))
+				})
+			})
+
+			When("the profile does not exist", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_PROFILE", "does-not-exist")).To(Succeed())
+				})
+
+				It("reports a ProfileNotFoundError", func() {
+					var loadErr error
+					config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+						loadErr = err
+					})
+					config.APIEndpoint()
+
+					Expect(loadErr).To(MatchError(configv3.ProfileNotFoundError{Name: "does-not-exist"}))
+				})
+			})
+		})
+
 		Context("when the configuration version is older than the current version", func() {
 			BeforeEach(func() {
 				cwd, err := os.Getwd()
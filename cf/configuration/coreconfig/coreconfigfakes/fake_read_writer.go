@@ -371,6 +371,17 @@ type FakeReadWriter struct {
 	spaceFieldsReturnsOnCall map[int]struct {
 		result1 models.SpaceFields
 	}
+	SyncTokensStub        func(func() error) error
+	syncTokensMutex       sync.RWMutex
+	syncTokensArgsForCall []struct {
+		arg1 func() error
+	}
+	syncTokensReturns struct {
+		result1 error
+	}
+	syncTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TraceStub        func() string
 	traceMutex       sync.RWMutex
 	traceArgsForCall []struct {
@@ -2460,6 +2471,66 @@ func (fake *FakeReadWriter) SpaceFieldsReturnsOnCall(i int, result1 models.Space
 	}{result1}
 }
 
+func (fake *FakeReadWriter) SyncTokens(arg1 func() error) error {
+	fake.syncTokensMutex.Lock()
+	ret, specificReturn := fake.syncTokensReturnsOnCall[len(fake.syncTokensArgsForCall)]
+	fake.syncTokensArgsForCall = append(fake.syncTokensArgsForCall, struct {
+		arg1 func() error
+	}{arg1})
+	fake.recordInvocation("SyncTokens", []interface{}{arg1})
+	fake.syncTokensMutex.Unlock()
+	if fake.SyncTokensStub != nil {
+		return fake.SyncTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.syncTokensReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReadWriter) SyncTokensCallCount() int {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	return len(fake.syncTokensArgsForCall)
+}
+
+func (fake *FakeReadWriter) SyncTokensCalls(stub func(func() error) error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = stub
+}
+
+func (fake *FakeReadWriter) SyncTokensArgsForCall(i int) func() error {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	argsForCall := fake.syncTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReadWriter) SyncTokensReturns(result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	fake.syncTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReadWriter) SyncTokensReturnsOnCall(i int, result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	if fake.syncTokensReturnsOnCall == nil {
+		fake.syncTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReadWriter) Trace() string {
 	fake.traceMutex.Lock()
 	ret, specificReturn := fake.traceReturnsOnCall[len(fake.traceArgsForCall)]
@@ -3006,6 +3077,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setUaaEndpointMutex.RUnlock()
 	fake.spaceFieldsMutex.RLock()
 	defer fake.spaceFieldsMutex.RUnlock()
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
 	fake.traceMutex.RLock()
 	defer fake.traceMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
@@ -0,0 +1,68 @@
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// mergeJSONChanges applies the values that changed from loaded to current to
+// the JSON object onDisk, leaving the values changed by other processes in
+// place. Objects are merged key by key; everything else is replaced as a
+// whole. current is returned unchanged when either onDisk or loaded is not a
+// JSON object.
+func mergeJSONChanges(onDisk []byte, loaded []byte, current []byte) ([]byte, error) {
+	var diskObject, loadedObject, currentObject map[string]interface{}
+	if decodeJSON(onDisk, &diskObject) != nil || decodeJSON(loaded, &loadedObject) != nil || diskObject == nil || loadedObject == nil {
+		return current, nil
+	}
+
+	err := decodeJSON(current, &currentObject)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeObjectChanges(diskObject, loadedObject, currentObject)
+	return json.MarshalIndent(diskObject, "", "  ")
+}
+
+func mergeObjectChanges(onDisk map[string]interface{}, loaded map[string]interface{}, current map[string]interface{}) {
+	for key := range loaded {
+		if _, ok := current[key]; !ok {
+			delete(onDisk, key)
+		}
+	}
+
+	for key, currentValue := range current {
+		loadedValue, wasLoaded := loaded[key]
+		diskValue, onDiskNow := onDisk[key]
+
+		switch {
+		case !wasLoaded:
+			onDisk[key] = currentValue
+		case !onDiskNow:
+			// Deleted by another process; only bring it back if it was changed
+			// here.
+			if !reflect.DeepEqual(loadedValue, currentValue) {
+				onDisk[key] = currentValue
+			}
+		default:
+			diskMap, diskIsMap := diskValue.(map[string]interface{})
+			loadedMap, loadedIsMap := loadedValue.(map[string]interface{})
+			currentMap, currentIsMap := currentValue.(map[string]interface{})
+			if diskIsMap && loadedIsMap && currentIsMap {
+				mergeObjectChanges(diskMap, loadedMap, currentMap)
+			} else if !reflect.DeepEqual(loadedValue, currentValue) {
+				onDisk[key] = currentValue
+			}
+		}
+	}
+}
+
+// decodeJSON decodes numbers as json.Number so that they are written back
+// exactly as they were read.
+func decodeJSON(data []byte, object *map[string]interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(object)
+}
@@ -27,7 +27,15 @@ func (fp *FakePersistor) Load(data configuration.DataInterface) error {
 	if fp.LoadReturns.Data == nil {
 		fp.LoadReturns.Data = coreconfig.NewData()
 	}
-	return fp.LoadReturns.Err
+	if fp.LoadReturns.Err != nil || fp.SaveArgs.Data == nil {
+		return fp.LoadReturns.Err
+	}
+
+	saved, err := fp.SaveArgs.Data.JSONMarshalV3()
+	if err != nil {
+		return err
+	}
+	return data.JSONUnmarshalV3(saved)
 }
 
 func (fp *FakePersistor) Delete() {}
@@ -41,3 +49,7 @@ func (fp *FakePersistor) Save(data configuration.DataInterface) (err error) {
 	err = fp.SaveReturns.Err
 	return
 }
+
+func (fp *FakePersistor) Lock() (func(), error) {
+	return func() {}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/retry"
 )
 
 type FakeConfig struct {
@@ -91,6 +92,21 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CreateProfileStub        func(string)
+	createProfileMutex       sync.RWMutex
+	createProfileArgsForCall []struct {
+		arg1 string
+	}
+	CurrentProfileStub        func() string
+	currentProfileMutex       sync.RWMutex
+	currentProfileArgsForCall []struct {
+	}
+	currentProfileReturns struct {
+		result1 string
+	}
+	currentProfileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -115,6 +131,11 @@ type FakeConfig struct {
 		result1 string
 		result2 error
 	}
+	DeleteProfileStub        func(string)
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		arg1 string
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct {
@@ -181,6 +202,19 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	GetProfileStub        func(string) (configv3.Profile, bool)
+	getProfileMutex       sync.RWMutex
+	getProfileArgsForCall []struct {
+		arg1 string
+	}
+	getProfileReturns struct {
+		result1 configv3.Profile
+		result2 bool
+	}
+	getProfileReturnsOnCall map[int]struct {
+		result1 configv3.Profile
+		result2 bool
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -231,6 +265,16 @@ type FakeConfig struct {
 	nOAARequestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct {
+	}
+	outputFormatReturns struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct {
@@ -241,6 +285,16 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PaginationWorkersStub        func() int
+	paginationWorkersMutex       sync.RWMutex
+	paginationWorkersArgsForCall []struct {
+	}
+	paginationWorkersReturns struct {
+		result1 int
+	}
+	paginationWorkersReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct {
@@ -261,6 +315,26 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginRequireSignedStub        func() bool
+	pluginRequireSignedMutex       sync.RWMutex
+	pluginRequireSignedArgsForCall []struct {
+	}
+	pluginRequireSignedReturns struct {
+		result1 bool
+	}
+	pluginRequireSignedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PluginTrustedKeysStub        func() []configv3.PluginTrustedKey
+	pluginTrustedKeysMutex       sync.RWMutex
+	pluginTrustedKeysArgsForCall []struct {
+	}
+	pluginTrustedKeysReturns struct {
+		result1 []configv3.PluginTrustedKey
+	}
+	pluginTrustedKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginTrustedKey
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -281,6 +355,16 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ProfilesStub        func() []string
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct {
+	}
+	profilesReturns struct {
+		result1 []string
+	}
+	profilesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -306,6 +390,16 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RequestRetryPolicyStub        func() retry.Policy
+	requestRetryPolicyMutex       sync.RWMutex
+	requestRetryPolicyArgsForCall []struct {
+	}
+	requestRetryPolicyReturns struct {
+		result1 retry.Policy
+	}
+	requestRetryPolicyReturnsOnCall map[int]struct {
+		result1 retry.Policy
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -418,6 +512,28 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchProfileStub        func(string) error
+	switchProfileMutex       sync.RWMutex
+	switchProfileArgsForCall []struct {
+		arg1 string
+	}
+	switchProfileReturns struct {
+		result1 error
+	}
+	switchProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SyncTokensStub        func(func() error) error
+	syncTokensMutex       sync.RWMutex
+	syncTokensArgsForCall []struct {
+		arg1 func() error
+	}
+	syncTokensReturns struct {
+		result1 error
+	}
+	syncTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -969,6 +1085,89 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CreateProfile(arg1 string) {
+	fake.createProfileMutex.Lock()
+	fake.createProfileArgsForCall = append(fake.createProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateProfile", []interface{}{arg1})
+	fake.createProfileMutex.Unlock()
+	if fake.CreateProfileStub != nil {
+		fake.CreateProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) CreateProfileCallCount() int {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	return len(fake.createProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CreateProfileCalls(stub func(string)) {
+	fake.createProfileMutex.Lock()
+	defer fake.createProfileMutex.Unlock()
+	fake.CreateProfileStub = stub
+}
+
+func (fake *FakeConfig) CreateProfileArgsForCall(i int) string {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	argsForCall := fake.createProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) CurrentProfile() string {
+	fake.currentProfileMutex.Lock()
+	ret, specificReturn := fake.currentProfileReturnsOnCall[len(fake.currentProfileArgsForCall)]
+	fake.currentProfileArgsForCall = append(fake.currentProfileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentProfile", []interface{}{})
+	fake.currentProfileMutex.Unlock()
+	if fake.CurrentProfileStub != nil {
+		return fake.CurrentProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.currentProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentProfileCallCount() int {
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
+	return len(fake.currentProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentProfileCalls(stub func() string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = stub
+}
+
+func (fake *FakeConfig) CurrentProfileReturns(result1 string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = nil
+	fake.currentProfileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentProfileReturnsOnCall(i int, result1 string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = nil
+	if fake.currentProfileReturnsOnCall == nil {
+		fake.currentProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentProfileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1079,6 +1278,37 @@ func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteProfile(arg1 string) {
+	fake.deleteProfileMutex.Lock()
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteProfile", []interface{}{arg1})
+	fake.deleteProfileMutex.Unlock()
+	if fake.DeleteProfileStub != nil {
+		fake.DeleteProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteProfileCalls(stub func(string)) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = stub
+}
+
+func (fake *FakeConfig) DeleteProfileArgsForCall(i int) string {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	argsForCall := fake.deleteProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1413,6 +1643,69 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) GetProfile(arg1 string) (configv3.Profile, bool) {
+	fake.getProfileMutex.Lock()
+	ret, specificReturn := fake.getProfileReturnsOnCall[len(fake.getProfileArgsForCall)]
+	fake.getProfileArgsForCall = append(fake.getProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetProfile", []interface{}{arg1})
+	fake.getProfileMutex.Unlock()
+	if fake.GetProfileStub != nil {
+		return fake.GetProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getProfileReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) GetProfileCallCount() int {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	return len(fake.getProfileArgsForCall)
+}
+
+func (fake *FakeConfig) GetProfileCalls(stub func(string) (configv3.Profile, bool)) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = stub
+}
+
+func (fake *FakeConfig) GetProfileArgsForCall(i int) string {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	argsForCall := fake.getProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) GetProfileReturns(result1 configv3.Profile, result2 bool) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	fake.getProfileReturns = struct {
+		result1 configv3.Profile
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetProfileReturnsOnCall(i int, result1 configv3.Profile, result2 bool) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	if fake.getProfileReturnsOnCall == nil {
+		fake.getProfileReturnsOnCall = make(map[int]struct {
+			result1 configv3.Profile
+			result2 bool
+		})
+	}
+	fake.getProfileReturnsOnCall[i] = struct {
+		result1 configv3.Profile
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -1673,6 +1966,58 @@ func (fake *FakeConfig) NOAARequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct {
+	}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.outputFormatReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatCalls(stub func() configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = stub
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
@@ -1725,6 +2070,58 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PaginationWorkers() int {
+	fake.paginationWorkersMutex.Lock()
+	ret, specificReturn := fake.paginationWorkersReturnsOnCall[len(fake.paginationWorkersArgsForCall)]
+	fake.paginationWorkersArgsForCall = append(fake.paginationWorkersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PaginationWorkers", []interface{}{})
+	fake.paginationWorkersMutex.Unlock()
+	if fake.PaginationWorkersStub != nil {
+		return fake.PaginationWorkersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.paginationWorkersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PaginationWorkersCallCount() int {
+	fake.paginationWorkersMutex.RLock()
+	defer fake.paginationWorkersMutex.RUnlock()
+	return len(fake.paginationWorkersArgsForCall)
+}
+
+func (fake *FakeConfig) PaginationWorkersCalls(stub func() int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = stub
+}
+
+func (fake *FakeConfig) PaginationWorkersReturns(result1 int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = nil
+	fake.paginationWorkersReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationWorkersReturnsOnCall(i int, result1 int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = nil
+	if fake.paginationWorkersReturnsOnCall == nil {
+		fake.paginationWorkersReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.paginationWorkersReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -1829,6 +2226,110 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginRequireSigned() bool {
+	fake.pluginRequireSignedMutex.Lock()
+	ret, specificReturn := fake.pluginRequireSignedReturnsOnCall[len(fake.pluginRequireSignedArgsForCall)]
+	fake.pluginRequireSignedArgsForCall = append(fake.pluginRequireSignedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PluginRequireSigned", []interface{}{})
+	fake.pluginRequireSignedMutex.Unlock()
+	if fake.PluginRequireSignedStub != nil {
+		return fake.PluginRequireSignedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pluginRequireSignedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginRequireSignedCallCount() int {
+	fake.pluginRequireSignedMutex.RLock()
+	defer fake.pluginRequireSignedMutex.RUnlock()
+	return len(fake.pluginRequireSignedArgsForCall)
+}
+
+func (fake *FakeConfig) PluginRequireSignedCalls(stub func() bool) {
+	fake.pluginRequireSignedMutex.Lock()
+	defer fake.pluginRequireSignedMutex.Unlock()
+	fake.PluginRequireSignedStub = stub
+}
+
+func (fake *FakeConfig) PluginRequireSignedReturns(result1 bool) {
+	fake.pluginRequireSignedMutex.Lock()
+	defer fake.pluginRequireSignedMutex.Unlock()
+	fake.PluginRequireSignedStub = nil
+	fake.pluginRequireSignedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginRequireSignedReturnsOnCall(i int, result1 bool) {
+	fake.pluginRequireSignedMutex.Lock()
+	defer fake.pluginRequireSignedMutex.Unlock()
+	fake.PluginRequireSignedStub = nil
+	if fake.pluginRequireSignedReturnsOnCall == nil {
+		fake.pluginRequireSignedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pluginRequireSignedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginTrustedKeys() []configv3.PluginTrustedKey {
+	fake.pluginTrustedKeysMutex.Lock()
+	ret, specificReturn := fake.pluginTrustedKeysReturnsOnCall[len(fake.pluginTrustedKeysArgsForCall)]
+	fake.pluginTrustedKeysArgsForCall = append(fake.pluginTrustedKeysArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PluginTrustedKeys", []interface{}{})
+	fake.pluginTrustedKeysMutex.Unlock()
+	if fake.PluginTrustedKeysStub != nil {
+		return fake.PluginTrustedKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pluginTrustedKeysReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCallCount() int {
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
+	return len(fake.pluginTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginTrustedKeysCalls(stub func() []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = stub
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturns(result1 []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = nil
+	fake.pluginTrustedKeysReturns = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginTrustedKeysReturnsOnCall(i int, result1 []configv3.PluginTrustedKey) {
+	fake.pluginTrustedKeysMutex.Lock()
+	defer fake.pluginTrustedKeysMutex.Unlock()
+	fake.PluginTrustedKeysStub = nil
+	if fake.pluginTrustedKeysReturnsOnCall == nil {
+		fake.pluginTrustedKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginTrustedKey
+		})
+	}
+	fake.pluginTrustedKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginTrustedKey
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -1933,6 +2434,58 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) Profiles() []string {
+	fake.profilesMutex.Lock()
+	ret, specificReturn := fake.profilesReturnsOnCall[len(fake.profilesArgsForCall)]
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if fake.ProfilesStub != nil {
+		return fake.ProfilesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.profilesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesCalls(stub func() []string) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = stub
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []string) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfilesReturnsOnCall(i int, result1 []string) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	if fake.profilesReturnsOnCall == nil {
+		fake.profilesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.profilesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -2068,6 +2621,58 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RequestRetryPolicy() retry.Policy {
+	fake.requestRetryPolicyMutex.Lock()
+	ret, specificReturn := fake.requestRetryPolicyReturnsOnCall[len(fake.requestRetryPolicyArgsForCall)]
+	fake.requestRetryPolicyArgsForCall = append(fake.requestRetryPolicyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryPolicy", []interface{}{})
+	fake.requestRetryPolicyMutex.Unlock()
+	if fake.RequestRetryPolicyStub != nil {
+		return fake.RequestRetryPolicyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryPolicyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryPolicyCallCount() int {
+	fake.requestRetryPolicyMutex.RLock()
+	defer fake.requestRetryPolicyMutex.RUnlock()
+	return len(fake.requestRetryPolicyArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryPolicyCalls(stub func() retry.Policy) {
+	fake.requestRetryPolicyMutex.Lock()
+	defer fake.requestRetryPolicyMutex.Unlock()
+	fake.RequestRetryPolicyStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryPolicyReturns(result1 retry.Policy) {
+	fake.requestRetryPolicyMutex.Lock()
+	defer fake.requestRetryPolicyMutex.Unlock()
+	fake.RequestRetryPolicyStub = nil
+	fake.requestRetryPolicyReturns = struct {
+		result1 retry.Policy
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryPolicyReturnsOnCall(i int, result1 retry.Policy) {
+	fake.requestRetryPolicyMutex.Lock()
+	defer fake.requestRetryPolicyMutex.Unlock()
+	fake.RequestRetryPolicyStub = nil
+	if fake.requestRetryPolicyReturnsOnCall == nil {
+		fake.requestRetryPolicyReturnsOnCall = make(map[int]struct {
+			result1 retry.Policy
+		})
+	}
+	fake.requestRetryPolicyReturnsOnCall[i] = struct {
+		result1 retry.Policy
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -2650,6 +3255,126 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchProfile(arg1 string) error {
+	fake.switchProfileMutex.Lock()
+	ret, specificReturn := fake.switchProfileReturnsOnCall[len(fake.switchProfileArgsForCall)]
+	fake.switchProfileArgsForCall = append(fake.switchProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SwitchProfile", []interface{}{arg1})
+	fake.switchProfileMutex.Unlock()
+	if fake.SwitchProfileStub != nil {
+		return fake.SwitchProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.switchProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchProfileCallCount() int {
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
+	return len(fake.switchProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchProfileCalls(stub func(string) error) {
+	fake.switchProfileMutex.Lock()
+	defer fake.switchProfileMutex.Unlock()
+	fake.SwitchProfileStub = stub
+}
+
+func (fake *FakeConfig) SwitchProfileArgsForCall(i int) string {
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
+	argsForCall := fake.switchProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchProfileReturns(result1 error) {
+	fake.switchProfileMutex.Lock()
+	defer fake.switchProfileMutex.Unlock()
+	fake.SwitchProfileStub = nil
+	fake.switchProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchProfileReturnsOnCall(i int, result1 error) {
+	fake.switchProfileMutex.Lock()
+	defer fake.switchProfileMutex.Unlock()
+	fake.SwitchProfileStub = nil
+	if fake.switchProfileReturnsOnCall == nil {
+		fake.switchProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SyncTokens(arg1 func() error) error {
+	fake.syncTokensMutex.Lock()
+	ret, specificReturn := fake.syncTokensReturnsOnCall[len(fake.syncTokensArgsForCall)]
+	fake.syncTokensArgsForCall = append(fake.syncTokensArgsForCall, struct {
+		arg1 func() error
+	}{arg1})
+	fake.recordInvocation("SyncTokens", []interface{}{arg1})
+	fake.syncTokensMutex.Unlock()
+	if fake.SyncTokensStub != nil {
+		return fake.SyncTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.syncTokensReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SyncTokensCallCount() int {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	return len(fake.syncTokensArgsForCall)
+}
+
+func (fake *FakeConfig) SyncTokensCalls(stub func(func() error) error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = stub
+}
+
+func (fake *FakeConfig) SyncTokensArgsForCall(i int) func() error {
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
+	argsForCall := fake.syncTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SyncTokensReturns(result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	fake.syncTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SyncTokensReturnsOnCall(i int, result1 error) {
+	fake.syncTokensMutex.Lock()
+	defer fake.syncTokensMutex.Unlock()
+	fake.SyncTokensStub = nil
+	if fake.syncTokensReturnsOnCall == nil {
+		fake.syncTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -3295,10 +4020,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -3311,6 +4042,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
@@ -3321,22 +4054,34 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.nOAARequestRetryCountMutex.RLock()
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.paginationWorkersMutex.RLock()
+	defer fake.paginationWorkersMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginRequireSignedMutex.RLock()
+	defer fake.pluginRequireSignedMutex.RUnlock()
+	fake.pluginTrustedKeysMutex.RLock()
+	defer fake.pluginTrustedKeysMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.requestRetryPolicyMutex.RLock()
+	defer fake.requestRetryPolicyMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
@@ -3367,6 +4112,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
+	fake.syncTokensMutex.RLock()
+	defer fake.syncTokensMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
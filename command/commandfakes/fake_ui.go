@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -84,6 +85,17 @@ type FakeUI struct {
 		arg1 ui.LogMessage
 		arg2 bool
 	}
+	DisplayLogMessageJSONStub        func(ui.LogMessage) error
+	displayLogMessageJSONMutex       sync.RWMutex
+	displayLogMessageJSONArgsForCall []struct {
+		arg1 ui.LogMessage
+	}
+	displayLogMessageJSONReturns struct {
+		result1 error
+	}
+	displayLogMessageJSONReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayNewlineStub        func()
 	displayNewlineMutex       sync.RWMutex
 	displayNewlineArgsForCall []struct {
@@ -128,6 +140,18 @@ type FakeUI struct {
 		result1 string
 		result2 error
 	}
+	DisplayStructuredOutputStub        func(configv3.OutputFormat, interface{}) error
+	displayStructuredOutputMutex       sync.RWMutex
+	displayStructuredOutputArgsForCall []struct {
+		arg1 configv3.OutputFormat
+		arg2 interface{}
+	}
+	displayStructuredOutputReturns struct {
+		result1 error
+	}
+	displayStructuredOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayTableWithHeaderStub        func(string, [][]string, int)
 	displayTableWithHeaderMutex       sync.RWMutex
 	displayTableWithHeaderArgsForCall []struct {
@@ -199,6 +223,10 @@ type FakeUI struct {
 	displayWarningsArgsForCall []struct {
 		arg1 []string
 	}
+	FlushStub        func()
+	flushMutex       sync.RWMutex
+	flushArgsForCall []struct {
+	}
 	GetErrStub        func() io.Writer
 	getErrMutex       sync.RWMutex
 	getErrArgsForCall []struct {
@@ -273,6 +301,17 @@ type FakeUI struct {
 	userFriendlyDateReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WithAppPrefixStub        func(string) ui.Interface
+	withAppPrefixMutex       sync.RWMutex
+	withAppPrefixArgsForCall []struct {
+		arg1 string
+	}
+	withAppPrefixReturns struct {
+		result1 ui.Interface
+	}
+	withAppPrefixReturnsOnCall map[int]struct {
+		result1 ui.Interface
+	}
 	WriterStub        func() io.Writer
 	writerMutex       sync.RWMutex
 	writerArgsForCall []struct {
@@ -699,6 +738,66 @@ func (fake *FakeUI) DisplayLogMessageArgsForCall(i int) (ui.LogMessage, bool) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayLogMessageJSON(arg1 ui.LogMessage) error {
+	fake.displayLogMessageJSONMutex.Lock()
+	ret, specificReturn := fake.displayLogMessageJSONReturnsOnCall[len(fake.displayLogMessageJSONArgsForCall)]
+	fake.displayLogMessageJSONArgsForCall = append(fake.displayLogMessageJSONArgsForCall, struct {
+		arg1 ui.LogMessage
+	}{arg1})
+	fake.recordInvocation("DisplayLogMessageJSON", []interface{}{arg1})
+	fake.displayLogMessageJSONMutex.Unlock()
+	if fake.DisplayLogMessageJSONStub != nil {
+		return fake.DisplayLogMessageJSONStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayLogMessageJSONReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayLogMessageJSONCallCount() int {
+	fake.displayLogMessageJSONMutex.RLock()
+	defer fake.displayLogMessageJSONMutex.RUnlock()
+	return len(fake.displayLogMessageJSONArgsForCall)
+}
+
+func (fake *FakeUI) DisplayLogMessageJSONCalls(stub func(ui.LogMessage) error) {
+	fake.displayLogMessageJSONMutex.Lock()
+	defer fake.displayLogMessageJSONMutex.Unlock()
+	fake.DisplayLogMessageJSONStub = stub
+}
+
+func (fake *FakeUI) DisplayLogMessageJSONArgsForCall(i int) ui.LogMessage {
+	fake.displayLogMessageJSONMutex.RLock()
+	defer fake.displayLogMessageJSONMutex.RUnlock()
+	argsForCall := fake.displayLogMessageJSONArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayLogMessageJSONReturns(result1 error) {
+	fake.displayLogMessageJSONMutex.Lock()
+	defer fake.displayLogMessageJSONMutex.Unlock()
+	fake.DisplayLogMessageJSONStub = nil
+	fake.displayLogMessageJSONReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayLogMessageJSONReturnsOnCall(i int, result1 error) {
+	fake.displayLogMessageJSONMutex.Lock()
+	defer fake.displayLogMessageJSONMutex.Unlock()
+	fake.DisplayLogMessageJSONStub = nil
+	if fake.displayLogMessageJSONReturnsOnCall == nil {
+		fake.displayLogMessageJSONReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayLogMessageJSONReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayNewline() {
 	fake.displayNewlineMutex.Lock()
 	fake.displayNewlineArgsForCall = append(fake.displayNewlineArgsForCall, struct {
@@ -912,6 +1011,67 @@ func (fake *FakeUI) DisplayPasswordPromptReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUI) DisplayStructuredOutput(arg1 configv3.OutputFormat, arg2 interface{}) error {
+	fake.displayStructuredOutputMutex.Lock()
+	ret, specificReturn := fake.displayStructuredOutputReturnsOnCall[len(fake.displayStructuredOutputArgsForCall)]
+	fake.displayStructuredOutputArgsForCall = append(fake.displayStructuredOutputArgsForCall, struct {
+		arg1 configv3.OutputFormat
+		arg2 interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("DisplayStructuredOutput", []interface{}{arg1, arg2})
+	fake.displayStructuredOutputMutex.Unlock()
+	if fake.DisplayStructuredOutputStub != nil {
+		return fake.DisplayStructuredOutputStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayStructuredOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayStructuredOutputCallCount() int {
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
+	return len(fake.displayStructuredOutputArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredOutputCalls(stub func(configv3.OutputFormat, interface{}) error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredOutputArgsForCall(i int) (configv3.OutputFormat, interface{}) {
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
+	argsForCall := fake.displayStructuredOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUI) DisplayStructuredOutputReturns(result1 error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = nil
+	fake.displayStructuredOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredOutputReturnsOnCall(i int, result1 error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = nil
+	if fake.displayStructuredOutputReturnsOnCall == nil {
+		fake.displayStructuredOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayStructuredOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayTableWithHeader(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1280,6 +1440,29 @@ func (fake *FakeUI) DisplayWarningsArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeUI) Flush() {
+	fake.flushMutex.Lock()
+	fake.flushArgsForCall = append(fake.flushArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Flush", []interface{}{})
+	fake.flushMutex.Unlock()
+	if fake.FlushStub != nil {
+		fake.FlushStub()
+	}
+}
+
+func (fake *FakeUI) FlushCallCount() int {
+	fake.flushMutex.RLock()
+	defer fake.flushMutex.RUnlock()
+	return len(fake.flushArgsForCall)
+}
+
+func (fake *FakeUI) FlushCalls(stub func()) {
+	fake.flushMutex.Lock()
+	defer fake.flushMutex.Unlock()
+	fake.FlushStub = stub
+}
+
 func (fake *FakeUI) GetErr() io.Writer {
 	fake.getErrMutex.Lock()
 	ret, specificReturn := fake.getErrReturnsOnCall[len(fake.getErrArgsForCall)]
@@ -1674,6 +1857,66 @@ func (fake *FakeUI) UserFriendlyDateReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeUI) WithAppPrefix(arg1 string) ui.Interface {
+	fake.withAppPrefixMutex.Lock()
+	ret, specificReturn := fake.withAppPrefixReturnsOnCall[len(fake.withAppPrefixArgsForCall)]
+	fake.withAppPrefixArgsForCall = append(fake.withAppPrefixArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("WithAppPrefix", []interface{}{arg1})
+	fake.withAppPrefixMutex.Unlock()
+	if fake.WithAppPrefixStub != nil {
+		return fake.WithAppPrefixStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withAppPrefixReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) WithAppPrefixCallCount() int {
+	fake.withAppPrefixMutex.RLock()
+	defer fake.withAppPrefixMutex.RUnlock()
+	return len(fake.withAppPrefixArgsForCall)
+}
+
+func (fake *FakeUI) WithAppPrefixCalls(stub func(string) ui.Interface) {
+	fake.withAppPrefixMutex.Lock()
+	defer fake.withAppPrefixMutex.Unlock()
+	fake.WithAppPrefixStub = stub
+}
+
+func (fake *FakeUI) WithAppPrefixArgsForCall(i int) string {
+	fake.withAppPrefixMutex.RLock()
+	defer fake.withAppPrefixMutex.RUnlock()
+	argsForCall := fake.withAppPrefixArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) WithAppPrefixReturns(result1 ui.Interface) {
+	fake.withAppPrefixMutex.Lock()
+	defer fake.withAppPrefixMutex.Unlock()
+	fake.WithAppPrefixStub = nil
+	fake.withAppPrefixReturns = struct {
+		result1 ui.Interface
+	}{result1}
+}
+
+func (fake *FakeUI) WithAppPrefixReturnsOnCall(i int, result1 ui.Interface) {
+	fake.withAppPrefixMutex.Lock()
+	defer fake.withAppPrefixMutex.Unlock()
+	fake.WithAppPrefixStub = nil
+	if fake.withAppPrefixReturnsOnCall == nil {
+		fake.withAppPrefixReturnsOnCall = make(map[int]struct {
+			result1 ui.Interface
+		})
+	}
+	fake.withAppPrefixReturnsOnCall[i] = struct {
+		result1 ui.Interface
+	}{result1}
+}
+
 func (fake *FakeUI) Writer() io.Writer {
 	fake.writerMutex.Lock()
 	ret, specificReturn := fake.writerReturnsOnCall[len(fake.writerArgsForCall)]
@@ -1751,6 +1994,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayKeyValueTableForAppMutex.RUnlock()
 	fake.displayLogMessageMutex.RLock()
 	defer fake.displayLogMessageMutex.RUnlock()
+	fake.displayLogMessageJSONMutex.RLock()
+	defer fake.displayLogMessageJSONMutex.RUnlock()
 	fake.displayNewlineMutex.RLock()
 	defer fake.displayNewlineMutex.RUnlock()
 	fake.displayNonWrappingTableMutex.RLock()
@@ -1761,6 +2006,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayOptionalTextPromptMutex.RUnlock()
 	fake.displayPasswordPromptMutex.RLock()
 	defer fake.displayPasswordPromptMutex.RUnlock()
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
 	fake.displayTableWithHeaderMutex.RLock()
 	defer fake.displayTableWithHeaderMutex.RUnlock()
 	fake.displayTextMutex.RLock()
@@ -1779,6 +2026,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayWarningV7Mutex.RUnlock()
 	fake.displayWarningsMutex.RLock()
 	defer fake.displayWarningsMutex.RUnlock()
+	fake.flushMutex.RLock()
+	defer fake.flushMutex.RUnlock()
 	fake.getErrMutex.RLock()
 	defer fake.getErrMutex.RUnlock()
 	fake.getInMutex.RLock()
@@ -1793,6 +2042,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.translateTextMutex.RUnlock()
 	fake.userFriendlyDateMutex.RLock()
 	defer fake.userFriendlyDateMutex.RUnlock()
+	fake.withAppPrefixMutex.RLock()
+	defer fake.withAppPrefixMutex.RUnlock()
 	fake.writerMutex.RLock()
 	defer fake.writerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
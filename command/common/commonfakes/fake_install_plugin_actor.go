@@ -144,6 +144,20 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	VerifyFileSignatureStub        func(string, string) (string, error)
+	verifyFileSignatureMutex       sync.RWMutex
+	verifyFileSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	verifyFileSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyFileSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -782,6 +796,70 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) VerifyFileSignature(arg1 string, arg2 string) (string, error) {
+	fake.verifyFileSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyFileSignatureReturnsOnCall[len(fake.verifyFileSignatureArgsForCall)]
+	fake.verifyFileSignatureArgsForCall = append(fake.verifyFileSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("VerifyFileSignature", []interface{}{arg1, arg2})
+	fake.verifyFileSignatureMutex.Unlock()
+	if fake.VerifyFileSignatureStub != nil {
+		return fake.VerifyFileSignatureStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.verifyFileSignatureReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) VerifyFileSignatureCallCount() int {
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
+	return len(fake.verifyFileSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyFileSignatureCalls(stub func(string, string) (string, error)) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) VerifyFileSignatureArgsForCall(i int) (string, string) {
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
+	argsForCall := fake.verifyFileSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallPluginActor) VerifyFileSignatureReturns(result1 string, result2 error) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = nil
+	fake.verifyFileSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) VerifyFileSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = nil
+	if fake.verifyFileSignatureReturnsOnCall == nil {
+		fake.verifyFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyFileSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -805,6 +883,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
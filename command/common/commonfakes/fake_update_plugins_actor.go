@@ -0,0 +1,1054 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commonfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeUpdatePluginsActor struct {
+	CreateExecutableCopyStub        func(string, string) (string, error)
+	createExecutableCopyMutex       sync.RWMutex
+	createExecutableCopyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createExecutableCopyReturns struct {
+		result1 string
+		result2 error
+	}
+	createExecutableCopyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DownloadExecutableBinaryFromURLStub        func(string, string, plugin.ProxyReader) (string, error)
+	downloadExecutableBinaryFromURLMutex       sync.RWMutex
+	downloadExecutableBinaryFromURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}
+	downloadExecutableBinaryFromURLReturns struct {
+		result1 string
+		result2 error
+	}
+	downloadExecutableBinaryFromURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	FileExistsStub        func(string) bool
+	fileExistsMutex       sync.RWMutex
+	fileExistsArgsForCall []struct {
+		arg1 string
+	}
+	fileExistsReturns struct {
+		result1 bool
+	}
+	fileExistsReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	GetAndValidatePluginStub        func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)
+	getAndValidatePluginMutex       sync.RWMutex
+	getAndValidatePluginArgsForCall []struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.CommandList
+		arg3 string
+	}
+	getAndValidatePluginReturns struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	getAndValidatePluginReturnsOnCall map[int]struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	GetOutdatedPluginsStub        func() ([]pluginaction.OutdatedPlugin, error)
+	getOutdatedPluginsMutex       sync.RWMutex
+	getOutdatedPluginsArgsForCall []struct {
+	}
+	getOutdatedPluginsReturns struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}
+	getOutdatedPluginsReturnsOnCall map[int]struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}
+	GetPlatformStringStub        func(string, string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetPluginInfoFromRepositoriesForPlatformStub        func(string, []configv3.PluginRepository, string) (pluginaction.PluginInfo, []string, error)
+	getPluginInfoFromRepositoriesForPlatformMutex       sync.RWMutex
+	getPluginInfoFromRepositoriesForPlatformArgsForCall []struct {
+		arg1 string
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}
+	getPluginInfoFromRepositoriesForPlatformReturns struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}
+	getPluginInfoFromRepositoriesForPlatformReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}
+	GetPluginRepositoryStub        func(string) (configv3.PluginRepository, error)
+	getPluginRepositoryMutex       sync.RWMutex
+	getPluginRepositoryArgsForCall []struct {
+		arg1 string
+	}
+	getPluginRepositoryReturns struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	getPluginRepositoryReturnsOnCall map[int]struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	InstallPluginFromPathStub        func(string, configv3.Plugin) error
+	installPluginFromPathMutex       sync.RWMutex
+	installPluginFromPathArgsForCall []struct {
+		arg1 string
+		arg2 configv3.Plugin
+	}
+	installPluginFromPathReturns struct {
+		result1 error
+	}
+	installPluginFromPathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReadPluginLockStub        func(string) (pluginaction.PluginLock, error)
+	readPluginLockMutex       sync.RWMutex
+	readPluginLockArgsForCall []struct {
+		arg1 string
+	}
+	readPluginLockReturns struct {
+		result1 pluginaction.PluginLock
+		result2 error
+	}
+	readPluginLockReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginLock
+		result2 error
+	}
+	UninstallPluginStub        func(pluginaction.PluginUninstaller, string) error
+	uninstallPluginMutex       sync.RWMutex
+	uninstallPluginArgsForCall []struct {
+		arg1 pluginaction.PluginUninstaller
+		arg2 string
+	}
+	uninstallPluginReturns struct {
+		result1 error
+	}
+	uninstallPluginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateFileChecksumStub        func(string, string) bool
+	validateFileChecksumMutex       sync.RWMutex
+	validateFileChecksumArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateFileChecksumReturns struct {
+		result1 bool
+	}
+	validateFileChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	VerifyFileSignatureStub        func(string, string) (string, error)
+	verifyFileSignatureMutex       sync.RWMutex
+	verifyFileSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	verifyFileSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyFileSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopy(arg1 string, arg2 string) (string, error) {
+	fake.createExecutableCopyMutex.Lock()
+	ret, specificReturn := fake.createExecutableCopyReturnsOnCall[len(fake.createExecutableCopyArgsForCall)]
+	fake.createExecutableCopyArgsForCall = append(fake.createExecutableCopyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateExecutableCopy", []interface{}{arg1, arg2})
+	fake.createExecutableCopyMutex.Unlock()
+	if fake.CreateExecutableCopyStub != nil {
+		return fake.CreateExecutableCopyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createExecutableCopyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopyCallCount() int {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	return len(fake.createExecutableCopyArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopyCalls(stub func(string, string) (string, error)) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopyArgsForCall(i int) (string, string) {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	argsForCall := fake.createExecutableCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopyReturns(result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	fake.createExecutableCopyReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) CreateExecutableCopyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	if fake.createExecutableCopyReturnsOnCall == nil {
+		fake.createExecutableCopyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createExecutableCopyReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURL(arg1 string, arg2 string, arg3 plugin.ProxyReader) (string, error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
+	fake.downloadExecutableBinaryFromURLArgsForCall = append(fake.downloadExecutableBinaryFromURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DownloadExecutableBinaryFromURL", []interface{}{arg1, arg2, arg3})
+	fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	if fake.DownloadExecutableBinaryFromURLStub != nil {
+		return fake.DownloadExecutableBinaryFromURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.downloadExecutableBinaryFromURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLCallCount() int {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return len(fake.downloadExecutableBinaryFromURLArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLCalls(stub func(string, string, plugin.ProxyReader) (string, error)) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLArgsForCall(i int) (string, string, plugin.ProxyReader) {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	argsForCall := fake.downloadExecutableBinaryFromURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLReturns(result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	fake.downloadExecutableBinaryFromURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	if fake.downloadExecutableBinaryFromURLReturnsOnCall == nil {
+		fake.downloadExecutableBinaryFromURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.downloadExecutableBinaryFromURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) FileExists(arg1 string) bool {
+	fake.fileExistsMutex.Lock()
+	ret, specificReturn := fake.fileExistsReturnsOnCall[len(fake.fileExistsArgsForCall)]
+	fake.fileExistsArgsForCall = append(fake.fileExistsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FileExists", []interface{}{arg1})
+	fake.fileExistsMutex.Unlock()
+	if fake.FileExistsStub != nil {
+		return fake.FileExistsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.fileExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) FileExistsCallCount() int {
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	return len(fake.fileExistsArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) FileExistsCalls(stub func(string) bool) {
+	fake.fileExistsMutex.Lock()
+	defer fake.fileExistsMutex.Unlock()
+	fake.FileExistsStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) FileExistsArgsForCall(i int) string {
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	argsForCall := fake.fileExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpdatePluginsActor) FileExistsReturns(result1 bool) {
+	fake.fileExistsMutex.Lock()
+	defer fake.fileExistsMutex.Unlock()
+	fake.FileExistsStub = nil
+	fake.fileExistsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) FileExistsReturnsOnCall(i int, result1 bool) {
+	fake.fileExistsMutex.Lock()
+	defer fake.fileExistsMutex.Unlock()
+	fake.FileExistsStub = nil
+	if fake.fileExistsReturnsOnCall == nil {
+		fake.fileExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.fileExistsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePlugin(arg1 pluginaction.PluginMetadata, arg2 pluginaction.CommandList, arg3 string) (configv3.Plugin, error) {
+	fake.getAndValidatePluginMutex.Lock()
+	ret, specificReturn := fake.getAndValidatePluginReturnsOnCall[len(fake.getAndValidatePluginArgsForCall)]
+	fake.getAndValidatePluginArgsForCall = append(fake.getAndValidatePluginArgsForCall, struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.CommandList
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetAndValidatePlugin", []interface{}{arg1, arg2, arg3})
+	fake.getAndValidatePluginMutex.Unlock()
+	if fake.GetAndValidatePluginStub != nil {
+		return fake.GetAndValidatePluginStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAndValidatePluginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginCallCount() int {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	return len(fake.getAndValidatePluginArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginCalls(stub func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.CommandList, string) {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	argsForCall := fake.getAndValidatePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginReturns(result1 configv3.Plugin, result2 error) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = nil
+	fake.getAndValidatePluginReturns = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginReturnsOnCall(i int, result1 configv3.Plugin, result2 error) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = nil
+	if fake.getAndValidatePluginReturnsOnCall == nil {
+		fake.getAndValidatePluginReturnsOnCall = make(map[int]struct {
+			result1 configv3.Plugin
+			result2 error
+		})
+	}
+	fake.getAndValidatePluginReturnsOnCall[i] = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error) {
+	fake.getOutdatedPluginsMutex.Lock()
+	ret, specificReturn := fake.getOutdatedPluginsReturnsOnCall[len(fake.getOutdatedPluginsArgsForCall)]
+	fake.getOutdatedPluginsArgsForCall = append(fake.getOutdatedPluginsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetOutdatedPlugins", []interface{}{})
+	fake.getOutdatedPluginsMutex.Unlock()
+	if fake.GetOutdatedPluginsStub != nil {
+		return fake.GetOutdatedPluginsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getOutdatedPluginsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsCallCount() int {
+	fake.getOutdatedPluginsMutex.RLock()
+	defer fake.getOutdatedPluginsMutex.RUnlock()
+	return len(fake.getOutdatedPluginsArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsCalls(stub func() ([]pluginaction.OutdatedPlugin, error)) {
+	fake.getOutdatedPluginsMutex.Lock()
+	defer fake.getOutdatedPluginsMutex.Unlock()
+	fake.GetOutdatedPluginsStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsReturns(result1 []pluginaction.OutdatedPlugin, result2 error) {
+	fake.getOutdatedPluginsMutex.Lock()
+	defer fake.getOutdatedPluginsMutex.Unlock()
+	fake.GetOutdatedPluginsStub = nil
+	fake.getOutdatedPluginsReturns = struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []pluginaction.OutdatedPlugin, result2 error) {
+	fake.getOutdatedPluginsMutex.Lock()
+	defer fake.getOutdatedPluginsMutex.Unlock()
+	fake.GetOutdatedPluginsStub = nil
+	if fake.getOutdatedPluginsReturnsOnCall == nil {
+		fake.getOutdatedPluginsReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.OutdatedPlugin
+			result2 error
+		})
+	}
+	fake.getOutdatedPluginsReturnsOnCall[i] = struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformString(arg1 string, arg2 string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetPlatformString", []interface{}{arg1, arg2})
+	fake.getPlatformStringMutex.Unlock()
+	if fake.GetPlatformStringStub != nil {
+		return fake.GetPlatformStringStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.getPlatformStringReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringCalls(stub func(string, string) string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	argsForCall := fake.getPlatformStringArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringReturns(result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatform(arg1 string, arg2 []configv3.PluginRepository, arg3 string) (pluginaction.PluginInfo, []string, error) {
+	var arg2Copy []configv3.PluginRepository
+	if arg2 != nil {
+		arg2Copy = make([]configv3.PluginRepository, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.Lock()
+	ret, specificReturn := fake.getPluginInfoFromRepositoriesForPlatformReturnsOnCall[len(fake.getPluginInfoFromRepositoriesForPlatformArgsForCall)]
+	fake.getPluginInfoFromRepositoriesForPlatformArgsForCall = append(fake.getPluginInfoFromRepositoriesForPlatformArgsForCall, struct {
+		arg1 string
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("GetPluginInfoFromRepositoriesForPlatform", []interface{}{arg1, arg2Copy, arg3})
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.Unlock()
+	if fake.GetPluginInfoFromRepositoriesForPlatformStub != nil {
+		return fake.GetPluginInfoFromRepositoriesForPlatformStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPluginInfoFromRepositoriesForPlatformReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatformCallCount() int {
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.RUnlock()
+	return len(fake.getPluginInfoFromRepositoriesForPlatformArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatformCalls(stub func(string, []configv3.PluginRepository, string) (pluginaction.PluginInfo, []string, error)) {
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.Lock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.Unlock()
+	fake.GetPluginInfoFromRepositoriesForPlatformStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatformArgsForCall(i int) (string, []configv3.PluginRepository, string) {
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.RUnlock()
+	argsForCall := fake.getPluginInfoFromRepositoriesForPlatformArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatformReturns(result1 pluginaction.PluginInfo, result2 []string, result3 error) {
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.Lock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.Unlock()
+	fake.GetPluginInfoFromRepositoriesForPlatformStub = nil
+	fake.getPluginInfoFromRepositoriesForPlatformReturns = struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoriesForPlatformReturnsOnCall(i int, result1 pluginaction.PluginInfo, result2 []string, result3 error) {
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.Lock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.Unlock()
+	fake.GetPluginInfoFromRepositoriesForPlatformStub = nil
+	if fake.getPluginInfoFromRepositoriesForPlatformReturnsOnCall == nil {
+		fake.getPluginInfoFromRepositoriesForPlatformReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginInfo
+			result2 []string
+			result3 error
+		})
+	}
+	fake.getPluginInfoFromRepositoriesForPlatformReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginInfo
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepository(arg1 string) (configv3.PluginRepository, error) {
+	fake.getPluginRepositoryMutex.Lock()
+	ret, specificReturn := fake.getPluginRepositoryReturnsOnCall[len(fake.getPluginRepositoryArgsForCall)]
+	fake.getPluginRepositoryArgsForCall = append(fake.getPluginRepositoryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetPluginRepository", []interface{}{arg1})
+	fake.getPluginRepositoryMutex.Unlock()
+	if fake.GetPluginRepositoryStub != nil {
+		return fake.GetPluginRepositoryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPluginRepositoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepositoryCallCount() int {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	return len(fake.getPluginRepositoryArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepositoryCalls(stub func(string) (configv3.PluginRepository, error)) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepositoryArgsForCall(i int) string {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	argsForCall := fake.getPluginRepositoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepositoryReturns(result1 configv3.PluginRepository, result2 error) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = nil
+	fake.getPluginRepositoryReturns = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginRepositoryReturnsOnCall(i int, result1 configv3.PluginRepository, result2 error) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = nil
+	if fake.getPluginRepositoryReturnsOnCall == nil {
+		fake.getPluginRepositoryReturnsOnCall = make(map[int]struct {
+			result1 configv3.PluginRepository
+			result2 error
+		})
+	}
+	fake.getPluginRepositoryReturnsOnCall[i] = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPath(arg1 string, arg2 configv3.Plugin) error {
+	fake.installPluginFromPathMutex.Lock()
+	ret, specificReturn := fake.installPluginFromPathReturnsOnCall[len(fake.installPluginFromPathArgsForCall)]
+	fake.installPluginFromPathArgsForCall = append(fake.installPluginFromPathArgsForCall, struct {
+		arg1 string
+		arg2 configv3.Plugin
+	}{arg1, arg2})
+	fake.recordInvocation("InstallPluginFromPath", []interface{}{arg1, arg2})
+	fake.installPluginFromPathMutex.Unlock()
+	if fake.InstallPluginFromPathStub != nil {
+		return fake.InstallPluginFromPathStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.installPluginFromPathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPathCallCount() int {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	return len(fake.installPluginFromPathArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPathCalls(stub func(string, configv3.Plugin) error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPathArgsForCall(i int) (string, configv3.Plugin) {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	argsForCall := fake.installPluginFromPathArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPathReturns(result1 error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = nil
+	fake.installPluginFromPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) InstallPluginFromPathReturnsOnCall(i int, result1 error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = nil
+	if fake.installPluginFromPathReturnsOnCall == nil {
+		fake.installPluginFromPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.installPluginFromPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLock(arg1 string) (pluginaction.PluginLock, error) {
+	fake.readPluginLockMutex.Lock()
+	ret, specificReturn := fake.readPluginLockReturnsOnCall[len(fake.readPluginLockArgsForCall)]
+	fake.readPluginLockArgsForCall = append(fake.readPluginLockArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReadPluginLock", []interface{}{arg1})
+	fake.readPluginLockMutex.Unlock()
+	if fake.ReadPluginLockStub != nil {
+		return fake.ReadPluginLockStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.readPluginLockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLockCallCount() int {
+	fake.readPluginLockMutex.RLock()
+	defer fake.readPluginLockMutex.RUnlock()
+	return len(fake.readPluginLockArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLockCalls(stub func(string) (pluginaction.PluginLock, error)) {
+	fake.readPluginLockMutex.Lock()
+	defer fake.readPluginLockMutex.Unlock()
+	fake.ReadPluginLockStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLockArgsForCall(i int) string {
+	fake.readPluginLockMutex.RLock()
+	defer fake.readPluginLockMutex.RUnlock()
+	argsForCall := fake.readPluginLockArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLockReturns(result1 pluginaction.PluginLock, result2 error) {
+	fake.readPluginLockMutex.Lock()
+	defer fake.readPluginLockMutex.Unlock()
+	fake.ReadPluginLockStub = nil
+	fake.readPluginLockReturns = struct {
+		result1 pluginaction.PluginLock
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) ReadPluginLockReturnsOnCall(i int, result1 pluginaction.PluginLock, result2 error) {
+	fake.readPluginLockMutex.Lock()
+	defer fake.readPluginLockMutex.Unlock()
+	fake.ReadPluginLockStub = nil
+	if fake.readPluginLockReturnsOnCall == nil {
+		fake.readPluginLockReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginLock
+			result2 error
+		})
+	}
+	fake.readPluginLockReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginLock
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPlugin(arg1 pluginaction.PluginUninstaller, arg2 string) error {
+	fake.uninstallPluginMutex.Lock()
+	ret, specificReturn := fake.uninstallPluginReturnsOnCall[len(fake.uninstallPluginArgsForCall)]
+	fake.uninstallPluginArgsForCall = append(fake.uninstallPluginArgsForCall, struct {
+		arg1 pluginaction.PluginUninstaller
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("UninstallPlugin", []interface{}{arg1, arg2})
+	fake.uninstallPluginMutex.Unlock()
+	if fake.UninstallPluginStub != nil {
+		return fake.UninstallPluginStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uninstallPluginReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPluginCallCount() int {
+	fake.uninstallPluginMutex.RLock()
+	defer fake.uninstallPluginMutex.RUnlock()
+	return len(fake.uninstallPluginArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPluginCalls(stub func(pluginaction.PluginUninstaller, string) error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPluginArgsForCall(i int) (pluginaction.PluginUninstaller, string) {
+	fake.uninstallPluginMutex.RLock()
+	defer fake.uninstallPluginMutex.RUnlock()
+	argsForCall := fake.uninstallPluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPluginReturns(result1 error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = nil
+	fake.uninstallPluginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) UninstallPluginReturnsOnCall(i int, result1 error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = nil
+	if fake.uninstallPluginReturnsOnCall == nil {
+		fake.uninstallPluginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uninstallPluginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksum(arg1 string, arg2 string) bool {
+	fake.validateFileChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
+	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ValidateFileChecksum", []interface{}{arg1, arg2})
+	fake.validateFileChecksumMutex.Unlock()
+	if fake.ValidateFileChecksumStub != nil {
+		return fake.ValidateFileChecksumStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileChecksumReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumCallCount() int {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return len(fake.validateFileChecksumArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumCalls(stub func(string, string) bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	argsForCall := fake.validateFileChecksumArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumReturns(result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	fake.validateFileChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	if fake.validateFileChecksumReturnsOnCall == nil {
+		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignature(arg1 string, arg2 string) (string, error) {
+	fake.verifyFileSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyFileSignatureReturnsOnCall[len(fake.verifyFileSignatureArgsForCall)]
+	fake.verifyFileSignatureArgsForCall = append(fake.verifyFileSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("VerifyFileSignature", []interface{}{arg1, arg2})
+	fake.verifyFileSignatureMutex.Unlock()
+	if fake.VerifyFileSignatureStub != nil {
+		return fake.VerifyFileSignatureStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.verifyFileSignatureReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignatureCallCount() int {
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
+	return len(fake.verifyFileSignatureArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignatureCalls(stub func(string, string) (string, error)) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = stub
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignatureArgsForCall(i int) (string, string) {
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
+	argsForCall := fake.verifyFileSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignatureReturns(result1 string, result2 error) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = nil
+	fake.verifyFileSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) VerifyFileSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.verifyFileSignatureMutex.Lock()
+	defer fake.verifyFileSignatureMutex.Unlock()
+	fake.VerifyFileSignatureStub = nil
+	if fake.verifyFileSignatureReturnsOnCall == nil {
+		fake.verifyFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyFileSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	fake.getOutdatedPluginsMutex.RLock()
+	defer fake.getOutdatedPluginsMutex.RUnlock()
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
+	defer fake.getPluginInfoFromRepositoriesForPlatformMutex.RUnlock()
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	fake.readPluginLockMutex.RLock()
+	defer fake.readPluginLockMutex.RUnlock()
+	fake.uninstallPluginMutex.RLock()
+	defer fake.uninstallPluginMutex.RUnlock()
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyFileSignatureMutex.RLock()
+	defer fake.verifyFileSignatureMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpdatePluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ common.UpdatePluginsActor = new(FakeUpdatePluginsActor)
@@ -301,9 +301,18 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CREDENTIAL_HELPER=name", cmd.UI.TranslateText("Run cf-credential-name for the credential-helper token store")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_PAGINATION_WORKERS=4", cmd.UI.TranslateText("Max number of pages of a list fetched at the same time")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_PROFILE=staging", cmd.UI.TranslateText("Use the given profile instead of the current one")},
+		{"CF_RETRY_COUNT=2", cmd.UI.TranslateText("Max number of times a failed request is retried")},
+		{"CF_RETRY_BACKOFF=500ms", cmd.UI.TranslateText("Wait time before the first retry, doubled on each following retry")},
+		{"CF_RETRY_MAX_BACKOFF=30s", cmd.UI.TranslateText("Max wait time between retries, including waits requested by the server")},
+		{"CF_TOKEN_STORE=encrypted-file", cmd.UI.TranslateText("Keep tokens out of the config file: encrypted-file or credential-helper")},
+		{"CF_TOKEN_STORE_KEY_FILE=path/key", cmd.UI.TranslateText("Key file for the encrypted-file token store")},
+		{"CF_TOKEN_STORE_PASSPHRASE=secret", cmd.UI.TranslateText("Passphrase for the encrypted-file token store")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
@@ -315,6 +324,7 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--profile", cmd.UI.TranslateText("Use the given profile for this command")},
 	}
 }
 
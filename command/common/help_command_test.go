@@ -609,9 +609,18 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CREDENTIAL_HELPER=name          Run cf-credential-name for the credential-helper token store"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
+				Expect(testUI.Out).To(Say("   CF_PAGINATION_WORKERS=4            Max number of pages of a list fetched at the same time"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_PROFILE=staging                 Use the given profile instead of the current one"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_COUNT=2                   Max number of times a failed request is retried"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_BACKOFF=500ms             Wait time before the first retry, doubled on each following retry"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_MAX_BACKOFF=30s           Max wait time between retries, including waits requested by the server"))
+				Expect(testUI.Out).To(Say("   CF_TOKEN_STORE=encrypted-file      Keep tokens out of the config file: encrypted-file or credential-helper"))
+				Expect(testUI.Out).To(Say("   CF_TOKEN_STORE_KEY_FILE=path/key   Key file for the encrypted-file token store"))
+				Expect(testUI.Out).To(Say("   CF_TOKEN_STORE_PASSPHRASE=secret   Passphrase for the encrypted-file token store"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
@@ -620,6 +629,7 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --profile                          Use the given profile for this command"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say(`APPS \(experimental\):`))
 				Expect(testUI.Out).To(Say(`   v3-apps\s+List all apps in the target space`))
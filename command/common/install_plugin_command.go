@@ -33,6 +33,7 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	VerifyFileSignature(path string, signature string) (string, error)
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -53,12 +54,15 @@ const (
 )
 
 type InstallPluginCommand struct {
-	OptionalArgs         flag.InstallPluginArgs `positional-args:"yes"`
-	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
-	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
-	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	OptionalArgs         flag.InstallPluginArgs      `positional-args:"yes"`
+	SkipSSLValidation    bool                        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                bool                        `short:"f" description:"Force install of plugin without confirmation"`
+	RegisteredRepository string                      `short:"r" description:"Restrict search for plugin to this registered repository"`
+	Checksum             string                      `long:"checksum" description:"Verify the plugin binary against this SHA-1, SHA-256 or SHA-512 checksum, optionally prefixed with its algorithm (e.g. sha256:CHECKSUM)"`
+	Signature            flag.PathWithExistenceCheck `long:"signature" description:"Path to a base64 encoded ed25519 signature of the plugin binary"`
+	RequireSigned        bool                        `long:"require-signed" description:"Only install the plugin if its signature is verified by a trusted key"`
+	usage                interface{}                 `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--require-signed]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f] [--checksum CHECKSUM] [--signature SIGNATURE_PATH] [--require-signed]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nSIGNED PLUGINS:\n   Signatures are verified against the publisher keys listed under PluginTrustedKeys in the config file.\n   Setting PluginRequireSigned to true in the config file requires a verified signature for every install, even with -f.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo\n   CF_NAME install-plugin ~/Downloads/plugin-foobar --checksum sha256:CHECKSUM --signature ~/Downloads/plugin-foobar.sig --require-signed"`
+	relatedCommands      interface{}                 `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
 	Actor                InstallPluginActor
@@ -75,7 +79,13 @@ func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) err
 	return nil
 }
 
-func (cmd InstallPluginCommand) Execute([]string) (err error) {
+func (cmd InstallPluginCommand) Execute([]string) error {
+	return cmd.installFrom(cmd.getPluginBinaryAndSource)
+}
+
+// installFrom installs the plugin binary returned by getPluginBinary, which
+// is given a temporary directory to download the binary into.
+func (cmd InstallPluginCommand) installFrom(getPluginBinary func(tempPluginDir string) (string, PluginSource, error)) (err error) {
 	log.WithField("PluginHome", cmd.Config.PluginHome()).Info("making plugin dir")
 
 	var tempPluginDir string
@@ -112,7 +122,7 @@ func (cmd InstallPluginCommand) Execute([]string) (err error) {
 		return err
 	}
 
-	tempPluginPath, pluginSource, err := cmd.getPluginBinaryAndSource(tempPluginDir)
+	tempPluginPath, pluginSource, err := getPluginBinary(tempPluginDir)
 	if _, ok := err.(cancelInstall); ok {
 		cmd.UI.DisplayText("Plugin installation cancelled.")
 		return nil
@@ -303,6 +313,11 @@ func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (s
 		return "", 0, err
 	}
 
+	err = cmd.verifyPluginBinary(pluginLocation, "")
+	if err != nil {
+		return "", 0, err
+	}
+
 	return pluginLocation, PluginFromLocalFile, err
 }
 
@@ -321,6 +336,11 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 		return "", 0, err
 	}
 
+	err = cmd.verifyPluginBinary(tempPath, "")
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromURL, err
 }
 
@@ -381,9 +401,80 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, translatableerror.InvalidChecksumError{}
 	}
 
+	err = cmd.verifyPluginBinary(tempPath, pluginInfo.Signature)
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromRepository, err
 }
 
+func (cmd InstallPluginCommand) getPluginFromLock(lockedPlugin pluginaction.LockedPlugin, binary pluginaction.LockedPluginBinary, tempPluginDir string) (string, PluginSource, error) {
+	err := cmd.installPluginPrompt("Do you want to install {{.Path}} {{.PluginVersion}}?", map[string]interface{}{
+		"Path":          lockedPlugin.Name,
+		"PluginVersion": lockedPlugin.Version,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": lockedPlugin.Repository.Name,
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(binary.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if !cmd.Actor.ValidateFileChecksum(tempPath, binary.Checksum) {
+		return "", 0, translatableerror.InvalidChecksumError{}
+	}
+
+	err = cmd.verifyPluginBinary(tempPath, binary.Signature)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return tempPath, PluginFromRepository, nil
+}
+
+// verifyPluginBinary checks the plugin binary against the provided checksum
+// and its signature before the binary is executed. The --signature flag takes
+// precedence over the signature published in a repository. A signature that
+// cannot be verified is always an error, while a missing signature is only an
+// error when signed plugins are required, even if -f is provided.
+func (cmd InstallPluginCommand) verifyPluginBinary(path string, signature string) error {
+	if cmd.Checksum != "" && !cmd.Actor.ValidateFileChecksum(path, cmd.Checksum) {
+		return translatableerror.PluginChecksumMismatchError{}
+	}
+
+	if cmd.Signature != "" {
+		rawSignature, err := ioutil.ReadFile(string(cmd.Signature))
+		if err != nil {
+			return err
+		}
+		signature = string(rawSignature)
+	}
+
+	if signature == "" {
+		if cmd.RequireSigned || cmd.Config.PluginRequireSigned() {
+			return translatableerror.PluginSignatureRequiredError{}
+		}
+		return nil
+	}
+
+	keyName, err := cmd.Actor.VerifyFileSignature(path, signature)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Plugin signature verified with trusted key {{.KeyName}}.", map[string]interface{}{
+		"KeyName": keyName,
+	})
+	return nil
+}
+
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
 	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
 	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -121,6 +122,131 @@ var _ = Describe("install-plugin command", func() {
 					})
 				})
 
+				When("the plugin binary is verified", func() {
+					var signaturePath string
+
+					BeforeEach(func() {
+						fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+
+						signatureFile, err := ioutil.TempFile("", "some-signature")
+						Expect(err).NotTo(HaveOccurred())
+						_, err = signatureFile.WriteString("some-signature")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(signatureFile.Close()).To(Succeed())
+						signaturePath = signatureFile.Name()
+					})
+
+					AfterEach(func() {
+						os.Remove(signaturePath)
+					})
+
+					When("the --checksum flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Checksum = "sha256:some-checksum"
+						})
+
+						When("the checksum matches", func() {
+							BeforeEach(func() {
+								fakeActor.ValidateFileChecksumReturns(true)
+							})
+
+							It("installs the plugin", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(1))
+								path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+								Expect(path).To(Equal("some-path"))
+								Expect(checksum).To(Equal("sha256:some-checksum"))
+								Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+							})
+						})
+
+						When("the checksum does not match", func() {
+							BeforeEach(func() {
+								fakeActor.ValidateFileChecksumReturns(false)
+							})
+
+							It("returns a PluginChecksumMismatchError without running the plugin", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{}))
+
+								Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+								Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					When("the --signature flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Signature = flag.PathWithExistenceCheck(signaturePath)
+						})
+
+						When("the signature is verified", func() {
+							BeforeEach(func() {
+								fakeActor.VerifyFileSignatureReturns("some-publisher", nil)
+							})
+
+							It("displays the signing key and installs the plugin", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.VerifyFileSignatureCallCount()).To(Equal(1))
+								path, signature := fakeActor.VerifyFileSignatureArgsForCall(0)
+								Expect(path).To(Equal("some-path"))
+								Expect(signature).To(Equal("some-signature"))
+
+								Expect(testUI.Out).To(Say(`Plugin signature verified with trusted key some-publisher\.`))
+								Expect(testUI.Out).To(Say(`Installing plugin some-plugin\.\.\.`))
+							})
+						})
+
+						When("the signature cannot be verified", func() {
+							BeforeEach(func() {
+								fakeActor.VerifyFileSignatureReturns("", actionerror.PluginSignatureInvalidError{})
+							})
+
+							It("returns the error without running the plugin", func() {
+								Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+
+								Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+								Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					When("signed plugins are required by the --require-signed flag", func() {
+						BeforeEach(func() {
+							cmd.RequireSigned = true
+						})
+
+						It("returns a PluginSignatureRequiredError for an unsigned plugin", func() {
+							Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{}))
+
+							Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+
+					When("signed plugins are required by the config", func() {
+						BeforeEach(func() {
+							fakeConfig.PluginRequireSignedReturns(true)
+						})
+
+						It("returns a PluginSignatureRequiredError for an unsigned plugin", func() {
+							Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{}))
+
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+
+					When("signed plugins are not required and no signature is provided", func() {
+						It("installs the plugin without verifying a signature", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.VerifyFileSignatureCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+						})
+					})
+				})
+
 				When("the plugin is already installed", func() {
 					var (
 						plugin    configv3.Plugin
@@ -291,6 +291,34 @@ var _ = Describe("install-plugin command", func() {
 									fakeActor.ValidateFileChecksumReturns(true)
 								})
 
+								When("the repository publishes a signature for the binary", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature"}, []string{repoName}, nil)
+										fakeActor.VerifyFileSignatureReturns("", actionerror.PluginSignatureInvalidError{})
+									})
+
+									It("verifies the signature before running the plugin", func() {
+										Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+
+										Expect(fakeActor.VerifyFileSignatureCallCount()).To(Equal(1))
+										pathArg, signatureArg := fakeActor.VerifyFileSignatureArgsForCall(0)
+										Expect(pathArg).To(Equal(execPath))
+										Expect(signatureArg).To(Equal("some-signature"))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+									})
+								})
+
+								When("signed plugins are required and the binary is unsigned", func() {
+									BeforeEach(func() {
+										fakeConfig.PluginRequireSignedReturns(true)
+									})
+
+									It("returns a PluginSignatureRequiredError", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{}))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+									})
+								})
+
 								When("creating an executable copy errors", func() {
 									BeforeEach(func() {
 										fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
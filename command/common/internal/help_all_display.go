@@ -8,14 +8,15 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"profiles", "create-profile", "switch-profile", "delete-profile"},
 		},
 	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app", "create-app", "apply-manifest"},
+			{"apps", "app", "create-app", "apply-manifest", "diff-manifest"},
 			{"push", "scale", "delete", "rename"},
-			{"cancel-deployment"},
+			{"deployment", "deployments", "cancel-deployment"},
 			{"start", "stop", "restart", "stage", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"packages", "create-package"},
@@ -24,7 +25,7 @@ var HelpCategoryList = []HelpCategory{
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -143,6 +144,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "METADATA:",
 		CommandList: [][]string{
 			{"labels", "set-label", "unset-label"},
+			{"annotations", "set-annotation", "unset-annotation"},
 		},
 	},
 	{
@@ -161,6 +163,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
 			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"update-plugins", "lock-plugins"},
 		},
 	},
 }
@@ -8,6 +8,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"profiles", "create-profile", "switch-profile", "delete-profile"},
 		},
 	},
 	{
@@ -151,6 +152,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
 			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"update-plugins", "lock-plugins"},
 		},
 	},
 }
@@ -0,0 +1,26 @@
+// +build !V7
+
+package internal_test
+
+import (
+	"code.cloudfoundry.org/cli/command/common/internal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("test v6 help all display", func() {
+	It("lists the plugin update and lock commands with the other plugin commands", func() {
+		var pluginCommands []string
+		for _, category := range internal.HelpCategoryList {
+			if category.CategoryName != "ADD/REMOVE PLUGIN:" {
+				continue
+			}
+			for _, row := range category.CommandList {
+				pluginCommands = append(pluginCommands, row...)
+			}
+		}
+
+		Expect(pluginCommands).To(ContainElement("update-plugins"))
+		Expect(pluginCommands).To(ContainElement("lock-plugins"))
+	})
+})
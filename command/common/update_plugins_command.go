@@ -0,0 +1,188 @@
+package common
+
+import (
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . UpdatePluginsActor
+
+type UpdatePluginsActor interface {
+	InstallPluginActor
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	ReadPluginLock(path string) (pluginaction.PluginLock, error)
+}
+
+type UpdatePluginsCommand struct {
+	OptionalArgs      flag.UpdatePluginsArgs      `positional-args:"yes"`
+	Force             bool                        `short:"f" description:"Force update of plugins without confirmation"`
+	LockFile          flag.PathWithExistenceCheck `long:"lock-file" description:"Install the exact plugin versions pinned in this lock file instead of the latest versions"`
+	RequireSigned     bool                        `long:"require-signed" description:"Only install plugins whose signature is verified by a trusted key"`
+	SkipSSLValidation bool                        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage             interface{}                 `usage:"CF_NAME update-plugins [PLUGIN_NAME...] [-f] [--require-signed]\n   CF_NAME update-plugins [PLUGIN_NAME...] --lock-file PATH [-f] [--require-signed]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME update-plugins\n   CF_NAME update-plugins plugin-echo -f\n   CF_NAME update-plugins --lock-file plugins.lock"`
+	relatedCommands   interface{}                 `related_commands:"install-plugin, lock-plugins, plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             UpdatePluginsActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) Execute([]string) error {
+	if cmd.LockFile != "" {
+		return cmd.installLockedPlugins()
+	}
+	return cmd.updateOutdatedPlugins()
+}
+
+func (cmd UpdatePluginsCommand) updateOutdatedPlugins() error {
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	for _, name := range cmd.OptionalArgs.PluginNames {
+		if _, installed := cmd.Config.GetPluginCaseInsensitive(name); !installed {
+			return translatableerror.PluginNotFoundError{PluginName: name}
+		}
+	}
+
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...", map[string]interface{}{
+		"RepoNames": strings.Join(repoNames, ", "),
+	})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return err
+	}
+
+	var pluginsToUpdate []pluginaction.OutdatedPlugin
+	for _, outdatedPlugin := range outdatedPlugins {
+		if cmd.selected(outdatedPlugin.Name) {
+			pluginsToUpdate = append(pluginsToUpdate, outdatedPlugin)
+		}
+	}
+
+	if len(pluginsToUpdate) == 0 {
+		cmd.UI.DisplayText("All plugins are up to date.")
+		return nil
+	}
+
+	installCmd := cmd.installCommand()
+	for _, outdatedPlugin := range pluginsToUpdate {
+		cmd.UI.DisplayNewline()
+		pluginName := outdatedPlugin.Name
+		err = installCmd.installFrom(func(tempPluginDir string) (string, PluginSource, error) {
+			return installCmd.getPluginFromRepositories(pluginName, repos, tempPluginDir)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) installLockedPlugins() error {
+	lock, err := cmd.Actor.ReadPluginLock(string(cmd.LockFile))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range cmd.OptionalArgs.PluginNames {
+		if !lockContains(lock, name) {
+			return translatableerror.PluginNotFoundError{PluginName: name}
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Installing plugins pinned in {{.LockFile}}...", map[string]interface{}{
+		"LockFile": cmd.LockFile,
+	})
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	installCmd := cmd.installCommand()
+	for _, lockedPlugin := range lock.Plugins {
+		if !cmd.selected(lockedPlugin.Name) {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		if installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(lockedPlugin.Name); installed && installedPlugin.Version.String() == lockedPlugin.Version {
+			cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} is already installed.", map[string]interface{}{
+				"PluginName":    installedPlugin.Name,
+				"PluginVersion": lockedPlugin.Version,
+			})
+			continue
+		}
+
+		binary, found := lockedPlugin.BinaryForPlatform(platform)
+		if !found {
+			return translatableerror.NoCompatibleBinaryError{}
+		}
+
+		lockedPlugin := lockedPlugin
+		err = installCmd.installFrom(func(tempPluginDir string) (string, PluginSource, error) {
+			return installCmd.getPluginFromLock(lockedPlugin, binary, tempPluginDir)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) installCommand() InstallPluginCommand {
+	return InstallPluginCommand{
+		Force:         cmd.Force,
+		RequireSigned: cmd.RequireSigned,
+		UI:            cmd.UI,
+		Config:        cmd.Config,
+		Actor:         cmd.Actor,
+		ProgressBar:   cmd.ProgressBar,
+	}
+}
+
+// selected returns true if no plugin names were provided or the plugin is one
+// of the provided plugins.
+func (cmd UpdatePluginsCommand) selected(pluginName string) bool {
+	if len(cmd.OptionalArgs.PluginNames) == 0 {
+		return true
+	}
+
+	for _, name := range cmd.OptionalArgs.PluginNames {
+		if strings.EqualFold(name, pluginName) {
+			return true
+		}
+	}
+	return false
+}
+
+func lockContains(lock pluginaction.PluginLock, pluginName string) bool {
+	for _, lockedPlugin := range lock.Plugins {
+		if strings.EqualFold(lockedPlugin.Name, pluginName) {
+			return true
+		}
+	}
+	return false
+}
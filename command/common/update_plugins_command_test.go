@@ -0,0 +1,240 @@
+package common_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugins command", func() {
+	var (
+		cmd             UpdatePluginsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginsCommand{
+			Force:       true,
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}})
+
+		fakeActor.GetPlatformStringReturns("linux64")
+		fakeActor.DownloadExecutableBinaryFromURLReturns("downloaded-path", nil)
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+		fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, _ string) (configv3.Plugin, error) {
+			return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 2}}, nil
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("updating to the latest versions", func() {
+		When("there are no plugin repositories", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a NoPluginRepositoriesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			})
+		})
+
+		When("a provided plugin is not installed", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.PluginNames = []string{"not-installed"}
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundError{PluginName: "not-installed"}))
+				Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("all plugins are up to date", func() {
+			It("displays that no updates are needed", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Searching CF-Community for newer versions of installed plugins\.\.\.`))
+				Expect(testUI.Out).To(Say(`All plugins are up to date\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("plugins are outdated", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+					{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+					{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "3.0.0"},
+				}, nil)
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: "plugin-1", Version: "2.0.0", URL: "https://example.com/plugin-1", Checksum: "some-checksum"}, []string{"CF-Community"}, nil)
+				fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}}, true)
+			})
+
+			When("plugin names are provided", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.PluginNames = []string{"PLUGIN-1"}
+				})
+
+				It("updates only the provided plugins from the repositories", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+					pluginName, repos, platform := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+					Expect(pluginName).To(Equal("plugin-1"))
+					Expect(repos).To(Equal([]configv3.PluginRepository{{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}}))
+					Expect(platform).To(Equal("linux64"))
+
+					url, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+					Expect(url).To(Equal("https://example.com/plugin-1"))
+					path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+					Expect(path).To(Equal("downloaded-path"))
+					Expect(checksum).To(Equal("some-checksum"))
+
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say(`Plugin plugin-1 2\.0\.0 successfully installed\.`))
+				})
+			})
+
+			It("updates every outdated plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+				pluginName, _, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(1)
+				Expect(pluginName).To(Equal("plugin-2"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("installing the versions in a lock file", func() {
+		BeforeEach(func() {
+			cmd.LockFile = "some-dir/plugins.lock"
+			fakeActor.ReadPluginLockReturns(pluginaction.PluginLock{Plugins: []pluginaction.LockedPlugin{
+				{
+					Name:       "plugin-1",
+					Version:    "2.0.0",
+					Repository: pluginaction.LockedPluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+					Binaries: []pluginaction.LockedPluginBinary{
+						{Platform: "osx", URL: "https://example.com/plugin-1-osx", Checksum: "osx-checksum"},
+						{Platform: "linux64", URL: "https://example.com/plugin-1-linux", Checksum: "sha256:linux-checksum"},
+					},
+				},
+			}}, nil)
+		})
+
+		When("reading the lock file fails", func() {
+			BeforeEach(func() {
+				fakeActor.ReadPluginLockReturns(pluginaction.PluginLock{}, actionerror.InvalidPluginLockFileError{Path: "some-dir/plugins.lock"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginLockFileError{Path: "some-dir/plugins.lock"}))
+				Expect(fakeActor.ReadPluginLockArgsForCall(0)).To(Equal("some-dir/plugins.lock"))
+			})
+		})
+
+		When("the locked version is already installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 2}}, true)
+			})
+
+			It("does not reinstall the plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plugin plugin-1 2\.0\.0 is already installed\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a different version is installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 3}}, true)
+			})
+
+			It("installs the locked binary for the platform and verifies its checksum", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Installing plugins pinned in some-dir/plugins\.lock\.\.\.`))
+				Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository CF-Community\.\.\.`))
+
+				url, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+				Expect(url).To(Equal("https://example.com/plugin-1-linux"))
+				path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+				Expect(path).To(Equal("downloaded-path"))
+				Expect(checksum).To(Equal("sha256:linux-checksum"))
+
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+
+			When("the checksum does not match", func() {
+				BeforeEach(func() {
+					fakeActor.ValidateFileChecksumReturns(false)
+				})
+
+				It("returns an InvalidChecksumError without running the plugin", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the lock has no binary for the platform", func() {
+			BeforeEach(func() {
+				fakeActor.GetPlatformStringReturns("win64")
+			})
+
+			It("returns a NoCompatibleBinaryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoCompatibleBinaryError{}))
+			})
+		})
+
+		When("a provided plugin is not in the lock file", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.PluginNames = []string{"plugin-2"}
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundError{PluginName: "plugin-2"}))
+			})
+		})
+	})
+})
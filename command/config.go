@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/retry"
 )
 
 //go:generate counterfeiter . Config
@@ -19,27 +20,37 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	CreateProfile(name string)
+	CurrentProfile() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
+	DeleteProfile(name string)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
 	ExperimentalLogin() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
+	GetProfile(name string) (configv3.Profile, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	Locale() string
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
+	PaginationWorkers() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginRequireSigned() bool
+	PluginTrustedKeys() []configv3.PluginTrustedKey
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	Profiles() []string
 	RefreshToken() string
 	RemovePlugin(string)
 	RequestRetryCount() int
+	RequestRetryPolicy() retry.Policy
 	RoutingEndpoint() string
 	SetAccessToken(token string)
 	SetMinCLIVersion(version string)
@@ -56,6 +67,8 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	SwitchProfile(name string) error
+	SyncTokens(refresh func() error) error
 	// TODO: Rename to APITarget()
 	Target() string
 	TargetedOrganization() configv3.Organization
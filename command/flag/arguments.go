@@ -4,6 +4,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type LogsArgs struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names; the logs of every app in the space are shown with --space"`
+}
+
 type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
@@ -48,6 +52,10 @@ type PluginRepoName struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 }
 
+type ProfileName struct {
+	ProfileName string `positional-arg-name:"PROFILE_NAME" required:"true" description:"The profile name"`
+}
+
 type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
@@ -254,6 +262,24 @@ type UnsetLabelArgs struct {
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
 	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to unset on the resource"`
 }
+
+type AnnotationsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to annotate"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetAnnotationArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to annotate"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Annotations  []string `positional-arg-name:"KEY=VALUE" required:"true" description:"A space-separated list of annotations to set on the resource"`
+}
+
+type UnsetAnnotationArgs struct {
+	ResourceType   string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceName   string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	AnnotationKeys []string `positional-arg-name:"KEY" required:"true" description:"An annotation to unset on the resource"`
+}
+
 type SetOrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
@@ -312,6 +338,10 @@ type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type UpdatePluginsArgs struct {
+	PluginNames []string `positional-arg-name:"PLUGIN_NAME" description:"The names of the installed plugins to update; all outdated plugins are updated when none are provided"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -345,9 +375,14 @@ type ResetOrgDefaultIsolationArgs struct {
 }
 
 type AddNetworkPolicyArgs struct {
-	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
+	SourceApp string `positional-arg-name:"SOURCE_APP" description:"The source app"`
 }
 
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type SCPArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path, or APP_NAME:PATH in the app container, to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path, or APP_NAME:PATH in the app container, to copy to"`
+}
@@ -0,0 +1,128 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var (
+	labelSelectorSetRegexp    = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+	labelSelectorPrefixRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?)*$`)
+	labelSelectorNameRegexp   = regexp.MustCompile(`^[a-zA-Z0-9]([-_.a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+)
+
+// LabelSelector is a label selector in the grammar accepted by the Cloud
+// Controller's label_selector query parameter, for example
+// "env=prod,tier in (web,api),!canary". Each comma separated requirement is
+// one of:
+//   key, !key
+//   key=value, key==value, key!=value
+//   key in (value,...), key notin (value,...)
+type LabelSelector string
+
+func (selector *LabelSelector) UnmarshalFlag(val string) error {
+	requirements, ok := splitLabelSelector(val)
+	if !ok || len(requirements) == 0 {
+		return invalidLabelSelectorError(val)
+	}
+
+	for _, requirement := range requirements {
+		if !isValidLabelRequirement(strings.TrimSpace(requirement)) {
+			return invalidLabelSelectorError(requirement)
+		}
+	}
+
+	*selector = LabelSelector(val)
+	return nil
+}
+
+// splitLabelSelector splits the selector on the commas that are not inside
+// the parentheses of a set requirement.
+func splitLabelSelector(selector string) ([]string, bool) {
+	var (
+		requirements []string
+		depth        int
+		start        int
+	)
+
+	for i, char := range selector {
+		switch char {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, false
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, false
+			}
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, false
+	}
+
+	return append(requirements, selector[start:]), true
+}
+
+func isValidLabelRequirement(requirement string) bool {
+	if strings.HasPrefix(requirement, "!") {
+		return isValidLabelKey(strings.TrimSpace(requirement[1:]))
+	}
+
+	if matches := labelSelectorSetRegexp.FindStringSubmatch(requirement); matches != nil {
+		if !isValidLabelKey(matches[1]) {
+			return false
+		}
+		for _, value := range strings.Split(matches[3], ",") {
+			value = strings.TrimSpace(value)
+			if value == "" || !isValidLabelValue(value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, operator := range []string{"!=", "==", "="} {
+		if index := strings.Index(requirement, operator); index >= 0 {
+			key := strings.TrimSpace(requirement[:index])
+			value := strings.TrimSpace(requirement[index+len(operator):])
+			return isValidLabelKey(key) && isValidLabelValue(value)
+		}
+	}
+
+	return isValidLabelKey(requirement)
+}
+
+func isValidLabelKey(key string) bool {
+	name := key
+	if index := strings.Index(key, "/"); index >= 0 {
+		prefix := key[:index]
+		if len(prefix) > 253 || !labelSelectorPrefixRegexp.MatchString(prefix) {
+			return false
+		}
+		name = key[index+1:]
+	}
+
+	return len(name) <= 63 && labelSelectorNameRegexp.MatchString(name)
+}
+
+func isValidLabelValue(value string) bool {
+	return value == "" || (len(value) <= 63 && labelSelectorNameRegexp.MatchString(value))
+}
+
+func invalidLabelSelectorError(requirement string) error {
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Invalid label selector requirement '%s'", strings.TrimSpace(requirement)),
+	}
+}
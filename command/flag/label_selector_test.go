@@ -0,0 +1,62 @@
+package flag_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelector", func() {
+	var selector LabelSelector
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			selector = ""
+		})
+
+		DescribeTable("valid selectors",
+			func(input string) {
+				err := selector.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selector).To(Equal(LabelSelector(input)))
+			},
+
+			Entry("existence", "env"),
+			Entry("non-existence", "!canary"),
+			Entry("equality", "env=prod"),
+			Entry("double equality", "env==prod"),
+			Entry("inequality", "env!=prod"),
+			Entry("empty value", "env="),
+			Entry("set inclusion", "tier in (web,api)"),
+			Entry("set exclusion", "tier notin (web, api)"),
+			Entry("prefixed key", "example.com/env=prod"),
+			Entry("multiple requirements", "env=prod,tier in (web,api),!canary"),
+		)
+
+		DescribeTable("invalid selectors",
+			func(input string, invalidRequirement string) {
+				err := selector.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Invalid label selector requirement '%s'", invalidRequirement),
+				}))
+			},
+
+			Entry("empty selector", "", ""),
+			Entry("empty requirement", "env=prod,", ""),
+			Entry("invalid key", "-env=prod", "-env=prod"),
+			Entry("invalid value", "env=prod!", "env=prod!"),
+			Entry("key too long", strings.Repeat("a", 64), strings.Repeat("a", 64)),
+			Entry("invalid prefix", "-example.com/env", "-example.com/env"),
+			Entry("empty set", "tier in ()", "tier in ()"),
+			Entry("set without operator", "tier (web,api)", "tier (web,api)"),
+			Entry("unbalanced parentheses", "tier in (web,api", "tier in (web,api"),
+			Entry("nested parentheses", "tier in ((web))", "tier in ((web))"),
+		)
+	})
+})
@@ -0,0 +1,36 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// logSourceTypes are the top level source types of Loggregator log messages.
+var logSourceTypes = []string{"API", "APP", "CELL", "LGR", "RTR", "SSH", "STG"}
+
+// LogSource is a log message source type such as APP, APP/PROC/WEB or RTR.
+// It is normalized to upper case.
+type LogSource string
+
+func (LogSource) Complete(prefix string) []flags.Completion {
+	return completions(logSourceTypes, prefix, false)
+}
+
+func (source *LogSource) UnmarshalFlag(val string) error {
+	normalized := strings.ToUpper(strings.TrimSpace(val))
+	topLevel := strings.SplitN(normalized, "/", 2)[0]
+
+	for _, sourceType := range logSourceTypes {
+		if topLevel == sourceType {
+			*source = LogSource(normalized)
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("SOURCE must be one of: %s", strings.Join(logSourceTypes, ", ")),
+	}
+}
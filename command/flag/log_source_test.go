@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var source LogSource
+
+	Describe("Complete", func() {
+		It("completes the top level source types", func() {
+			Expect(source.Complete("s")).To(ConsistOf(
+				flags.Completion{Item: "SSH"},
+				flags.Completion{Item: "STG"},
+			))
+		})
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			source = ""
+		})
+
+		DescribeTable("valid sources are normalized to upper case",
+			func(input string, expected LogSource) {
+				Expect(source.UnmarshalFlag(input)).To(Succeed())
+				Expect(source).To(Equal(expected))
+			},
+
+			Entry("app", "app", LogSource("APP")),
+			Entry("nested app process", "app/proc/web", LogSource("APP/PROC/WEB")),
+			Entry("router", "RTR", LogSource("RTR")),
+			Entry("cell", "Cell", LogSource("CELL")),
+		)
+
+		It("errors on an unknown source", func() {
+			err := source.UnmarshalFlag("nope")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "SOURCE must be one of: API, APP, CELL, LGR, RTR, SSH, STG",
+			}))
+			Expect(source).To(BeEmpty())
+		})
+	})
+})
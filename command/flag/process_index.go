@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ProcessIndex is the index of an app process instance. Use IsSet to tell an
+// explicit index of 0 apart from the flag being left out.
+type ProcessIndex struct {
+	types.NullUint64
+}
+
+func (i *ProcessIndex) UnmarshalFlag(val string) error {
+	err := i.ParseStringValue(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '-i' (expected int >= 0)",
+		}
+	}
+	return nil
+}
+
+func (i *ProcessIndex) IsValidValue(val string) error {
+	return i.UnmarshalFlag(val)
+}
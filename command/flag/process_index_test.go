@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProcessIndex", func() {
+	var processIndex ProcessIndex
+
+	BeforeEach(func() {
+		processIndex = ProcessIndex{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("the empty string is provided", func() {
+			It("sets IsSet to false", func() {
+				err := processIndex.IsValidValue("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(processIndex).To(Equal(ProcessIndex{NullUint64: types.NullUint64{Value: 0, IsSet: false}}))
+			})
+		})
+
+		When("an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := processIndex.IsValidValue("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-i' (expected int >= 0)",
+				}))
+				Expect(processIndex).To(Equal(ProcessIndex{}))
+			})
+		})
+
+		When("a negative integer is provided", func() {
+			It("returns an error", func() {
+				err := processIndex.IsValidValue("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-i' (expected int >= 0)",
+				}))
+				Expect(processIndex).To(Equal(ProcessIndex{}))
+			})
+		})
+
+		When("0 is provided", func() {
+			It("stores the index and sets IsSet to true", func() {
+				err := processIndex.IsValidValue("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(processIndex).To(Equal(ProcessIndex{NullUint64: types.NullUint64{Value: 0, IsSet: true}}))
+			})
+		})
+	})
+})
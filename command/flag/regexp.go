@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Regexp is a regular expression in the syntax accepted by the regexp
+// package.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	compiled, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Invalid regular expression '%s': %s", val, err),
+		}
+	}
+
+	r.Regexp = compiled
+	return nil
+}
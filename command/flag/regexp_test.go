@@ -0,0 +1,32 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var pattern Regexp
+
+	BeforeEach(func() {
+		pattern = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("compiles the regular expression", func() {
+			Expect(pattern.UnmarshalFlag(`GET /health \d+`)).To(Succeed())
+			Expect(pattern.MatchString("GET /health 200")).To(BeTrue())
+		})
+
+		It("errors on an invalid regular expression", func() {
+			err := pattern.UnmarshalFlag("(unclosed")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Invalid regular expression '(unclosed': error parsing regexp: missing closing ): `(unclosed`",
+			}))
+			Expect(pattern.Regexp).To(BeNil())
+		})
+	})
+})
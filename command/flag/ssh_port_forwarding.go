@@ -16,30 +16,81 @@ type SSHPortForwarding struct {
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
-		}
+	bindAddress, targetAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
 	}
 
+	s.LocalAddress = bindAddress
+	s.RemoteAddress = targetAddress
+	return nil
+}
+
+// SSHRemotePortForwarding listens on RemoteAddress inside the app container
+// and forwards connections to LocalAddress on the local machine.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	bindAddress, targetAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = bindAddress
+	s.LocalAddress = targetAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is the local address of a SOCKS proxy that
+// connects from inside the app container.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
+
 	re := regexp.MustCompile(`^\d+$`)
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && re.MatchString(splitHosts[1]):
+		s.LocalAddress = val
 	default:
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
 	return nil
 }
+
+// parseForwardingSpecification splits a [BIND_ADDRESS:]PORT:HOST:HOSTPORT
+// specification into the address to listen on and the address to connect to.
+func parseForwardingSpecification(val string, direction string) (string, string, error) {
+	specificationErr := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", direction, val),
+	}
+
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", specificationErr
+		}
+	}
+
+	re := regexp.MustCompile(`^\d+$`)
+	switch {
+	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]), fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]), nil
+	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]), fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]), nil
+	default:
+		return "", "", specificationErr
+	}
+}
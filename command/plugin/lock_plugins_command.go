@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . LockPluginsActor
+
+type LockPluginsActor interface {
+	CreatePluginLock() (pluginaction.PluginLock, []string, error)
+	WritePluginLock(path string, lock pluginaction.PluginLock) error
+}
+
+type LockPluginsCommand struct {
+	Path              string      `long:"path" default:"plugins.lock" description:"Path of the lock file to write"`
+	usage             interface{} `usage:"CF_NAME lock-plugins [--path PATH]\n\nEXAMPLES:\n   CF_NAME lock-plugins --path ~/workstation/plugins.lock\n   CF_NAME update-plugins --lock-file ~/workstation/plugins.lock"`
+	relatedCommands   interface{} `related_commands:"plugins, update-plugins"`
+	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	UI                command.UI
+	Config            command.Config
+	Actor             LockPluginsActor
+}
+
+func (cmd *LockPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	pluginClient := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
+	return nil
+}
+
+func (cmd LockPluginsCommand) Execute([]string) error {
+	if len(cmd.Config.PluginRepositories()) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	cmd.UI.DisplayText("Locking installed plugins to their versions in the registered plugin repositories...")
+
+	lock, unlockedPlugins, err := cmd.Actor.CreatePluginLock()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.WritePluginLock(cmd.Path, lock)
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{"plugin", "version", "repository"}}
+	for _, lockedPlugin := range lock.Plugins {
+		table = append(table, []string{lockedPlugin.Name, lockedPlugin.Version, lockedPlugin.Repository.Name})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	for _, pluginName := range unlockedPlugins {
+		cmd.UI.DisplayWarning("Plugin {{.PluginName}} was not locked because its installed version is not in any registered repository.", map[string]interface{}{
+			"PluginName": pluginName,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Plugin lock file written to {{.Path}}.", map[string]interface{}{
+		"Path": cmd.Path,
+	})
+	cmd.UI.DisplayText("Use '{{.BinaryName}} update-plugins --lock-file {{.Path}}' to install the locked plugins.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Path":       cmd.Path,
+	})
+
+	return nil
+}
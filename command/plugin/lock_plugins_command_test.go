@@ -0,0 +1,88 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("lock-plugins command", func() {
+	var (
+		cmd        LockPluginsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeLockPluginsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeLockPluginsActor)
+		cmd = LockPluginsCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor, Path: "some-dir/plugins.lock"}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no plugin repositories", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			Expect(fakeActor.WritePluginLockCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the installed plugins are locked", func() {
+		var lock pluginaction.PluginLock
+
+		BeforeEach(func() {
+			lock = pluginaction.PluginLock{Plugins: []pluginaction.LockedPlugin{
+				{Name: "plugin-1", Version: "1.0.0", Repository: pluginaction.LockedPluginRepository{Name: "CF-Community"}},
+			}}
+			fakeActor.CreatePluginLockReturns(lock, []string{"plugin-2"}, nil)
+		})
+
+		It("writes the lock file and displays the locked plugins", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.WritePluginLockCallCount()).To(Equal(1))
+			path, writtenLock := fakeActor.WritePluginLockArgsForCall(0)
+			Expect(path).To(Equal("some-dir/plugins.lock"))
+			Expect(writtenLock).To(Equal(lock))
+
+			Expect(testUI.Out).To(Say(`Locking installed plugins to their versions in the registered plugin repositories\.\.\.`))
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+repository`))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.0\.0\s+CF-Community`))
+			Expect(testUI.Out).To(Say(`Plugin lock file written to some-dir/plugins.lock\.`))
+			Expect(testUI.Out).To(Say(`Use 'faceman update-plugins --lock-file some-dir/plugins.lock' to install the locked plugins\.`))
+			Expect(testUI.Err).To(Say(`Plugin plugin-2 was not locked because its installed version is not in any registered repository\.`))
+		})
+	})
+
+	When("writing the lock file fails", func() {
+		BeforeEach(func() {
+			fakeActor.WritePluginLockReturns(errors.New("write-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("write-error"))
+		})
+	})
+})
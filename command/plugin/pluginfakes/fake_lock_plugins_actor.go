@@ -0,0 +1,187 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/plugin"
+)
+
+type FakeLockPluginsActor struct {
+	CreatePluginLockStub        func() (pluginaction.PluginLock, []string, error)
+	createPluginLockMutex       sync.RWMutex
+	createPluginLockArgsForCall []struct {
+	}
+	createPluginLockReturns struct {
+		result1 pluginaction.PluginLock
+		result2 []string
+		result3 error
+	}
+	createPluginLockReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginLock
+		result2 []string
+		result3 error
+	}
+	WritePluginLockStub        func(string, pluginaction.PluginLock) error
+	writePluginLockMutex       sync.RWMutex
+	writePluginLockArgsForCall []struct {
+		arg1 string
+		arg2 pluginaction.PluginLock
+	}
+	writePluginLockReturns struct {
+		result1 error
+	}
+	writePluginLockReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLockPluginsActor) CreatePluginLock() (pluginaction.PluginLock, []string, error) {
+	fake.createPluginLockMutex.Lock()
+	ret, specificReturn := fake.createPluginLockReturnsOnCall[len(fake.createPluginLockArgsForCall)]
+	fake.createPluginLockArgsForCall = append(fake.createPluginLockArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatePluginLock", []interface{}{})
+	fake.createPluginLockMutex.Unlock()
+	if fake.CreatePluginLockStub != nil {
+		return fake.CreatePluginLockStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createPluginLockReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLockPluginsActor) CreatePluginLockCallCount() int {
+	fake.createPluginLockMutex.RLock()
+	defer fake.createPluginLockMutex.RUnlock()
+	return len(fake.createPluginLockArgsForCall)
+}
+
+func (fake *FakeLockPluginsActor) CreatePluginLockCalls(stub func() (pluginaction.PluginLock, []string, error)) {
+	fake.createPluginLockMutex.Lock()
+	defer fake.createPluginLockMutex.Unlock()
+	fake.CreatePluginLockStub = stub
+}
+
+func (fake *FakeLockPluginsActor) CreatePluginLockReturns(result1 pluginaction.PluginLock, result2 []string, result3 error) {
+	fake.createPluginLockMutex.Lock()
+	defer fake.createPluginLockMutex.Unlock()
+	fake.CreatePluginLockStub = nil
+	fake.createPluginLockReturns = struct {
+		result1 pluginaction.PluginLock
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLockPluginsActor) CreatePluginLockReturnsOnCall(i int, result1 pluginaction.PluginLock, result2 []string, result3 error) {
+	fake.createPluginLockMutex.Lock()
+	defer fake.createPluginLockMutex.Unlock()
+	fake.CreatePluginLockStub = nil
+	if fake.createPluginLockReturnsOnCall == nil {
+		fake.createPluginLockReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginLock
+			result2 []string
+			result3 error
+		})
+	}
+	fake.createPluginLockReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginLock
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLock(arg1 string, arg2 pluginaction.PluginLock) error {
+	fake.writePluginLockMutex.Lock()
+	ret, specificReturn := fake.writePluginLockReturnsOnCall[len(fake.writePluginLockArgsForCall)]
+	fake.writePluginLockArgsForCall = append(fake.writePluginLockArgsForCall, struct {
+		arg1 string
+		arg2 pluginaction.PluginLock
+	}{arg1, arg2})
+	fake.recordInvocation("WritePluginLock", []interface{}{arg1, arg2})
+	fake.writePluginLockMutex.Unlock()
+	if fake.WritePluginLockStub != nil {
+		return fake.WritePluginLockStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.writePluginLockReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLockCallCount() int {
+	fake.writePluginLockMutex.RLock()
+	defer fake.writePluginLockMutex.RUnlock()
+	return len(fake.writePluginLockArgsForCall)
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLockCalls(stub func(string, pluginaction.PluginLock) error) {
+	fake.writePluginLockMutex.Lock()
+	defer fake.writePluginLockMutex.Unlock()
+	fake.WritePluginLockStub = stub
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLockArgsForCall(i int) (string, pluginaction.PluginLock) {
+	fake.writePluginLockMutex.RLock()
+	defer fake.writePluginLockMutex.RUnlock()
+	argsForCall := fake.writePluginLockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLockReturns(result1 error) {
+	fake.writePluginLockMutex.Lock()
+	defer fake.writePluginLockMutex.Unlock()
+	fake.WritePluginLockStub = nil
+	fake.writePluginLockReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLockPluginsActor) WritePluginLockReturnsOnCall(i int, result1 error) {
+	fake.writePluginLockMutex.Lock()
+	defer fake.writePluginLockMutex.Unlock()
+	fake.WritePluginLockStub = nil
+	if fake.writePluginLockReturnsOnCall == nil {
+		fake.writePluginLockReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writePluginLockReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLockPluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createPluginLockMutex.RLock()
+	defer fake.createPluginLockMutex.RUnlock()
+	fake.writePluginLockMutex.RLock()
+	defer fake.writePluginLockMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLockPluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.LockPluginsActor = new(FakeLockPluginsActor)
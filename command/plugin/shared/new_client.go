@@ -26,7 +26,7 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	pluginClient.WrapConnection(wrapper.NewRetryRequest(config.RequestRetryCount()))
+	pluginClient.WrapConnection(wrapper.NewRetryRequest(config.RequestRetryPolicy()))
 
 	return pluginClient
 }
@@ -87,6 +87,8 @@ func ConvertToTranslatableError(err error) error {
 		return FileNotFoundError(e)
 	case actionerror.NoOrganizationTargetedError:
 		return NoOrganizationTargetedError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return NoRunningProcessInstancesError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
 	case actionerror.NotLoggedInError:
@@ -103,6 +105,8 @@ func ConvertToTranslatableError(err error) error {
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginSignatureInvalidError:
+		return PluginSignatureInvalidError{}
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -144,6 +148,10 @@ func ConvertToTranslatableError(err error) error {
 		return StackNotFoundError(e)
 	case actionerror.StagingTimeoutError:
 		return StagingTimeoutError(e)
+	case actionerror.TaskFailedError:
+		return TaskFailedError(e)
+	case actionerror.TaskTimeoutError:
+		return TaskTimeoutError(e)
 	case actionerror.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
 	case actionerror.TCPRouteOptionsNotProvidedError:
@@ -218,6 +226,8 @@ func ConvertToTranslatableError(err error) error {
 	// SSH Errors
 	case ssherror.UnableToAuthenticateError:
 		return SSHUnableToAuthenticateError{}
+	case ssherror.IsDirectoryError:
+		return SSHCopyDirectoryError{Path: e.Path}
 
 	// UAA Errors
 	case uaa.UnauthorizedError:
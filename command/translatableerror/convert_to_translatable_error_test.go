@@ -165,6 +165,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoOrganizationTargetedError{BinaryName: "faceman"},
 			NoOrganizationTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.NoSpaceTargetedError -> NoSpaceTargetedError",
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
@@ -211,6 +215,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginSignatureInvalidError -> PluginSignatureInvalidError",
+			actionerror.PluginSignatureInvalidError{},
+			PluginSignatureInvalidError{}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -280,6 +288,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("actionerror.TaskFailedError -> TaskFailedError",
+			actionerror.TaskFailedError{SequenceID: 3, Name: "some-task", FailureReason: "Exited with status 1"},
+			TaskFailedError{SequenceID: 3, Name: "some-task", FailureReason: "Exited with status 1"}),
+
+		Entry("actionerror.TaskTimeoutError -> TaskTimeoutError",
+			actionerror.TaskTimeoutError{SequenceID: 3, Name: "some-task", Timeout: time.Minute},
+			TaskTimeoutError{SequenceID: 3, Name: "some-task", Timeout: time.Minute}),
+
 		Entry("actionerror.TaskWorkersUnavailableError -> RunTaskError",
 			actionerror.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
@@ -429,6 +445,9 @@ var _ = Describe("ConvertToTranslatableError", func() {
 		Entry("ssherror.UnableToAuthenticateError -> UnableToAuthenticateError",
 			ssherror.UnableToAuthenticateError{},
 			SSHUnableToAuthenticateError{}),
+		Entry("ssherror.IsDirectoryError -> SSHCopyDirectoryError",
+			ssherror.IsDirectoryError{Path: "some-path"},
+			SSHCopyDirectoryError{Path: "some-path"}),
 
 		// UAA Errors
 		Entry("uaa.BadCredentialsError -> BadCredentialsError",
@@ -0,0 +1,15 @@
+package translatableerror
+
+type DeleteCurrentProfileError struct {
+	Name string
+}
+
+func (DeleteCurrentProfileError) Error() string {
+	return "Cannot delete profile '{{.Name}}' because it is in use. Switch to another profile first."
+}
+
+func (e DeleteCurrentProfileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ManifestDriftError is returned by diff-manifest when applying the manifest
+// would change at least one app, so that the command exits non-zero.
+type ManifestDriftError struct {
+	AppCount int
+}
+
+func (ManifestDriftError) Error() string {
+	return "Applying the manifest would change {{.AppCount}} app(s)."
+}
+
+func (e ManifestDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppCount": e.AppCount,
+	})
+}
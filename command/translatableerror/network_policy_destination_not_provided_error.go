@@ -0,0 +1,13 @@
+package translatableerror
+
+type NetworkPolicyDestinationNotProvidedError struct{}
+
+func (NetworkPolicyDestinationNotProvidedError) DisplayUsage() {}
+
+func (NetworkPolicyDestinationNotProvidedError) Error() string {
+	return "Incorrect Usage: --destination-app, --destination-labels or --all-apps-in-space must be provided"
+}
+
+func (e NetworkPolicyDestinationNotProvidedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+type NetworkPolicySourceNotProvidedError struct{}
+
+func (NetworkPolicySourceNotProvidedError) DisplayUsage() {}
+
+func (NetworkPolicySourceNotProvidedError) Error() string {
+	return "Incorrect Usage: SOURCE_APP, --source-labels or --all-apps-in-space must be provided"
+}
+
+func (e NetworkPolicySourceNotProvidedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
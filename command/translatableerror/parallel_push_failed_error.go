@@ -0,0 +1,20 @@
+package translatableerror
+
+import "strings"
+
+// ParallelPushFailedError is returned by push --parallel after every app has
+// finished when at least one of them failed to push. The error of each app is
+// displayed with that app's output.
+type ParallelPushFailedError struct {
+	AppNames []string
+}
+
+func (ParallelPushFailedError) Error() string {
+	return "Failed to push apps: {{.AppNames}}"
+}
+
+func (e ParallelPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
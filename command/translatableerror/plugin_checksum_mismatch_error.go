@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginChecksumMismatchError is returned when the plugin binary does not
+// match the checksum provided with --checksum.
+type PluginChecksumMismatchError struct{}
+
+func (PluginChecksumMismatchError) Error() string {
+	return "Plugin binary's checksum does not match the provided checksum."
+}
+
+func (e PluginChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginSignatureInvalidError is returned when the plugin binary's signature
+// cannot be verified against any trusted plugin publisher key.
+type PluginSignatureInvalidError struct{}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Plugin binary's signature could not be verified against any trusted key.\nPlease try again or contact the plugin author."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginSignatureRequiredError is returned when signed plugins are required
+// and the plugin binary has no signature.
+type PluginSignatureRequiredError struct{}
+
+func (PluginSignatureRequiredError) Error() string {
+	return "Plugin could not be installed. Only signed plugins may be installed.\nTIP: Use '--signature' to provide the plugin binary's signature."
+}
+
+func (e PluginSignatureRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
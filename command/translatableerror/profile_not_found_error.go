@@ -0,0 +1,15 @@
+package translatableerror
+
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (ProfileNotFoundError) Error() string {
+	return "Profile '{{.Name}}' not found."
+}
+
+func (e ProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SSHCopyDirectoryError struct {
+	Path string
+}
+
+func (SSHCopyDirectoryError) Error() string {
+	return "{{.Path}} is a directory. Use --recursive to copy directories."
+}
+
+func (e SSHCopyDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
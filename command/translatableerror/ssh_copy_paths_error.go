@@ -0,0 +1,13 @@
+package translatableerror
+
+// SSHCopyPathsError is returned when neither or both of the paths given to
+// scp are in an app container.
+type SSHCopyPathsError struct{}
+
+func (SSHCopyPathsError) Error() string {
+	return "Incorrect Usage: exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH"
+}
+
+func (e SSHCopyPathsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// SSHInstancesFailedError is returned when a command run on every instance of
+// a process fails on some of them.
+type SSHInstancesFailedError struct {
+	FailedCount   int
+	InstanceCount int
+}
+
+func (SSHInstancesFailedError) Error() string {
+	return "Command failed on {{.FailedCount}} of {{.InstanceCount}} instances."
+}
+
+func (e SSHInstancesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount":   e.FailedCount,
+		"InstanceCount": e.InstanceCount,
+	})
+}
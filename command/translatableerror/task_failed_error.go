@@ -0,0 +1,19 @@
+package translatableerror
+
+type TaskFailedError struct {
+	SequenceID    int
+	Name          string
+	FailureReason string
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.SequenceID}} ({{.TaskName}}) failed: {{.FailureReason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID":    e.SequenceID,
+		"TaskName":      e.Name,
+		"FailureReason": e.FailureReason,
+	})
+}
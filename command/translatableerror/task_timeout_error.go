@@ -0,0 +1,21 @@
+package translatableerror
+
+import "time"
+
+type TaskTimeoutError struct {
+	SequenceID int
+	Name       string
+	Timeout    time.Duration
+}
+
+func (TaskTimeoutError) Error() string {
+	return "Task {{.SequenceID}} ({{.TaskName}}) did not finish within {{.Timeout}} and was terminated."
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID": e.SequenceID,
+		"TaskName":   e.Name,
+		"Timeout":    e.Timeout.String(),
+	})
+}
@@ -76,7 +76,9 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
 		Entry("MissingCredentialsError", MissingCredentialsError{}),
 		Entry("MultiError", MultiError{}),
+		Entry("NetworkPolicyDestinationNotProvidedError", NetworkPolicyDestinationNotProvidedError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
+		Entry("NetworkPolicySourceNotProvidedError", NetworkPolicySourceNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
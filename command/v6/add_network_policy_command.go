@@ -1,6 +1,9 @@
 package v6
 
 import (
+	"fmt"
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -8,12 +11,15 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . AddNetworkPolicyActor
 
 type AddNetworkPolicyActor interface {
 	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	ApplyNetworkPolicyPlan(plan cfnetworkingaction.NetworkPolicyPlan) error
+	PlanNetworkPolicies(src cfnetworkingaction.AppSelector, dest cfnetworkingaction.AppSelector, protocol string, startPort int, endPort int, prune bool) (cfnetworkingaction.NetworkPolicyPlan, cfnetworkingaction.Warnings, error)
 }
 
 //go:generate counterfeiter . MembershipActor
@@ -24,15 +30,20 @@ type MembershipActor interface {
 }
 
 type AddNetworkPolicyCommand struct {
-	RequiredArgs   flag.AddNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
-	Protocol       flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+	RequiredArgs      flag.AddNetworkPolicyArgs `positional-args:"yes"`
+	AllAppsInSpace    bool                      `long:"all-apps-in-space" description:"Use every app in the space as the source or destination apps when they are not otherwise given"`
+	DestinationApp    string                    `long:"destination-app" description:"Name of app to connect to"`
+	DestinationLabels string                    `long:"destination-labels" description:"Selector to choose the apps to connect to by label"`
+	DryRun            bool                      `long:"dry-run" description:"Show the policies that would be added or removed without changing them"`
+	Port              flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
+	Protocol          flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+	Prune             bool                      `long:"prune" description:"Remove policies on the same protocol and ports that involve a selected app but are no longer matched"`
+	SourceLabels      string                    `long:"source-labels" description:"Selector to choose the source apps in the targeted space by label"`
 
 	DestinationOrg   string `short:"o" description:"The org of the destination app (Default: targeted org)"`
 	DestinationSpace string `short:"s" description:"The space of the destination app (Default: targeted space)"`
 
-	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend -s backend-space -o backend-org --protocol tcp --port 8080-8090"`
+	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE]\n   CF_NAME add-network-policy (SOURCE_APP | --source-labels SELECTOR) (--destination-app DESTINATION_APP | --destination-labels SELECTOR) [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE] [--prune] [--dry-run]\n   CF_NAME add-network-policy --all-apps-in-space [--protocol (tcp | udp) --port RANGE] [--prune] [--dry-run]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend -s backend-space -o backend-org --protocol tcp --port 8080-8090\n   CF_NAME add-network-policy --source-labels tier=web --destination-labels tier=api --prune\n   CF_NAME add-network-policy --all-apps-in-space --dry-run"`
 	relatedCommands interface{} `related_commands:"apps, network-policies, remove-network-policy"`
 
 	UI                 command.UI
@@ -72,6 +83,16 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 		return translatableerror.NetworkPolicyProtocolOrPortNotProvidedError{}
 	case cmd.DestinationOrg != "" && cmd.DestinationSpace == "":
 		return translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}
+	case cmd.RequiredArgs.SourceApp != "" && cmd.SourceLabels != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"SOURCE_APP", "--source-labels"}}
+	case cmd.DestinationApp != "" && cmd.DestinationLabels != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--destination-app", "--destination-labels"}}
+	case cmd.AllAppsInSpace && (cmd.RequiredArgs.SourceApp != "" || cmd.SourceLabels != "") && (cmd.DestinationApp != "" || cmd.DestinationLabels != ""):
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all-apps-in-space", "SOURCE_APP or --source-labels", "--destination-app or --destination-labels"}}
+	case !cmd.AllAppsInSpace && cmd.RequiredArgs.SourceApp == "" && cmd.SourceLabels == "":
+		return translatableerror.NetworkPolicySourceNotProvidedError{}
+	case !cmd.AllAppsInSpace && cmd.DestinationApp == "" && cmd.DestinationLabels == "":
+		return translatableerror.NetworkPolicyDestinationNotProvidedError{}
 	case cmd.Protocol.Protocol == "" && cmd.Port.StartPort == 0 && cmd.Port.EndPort == 0:
 		cmd.Protocol.Protocol = "tcp"
 		cmd.Port.StartPort = 8080
@@ -118,6 +139,10 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.selectsAppSets() {
+		return cmd.addNetworkPolicies(destSpaceGUID, displayDestinationOrg, user.Name)
+	}
+
 	if cmd.DestinationSpace != "" {
 		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DstAppName}} in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
 			"SrcAppName": cmd.RequiredArgs.SourceApp,
@@ -147,3 +172,102 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// selectsAppSets returns true when the policies are between sets of apps, or
+// should be previewed or pruned, rather than a single source and destination.
+func (cmd AddNetworkPolicyCommand) selectsAppSets() bool {
+	return cmd.SourceLabels != "" || cmd.DestinationLabels != "" || cmd.AllAppsInSpace || cmd.Prune || cmd.DryRun
+}
+
+func (cmd AddNetworkPolicyCommand) addNetworkPolicies(destSpaceGUID string, displayDestinationOrg string, userName string) error {
+	destSpaceName := cmd.Config.TargetedSpace().Name
+	if cmd.DestinationSpace != "" {
+		destSpaceName = cmd.DestinationSpace
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Adding network policies from apps in org {{.Org}} / space {{.Space}} to apps in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
+		"Org":      cmd.Config.TargetedOrganization().Name,
+		"Space":    cmd.Config.TargetedSpace().Name,
+		"DstOrg":   displayDestinationOrg,
+		"DstSpace": destSpaceName,
+		"User":     userName,
+	})
+
+	plan, warnings, err := cmd.NetworkPolicyActor.PlanNetworkPolicies(
+		cfnetworkingaction.AppSelector{
+			SpaceGUID:     cmd.Config.TargetedSpace().GUID,
+			AppName:       cmd.RequiredArgs.SourceApp,
+			LabelSelector: cmd.SourceLabels,
+		},
+		cfnetworkingaction.AppSelector{
+			SpaceGUID:     destSpaceGUID,
+			AppName:       cmd.DestinationApp,
+			LabelSelector: cmd.DestinationLabels,
+		},
+		cmd.Protocol.Protocol,
+		cmd.Port.StartPort,
+		cmd.Port.EndPort,
+		cmd.Prune,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(plan.Additions) == 0 && len(plan.Removals) == 0 {
+		cmd.UI.DisplayText("No policies need to be added or removed.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayPolicies("Policies to add:", plan.Additions)
+	cmd.displayPolicies("Policies to remove:", plan.Removals)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("No policies were changed because --dry-run was given.")
+		return nil
+	}
+
+	err = cmd.NetworkPolicyActor.ApplyNetworkPolicyPlan(plan)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd AddNetworkPolicyCommand) displayPolicies(header string, policies []cfnetworkingaction.Policy) {
+	if len(policies) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText(header)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+		},
+	}
+
+	for _, policy := range policies {
+		portEntry := strconv.Itoa(policy.StartPort)
+		if policy.StartPort != policy.EndPort {
+			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+		}
+		table = append(table, []string{
+			policy.SourceName,
+			policy.DestinationName,
+			policy.Protocol,
+			portEntry,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
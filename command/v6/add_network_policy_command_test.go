@@ -1,6 +1,8 @@
 package v6_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -276,5 +278,172 @@ var _ = Describe("add-network-policy Command", func() {
 				Expect(testUI.Out).To(Say("OK"))
 			})
 		})
+
+		When("neither a source app nor source labels are given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SourceApp = ""
+			})
+
+			It("returns a NetworkPolicySourceNotProvidedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicySourceNotProvidedError{}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("neither a destination app nor destination labels are given", func() {
+			BeforeEach(func() {
+				cmd.DestinationApp = ""
+			})
+
+			It("returns a NetworkPolicyDestinationNotProvidedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicyDestinationNotProvidedError{}))
+			})
+		})
+
+		When("a source app and source labels are both given", func() {
+			BeforeEach(func() {
+				cmd.SourceLabels = "tier=web"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"SOURCE_APP", "--source-labels"}}))
+			})
+		})
+
+		When("a destination app and destination labels are both given", func() {
+			BeforeEach(func() {
+				cmd.DestinationLabels = "tier=api"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--destination-app", "--destination-labels"}}))
+			})
+		})
+
+		When("selecting apps by label", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SourceApp = ""
+				cmd.SourceLabels = "tier=web"
+				cmd.DestinationApp = ""
+				cmd.DestinationLabels = "tier=api"
+				cmd.Prune = true
+
+				fakeNetworkPolicyActor.PlanNetworkPoliciesReturns(
+					cfnetworkingaction.NetworkPolicyPlan{
+						Additions: []cfnetworkingaction.Policy{
+							{SourceName: "web", DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+						},
+						Removals: []cfnetworkingaction.Policy{
+							{SourceName: "db", DestinationName: "api", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+						},
+					},
+					cfnetworkingaction.Warnings{"some-plan-warning"},
+					nil,
+				)
+			})
+
+			It("previews and applies the planned policies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeNetworkPolicyActor.AddNetworkPolicyCallCount()).To(Equal(0))
+
+				Expect(fakeNetworkPolicyActor.PlanNetworkPoliciesCallCount()).To(Equal(1))
+				src, dest, passedProtocol, passedStartPort, passedEndPort, passedPrune := fakeNetworkPolicyActor.PlanNetworkPoliciesArgsForCall(0)
+				Expect(src).To(Equal(cfnetworkingaction.AppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=web"}))
+				Expect(dest).To(Equal(cfnetworkingaction.AppSelector{SpaceGUID: "some-space-guid", LabelSelector: "tier=api"}))
+				Expect(passedProtocol).To(Equal("tcp"))
+				Expect(passedStartPort).To(Equal(8080))
+				Expect(passedEndPort).To(Equal(8080))
+				Expect(passedPrune).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Adding network policies from apps in org some-org / space some-space to apps in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("some-plan-warning"))
+				Expect(testUI.Out).To(Say(`Policies to add:`))
+				Expect(testUI.Out).To(Say(`source\s+destination\s+protocol\s+ports`))
+				Expect(testUI.Out).To(Say(`web\s+api\s+tcp\s+8080\n`))
+				Expect(testUI.Out).To(Say(`Policies to remove:`))
+				Expect(testUI.Out).To(Say(`db\s+api\s+tcp\s+8080-8090\n`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeNetworkPolicyActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(1))
+				Expect(fakeNetworkPolicyActor.ApplyNetworkPolicyPlanArgsForCall(0).Additions).To(HaveLen(1))
+			})
+
+			When("--dry-run is given", func() {
+				BeforeEach(func() {
+					cmd.DryRun = true
+				})
+
+				It("previews the policies without changing them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Policies to add:`))
+					Expect(testUI.Out).To(Say(`No policies were changed because --dry-run was given\.`))
+					Expect(fakeNetworkPolicyActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+				})
+			})
+
+			When("there is nothing to change", func() {
+				BeforeEach(func() {
+					fakeNetworkPolicyActor.PlanNetworkPoliciesReturns(cfnetworkingaction.NetworkPolicyPlan{}, nil, nil)
+				})
+
+				It("says so and does not apply the plan", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`No policies need to be added or removed\.`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeNetworkPolicyActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+				})
+			})
+
+			When("planning fails", func() {
+				BeforeEach(func() {
+					fakeNetworkPolicyActor.PlanNetworkPoliciesReturns(cfnetworkingaction.NetworkPolicyPlan{}, cfnetworkingaction.Warnings{"some-plan-warning"}, errors.New("some-plan-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("some-plan-error"))
+					Expect(testUI.Err).To(Say("some-plan-warning"))
+					Expect(fakeNetworkPolicyActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+				})
+			})
+
+			When("applying the plan fails", func() {
+				BeforeEach(func() {
+					fakeNetworkPolicyActor.ApplyNetworkPolicyPlanReturns(errors.New("some-apply-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-apply-error"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+
+		When("--all-apps-in-space is given along with both a source and a destination", func() {
+			BeforeEach(func() {
+				cmd.AllAppsInSpace = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--all-apps-in-space", "SOURCE_APP or --source-labels", "--destination-app or --destination-labels"},
+				}))
+			})
+		})
+
+		When("selecting every app in the space", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SourceApp = ""
+				cmd.DestinationApp = "some-other-app"
+				cmd.AllAppsInSpace = true
+			})
+
+			It("uses every app in the space for the side that is not given", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				src, dest, _, _, _, passedPrune := fakeNetworkPolicyActor.PlanNetworkPoliciesArgsForCall(0)
+				Expect(src).To(Equal(cfnetworkingaction.AppSelector{SpaceGUID: "some-space-guid"}))
+				Expect(dest).To(Equal(cfnetworkingaction.AppSelector{SpaceGUID: "some-space-guid", AppName: "some-other-app"}))
+				Expect(passedPrune).To(BeFalse())
+			})
+		})
 	})
 })
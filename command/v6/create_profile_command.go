@@ -0,0 +1,47 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type CreateProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME create-profile PROFILE_NAME\n\nEXAMPLES:\n   CF_NAME create-profile staging\n   CF_NAME switch-profile staging\n   CF_NAME login -a https://api.staging.example.com"`
+	relatedCommands interface{}      `related_commands:"profiles, switch-profile"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *CreateProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd CreateProfileCommand) Execute(args []string) error {
+	profileName := cmd.RequiredArgs.ProfileName
+
+	cmd.UI.DisplayTextWithFlavor("Creating profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": profileName,
+	})
+
+	if _, exists := cmd.Config.GetProfile(profileName); exists {
+		cmd.UI.DisplayWarning("Profile {{.ProfileName}} already exists.", map[string]interface{}{
+			"ProfileName": profileName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.Config.CreateProfile(profileName)
+	cmd.UI.DisplayOK()
+
+	cmd.UI.DisplayText("TIP: Use '{{.SwitchProfileCommand}}' to use this profile, or '--profile {{.ProfileName}}' to use it for a single command.", map[string]interface{}{
+		"SwitchProfileCommand": cmd.Config.BinaryName() + " switch-profile " + profileName,
+		"ProfileName":          profileName,
+	})
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-profile Command", func() {
+	var (
+		cmd        CreateProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = CreateProfileCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ProfileName = "staging"
+
+		fakeConfig.BinaryNameReturns("faceman")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the profile does not exist", func() {
+		It("creates the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.CreateProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.CreateProfileArgsForCall(0)).To(Equal("staging"))
+
+			Expect(testUI.Out).To(Say("Creating profile staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman switch-profile staging' to use this profile, or '--profile staging' to use it for a single command\.`))
+		})
+	})
+
+	When("the profile already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{}, true)
+		})
+
+		It("warns that the profile exists and does not create it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.CreateProfileCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("Creating profile staging..."))
+			Expect(testUI.Err).To(Say("Profile staging already exists."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+})
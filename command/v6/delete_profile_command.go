@@ -0,0 +1,64 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DeleteProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-profile PROFILE_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"profiles, switch-profile"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DeleteProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd DeleteProfileCommand) Execute(args []string) error {
+	profileName := cmd.RequiredArgs.ProfileName
+
+	if profileName == cmd.Config.CurrentProfile() {
+		return translatableerror.DeleteCurrentProfileError{Name: profileName}
+	}
+
+	if !cmd.Force {
+		deleteProfile, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the profile {{.ProfileName}}, including its tokens?", map[string]interface{}{
+			"ProfileName": profileName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteProfile {
+			cmd.UI.DisplayText("Profile '{{.ProfileName}}' has not been deleted.", map[string]interface{}{
+				"ProfileName": profileName,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": profileName,
+	})
+
+	if _, exists := cmd.Config.GetProfile(profileName); !exists {
+		cmd.UI.DisplayWarning("Profile {{.ProfileName}} does not exist.", map[string]interface{}{
+			"ProfileName": profileName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.Config.DeleteProfile(profileName)
+	cmd.UI.DisplayOK()
+
+	return nil
+}
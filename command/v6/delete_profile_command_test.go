@@ -0,0 +1,120 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-profile Command", func() {
+	var (
+		cmd        DeleteProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = DeleteProfileCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ProfileName = "staging"
+
+		fakeConfig.CurrentProfileReturns("default")
+		fakeConfig.GetProfileReturns(configv3.Profile{}, true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the profile is the one in use", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentProfileReturns("staging")
+			cmd.Force = true
+		})
+
+		It("returns a DeleteCurrentProfileError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DeleteCurrentProfileError{Name: "staging"}))
+			Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the profile without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Out).To(Say("Deleting profile staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteProfileArgsForCall(0)).To(Equal("staging"))
+		})
+
+		When("the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.GetProfileReturns(configv3.Profile{}, false)
+			})
+
+			It("warns that the profile does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting profile staging..."))
+				Expect(testUI.Err).To(Say("Profile staging does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the -f flag is not provided", func() {
+		When("the user inputs yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the profile", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Really delete the profile staging, including its tokens\?`))
+				Expect(testUI.Out).To(Say("Deleting profile staging..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the user inputs no", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the profile", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Really delete the profile staging, including its tokens\?`))
+				Expect(testUI.Out).To(Say("Profile 'staging' has not been deleted."))
+
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
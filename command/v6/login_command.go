@@ -6,8 +6,10 @@ import (
 	"io"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/browserlogin"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -23,8 +25,15 @@ import (
 
 const maxLoginTries = 3
 
+// browserLoginTimeout is how long to wait for the browser to be redirected
+// back after the user has been sent to log in.
+const browserLoginTimeout = 5 * time.Minute
+
 type LoginActor interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error
+	AuthenticateWithDeviceAuthorization(authorization v3action.DeviceAuthorization) error
+	GetAuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error)
+	GetDeviceAuthorization() (v3action.DeviceAuthorization, error)
 	GetLoginPrompts() map[string]coreconfig.AuthPrompt
 	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
 	GetOrganizationSpaces(orgName string) ([]v3action.Space, v3action.Warnings, error)
@@ -33,6 +42,14 @@ type LoginActor interface {
 	SetTarget(settings v3action.TargetSettings) (v3action.Warnings, error)
 }
 
+//go:generate counterfeiter . BrowserLoginServer
+
+type BrowserLoginServer interface {
+	RedirectURI() string
+	WaitForCode(timeout time.Duration) (string, error)
+	Close() error
+}
+
 //go:generate counterfeiter . VersionChecker
 
 type VersionChecker interface {
@@ -85,6 +102,8 @@ var checkerMaker CheckerMakerFunc = func(config command.Config, ui command.UI, t
 
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in through a browser on this machine"`
+	Device            bool        `long:"device" description:"Log in by entering a code in a browser on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -92,7 +111,7 @@ type LoginCommand struct {
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a browser to log in)\n   CF_NAME login --device (CF_NAME will provide a url and a code to log in from another device)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 
 	UI           command.UI
@@ -101,6 +120,9 @@ type LoginCommand struct {
 	Checker      VersionChecker
 	CheckerMaker CheckerMaker
 	Config       command.Config
+
+	NewBrowserLoginServer func(state string) (BrowserLoginServer, error)
+	OpenBrowser           func(url string) error
 }
 
 func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
@@ -113,14 +135,25 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = actor
 	cmd.UI = ui
 	cmd.Config = config
+	cmd.NewBrowserLoginServer = func(state string) (BrowserLoginServer, error) {
+		return browserlogin.NewCallbackServer(state)
+	}
+	cmd.OpenBrowser = browserlogin.OpenBrowser
 	return nil
 }
 
 func (cmd *LoginCommand) Execute(args []string) error {
-	if !cmd.Config.ExperimentalLogin() {
-		return translatableerror.UnrefactoredCommandError{}
+	if cmd.Browser || cmd.Device {
+		err := cmd.validateLoginFlowFlags()
+		if err != nil {
+			return err
+		}
+	} else {
+		if !cmd.Config.ExperimentalLogin() {
+			return translatableerror.UnrefactoredCommandError{}
+		}
+		cmd.UI.DisplayWarning("Using experimental login command, some behavior may be different")
 	}
-	cmd.UI.DisplayWarning("Using experimental login command, some behavior may be different")
 
 	err := cmd.getAPI()
 	if err != nil {
@@ -148,7 +181,11 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	}
 
 	var authErr error
-	if cmd.SSO || cmd.SSOPasscode != "" {
+	if cmd.Browser {
+		authErr = cmd.authenticateBrowser()
+	} else if cmd.Device {
+		authErr = cmd.authenticateDevice()
+	} else if cmd.SSO || cmd.SSOPasscode != "" {
 		if cmd.SSO && cmd.SSOPasscode != "" {
 			return translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--sso"}}
 		}
@@ -397,6 +434,137 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return nil
 }
 
+// validateLoginFlowFlags checks that at most one way of logging in is given
+// along with --browser or --device.
+func (cmd *LoginCommand) validateLoginFlowFlags() error {
+	var conflicts []string
+	if cmd.Browser {
+		conflicts = append(conflicts, "--browser")
+	}
+	if cmd.Device {
+		conflicts = append(conflicts, "--device")
+	}
+	if cmd.SSO {
+		conflicts = append(conflicts, "--sso")
+	}
+	if cmd.SSOPasscode != "" {
+		conflicts = append(conflicts, "--sso-passcode")
+	}
+	if cmd.Username != "" {
+		conflicts = append(conflicts, "-u")
+	}
+	if cmd.Password != "" {
+		conflicts = append(conflicts, "-p")
+	}
+
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+
+	return nil
+}
+
+// authenticateBrowser sends the user to the login server in a browser and
+// exchanges the authorization code it redirects back to a loopback listener
+// for tokens. PKCE ties the code to this login so an intercepted code cannot
+// be exchanged by anyone else.
+func (cmd *LoginCommand) authenticateBrowser() error {
+	err := cmd.loginWithBrowser()
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd *LoginCommand) loginWithBrowser() error {
+	pkce, err := browserlogin.NewPKCE()
+	if err != nil {
+		return err
+	}
+
+	state, err := browserlogin.NewState()
+	if err != nil {
+		return err
+	}
+
+	server, err := cmd.NewBrowserLoginServer(state)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	authorizeURL, err := cmd.Actor.GetAuthorizationCodeURL(server.RedirectURI(), pkce.Challenge, state)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Opening your browser to log in. If it does not open, visit this URL:")
+	cmd.UI.DisplayText(authorizeURL)
+	cmd.UI.DisplayNewline()
+
+	err = cmd.OpenBrowser(authorizeURL)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to open a browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayText("Waiting for the browser login to complete...")
+	code, err := server.WaitForCode(browserLoginTimeout)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	return cmd.Actor.Authenticate(map[string]string{
+		"code":          code,
+		"code_verifier": pkce.Verifier,
+		"redirect_uri":  server.RedirectURI(),
+	}, "", constant.GrantTypeAuthorizationCode)
+}
+
+// authenticateDevice logs in with the device authorization grant, for machines
+// without a browser: the user approves the login by entering a code on any
+// other device while the CLI polls for the tokens.
+func (cmd *LoginCommand) authenticateDevice() error {
+	err := cmd.loginWithDevice()
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd *LoginCommand) loginWithDevice() error {
+	authorization, err := cmd.Actor.GetDeviceAuthorization()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.VerificationURI}} on any device and enter the code {{.UserCode}}", map[string]interface{}{
+		"VerificationURI": authorization.VerificationURI,
+		"UserCode":        authorization.UserCode,
+	})
+	if authorization.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("Or visit {{.VerificationURIComplete}} to have the code entered for you.", map[string]interface{}{
+			"VerificationURIComplete": authorization.VerificationURIComplete,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for the device login to complete...")
+	return cmd.Actor.AuthenticateWithDeviceAuthorization(authorization)
+}
+
 func (cmd *LoginCommand) checkMinCLIVersion() error {
 	newChecker, err := cmd.CheckerMaker.NewVersionChecker(cmd.Config, cmd.UI, true)
 	if err != nil {
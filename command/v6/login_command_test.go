@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -15,6 +16,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/browserlogin"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -70,6 +72,223 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	When("the --browser flag is set", func() {
+		var (
+			fakeServer  *v6fakes.FakeBrowserLoginServer
+			serverState string
+			openedURL   string
+		)
+
+		BeforeEach(func() {
+			cmd.Browser = true
+			fakeConfig.TargetReturns("whatever.com")
+			fakeConfig.CurrentUserNameReturns("potatoface", nil)
+
+			fakeServer = new(v6fakes.FakeBrowserLoginServer)
+			fakeServer.RedirectURIReturns("http://127.0.0.1:8080/callback")
+			fakeServer.WaitForCodeReturns("some-code", nil)
+			serverState = ""
+			cmd.NewBrowserLoginServer = func(state string) (BrowserLoginServer, error) {
+				serverState = state
+				return fakeServer, nil
+			}
+
+			openedURL = ""
+			cmd.OpenBrowser = func(url string) error {
+				openedURL = url
+				return nil
+			}
+
+			fakeActor.GetAuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?state=some-state", nil)
+		})
+
+		It("does not require the experimental login flag", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).ToNot(Say("Using experimental login command"))
+		})
+
+		It("opens the authorize URL in a browser and exchanges the returned code", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetAuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, codeChallenge, state := fakeActor.GetAuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(Equal("http://127.0.0.1:8080/callback"))
+			Expect(state).ToNot(BeEmpty())
+			Expect(state).To(Equal(serverState))
+
+			Expect(testUI.Out).To(Say("Opening your browser to log in. If it does not open, visit this URL:"))
+			Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize\?state=some-state`))
+			Expect(openedURL).To(Equal("https://login.example.com/oauth/authorize?state=some-state"))
+			Expect(testUI.Out).To(Say(`Waiting for the browser login to complete\.\.\.`))
+			Expect(fakeServer.WaitForCodeArgsForCall(0)).To(Equal(5 * time.Minute))
+
+			Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials["code"]).To(Equal("some-code"))
+			Expect(credentials["redirect_uri"]).To(Equal("http://127.0.0.1:8080/callback"))
+			Expect(browserlogin.S256Challenge(credentials["code_verifier"])).To(Equal(codeChallenge))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+			Expect(fakeServer.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the browser cannot be opened", func() {
+			BeforeEach(func() {
+				cmd.OpenBrowser = func(string) error {
+					return errors.New("no browser")
+				}
+			})
+
+			It("warns and still waits for the user to visit the URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser: no browser"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the browser login does not complete", func() {
+			BeforeEach(func() {
+				fakeServer.WaitForCodeReturns("", browserlogin.TimeoutError{Timeout: 5 * time.Minute})
+				fakeConfig.CurrentUserNameReturns("", nil)
+			})
+
+			It("displays the error and returns an error message", func() {
+				Expect(testUI.Err).To(Say("Browser login timed out after 5m0s"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(fakeServer.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("exchanging the code fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateReturns(uaa.UnauthorizedError{Message: "Bad credentials"})
+				fakeConfig.CurrentUserNameReturns("", nil)
+			})
+
+			It("displays the error and returns an error message", func() {
+				Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+			})
+		})
+
+		When("starting the callback server fails", func() {
+			BeforeEach(func() {
+				cmd.NewBrowserLoginServer = func(string) (BrowserLoginServer, error) {
+					return nil, errors.New("address in use")
+				}
+			})
+
+			It("returns an error message without opening a browser", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("address in use"))
+				Expect(openedURL).To(BeEmpty())
+			})
+		})
+
+		When("it is combined with other ways of logging in", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an ArgumentCombinationError before targeting the API", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--browser", "--sso", "-u"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the --device flag is set", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			fakeConfig.TargetReturns("whatever.com")
+			fakeConfig.CurrentUserNameReturns("potatoface", nil)
+
+			fakeActor.GetDeviceAuthorizationReturns(v3action.DeviceAuthorization{
+				DeviceCode:              "some-device-code",
+				UserCode:                "WDJB-MJHT",
+				VerificationURI:         "https://login.example.com/device",
+				VerificationURIComplete: "https://login.example.com/device?user_code=WDJB-MJHT",
+			}, nil)
+		})
+
+		It("does not require the experimental login flag", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).ToNot(Say("Using experimental login command"))
+		})
+
+		It("displays the verification URI and user code and waits for the login to be approved", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`To log in, visit https://login\.example\.com/device on any device and enter the code WDJB-MJHT`))
+			Expect(testUI.Out).To(Say(`Or visit https://login\.example\.com/device\?user_code=WDJB-MJHT to have the code entered for you\.`))
+			Expect(testUI.Out).To(Say(`Waiting for the device login to complete\.\.\.`))
+
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+		})
+
+		When("the server does not give a complete verification URI", func() {
+			BeforeEach(func() {
+				fakeActor.GetDeviceAuthorizationReturns(v3action.DeviceAuthorization{
+					UserCode:        "WDJB-MJHT",
+					VerificationURI: "https://login.example.com/device",
+				}, nil)
+			})
+
+			It("only displays the verification URI", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("to have the code entered for you"))
+			})
+		})
+
+		When("starting the device login fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetDeviceAuthorizationReturns(v3action.DeviceAuthorization{}, errors.New("some error"))
+				fakeConfig.CurrentUserNameReturns("", nil)
+			})
+
+			It("displays the error and returns an error message", func() {
+				Expect(testUI.Err).To(Say("some error"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the login is not approved", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceAuthorizationReturns(uaa.ExpiredTokenError{})
+				fakeConfig.CurrentUserNameReturns("", nil)
+			})
+
+			It("displays the error and returns an error message", func() {
+				Expect(testUI.Err).To(Say("The device code expired before the login was completed."))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+			})
+		})
+
+		When("it is combined with other ways of logging in", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Password = "some-password"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--browser", "--device", "-p"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("the experimental login flag is set", func() {
 		BeforeEach(func() {
 			fakeConfig.ExperimentalLoginReturns(true)
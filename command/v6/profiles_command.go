@@ -0,0 +1,49 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProfilesCommand struct {
+	usage           interface{} `usage:"CF_NAME profiles"`
+	relatedCommands interface{} `related_commands:"create-profile, delete-profile, switch-profile"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ProfilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ProfilesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting profiles...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("current"),
+		},
+	}
+
+	currentProfile := cmd.Config.CurrentProfile()
+	for _, name := range cmd.Config.Profiles() {
+		profile, _ := cmd.Config.GetProfile(name)
+
+		current := ""
+		if name == currentProfile {
+			current = "*"
+		}
+
+		table = append(table, []string{name, profile.Target, current})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
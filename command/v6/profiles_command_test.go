@@ -0,0 +1,52 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profiles Command", func() {
+	var (
+		cmd        ProfilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = ProfilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+
+		fakeConfig.CurrentProfileReturns("default")
+		fakeConfig.ProfilesReturns([]string{"default", "staging"})
+		fakeConfig.GetProfileStub = func(name string) (configv3.Profile, bool) {
+			return configv3.Profile{Target: "https://api." + name + ".com"}, true
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the profiles and marks the one in use", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting profiles..."))
+		Expect(testUI.Out).To(Say(`name\s+api endpoint\s+current`))
+		Expect(testUI.Out).To(Say(`default\s+https://api.default.com\s+\*`))
+		Expect(testUI.Out).To(Say(`staging\s+https://api.staging.com\s*\n`))
+
+		Expect(fakeConfig.GetProfileCallCount()).To(Equal(2))
+		Expect(fakeConfig.GetProfileArgsForCall(0)).To(Equal("default"))
+		Expect(fakeConfig.GetProfileArgsForCall(1)).To(Equal("staging"))
+	})
+})
@@ -25,13 +25,14 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool) (*cc
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryPolicy()))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
+		PaginationWorkers:  config.PaginationWorkers(),
 		Wrappers:           ccWrappers,
 	})
 
@@ -69,7 +70,7 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool) (*cc
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryPolicy()))
 
 	err = uaaClient.SetupResources(ccClient.UAA())
 	if err != nil {
@@ -0,0 +1,56 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type SwitchProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME switch-profile PROFILE_NAME"`
+	relatedCommands interface{}      `related_commands:"create-profile, profiles, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SwitchProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd SwitchProfileCommand) Execute(args []string) error {
+	profileName := cmd.RequiredArgs.ProfileName
+
+	profile, exists := cmd.Config.GetProfile(profileName)
+	if !exists {
+		return translatableerror.ProfileNotFoundError{Name: profileName}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Switching to profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": profileName,
+	})
+
+	err := cmd.Config.SwitchProfile(profileName)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+
+	if profile.Target == "" {
+		cmd.UI.DisplayText("TIP: No API endpoint set. Use '{{.LoginCommand}}' to log in.", map[string]interface{}{
+			"LoginCommand": cmd.Config.BinaryName() + " login -a API_URL",
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), profile.Target},
+		{cmd.UI.TranslateText("org:"), profile.TargetedOrganization.Name},
+		{cmd.UI.TranslateText("space:"), profile.TargetedSpace.Name},
+	}, 3)
+
+	return nil
+}
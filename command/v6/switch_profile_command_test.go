@@ -0,0 +1,96 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-profile Command", func() {
+	var (
+		cmd        SwitchProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = SwitchProfileCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ProfileName = "staging"
+
+		fakeConfig.BinaryNameReturns("faceman")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the profile does not exist", func() {
+		It("returns a ProfileNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ProfileNotFoundError{Name: "staging"}))
+			Expect(fakeConfig.SwitchProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the profile has a target", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{
+				Target:               "https://api.staging.com",
+				TargetedOrganization: configv3.Organization{Name: "some-org"},
+				TargetedSpace:        configv3.Space{Name: "some-space"},
+			}, true)
+		})
+
+		It("switches to the profile and displays its target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SwitchProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchProfileArgsForCall(0)).To(Equal("staging"))
+
+			Expect(testUI.Out).To(Say("Switching to profile staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.staging.com`))
+			Expect(testUI.Out).To(Say(`org:\s+some-org`))
+			Expect(testUI.Out).To(Say(`space:\s+some-space`))
+		})
+	})
+
+	When("the profile has no target", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{}, true)
+		})
+
+		It("switches to the profile and tells the user to log in", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SwitchProfileCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Switching to profile staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: No API endpoint set\. Use 'faceman login -a API_URL' to log in\.`))
+		})
+	})
+	When("the profile's credentials cannot be read", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{}, true)
+			fakeConfig.SwitchProfileReturns(errors.New("keychain is locked"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("keychain is locked"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
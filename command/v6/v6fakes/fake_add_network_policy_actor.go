@@ -28,6 +28,37 @@ type FakeAddNetworkPolicyActor struct {
 		result1 cfnetworkingaction.Warnings
 		result2 error
 	}
+	ApplyNetworkPolicyPlanStub        func(cfnetworkingaction.NetworkPolicyPlan) error
+	applyNetworkPolicyPlanMutex       sync.RWMutex
+	applyNetworkPolicyPlanArgsForCall []struct {
+		arg1 cfnetworkingaction.NetworkPolicyPlan
+	}
+	applyNetworkPolicyPlanReturns struct {
+		result1 error
+	}
+	applyNetworkPolicyPlanReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PlanNetworkPoliciesStub        func(cfnetworkingaction.AppSelector, cfnetworkingaction.AppSelector, string, int, int, bool) (cfnetworkingaction.NetworkPolicyPlan, cfnetworkingaction.Warnings, error)
+	planNetworkPoliciesMutex       sync.RWMutex
+	planNetworkPoliciesArgsForCall []struct {
+		arg1 cfnetworkingaction.AppSelector
+		arg2 cfnetworkingaction.AppSelector
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 bool
+	}
+	planNetworkPoliciesReturns struct {
+		result1 cfnetworkingaction.NetworkPolicyPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	planNetworkPoliciesReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.NetworkPolicyPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -101,11 +132,146 @@ func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlan(arg1 cfnetworkingaction.NetworkPolicyPlan) error {
+	fake.applyNetworkPolicyPlanMutex.Lock()
+	ret, specificReturn := fake.applyNetworkPolicyPlanReturnsOnCall[len(fake.applyNetworkPolicyPlanArgsForCall)]
+	fake.applyNetworkPolicyPlanArgsForCall = append(fake.applyNetworkPolicyPlanArgsForCall, struct {
+		arg1 cfnetworkingaction.NetworkPolicyPlan
+	}{arg1})
+	fake.recordInvocation("ApplyNetworkPolicyPlan", []interface{}{arg1})
+	fake.applyNetworkPolicyPlanMutex.Unlock()
+	if fake.ApplyNetworkPolicyPlanStub != nil {
+		return fake.ApplyNetworkPolicyPlanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.applyNetworkPolicyPlanReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlanCallCount() int {
+	fake.applyNetworkPolicyPlanMutex.RLock()
+	defer fake.applyNetworkPolicyPlanMutex.RUnlock()
+	return len(fake.applyNetworkPolicyPlanArgsForCall)
+}
+
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlanCalls(stub func(cfnetworkingaction.NetworkPolicyPlan) error) {
+	fake.applyNetworkPolicyPlanMutex.Lock()
+	defer fake.applyNetworkPolicyPlanMutex.Unlock()
+	fake.ApplyNetworkPolicyPlanStub = stub
+}
+
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlanArgsForCall(i int) cfnetworkingaction.NetworkPolicyPlan {
+	fake.applyNetworkPolicyPlanMutex.RLock()
+	defer fake.applyNetworkPolicyPlanMutex.RUnlock()
+	argsForCall := fake.applyNetworkPolicyPlanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlanReturns(result1 error) {
+	fake.applyNetworkPolicyPlanMutex.Lock()
+	defer fake.applyNetworkPolicyPlanMutex.Unlock()
+	fake.ApplyNetworkPolicyPlanStub = nil
+	fake.applyNetworkPolicyPlanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddNetworkPolicyActor) ApplyNetworkPolicyPlanReturnsOnCall(i int, result1 error) {
+	fake.applyNetworkPolicyPlanMutex.Lock()
+	defer fake.applyNetworkPolicyPlanMutex.Unlock()
+	fake.ApplyNetworkPolicyPlanStub = nil
+	if fake.applyNetworkPolicyPlanReturnsOnCall == nil {
+		fake.applyNetworkPolicyPlanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyNetworkPolicyPlanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPolicies(arg1 cfnetworkingaction.AppSelector, arg2 cfnetworkingaction.AppSelector, arg3 string, arg4 int, arg5 int, arg6 bool) (cfnetworkingaction.NetworkPolicyPlan, cfnetworkingaction.Warnings, error) {
+	fake.planNetworkPoliciesMutex.Lock()
+	ret, specificReturn := fake.planNetworkPoliciesReturnsOnCall[len(fake.planNetworkPoliciesArgsForCall)]
+	fake.planNetworkPoliciesArgsForCall = append(fake.planNetworkPoliciesArgsForCall, struct {
+		arg1 cfnetworkingaction.AppSelector
+		arg2 cfnetworkingaction.AppSelector
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("PlanNetworkPolicies", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.planNetworkPoliciesMutex.Unlock()
+	if fake.PlanNetworkPoliciesStub != nil {
+		return fake.PlanNetworkPoliciesStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.planNetworkPoliciesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPoliciesCallCount() int {
+	fake.planNetworkPoliciesMutex.RLock()
+	defer fake.planNetworkPoliciesMutex.RUnlock()
+	return len(fake.planNetworkPoliciesArgsForCall)
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPoliciesCalls(stub func(cfnetworkingaction.AppSelector, cfnetworkingaction.AppSelector, string, int, int, bool) (cfnetworkingaction.NetworkPolicyPlan, cfnetworkingaction.Warnings, error)) {
+	fake.planNetworkPoliciesMutex.Lock()
+	defer fake.planNetworkPoliciesMutex.Unlock()
+	fake.PlanNetworkPoliciesStub = stub
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPoliciesArgsForCall(i int) (cfnetworkingaction.AppSelector, cfnetworkingaction.AppSelector, string, int, int, bool) {
+	fake.planNetworkPoliciesMutex.RLock()
+	defer fake.planNetworkPoliciesMutex.RUnlock()
+	argsForCall := fake.planNetworkPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPoliciesReturns(result1 cfnetworkingaction.NetworkPolicyPlan, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.planNetworkPoliciesMutex.Lock()
+	defer fake.planNetworkPoliciesMutex.Unlock()
+	fake.PlanNetworkPoliciesStub = nil
+	fake.planNetworkPoliciesReturns = struct {
+		result1 cfnetworkingaction.NetworkPolicyPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAddNetworkPolicyActor) PlanNetworkPoliciesReturnsOnCall(i int, result1 cfnetworkingaction.NetworkPolicyPlan, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.planNetworkPoliciesMutex.Lock()
+	defer fake.planNetworkPoliciesMutex.Unlock()
+	fake.PlanNetworkPoliciesStub = nil
+	if fake.planNetworkPoliciesReturnsOnCall == nil {
+		fake.planNetworkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.NetworkPolicyPlan
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.planNetworkPoliciesReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.NetworkPolicyPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeAddNetworkPolicyActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addNetworkPolicyMutex.RLock()
 	defer fake.addNetworkPolicyMutex.RUnlock()
+	fake.applyNetworkPolicyPlanMutex.RLock()
+	defer fake.applyNetworkPolicyPlanMutex.RUnlock()
+	fake.planNetworkPoliciesMutex.RLock()
+	defer fake.planNetworkPoliciesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,244 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	"sync"
+	"time"
+
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeBrowserLoginServer struct {
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RedirectURIStub        func() string
+	redirectURIMutex       sync.RWMutex
+	redirectURIArgsForCall []struct {
+	}
+	redirectURIReturns struct {
+		result1 string
+	}
+	redirectURIReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WaitForCodeStub        func(time.Duration) (string, error)
+	waitForCodeMutex       sync.RWMutex
+	waitForCodeArgsForCall []struct {
+		arg1 time.Duration
+	}
+	waitForCodeReturns struct {
+		result1 string
+		result2 error
+	}
+	waitForCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBrowserLoginServer) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		return fake.CloseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.closeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserLoginServer) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeBrowserLoginServer) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeBrowserLoginServer) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserLoginServer) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserLoginServer) RedirectURI() string {
+	fake.redirectURIMutex.Lock()
+	ret, specificReturn := fake.redirectURIReturnsOnCall[len(fake.redirectURIArgsForCall)]
+	fake.redirectURIArgsForCall = append(fake.redirectURIArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RedirectURI", []interface{}{})
+	fake.redirectURIMutex.Unlock()
+	if fake.RedirectURIStub != nil {
+		return fake.RedirectURIStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.redirectURIReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserLoginServer) RedirectURICallCount() int {
+	fake.redirectURIMutex.RLock()
+	defer fake.redirectURIMutex.RUnlock()
+	return len(fake.redirectURIArgsForCall)
+}
+
+func (fake *FakeBrowserLoginServer) RedirectURICalls(stub func() string) {
+	fake.redirectURIMutex.Lock()
+	defer fake.redirectURIMutex.Unlock()
+	fake.RedirectURIStub = stub
+}
+
+func (fake *FakeBrowserLoginServer) RedirectURIReturns(result1 string) {
+	fake.redirectURIMutex.Lock()
+	defer fake.redirectURIMutex.Unlock()
+	fake.RedirectURIStub = nil
+	fake.redirectURIReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBrowserLoginServer) RedirectURIReturnsOnCall(i int, result1 string) {
+	fake.redirectURIMutex.Lock()
+	defer fake.redirectURIMutex.Unlock()
+	fake.RedirectURIStub = nil
+	if fake.redirectURIReturnsOnCall == nil {
+		fake.redirectURIReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.redirectURIReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCode(arg1 time.Duration) (string, error) {
+	fake.waitForCodeMutex.Lock()
+	ret, specificReturn := fake.waitForCodeReturnsOnCall[len(fake.waitForCodeArgsForCall)]
+	fake.waitForCodeArgsForCall = append(fake.waitForCodeArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("WaitForCode", []interface{}{arg1})
+	fake.waitForCodeMutex.Unlock()
+	if fake.WaitForCodeStub != nil {
+		return fake.WaitForCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.waitForCodeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCodeCallCount() int {
+	fake.waitForCodeMutex.RLock()
+	defer fake.waitForCodeMutex.RUnlock()
+	return len(fake.waitForCodeArgsForCall)
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCodeCalls(stub func(time.Duration) (string, error)) {
+	fake.waitForCodeMutex.Lock()
+	defer fake.waitForCodeMutex.Unlock()
+	fake.WaitForCodeStub = stub
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCodeArgsForCall(i int) time.Duration {
+	fake.waitForCodeMutex.RLock()
+	defer fake.waitForCodeMutex.RUnlock()
+	argsForCall := fake.waitForCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCodeReturns(result1 string, result2 error) {
+	fake.waitForCodeMutex.Lock()
+	defer fake.waitForCodeMutex.Unlock()
+	fake.WaitForCodeStub = nil
+	fake.waitForCodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserLoginServer) WaitForCodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.waitForCodeMutex.Lock()
+	defer fake.waitForCodeMutex.Unlock()
+	fake.WaitForCodeStub = nil
+	if fake.waitForCodeReturnsOnCall == nil {
+		fake.waitForCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.waitForCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserLoginServer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.redirectURIMutex.RLock()
+	defer fake.redirectURIMutex.RUnlock()
+	fake.waitForCodeMutex.RLock()
+	defer fake.waitForCodeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBrowserLoginServer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.BrowserLoginServer = new(FakeBrowserLoginServer)
@@ -24,6 +24,44 @@ type FakeLoginActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithDeviceAuthorizationStub        func(v3action.DeviceAuthorization) error
+	authenticateWithDeviceAuthorizationMutex       sync.RWMutex
+	authenticateWithDeviceAuthorizationArgsForCall []struct {
+		arg1 v3action.DeviceAuthorization
+	}
+	authenticateWithDeviceAuthorizationReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAuthorizationCodeURLStub        func(string, string, string) (string, error)
+	getAuthorizationCodeURLMutex       sync.RWMutex
+	getAuthorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getAuthorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	getAuthorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetDeviceAuthorizationStub        func() (v3action.DeviceAuthorization, error)
+	getDeviceAuthorizationMutex       sync.RWMutex
+	getDeviceAuthorizationArgsForCall []struct {
+	}
+	getDeviceAuthorizationReturns struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}
+	getDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}
 	GetLoginPromptsStub        func() map[string]coreconfig.AuthPrompt
 	getLoginPromptsMutex       sync.RWMutex
 	getLoginPromptsArgsForCall []struct {
@@ -173,6 +211,186 @@ func (fake *FakeLoginActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorization(arg1 v3action.DeviceAuthorization) error {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceAuthorizationReturnsOnCall[len(fake.authenticateWithDeviceAuthorizationArgsForCall)]
+	fake.authenticateWithDeviceAuthorizationArgsForCall = append(fake.authenticateWithDeviceAuthorizationArgsForCall, struct {
+		arg1 v3action.DeviceAuthorization
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceAuthorization", []interface{}{arg1})
+	fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	if fake.AuthenticateWithDeviceAuthorizationStub != nil {
+		return fake.AuthenticateWithDeviceAuthorizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceAuthorizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationCallCount() int {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	return len(fake.authenticateWithDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationCalls(stub func(v3action.DeviceAuthorization) error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationArgsForCall(i int) v3action.DeviceAuthorization {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationReturns(result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	fake.authenticateWithDeviceAuthorizationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	if fake.authenticateWithDeviceAuthorizationReturnsOnCall == nil {
+		fake.authenticateWithDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.getAuthorizationCodeURLReturnsOnCall[len(fake.getAuthorizationCodeURLArgsForCall)]
+	fake.getAuthorizationCodeURLArgsForCall = append(fake.getAuthorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetAuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.getAuthorizationCodeURLMutex.Unlock()
+	if fake.GetAuthorizationCodeURLStub != nil {
+		return fake.GetAuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAuthorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURLCallCount() int {
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
+	return len(fake.getAuthorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.getAuthorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = nil
+	fake.getAuthorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) GetAuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = nil
+	if fake.getAuthorizationCodeURLReturnsOnCall == nil {
+		fake.getAuthorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getAuthorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) GetDeviceAuthorization() (v3action.DeviceAuthorization, error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.getDeviceAuthorizationReturnsOnCall[len(fake.getDeviceAuthorizationArgsForCall)]
+	fake.getDeviceAuthorizationArgsForCall = append(fake.getDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetDeviceAuthorization", []interface{}{})
+	fake.getDeviceAuthorizationMutex.Unlock()
+	if fake.GetDeviceAuthorizationStub != nil {
+		return fake.GetDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) GetDeviceAuthorizationCallCount() int {
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
+	return len(fake.getDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetDeviceAuthorizationCalls(stub func() (v3action.DeviceAuthorization, error)) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeLoginActor) GetDeviceAuthorizationReturns(result1 v3action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	fake.getDeviceAuthorizationReturns = struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) GetDeviceAuthorizationReturnsOnCall(i int, result1 v3action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	if fake.getDeviceAuthorizationReturnsOnCall == nil {
+		fake.getDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v3action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.getDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLoginActor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	fake.getLoginPromptsMutex.Lock()
 	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
@@ -550,6 +768,12 @@ func (fake *FakeLoginActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
 	fake.getLoginPromptsMutex.RLock()
 	defer fake.getLoginPromptsMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
@@ -0,0 +1,187 @@
+package v7
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . AnnotationsActor
+
+type AnnotationsActor interface {
+	GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
+	GetOrganizationAnnotations(orgName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetRouteAnnotations(routeURL string) (map[string]types.NullString, v7action.Warnings, error)
+	GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetStackAnnotations(stackName string) (map[string]types.NullString, v7action.Warnings, error)
+}
+
+type AnnotationsCommand struct {
+	RequiredArgs   flag.AnnotationsArgs `positional-args:"yes"`
+	BuildpackStack string               `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage          interface{}          `usage:"CF_NAME annotations RESOURCE RESOURCE_NAME\n\nEXAMPLES:\n   cf annotations app dora\n   cf annotations route dora.example.com/path\n\nRESOURCES:\n   app\n   buildpack\n   org\n   route\n   space\n   stack\n\nSEE ALSO:\n   set-annotation, unset-annotation"`
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          AnnotationsActor
+}
+
+func (cmd *AnnotationsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+	return nil
+}
+
+func (cmd AnnotationsCommand) Execute(args []string) error {
+	resource, err := newAnnotationResource(cmd.RequiredArgs.ResourceType, cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+	if err != nil {
+		return err
+	}
+
+	username, err := cmd.Config.CurrentUserName()
+	if err != nil {
+		return err
+	}
+
+	err = resource.checkTarget(cmd.SharedActor)
+	if err != nil {
+		return err
+	}
+
+	resource.displayFlavor(cmd.UI, cmd.Config, "Getting annotations", username)
+	cmd.UI.DisplayNewline()
+
+	var (
+		annotations map[string]types.NullString
+		warnings    v7action.Warnings
+	)
+	switch resource.Type {
+	case App:
+		annotations, warnings, err = cmd.Actor.GetApplicationAnnotations(resource.Name, cmd.Config.TargetedSpace().GUID)
+	case Buildpack:
+		annotations, warnings, err = cmd.Actor.GetBuildpackAnnotations(resource.Name, resource.BuildpackStack)
+	case Org:
+		annotations, warnings, err = cmd.Actor.GetOrganizationAnnotations(resource.Name)
+	case Route:
+		annotations, warnings, err = cmd.Actor.GetRouteAnnotations(resource.Name)
+	case Space:
+		annotations, warnings, err = cmd.Actor.GetSpaceAnnotations(resource.Name, cmd.Config.TargetedOrganization().GUID)
+	case Stack:
+		annotations, warnings, err = cmd.Actor.GetStackAnnotations(resource.Name)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.printAnnotations(annotations)
+	return nil
+}
+
+func (cmd AnnotationsCommand) printAnnotations(annotations map[string]types.NullString) {
+	if len(annotations) == 0 {
+		cmd.UI.DisplayText("No annotations found.")
+		return
+	}
+
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+
+	for _, key := range keys {
+		table = append(table, []string{key, annotations[key].Value})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// annotationResource is the resource an annotations, set-annotation or
+// unset-annotation command acts on.
+type annotationResource struct {
+	Type           ResourceType
+	Name           string
+	BuildpackStack string
+}
+
+func newAnnotationResource(resourceType string, resourceName string, buildpackStack string) (annotationResource, error) {
+	resource := annotationResource{
+		Type:           ResourceType(strings.ToLower(resourceType)),
+		Name:           resourceName,
+		BuildpackStack: buildpackStack,
+	}
+
+	switch resource.Type {
+	case App, Buildpack, Org, Route, Space, Stack:
+	default:
+		return annotationResource{}, fmt.Errorf("Unsupported resource type of '%s'", resourceType)
+	}
+
+	if buildpackStack != "" && resource.Type != Buildpack {
+		return annotationResource{}, translatableerror.ArgumentCombinationError{
+			Args: []string{resourceType, "--stack, -s"},
+		}
+	}
+
+	return resource, nil
+}
+
+// checkTarget checks that the org and space needed to find the resource are
+// targeted.
+func (resource annotationResource) checkTarget(sharedActor command.SharedActor) error {
+	switch resource.Type {
+	case App:
+		return sharedActor.CheckTarget(true, true)
+	case Space:
+		return sharedActor.CheckTarget(true, false)
+	default:
+		return sharedActor.CheckTarget(false, false)
+	}
+}
+
+func (resource annotationResource) displayFlavor(ui command.UI, config command.Config, action string, username string) {
+	template := action + " for {{.ResourceType}} {{.ResourceName}}"
+	switch {
+	case resource.Type == App:
+		template += " in org {{.OrgName}} / space {{.SpaceName}}"
+	case resource.Type == Space:
+		template += " in org {{.OrgName}}"
+	case resource.Type == Buildpack && resource.BuildpackStack != "":
+		template += " with stack {{.StackName}}"
+	}
+	template += " as {{.User}}..."
+
+	ui.DisplayTextWithFlavor(template, map[string]interface{}{
+		"ResourceType": string(resource.Type),
+		"ResourceName": resource.Name,
+		"OrgName":      config.TargetedOrganization().Name,
+		"SpaceName":    config.TargetedSpace().Name,
+		"StackName":    resource.BuildpackStack,
+		"User":         username,
+	})
+}
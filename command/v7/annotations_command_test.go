@@ -0,0 +1,156 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("annotations command", func() {
+	var (
+		cmd             AnnotationsCommand
+		fakeActor       *v7fakes.FakeAnnotationsActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeAnnotationsActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		cmd = AnnotationsCommand{
+			Actor:       fakeActor,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+		}
+
+		fakeConfig.CurrentUserNameReturns("some-user", nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("getting app annotations", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "app", ResourceName: "dora"}
+			fakeActor.GetApplicationAnnotationsReturns(
+				map[string]types.NullString{
+					"runbook": types.NewNullString("https://example.com/runbook"),
+					"owner":   types.NewNullString("team-a"),
+				},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("checks that an org and space are targeted", func() {
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeTrue())
+		})
+
+		It("displays the annotations sorted by key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationAnnotationsArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting annotations for app dora in org fake-org / space fake-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`key\s+value`))
+			Expect(testUI.Out).To(Say(`owner\s+team-a`))
+			Expect(testUI.Out).To(Say(`runbook\s+https://example.com/runbook`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("getting route annotations", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "route", ResourceName: "dora.example.com/path"}
+		})
+
+		It("gets the route's annotations without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(fakeActor.GetRouteAnnotationsArgsForCall(0)).To(Equal("dora.example.com/path"))
+			Expect(testUI.Out).To(Say(`Getting annotations for route dora.example.com/path as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("No annotations found."))
+		})
+	})
+
+	When("getting buildpack annotations with a stack", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "Buildpack", ResourceName: "some-buildpack"}
+			cmd.BuildpackStack = "cflinuxfs3"
+		})
+
+		It("gets the annotations of the buildpack on that stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			buildpackName, stackName := fakeActor.GetBuildpackAnnotationsArgsForCall(0)
+			Expect(buildpackName).To(Equal("some-buildpack"))
+			Expect(stackName).To(Equal("cflinuxfs3"))
+			Expect(testUI.Out).To(Say(`Getting annotations for buildpack some-buildpack with stack cflinuxfs3 as some-user\.\.\.`))
+		})
+	})
+
+	When("the --stack flag is given for a resource other than buildpack", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "space", ResourceName: "some-space"}
+			cmd.BuildpackStack = "cflinuxfs3"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"space", "--stack, -s"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "service-broker", ResourceName: "some-broker"}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("Unsupported resource type of 'service-broker'"))
+		})
+	})
+
+	When("getting the annotations fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "org", ResourceName: "some-org"}
+			fakeActor.GetOrganizationAnnotationsReturns(nil, v7action.Warnings{"some-warning"}, errors.New("get-org-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-org-error"))
+			Expect(fakeActor.GetOrganizationAnnotationsArgsForCall(0)).To(Equal("some-org"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
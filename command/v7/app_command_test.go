@@ -237,4 +237,65 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: v7action.Application{
+						Name:  "some-app",
+						GUID:  "some-app-guid",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: v7action.Process{
+								Type:       constant.ProcessTypeWeb,
+								MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+								DiskInMB:   types.NullUint64{Value: 128, IsSet: true},
+							},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning, CPU: 0.5, MemoryUsage: 1024, MemoryQuota: 2048, DiskUsage: 10, DiskQuota: 20},
+							},
+						},
+					},
+					Routes: []v7action.Route{{URL: "some-app.example.com"}},
+				},
+				CurrentDroplet: v7action.Droplet{
+					Stack:      "cflinuxfs3",
+					CreatedAt:  "2019-01-02T03:04:05Z",
+					Buildpacks: []v7action.DropletBuildpack{{Name: "ruby_buildpack", DetectOutput: "ruby 1.6"}},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the app summary as json and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Showing health and status"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+				"name": "some-app",
+				"guid": "some-app-guid",
+				"state": "started",
+				"routes": ["some-app.example.com"],
+				"last_uploaded": "2019-01-02T03:04:05Z",
+				"stack": "cflinuxfs3",
+				"buildpacks": ["ruby 1.6"],
+				"processes": [
+					{
+						"type": "web",
+						"instances": 1,
+						"running_instances": 1,
+						"memory_in_mb": 64,
+						"disk_in_mb": 128,
+						"instance_details": [
+							{"index": 0, "state": "running", "cpu": 0.5, "memory_usage": 1024, "memory_quota": 2048, "disk_usage": 10, "disk_quota": 20}
+						]
+					}
+				]
+			}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -6,7 +6,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 )
@@ -14,12 +16,13 @@ import (
 //go:generate counterfeiter . AppsActor
 
 type AppsActor interface {
-	GetAppSummariesForSpace(spaceGUID string) ([]v7action.ApplicationSummary, v7action.Warnings, error)
+	GetAppSummariesForSpace(spaceGUID string, labelSelector string) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 }
 
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
-	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter apps by labels"`
+	usage           interface{}        `usage:"CF_NAME apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'env=prod,tier in (web,api),!canary'"`
+	relatedCommands interface{}        `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
 
 	UI          command.UI
 	Config      command.Config
@@ -52,19 +55,26 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
-	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID)
+	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewAppSummaryDocuments(summaries))
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
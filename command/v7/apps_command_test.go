@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -243,11 +244,23 @@ var _ = Describe("apps Command", func() {
 				Expect(testUI.Err).To(Say("warning-2"))
 
 				Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+				spaceGUID, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 			})
 		})
 
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, labelSelector := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
+		})
+
 		When("app does not have processes", func() {
 			BeforeEach(func() {
 				appSummaries := []v7action.ApplicationSummary{
@@ -273,7 +286,7 @@ var _ = Describe("apps Command", func() {
 				Expect(testUI.Err).To(Say("warning"))
 
 				Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+				spaceGUID, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 			})
 		})
@@ -291,4 +304,64 @@ var _ = Describe("apps Command", func() {
 			})
 		})
 	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{
+					Application: v7action.Application{
+						GUID:  "app-guid",
+						Name:  "some-app",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: []v7action.ProcessSummary{
+						{
+							Process: v7action.Process{
+								Type:       constant.ProcessTypeWeb,
+								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+							},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning},
+							},
+						},
+					},
+					Routes: []v7action.Route{
+						{URL: "some-app.some-domain"},
+					},
+				},
+			}, v7action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("displays the app summaries as json and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting apps"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"name": "some-app",
+					"guid": "app-guid",
+					"state": "started",
+					"processes": [
+						{"type": "web", "instances": 1, "running_instances": 1, "memory_in_mb": 32, "disk_in_mb": 0}
+					],
+					"routes": ["some-app.some-domain"]
+				}
+			]`))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppSummariesForSpaceReturns(nil, nil, nil)
+			})
+
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+	})
 })
@@ -0,0 +1,110 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . BindServiceActor
+
+type BindServiceActor interface {
+	BindServiceInstanceToApp(appName string, serviceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type BindServiceCommand struct {
+	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
+	BindingName      flag.BindingName              `long:"binding-name" description:"Name to expose service instance to app process with (Default: service instance name)"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Wait             bool                          `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage            interface{}                   `usage:"CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--wait]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\n   Optionally provide a binding name for the association between an app and a service instance:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE --binding-name BINDING_NAME\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME bind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n\n   Windows Command Line:\n      CF_NAME bind-service myapp mydb -c \"{\\\"permissions\\\":\\\"read-only\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME bind-service myapp mydb -c '{\\\"permissions\\\":\\\"read-only\\\"}'\n\n   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/instance_config.json --binding-name BINDING_NAME"`
+	relatedCommands  interface{}                   `related_commands:"services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BindServiceActor
+}
+
+func (cmd *BindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd BindServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Binding service {{.ServiceName}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.BindServiceInstanceToApp(
+		cmd.RequiredArgs.AppName,
+		cmd.RequiredArgs.ServiceInstanceName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.BindingName.Value,
+		cmd.ParametersAsJSON,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ServiceBindingTakenError); ok {
+			cmd.UI.DisplayText("App {{.AppName}} is already bound to {{.ServiceName}}.", map[string]interface{}{
+				"AppName":     cmd.RequiredArgs.AppName,
+				"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Binding in progress. Use 'cf service {{.ServiceName}}' to check operation status.", map[string]interface{}{
+			"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("TIP: Use 'cf restage {{.AppName}}' to ensure your env variable changes take effect", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
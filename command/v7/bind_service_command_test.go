@@ -0,0 +1,151 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("bind-service Command", func() {
+	var (
+		cmd             v7.BindServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeBindServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeBindServiceActor)
+
+		cmd = v7.BindServiceCommand{
+			RequiredArgs: flag.BindServiceArgs{
+				AppName:             "some-app",
+				ServiceInstanceName: "some-instance",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.BindServiceInstanceToAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the binding is created synchronously", func() {
+		BeforeEach(func() {
+			cmd.BindingName = flag.BindingName{Value: "some-binding"}
+			cmd.ParametersAsJSON = map[string]interface{}{"foo": "bar"}
+			fakeActor.BindServiceInstanceToAppReturns("", v7action.Warnings{"bind-warning"}, nil)
+		})
+
+		It("binds the service instance and displays a restage tip", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.BindServiceInstanceToAppCallCount()).To(Equal(1))
+			appName, instanceName, spaceGUID, bindingName, parameters := fakeActor.BindServiceInstanceToAppArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(bindingName).To(Equal("some-binding"))
+			Expect(parameters).To(Equal(map[string]interface{}{"foo": "bar"}))
+
+			Expect(testUI.Out).To(Say(`Binding service some-instance to app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'cf restage some-app' to ensure your env variable changes take effect`))
+			Expect(testUI.Err).To(Say("bind-warning"))
+		})
+	})
+
+	When("the service broker creates the binding asynchronously", func() {
+		BeforeEach(func() {
+			fakeActor.BindServiceInstanceToAppReturns("some-job-url", v7action.Warnings{"bind-warning"}, nil)
+		})
+
+		It("displays that the binding is in progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Binding in progress\. Use 'cf service some-instance' to check operation status\.`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+				fakeActor.PollJobReturns(v7action.Warnings{"poll-warning"}, nil)
+			})
+
+			It("waits for the job and displays a restage tip", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+				Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Use 'cf restage some-app'"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+	})
+
+	When("the app is already bound to the service instance", func() {
+		BeforeEach(func() {
+			fakeActor.BindServiceInstanceToAppReturns("", v7action.Warnings{"bind-warning"}, ccerror.ServiceBindingTakenError{})
+		})
+
+		It("displays that the app is already bound and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`App some-app is already bound to some-instance\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.BindServiceInstanceToAppReturns("", v7action.Warnings{"bind-warning"}, errors.New("bind-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("bind-error"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+		})
+	})
+})
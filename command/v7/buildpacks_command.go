@@ -6,7 +6,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 )
@@ -14,12 +16,13 @@ import (
 //go:generate counterfeiter . BuildpacksActor
 
 type BuildpacksActor interface {
-	GetBuildpacks() ([]v7action.Buildpack, v7action.Warnings, error)
+	GetBuildpacks(labelSelector string) ([]v7action.Buildpack, v7action.Warnings, error)
 }
 
 type BuildpacksCommand struct {
-	usage           interface{} `usage:"CF_NAME buildpacks"`
-	relatedCommands interface{} `related_commands:"push"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter buildpacks by labels"`
+	usage           interface{}        `usage:"CF_NAME buildpacks [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"push"`
 
 	UI          command.UI
 	Config      command.Config
@@ -53,17 +56,24 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
-	buildpacks, warnings, err := cmd.Actor.GetBuildpacks()
+	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewBuildpackDocuments(buildpacks))
+	}
+
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
 	} else {
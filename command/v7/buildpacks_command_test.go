@@ -77,6 +77,17 @@ var _ = Describe("buildpacks Command", func() {
 			Expect(testUI.Out).To(Say(`Getting buildpacks as apple\.\.\.`))
 		})
 
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetBuildpacksArgsForCall(0)).To(Equal("env=prod"))
+			})
+		})
+
 		When("getting buildpacks fails", func() {
 			BeforeEach(func() {
 				fakeActor.GetBuildpacksReturns(nil, v7action.Warnings{"some-warning-1", "some-warning-2"},
@@ -138,4 +149,40 @@ var _ = Describe("buildpacks Command", func() {
 			})
 		})
 	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "apple"}, nil)
+			fakeActor.GetBuildpacksReturns([]v7action.Buildpack{
+				{
+					Name:     "buildpack-1",
+					GUID:     "buildpack-guid-1",
+					Position: types.NullInt{Value: 1, IsSet: true},
+					Enabled:  types.NullBool{Value: true, IsSet: true},
+					Locked:   types.NullBool{Value: false, IsSet: true},
+					Filename: "buildpack-1.file",
+					Stack:    "buildpack-1-stack",
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the buildpacks as json and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting buildpacks"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"position": 1,
+					"name": "buildpack-1",
+					"guid": "buildpack-guid-1",
+					"stack": "buildpack-1-stack",
+					"enabled": true,
+					"locked": false,
+					"filename": "buildpack-1.file"
+				}
+			]`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -0,0 +1,106 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . CreateServiceActor
+
+type CreateServiceActor interface {
+	CreateManagedServiceInstance(params v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type CreateServiceCommand struct {
+	RequiredArgs     flag.CreateServiceArgs        `positional-args:"yes"`
+	ServiceBroker    string                        `short:"b" description:"Create a service instance from a particular broker. Required when service name is ambiguous"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Tags             flag.Tags                     `short:"t" description:"User provided tags"`
+	Wait             bool                          `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage            interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\""`
+	relatedCommands  interface{}                   `related_commands:"bind-service, create-user-provided-service, marketplace, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateServiceActor
+}
+
+func (cmd *CreateServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd CreateServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.CreateManagedServiceInstance(v7action.ManagedServiceInstanceParams{
+		ServiceOfferingName: cmd.RequiredArgs.Service,
+		ServicePlanName:     cmd.RequiredArgs.ServicePlan,
+		ServiceBrokerName:   cmd.ServiceBroker,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		Tags:                cmd.Tags.Value,
+		Parameters:          cmd.ParametersAsJSON,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ServiceInstanceNameTakenError); ok {
+			cmd.UI.DisplayText("Service {{.ServiceInstance}} already exists", map[string]interface{}{
+				"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Create in progress. Use 'cf services' or 'cf service {{.ServiceInstance}}' to check operation status.", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+	}
+
+	return nil
+}
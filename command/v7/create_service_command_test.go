@@ -0,0 +1,168 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-service Command", func() {
+	var (
+		cmd             v7.CreateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeCreateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeCreateServiceActor)
+
+		cmd = v7.CreateServiceCommand{
+			RequiredArgs: flag.CreateServiceArgs{
+				Service:         "some-offering",
+				ServicePlan:     "some-plan",
+				ServiceInstance: "some-instance",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateManagedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the service instance is created synchronously", func() {
+		BeforeEach(func() {
+			cmd.ServiceBroker = "some-broker"
+			cmd.ParametersAsJSON = map[string]interface{}{"foo": "bar"}
+			cmd.Tags = flag.Tags{IsSet: true, Value: []string{"tag-1", "tag-2"}}
+			fakeActor.CreateManagedServiceInstanceReturns("", v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the service instance with the given params", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateManagedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.ManagedServiceInstanceParams{
+				ServiceOfferingName: "some-offering",
+				ServicePlanName:     "some-plan",
+				ServiceBrokerName:   "some-broker",
+				ServiceInstanceName: "some-instance",
+				SpaceGUID:           "some-space-guid",
+				Tags:                []string{"tag-1", "tag-2"},
+				Parameters:          map[string]interface{}{"foo": "bar"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Creating service instance some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).ToNot(Say("in progress"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	When("the service broker creates the service instance asynchronously", func() {
+		BeforeEach(func() {
+			fakeActor.CreateManagedServiceInstanceReturns("some-job-url", v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("displays that the create is in progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Create in progress\. Use 'cf services' or 'cf service some-instance' to check operation status\.`))
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+				fakeActor.PollJobReturns(v7action.Warnings{"poll-warning"}, nil)
+			})
+
+			It("waits for the job to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+				Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("in progress"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+
+			When("the job fails", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobReturns(v7action.Warnings{"poll-warning"}, errors.New("job-failed"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("job-failed"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+	})
+
+	When("the service instance already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateManagedServiceInstanceReturns("", v7action.Warnings{"create-warning"}, ccerror.ServiceInstanceNameTakenError{})
+		})
+
+		It("displays that the service instance already exists and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Service some-instance already exists"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("creating the service instance fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateManagedServiceInstanceReturns("", v7action.Warnings{"create-warning"}, actionerror.ServicePlanNotFoundError{PlanName: "some-plan"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "some-plan"}))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
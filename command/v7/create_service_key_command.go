@@ -0,0 +1,96 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . CreateServiceKeyActor
+
+type CreateServiceKeyActor interface {
+	CreateServiceKey(serviceInstanceName string, serviceKeyName string, spaceGUID string, parameters map[string]interface{}) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type CreateServiceKeyCommand struct {
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Wait             bool                          `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage            interface{}                   `usage:"CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--wait]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   CF_NAME create-service-key mydb mykey -c '{\"permissions\":\"read-only\"}'\n   CF_NAME create-service-key mydb mykey -c ~/workspace/tmp/instance_config.json"`
+	relatedCommands  interface{}                   `related_commands:"service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateServiceKeyActor
+}
+
+func (cmd *CreateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd CreateServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating service key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.CreateServiceKey(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ServiceKeyTakenError); ok {
+			cmd.UI.DisplayText("Service key {{.ServiceKeyName}} already exists", map[string]interface{}{
+				"ServiceKeyName": cmd.RequiredArgs.ServiceKey,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Create in progress. Use 'cf service-key {{.ServiceInstanceName}} {{.ServiceKeyName}}' to check operation status.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-service-key Command", func() {
+	var (
+		cmd             v7.CreateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeCreateServiceKeyActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeCreateServiceKeyActor)
+
+		cmd = v7.CreateServiceKeyCommand{
+			RequiredArgs: flag.ServiceInstanceKey{
+				ServiceInstance: "some-instance",
+				ServiceKey:      "some-key",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the key is created", func() {
+		BeforeEach(func() {
+			cmd.ParametersAsJSON = map[string]interface{}{"foo": "bar"}
+			fakeActor.CreateServiceKeyReturns("", v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the key with the given parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(1))
+			instanceName, keyName, spaceGUID, parameters := fakeActor.CreateServiceKeyArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(keyName).To(Equal("some-key"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(parameters).To(Equal(map[string]interface{}{"foo": "bar"}))
+
+			Expect(testUI.Out).To(Say(`Creating service key some-key for service instance some-instance as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	When("the service broker creates the key asynchronously", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns("some-job-url", nil, nil)
+		})
+
+		It("displays that the create is in progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`Create in progress\. Use 'cf service-key some-instance some-key' to check operation status\.`))
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+			})
+
+			It("waits for the job to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+				Expect(testUI.Out).ToNot(Say("in progress"))
+			})
+		})
+	})
+
+	When("the key already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns("", nil, ccerror.ServiceKeyTakenError{})
+		})
+
+		It("displays that the key already exists and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Service key some-key already exists"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("creating the key fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns("", v7action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
@@ -0,0 +1,128 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . CreateUserProvidedServiceActor
+
+type CreateUserProvidedServiceActor interface {
+	CreateUserProvidedServiceInstance(serviceInstance v7action.ServiceInstance) (v7action.Warnings, error)
+}
+
+type CreateUserProvidedServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance   `positional-args:"yes"`
+	SyslogDrainURL  flag.OptionalString    `short:"l" description:"URL to which logs for bound applications will be streamed"`
+	Credentials     flag.CredentialsOrJSON `short:"p" description:"Credentials, provided inline or in a file, to be exposed in the VCAP_SERVICES environment variable for bound applications"`
+	RouteServiceURL flag.OptionalString    `short:"r" description:"URL to which requests for bound routes will be forwarded. Scheme for this URL must be https"`
+	Tags            flag.Tags              `short:"t" description:"User provided tags"`
+	usage           interface{}            `usage:"CF_NAME create-user-provided-service SERVICE_INSTANCE [-p CREDENTIALS] [-l SYSLOG_DRAIN_URL] [-r ROUTE_SERVICE_URL] [-t TAGS]\n\n   Pass comma separated credential parameter names to enable interactive mode:\n   CF_NAME create-user-provided-service SERVICE_INSTANCE -p \"comma, separated, parameter, names\"\n\n   Pass credential parameters as JSON to create a service non-interactively:\n   CF_NAME create-user-provided-service SERVICE_INSTANCE -p '{\"key1\":\"value1\",\"key2\":\"value2\"}'\n\n   Specify a path to a file containing JSON:\n   CF_NAME create-user-provided-service SERVICE_INSTANCE -p PATH_TO_FILE\n\nEXAMPLES:\n   CF_NAME create-user-provided-service my-db-mine -p \"username, password\"\n   CF_NAME create-user-provided-service my-db-mine -p /path/to/credentials.json\n   CF_NAME create-user-provided-service my-db-mine -t \"list, of, tags\"\n   CF_NAME create-user-provided-service my-drain-service -l syslog://example.com\n   CF_NAME create-user-provided-service my-route-service -r https://example.com\n\n   Linux/Mac:\n      CF_NAME create-user-provided-service my-db-mine -p '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'\n\n   Windows Command Line:\n      CF_NAME create-user-provided-service my-db-mine -p \"{\\\"username\\\":\\\"admin\\\",\\\"password\\\":\\\"pa55woRD\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME create-user-provided-service my-db-mine -p '{\\\"username\\\":\\\"admin\\\",\\\"password\\\":\\\"pa55woRD\\\"}'"`
+	relatedCommands interface{}            `related_commands:"bind-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateUserProvidedServiceActor
+}
+
+func (cmd *CreateUserProvidedServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd CreateUserProvidedServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if err = promptForCredentials(cmd.UI, &cmd.Credentials); err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating user provided service {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+
+	serviceInstance := userProvidedServiceInstanceChanges(cmd.Credentials, cmd.SyslogDrainURL, cmd.RouteServiceURL, cmd.Tags)
+	serviceInstance.Name = cmd.RequiredArgs.ServiceInstance
+	serviceInstance.SpaceGUID = cmd.Config.TargetedSpace().GUID
+
+	warnings, err := cmd.Actor.CreateUserProvidedServiceInstance(serviceInstance)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ServiceInstanceNameTakenError); ok {
+			cmd.UI.DisplayText("Service {{.ServiceInstance}} already exists", map[string]interface{}{
+				"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// promptForCredentials prompts for the value of each credential named by the
+// -p flag, unless the flag was given JSON.
+func promptForCredentials(ui command.UI, credentials *flag.CredentialsOrJSON) error {
+	if len(credentials.UserPromptCredentials) == 0 {
+		return nil
+	}
+
+	credentials.Value = make(map[string]interface{})
+	for _, key := range credentials.UserPromptCredentials {
+		var err error
+		credentials.Value[key], err = ui.DisplayPasswordPrompt(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// userProvidedServiceInstanceChanges returns a service instance with only the
+// fields given by the flags set.
+func userProvidedServiceInstanceChanges(credentials flag.CredentialsOrJSON, syslogDrainURL flag.OptionalString, routeServiceURL flag.OptionalString, tags flag.Tags) v7action.ServiceInstance {
+	var serviceInstance v7action.ServiceInstance
+	if credentials.IsSet {
+		serviceInstance.Credentials = credentials.Value
+	}
+	if syslogDrainURL.IsSet {
+		serviceInstance.SyslogDrainURL = types.NewNullString(syslogDrainURL.Value)
+	}
+	if routeServiceURL.IsSet {
+		serviceInstance.RouteServiceURL = types.NewNullString(routeServiceURL.Value)
+	}
+	if tags.IsSet {
+		serviceInstance.Tags = tags.Value
+	}
+	return serviceInstance
+}
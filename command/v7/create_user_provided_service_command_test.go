@@ -0,0 +1,145 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-user-provided-service Command", func() {
+	var (
+		cmd             v7.CreateUserProvidedServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeCreateUserProvidedServiceActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeCreateUserProvidedServiceActor)
+
+		cmd = v7.CreateUserProvidedServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("all the flags are provided", func() {
+		BeforeEach(func() {
+			cmd.Credentials = flag.CredentialsOrJSON{IsSet: true, Value: map[string]interface{}{"foo": "bar"}}
+			cmd.SyslogDrainURL = flag.OptionalString{IsSet: true, Value: "https://syslog.example.com"}
+			cmd.RouteServiceURL = flag.OptionalString{IsSet: true, Value: "https://route.example.com"}
+			cmd.Tags = flag.Tags{IsSet: true, Value: []string{"tag-1"}}
+			fakeActor.CreateUserProvidedServiceInstanceReturns(v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(v7action.ServiceInstance{
+				Name:            "some-instance",
+				SpaceGUID:       "some-space-guid",
+				Credentials:     map[string]interface{}{"foo": "bar"},
+				SyslogDrainURL:  types.NewNullString("https://syslog.example.com"),
+				RouteServiceURL: types.NewNullString("https://route.example.com"),
+				Tags:            []string{"tag-1"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Creating user provided service some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	When("credential names are provided", func() {
+		BeforeEach(func() {
+			cmd.Credentials = flag.CredentialsOrJSON{
+				IsSet:                 true,
+				UserPromptCredentials: []string{"username", "password"},
+			}
+			_, err := input.Write([]byte("some-user\nsome-password\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prompts for the value of each credential", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("username"))
+			Expect(testUI.Out).To(Say("password"))
+
+			serviceInstance := fakeActor.CreateUserProvidedServiceInstanceArgsForCall(0)
+			Expect(serviceInstance.Credentials).To(Equal(map[string]interface{}{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+		})
+	})
+
+	When("the service instance already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateUserProvidedServiceInstanceReturns(nil, ccerror.ServiceInstanceNameTakenError{})
+		})
+
+		It("displays that the service instance already exists and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Service some-instance already exists"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("creating the service instance fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateUserProvidedServiceInstanceReturns(v7action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
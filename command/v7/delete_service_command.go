@@ -0,0 +1,110 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . DeleteServiceActor
+
+type DeleteServiceActor interface {
+	DeleteServiceInstance(serviceInstanceName string, spaceGUID string) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type DeleteServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	Wait            bool                 `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait]"`
+	relatedCommands interface{}          `related_commands:"unbind-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteServiceActor
+}
+
+func (cmd *DeleteServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd DeleteServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the service {{.ServiceInstance}}?", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting service {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.DeleteServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceInstanceNotFoundError); ok {
+			cmd.UI.DisplayWarningV7("Service {{.ServiceInstance}} does not exist.", map[string]interface{}{
+				"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Delete in progress. Use 'cf services' or 'cf service {{.ServiceInstance}}' to check operation status.", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+	}
+
+	return nil
+}
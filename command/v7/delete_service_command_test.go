@@ -0,0 +1,170 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-service Command", func() {
+	var (
+		cmd             v7.DeleteServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeleteServiceActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeleteServiceActor)
+
+		cmd = v7.DeleteServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user does not confirm the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Really delete the service some-instance\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.DeleteServiceInstanceReturns("", v7action.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+			instanceName, spaceGUID := fakeActor.DeleteServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Deleting service some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("does not prompt the user", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		When("the service broker deletes the service instance asynchronously", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceReturns("some-job-url", v7action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("displays that the delete is in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`Delete in progress\. Use 'cf services' or 'cf service some-instance' to check operation status\.`))
+			})
+
+			When("--wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+				})
+
+				It("waits for the job to complete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+					Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+					Expect(testUI.Out).ToNot(Say("in progress"))
+				})
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceReturns("", v7action.Warnings{"delete-warning"}, actionerror.ServiceInstanceNotFoundError{Name: "some-instance"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Err).To(Say("Service some-instance does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("deleting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceReturns("", v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+			})
+		})
+	})
+})
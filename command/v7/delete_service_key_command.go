@@ -0,0 +1,116 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . DeleteServiceKeyActor
+
+type DeleteServiceKeyActor interface {
+	DeleteServiceKeyByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type DeleteServiceKeyCommand struct {
+	RequiredArgs    flag.ServiceInstanceKey `positional-args:"yes"`
+	Force           bool                    `short:"f" description:"Force deletion without confirmation"`
+	Wait            bool                    `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage           interface{}             `usage:"CF_NAME delete-service-key SERVICE_INSTANCE SERVICE_KEY [-f] [--wait]\n\nEXAMPLES:\n   CF_NAME delete-service-key mydb mykey"`
+	relatedCommands interface{}             `related_commands:"service-keys"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteServiceKeyActor
+}
+
+func (cmd *DeleteServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd DeleteServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the service key {{.ServiceKeyName}}?", map[string]interface{}{
+			"ServiceKeyName": cmd.RequiredArgs.ServiceKey,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.DeleteServiceKeyByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch err.(type) {
+		case actionerror.ServiceInstanceNotFoundError:
+			cmd.UI.DisplayWarningV7("Service instance {{.ServiceInstanceName}} does not exist.", map[string]interface{}{
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			})
+		case actionerror.ServiceKeyNotFoundError:
+			cmd.UI.DisplayWarningV7("Service key {{.ServiceKeyName}} does not exist for service instance {{.ServiceInstanceName}}.", map[string]interface{}{
+				"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			})
+		default:
+			return err
+		}
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Delete in progress. Use 'cf service-keys {{.ServiceInstanceName}}' to check operation status.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+	}
+
+	return nil
+}
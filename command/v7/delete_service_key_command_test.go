@@ -0,0 +1,165 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-service-key Command", func() {
+	var (
+		cmd             v7.DeleteServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeleteServiceKeyActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeleteServiceKeyActor)
+
+		cmd = v7.DeleteServiceKeyCommand{
+			RequiredArgs: flag.ServiceInstanceKey{
+				ServiceInstance: "some-instance",
+				ServiceKey:      "some-key",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the user does not confirm the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Really delete the service key some-key\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns("", v7action.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the key without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeActor.DeleteServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+			instanceName, keyName, spaceGUID := fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(keyName).To(Equal("some-key"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Deleting key some-key for service instance some-instance as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+
+		When("the service broker deletes the key asynchronously", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns("some-job-url", nil, nil)
+			})
+
+			It("displays that the delete is in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Delete in progress\. Use 'cf service-keys some-instance' to check operation status\.`))
+			})
+
+			When("--wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+				})
+
+				It("waits for the job to complete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+					Expect(testUI.Out).ToNot(Say("in progress"))
+				})
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns("", nil, actionerror.ServiceInstanceNotFoundError{Name: "some-instance"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("Service instance some-instance does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("the key does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns("", nil, actionerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-instance"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("Service key some-key does not exist for service instance some-instance."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("deleting the key fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByServiceInstanceAndNameReturns("", nil, errors.New("delete-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+			})
+		})
+	})
+})
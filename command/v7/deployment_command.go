@@ -0,0 +1,174 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . DeploymentActor
+
+type DeploymentActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetLatestDeploymentForApp(appGUID string) (v7action.Deployment, v7action.Warnings, error)
+	GetDeploymentSummary(deployment v7action.Deployment) (v7action.DeploymentSummary, v7action.Warnings, error)
+	PollDeployment(deploymentGUID string, handleSummary func(v7action.DeploymentSummary)) (v7action.Warnings, error)
+}
+
+type DeploymentCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Wait            bool         `long:"wait" description:"Wait for the deployment to finish, displaying its progress"`
+	usage           interface{}  `usage:"CF_NAME deployment APP_NAME [--wait]\n\nEXAMPLES:\n   cf deployment my-app\n   cf deployment my-app --wait"`
+	relatedCommands interface{}  `related_commands:"deployments, cancel-deployment, push"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       DeploymentActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *DeploymentCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient, clock.NewClock())
+	return nil
+}
+
+func (cmd DeploymentCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"UserName":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployment, warnings, err := cmd.Actor.GetLatestDeploymentForApp(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	summary, warnings, err := cmd.Actor.GetDeploymentSummary(deployment)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Wait && summary.StatusValue == constant.DeploymentStatusValueDeploying {
+		summary, err = cmd.waitForDeployment(summary)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cmd.displayDeploymentSummary(summary)
+}
+
+// waitForDeployment polls the deployment until it is finalized, displaying a
+// progress line each time its instance counts change. It returns the last
+// summary seen.
+func (cmd DeploymentCommand) waitForDeployment(summary v7action.DeploymentSummary) (v7action.DeploymentSummary, error) {
+	cmd.UI.DisplayText("Waiting for deployment to finish...")
+
+	var lastProgress string
+	cmd.displayDeploymentProgress(summary, &lastProgress)
+
+	warnings, err := cmd.Actor.PollDeployment(summary.GUID, func(latest v7action.DeploymentSummary) {
+		summary = latest
+		cmd.displayDeploymentProgress(summary, &lastProgress)
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayNewline()
+
+	return summary, err
+}
+
+func (cmd DeploymentCommand) displayDeploymentProgress(summary v7action.DeploymentSummary, lastProgress *string) {
+	progress := cmd.UI.TranslateText("   new instances: {{.New}}, previous instances: {{.Previous}}", map[string]interface{}{
+		"New":      summary.NewProcessSummaries.String(),
+		"Previous": summary.PreviousProcessSummaries.String(),
+	})
+	if progress == *lastProgress {
+		return
+	}
+
+	*lastProgress = progress
+	cmd.UI.DisplayText(progress)
+}
+
+func (cmd DeploymentCommand) displayDeploymentSummary(summary v7action.DeploymentSummary) error {
+	createdAt, err := formatDeploymentTime(cmd.UI, summary.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	lastStatusChange, err := formatDeploymentTime(cmd.UI, summary.LastStatusChange)
+	if err != nil {
+		return err
+	}
+
+	status := strings.ToLower(string(summary.StatusValue))
+	if summary.StatusReason != "" {
+		status += " (" + strings.ToLower(string(summary.StatusReason)) + ")"
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("guid:"), summary.GUID},
+		{cmd.UI.TranslateText("status:"), status},
+		{cmd.UI.TranslateText("strategy:"), strings.ToLower(string(summary.Strategy))},
+		{cmd.UI.TranslateText("droplet:"), summary.DropletGUID},
+		{cmd.UI.TranslateText("previous droplet:"), summary.PreviousDropletGUID},
+		{cmd.UI.TranslateText("new instances:"), summary.NewProcessSummaries.String()},
+		{cmd.UI.TranslateText("previous instances:"), summary.PreviousProcessSummaries.String()},
+		{cmd.UI.TranslateText("created:"), createdAt},
+		{cmd.UI.TranslateText("last status change:"), lastStatusChange},
+	}, 3)
+
+	return nil
+}
+
+// formatDeploymentTime converts a Cloud Controller timestamp into the date
+// format used elsewhere in the CLI. Unset timestamps are left blank.
+func formatDeploymentTime(ui command.UI, timestamp string) (string, error) {
+	if timestamp == "" {
+		return "", nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "", err
+	}
+
+	return ui.UserFriendlyDate(t), nil
+}
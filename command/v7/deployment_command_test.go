@@ -0,0 +1,236 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("deployment Command", func() {
+	var (
+		cmd             DeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeploymentActor
+
+		deployingSummary v7action.DeploymentSummary
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeploymentActor)
+
+		cmd = DeploymentCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v7action.Application{GUID: "some-app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetLatestDeploymentForAppReturns(
+			v7action.Deployment{GUID: "some-deployment-guid"},
+			v7action.Warnings{"get-deployment-warning"},
+			nil,
+		)
+
+		deployingSummary = v7action.DeploymentSummary{
+			Deployment: v7action.Deployment{
+				GUID:                "some-deployment-guid",
+				StatusValue:         constant.DeploymentStatusValueDeploying,
+				Strategy:            constant.DeploymentStrategyRolling,
+				DropletGUID:         "new-droplet-guid",
+				PreviousDropletGUID: "old-droplet-guid",
+				CreatedAt:           "2019-05-01T10:00:00Z",
+				LastStatusChange:    "2019-05-01T10:00:05Z",
+			},
+			NewProcessSummaries: v7action.ProcessSummaries{{
+				Process: v7action.Process{Type: constant.ProcessTypeWeb},
+				InstanceDetails: []v7action.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+					{State: constant.ProcessInstanceStarting},
+				},
+			}},
+			PreviousProcessSummaries: v7action.ProcessSummaries{{
+				Process: v7action.Process{Type: constant.ProcessTypeWeb},
+				InstanceDetails: []v7action.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+				},
+			}},
+		}
+		fakeActor.GetDeploymentSummaryReturns(deployingSummary, v7action.Warnings{"get-summary-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks that an org and space are targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeTrue())
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "cf"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "cf"}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the most recent deployment of the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.GetLatestDeploymentForAppArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeActor.GetDeploymentSummaryArgsForCall(0).GUID).To(Equal("some-deployment-guid"))
+		Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Getting deployment for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`guid:\s+some-deployment-guid`))
+		Expect(testUI.Out).To(Say(`status:\s+deploying`))
+		Expect(testUI.Out).To(Say(`strategy:\s+rolling`))
+		Expect(testUI.Out).To(Say(`droplet:\s+new-droplet-guid`))
+		Expect(testUI.Out).To(Say(`previous droplet:\s+old-droplet-guid`))
+		Expect(testUI.Out).To(Say(`new instances:\s+web:1/2`))
+		Expect(testUI.Out).To(Say(`previous instances:\s+web:1/1`))
+		Expect(testUI.Out).To(Say(`created:\s+Wed 01 May 10:00:00 UTC 2019`))
+		Expect(testUI.Out).To(Say(`last status change:\s+Wed 01 May 10:00:05 UTC 2019`))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-deployment-warning"))
+		Expect(testUI.Err).To(Say("get-summary-warning"))
+	})
+
+	When("the deployment has finished", func() {
+		BeforeEach(func() {
+			summary := v7action.DeploymentSummary{Deployment: v7action.Deployment{
+				GUID:         "some-deployment-guid",
+				StatusValue:  constant.DeploymentStatusValueFinalized,
+				StatusReason: constant.DeploymentStatusReasonSuperseded,
+			}}
+			fakeActor.GetDeploymentSummaryReturns(summary, nil, nil)
+		})
+
+		It("displays the status with its reason", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`status:\s+finalized \(superseded\)`))
+		})
+	})
+
+	When("the app has no deployments", func() {
+		BeforeEach(func() {
+			fakeActor.GetLatestDeploymentForAppReturns(v7action.Deployment{}, v7action.Warnings{"get-deployment-warning"}, actionerror.AppDeploymentNotFoundError{})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.AppDeploymentNotFoundError{}))
+			Expect(testUI.Err).To(Say("get-deployment-warning"))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v7action.Application{}, v7action.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-app-error"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.GetLatestDeploymentForAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the --wait flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+		})
+
+		When("the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeActor.PollDeploymentStub = func(deploymentGUID string, handleSummary func(v7action.DeploymentSummary)) (v7action.Warnings, error) {
+					handleSummary(deployingSummary)
+
+					deployingSummary.PreviousProcessSummaries = nil
+					deployingSummary.NewProcessSummaries[0].InstanceDetails[1].State = constant.ProcessInstanceRunning
+					deployingSummary.StatusValue = constant.DeploymentStatusValueFinalized
+					deployingSummary.StatusReason = constant.DeploymentStatusReasonDeployed
+					handleSummary(deployingSummary)
+
+					return v7action.Warnings{"poll-warning"}, nil
+				}
+			})
+
+			It("displays progress as it changes, then the final deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+
+				Expect(testUI.Out).To(Say(`Waiting for deployment to finish\.\.\.`))
+				Expect(testUI.Out).To(Say(`new instances: web:1/2, previous instances: web:1/1`))
+				Expect(testUI.Out).ToNot(Say(`new instances: web:1/2, previous instances: web:1/1`))
+				Expect(testUI.Out).To(Say(`new instances: web:2/2, previous instances: \n`))
+				Expect(testUI.Out).To(Say(`status:\s+finalized \(deployed\)`))
+				Expect(testUI.Out).To(Say(`new instances:\s+web:2/2`))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+
+		When("the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeActor.PollDeploymentReturns(v7action.Warnings{"poll-warning"}, errors.New("Deployment has been canceled"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("Deployment has been canceled"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+
+		When("the deployment has already finished", func() {
+			BeforeEach(func() {
+				fakeActor.GetDeploymentSummaryReturns(v7action.DeploymentSummary{Deployment: v7action.Deployment{
+					StatusValue:  constant.DeploymentStatusValueFinalized,
+					StatusReason: constant.DeploymentStatusReasonDeployed,
+				}}, nil, nil)
+			})
+
+			It("does not wait", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+				Expect(testUI.Out).ToNot(Say("Waiting for deployment"))
+			})
+		})
+	})
+})
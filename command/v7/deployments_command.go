@@ -0,0 +1,118 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . DeploymentsActor
+
+type DeploymentsActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetDeploymentsForApp(appGUID string) ([]v7action.Deployment, v7action.Warnings, error)
+}
+
+type DeploymentsCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME deployments APP_NAME\n\nEXAMPLES:\n   cf deployments my-app"`
+	relatedCommands interface{}  `related_commands:"deployment, cancel-deployment, push"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       DeploymentsActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *DeploymentsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient, clock.NewClock())
+	return nil
+}
+
+func (cmd DeploymentsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting deployments for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"UserName":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployments, warnings, err := cmd.Actor.GetDeploymentsForApp(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(deployments) == 0 {
+		cmd.UI.DisplayText("No deployments found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("reason"),
+			cmd.UI.TranslateText("strategy"),
+			cmd.UI.TranslateText("created"),
+			cmd.UI.TranslateText("last status change"),
+		},
+	}
+
+	for _, deployment := range deployments {
+		createdAt, err := formatDeploymentTime(cmd.UI, deployment.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		lastStatusChange, err := formatDeploymentTime(cmd.UI, deployment.LastStatusChange)
+		if err != nil {
+			return err
+		}
+
+		table = append(table, []string{
+			deployment.GUID,
+			strings.ToLower(string(deployment.StatusValue)),
+			strings.ToLower(string(deployment.StatusReason)),
+			strings.ToLower(string(deployment.Strategy)),
+			createdAt,
+			lastStatusChange,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("deployments Command", func() {
+	var (
+		cmd             DeploymentsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeploymentsActor
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeploymentsActor)
+
+		cmd = DeploymentsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v7action.Application{GUID: "some-app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the app has deployments", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentsForAppReturns(
+				[]v7action.Deployment{
+					{
+						GUID:        "deployment-guid-2",
+						StatusValue: constant.DeploymentStatusValueDeploying,
+						Strategy:    constant.DeploymentStrategyRolling,
+						CreatedAt:   "2019-05-02T10:00:00Z",
+					},
+					{
+						GUID:             "deployment-guid-1",
+						StatusValue:      constant.DeploymentStatusValueFinalized,
+						StatusReason:     constant.DeploymentStatusReasonCanceled,
+						Strategy:         constant.DeploymentStrategyRolling,
+						CreatedAt:        "2019-05-01T10:00:00Z",
+						LastStatusChange: "2019-05-01T10:05:00Z",
+					},
+				},
+				v7action.Warnings{"get-deployments-warning"},
+				nil,
+			)
+		})
+
+		It("lists the deployments", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetDeploymentsForAppArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting deployments for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`guid\s+status\s+reason\s+strategy\s+created\s+last status change`))
+			Expect(testUI.Out).To(Say(`deployment-guid-2\s+deploying\s+rolling\s+Thu 02 May 10:00:00 UTC 2019\s*\n`))
+			Expect(testUI.Out).To(Say(`deployment-guid-1\s+finalized\s+canceled\s+rolling\s+Wed 01 May 10:00:00 UTC 2019\s+Wed 01 May 10:05:00 UTC 2019`))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-deployments-warning"))
+		})
+	})
+
+	When("the app has no deployments", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No deployments found"))
+		})
+	})
+
+	When("getting the deployments fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentsForAppReturns(nil, v7action.Warnings{"get-deployments-warning"}, errors.New("get-deployments-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-deployments-error"))
+			Expect(testUI.Err).To(Say("get-deployments-warning"))
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("user-error"))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . DiffManifestActor
+
+type DiffManifestActor interface {
+	DiffManifest(spaceGUID string, parser v7pushaction.ManifestParser) ([]v7pushaction.ManifestAppDiff, v7pushaction.Warnings, error)
+}
+
+type DiffManifestCommand struct {
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                         `usage:"CF_NAME diff-manifest [-f APP_MANIFEST_PATH] [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]...\n\n   Exits with a non-zero status when applying the manifest would change any app."`
+	relatedCommands  interface{}                         `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	ManifestLocator ManifestLocator
+	Parser          ManifestParser
+	Actor           DiffManifestActor
+	CWD             string
+}
+
+func (cmd *DiffManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	v7actor := v7action.NewActor(ccClient, config, sharedActor, uaaClient, clock.NewClock())
+	cmd.Actor = v7pushaction.NewActor(v7actor, sharedActor)
+
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.Parser = manifestparser.NewParser()
+
+	currentDir, err := os.Getwd()
+	cmd.CWD = currentDir
+
+	return err
+}
+
+func (cmd DiffManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	manifestPath, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} with org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ManifestPath": manifestPath,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	})
+
+	var pathsToVarsFiles []string
+	for _, path := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+
+	err = cmd.Parser.InterpolateAndParse(manifestPath, pathsToVarsFiles, cmd.Vars, "")
+	if err != nil {
+		return err
+	}
+
+	diffs, warnings, err := cmd.Actor.DiffManifest(cmd.Config.TargetedSpace().GUID, cmd.Parser)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	driftCount := 0
+	for _, diff := range diffs {
+		cmd.UI.DisplayNewline()
+		cmd.displayAppDiff(diff)
+		if diff.HasDrift() {
+			driftCount++
+		}
+	}
+
+	if driftCount > 0 {
+		return translatableerror.ManifestDriftError{AppCount: driftCount}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("No differences found.")
+	return nil
+}
+
+func (cmd DiffManifestCommand) displayAppDiff(diff v7pushaction.ManifestAppDiff) {
+	cmd.UI.DisplayText("{{.AppName}}:", map[string]interface{}{"AppName": diff.AppName})
+
+	switch {
+	case diff.Create:
+		cmd.UI.DisplayText("  app will be created")
+	case !diff.HasDrift():
+		cmd.UI.DisplayText("  no changes")
+	default:
+		table := [][]string{{
+			cmd.UI.TranslateText("field"),
+			cmd.UI.TranslateText("current"),
+			cmd.UI.TranslateText("desired"),
+		}}
+		for _, field := range diff.Fields {
+			table = append(table, []string{field.Field, field.Current, field.Desired})
+		}
+		cmd.UI.DisplayTableWithHeader("  ", table, ui.DefaultTableSpacePadding)
+	}
+}
@@ -0,0 +1,180 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-manifest Command", func() {
+	var (
+		cmd             DiffManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDiffManifestActor
+		fakeParser      *v7fakes.FakeManifestParser
+		fakeLocator     *v7fakes.FakeManifestLocator
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDiffManifestActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeLocator.PathReturns("/some/path/manifest.yml", true, nil)
+
+		cmd = DiffManifestCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			Parser:          fakeParser,
+			ManifestLocator: fakeLocator,
+			CWD:             "fake-directory",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("no manifest is found", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns a manifest not found error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+			Expect(fakeActor.DiffManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("/other/manifest.yml")
+		})
+
+		It("locates the manifest at the given path", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/other/manifest.yml"))
+		})
+	})
+
+	When("parsing the manifest fails", func() {
+		BeforeEach(func() {
+			fakeParser.InterpolateAndParseReturns(errors.New("parse-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("parse-error"))
+			Expect(fakeActor.DiffManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest matches the space", func() {
+		BeforeEach(func() {
+			fakeActor.DiffManifestReturns(
+				[]v7pushaction.ManifestAppDiff{{AppName: "some-app"}},
+				v7pushaction.Warnings{"diff-warning"},
+				nil,
+			)
+		})
+
+		It("displays that there are no differences", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeParser.InterpolateAndParseCallCount()).To(Equal(1))
+			path, _, _, _ := fakeParser.InterpolateAndParseArgsForCall(0)
+			Expect(path).To(Equal("/some/path/manifest.yml"))
+
+			spaceGUID, parser := fakeActor.DiffManifestArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(parser).To(Equal(fakeParser))
+
+			Expect(testUI.Out).To(Say(`Comparing manifest /some/path/manifest.yml with org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("some-app:"))
+			Expect(testUI.Out).To(Say("no changes"))
+			Expect(testUI.Out).To(Say(`No differences found\.`))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+
+	When("applying the manifest would change apps", func() {
+		BeforeEach(func() {
+			fakeActor.DiffManifestReturns(
+				[]v7pushaction.ManifestAppDiff{
+					{AppName: "new-app", Create: true},
+					{AppName: "same-app"},
+					{AppName: "some-app", Fields: []v7pushaction.ManifestFieldDiff{
+						{Field: "instances", Current: "1", Desired: "3"},
+						{Field: "env.ADDED", Current: "", Desired: "42"},
+					}},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the changes and returns a drift error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{AppCount: 2}))
+
+			Expect(testUI.Out).To(Say("new-app:"))
+			Expect(testUI.Out).To(Say("app will be created"))
+			Expect(testUI.Out).To(Say("same-app:"))
+			Expect(testUI.Out).To(Say("no changes"))
+			Expect(testUI.Out).To(Say("some-app:"))
+			Expect(testUI.Out).To(Say(`field\s+current\s+desired`))
+			Expect(testUI.Out).To(Say(`instances\s+1\s+3`))
+			Expect(testUI.Out).To(Say(`env.ADDED\s+42`))
+			Expect(testUI.Out).ToNot(Say("No differences found"))
+		})
+	})
+
+	When("diffing the manifest fails", func() {
+		BeforeEach(func() {
+			fakeActor.DiffManifestReturns(nil, v7pushaction.Warnings{"diff-warning"}, errors.New("diff-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("diff-error"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+})
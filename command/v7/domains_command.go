@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/sorting"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
@@ -54,10 +55,13 @@ func (cmd DomainsCommand) Execute(args []string) error {
 	}
 
 	targetedOrg := cmd.Config.TargetedOrganization()
-	cmd.UI.DisplayTextWithFlavor("Getting domains in org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-		"CurrentOrg":  targetedOrg.Name,
-		"CurrentUser": currentUser.Name,
-	})
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting domains in org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+			"CurrentOrg":  targetedOrg.Name,
+			"CurrentUser": currentUser.Name,
+		})
+	}
 
 	domains, warnings, err := cmd.Actor.GetOrganizationDomains(targetedOrg.GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -67,6 +71,10 @@ func (cmd DomainsCommand) Execute(args []string) error {
 
 	sort.Slice(domains, func(i, j int) bool { return sorting.LessIgnoreCase(domains[i].Name, domains[j].Name) })
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewDomainDocuments(domains))
+	}
+
 	if len(domains) > 0 {
 		cmd.displayDomainsTable(domains)
 	} else {
@@ -194,4 +194,32 @@ var _ = Describe("domains Command", func() {
 			})
 		})
 	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeActor.GetOrganizationDomainsReturns([]v7action.Domain{
+				{Name: "b.com", GUID: "domain-guid-2", OrganizationGUID: "owning-org-guid"},
+				{Name: "a.com", GUID: "domain-guid-1", Internal: types.NullBool{IsSet: true, Value: true}},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the sorted domains as yaml and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting domains"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchYAML(`
+- name: a.com
+  guid: domain-guid-1
+  availability: shared
+  internal: true
+- name: b.com
+  guid: domain-guid-2
+  availability: private
+  internal: false
+`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -22,6 +22,7 @@ const (
 	App       ResourceType = "app"
 	Buildpack ResourceType = "buildpack"
 	Org       ResourceType = "org"
+	Route     ResourceType = "route"
 	Space     ResourceType = "space"
 	Stack     ResourceType = "stack"
 )
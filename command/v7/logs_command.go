@@ -0,0 +1,272 @@
+package v7
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . LogsActor
+
+type LogsActor interface {
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v7action.NOAAClient) ([]v7action.LogMessage, v7action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpaceFromLogCache(appName string, spaceGUID string, since time.Time, client v7action.LogCacheClient) ([]v7action.LogMessage, v7action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+	GetStreamingLogsForApplicationsByNamesAndSpace(appNames []string, spaceGUID string, client v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+	GetStreamingLogsForSpace(spaceGUID string, client v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+}
+
+type LogsCommand struct {
+	RequiredArgs    flag.LogsArgs    `positional-args:"yes"`
+	Space           bool             `long:"space" description:"Tail the logs of every app in the targeted space"`
+	Recent          bool             `long:"recent" description:"Dump recent logs instead of tailing"`
+	Sources         []flag.LogSource `long:"source" description:"Only show logs from this source type, such as APP, APP/PROC/WEB, RTR, STG, API or CELL (can be repeated)"`
+	Instances       []int            `long:"instance" description:"Only show logs from this instance index (can be repeated)"`
+	Stream          string           `long:"stream" choice:"stdout" choice:"stderr" description:"Only show logs written to this stream"`
+	Match           flag.Regexp      `long:"match" description:"Only show logs whose message matches this regular expression"`
+	Since           time.Duration    `long:"since" description:"Only show logs from within this duration, such as 30s, 5m or 1h"`
+	JSON            bool             `long:"json" description:"Display each log as a single line JSON object"`
+	usage           interface{}      `usage:"CF_NAME logs APP_NAME [--recent] [--source SOURCE]... [--instance INDEX]... [--stream stdout|stderr] [--match REGEX] [--since DURATION] [--json]\n   CF_NAME logs (APP_NAME... | --space) [--source SOURCE]... [--instance INDEX]... [--stream stdout|stderr] [--match REGEX] [--json]\n\nEXAMPLES:\n   CF_NAME logs my-app --source RTR --match ' 5\\d\\d '\n   CF_NAME logs my-app --recent --since 15m --source APP --stream stderr\n   CF_NAME logs my-app --json | jq -r .message\n   CF_NAME logs frontend orders payments --match 'request_id=1234'\n   CF_NAME logs --space --source APP --stream stderr"`
+	relatedCommands interface{}      `related_commands:"app, apps, ssh"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          LogsActor
+	NOAAClient     v7action.NOAAClient
+	LogCacheClient v7action.LogCacheClient
+}
+
+func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, uaaClient, clock.NewClock())
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	// Recent logs are read through NOAA on foundations without Log Cache.
+	if logCacheURL := ccClient.Info.LogCache(); logCacheURL != "" {
+		cmd.LogCacheClient = shared.NewLogCacheClient(logCacheURL, config, uaaClient, ui)
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) Execute(args []string) error {
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	// Keep stdout machine readable when displaying JSON.
+	if !cmd.displayJSON() {
+		cmd.displayFlavorText(user.Name)
+	}
+
+	filter := cmd.logFilter()
+
+	if cmd.Recent {
+		return cmd.displayRecentLogs(filter)
+	}
+
+	return cmd.streamLogs(filter)
+}
+
+func (cmd LogsCommand) validateArgs() error {
+	appNames := cmd.RequiredArgs.AppNames
+
+	switch {
+	case cmd.Space && len(appNames) > 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--space"},
+		}
+	case !cmd.Space && len(appNames) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.Recent && cmd.Space:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--recent", "--space"},
+		}
+	case cmd.Recent && len(appNames) > 1:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--recent", "multiple APP_NAMEs"},
+		}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) displayFlavorText(username string) {
+	templateValues := map[string]interface{}{
+		"AppNames":  strings.Join(cmd.RequiredArgs.AppNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	}
+
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd LogsCommand) displayRecentLogs(filter v7action.LogFilter) error {
+	var (
+		messages []v7action.LogMessage
+		warnings v7action.Warnings
+		err      error
+	)
+
+	if cmd.LogCacheClient != nil {
+		messages, warnings, err = cmd.Actor.GetRecentLogsForApplicationByNameAndSpaceFromLogCache(
+			cmd.RequiredArgs.AppNames[0],
+			cmd.Config.TargetedSpace().GUID,
+			filter.Since,
+			cmd.LogCacheClient,
+		)
+	} else {
+		messages, warnings, err = cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+			cmd.RequiredArgs.AppNames[0],
+			cmd.Config.TargetedSpace().GUID,
+			cmd.NOAAClient,
+		)
+	}
+
+	for _, message := range messages {
+		if displayErr := cmd.displayLogMessage(message, filter); displayErr != nil {
+			return displayErr
+		}
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+func (cmd LogsCommand) streamLogs(filter v7action.LogFilter) error {
+	messages, logErrs, warnings, err := cmd.getStreamingLogs()
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.ApplicationsNotFoundError); ok && cmd.Space {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var messagesClosed, errLogsClosed bool
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messagesClosed = true
+				break
+			}
+
+			err = cmd.displayLogMessage(*message, filter)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				errLogsClosed = true
+				break
+			}
+
+			cmd.NOAAClient.Close()
+			return logErr
+		}
+
+		if messagesClosed && errLogsClosed {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) getStreamingLogs() (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	switch {
+	case cmd.Space:
+		return cmd.Actor.GetStreamingLogsForSpace(spaceGUID, cmd.NOAAClient)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		return cmd.Actor.GetStreamingLogsForApplicationsByNamesAndSpace(cmd.RequiredArgs.AppNames, spaceGUID, cmd.NOAAClient)
+	default:
+		return cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppNames[0], spaceGUID, cmd.NOAAClient)
+	}
+}
+
+func (cmd LogsCommand) displayLogMessage(message v7action.LogMessage, filter v7action.LogFilter) error {
+	if !filter.Matches(message) {
+		return nil
+	}
+
+	if cmd.displayJSON() {
+		return cmd.UI.DisplayLogMessageJSON(message)
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
+
+func (cmd LogsCommand) displayJSON() bool {
+	return cmd.JSON || cmd.Config.OutputFormat() == configv3.OutputFormatJSON
+}
+
+func (cmd LogsCommand) logFilter() v7action.LogFilter {
+	filter := v7action.LogFilter{
+		Pattern: cmd.Match.Regexp,
+	}
+
+	for _, source := range cmd.Sources {
+		filter.SourceTypes = append(filter.SourceTypes, string(source))
+	}
+
+	for _, instance := range cmd.Instances {
+		filter.Instances = append(filter.Instances, strconv.Itoa(instance))
+	}
+
+	switch cmd.Stream {
+	case "stdout":
+		filter.MessageType = "OUT"
+	case "stderr":
+		filter.MessageType = "ERR"
+	}
+
+	if cmd.Since > 0 {
+		filter.Since = time.Now().Add(-cmd.Since)
+	}
+
+	return filter
+}
@@ -0,0 +1,420 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("logs command", func() {
+	var (
+		cmd             LogsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeLogsActor
+		fakeNOAAClient  *v7actionfakes.FakeNOAAClient
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeLogsActor)
+		fakeNOAAClient = new(v7actionfakes.FakeNOAAClient)
+
+		cmd = LogsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		cmd.RequiredArgs.AppNames = []string{"some-app"}
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space-name",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org-name",
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no app name is provided without --space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("app names are provided with --space", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--space"},
+			}))
+		})
+	})
+
+	When("--recent is provided with multiple app names", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			cmd.RequiredArgs.AppNames = []string{"app-1", "app-2"}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--recent", "multiple APP_NAMEs"},
+			}))
+		})
+	})
+
+	When("--recent is provided with --space", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			cmd.Space = true
+			cmd.RequiredArgs.AppNames = nil
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--recent", "--space"},
+			}))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(
+				actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			orgRequired, spaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(orgRequired).To(BeTrue())
+			Expect(spaceRequired).To(BeTrue())
+
+			Expect(executeErr).To(MatchError(
+				actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the --recent flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+				[]v7action.LogMessage{
+					*v7action.NewLogMessage("GET /health 200", 1, time.Now().Add(-2*time.Hour), "RTR", "0"),
+					*v7action.NewLogMessage("app started", 1, time.Now().Add(-time.Minute), "APP/PROC/WEB", "0"),
+					*v7action.NewLogMessage("app failed", 2, time.Now().Add(-time.Minute), "APP/PROC/WEB", "1"),
+					*v7action.NewLogMessage("staging done", 1, time.Now().Add(-time.Minute), "STG", "0"),
+				},
+				v7action.Warnings{"some-warning-1", "some-warning-2"},
+				nil,
+			)
+		})
+
+		It("displays flavor text and all recent log messages and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Retrieving logs for app some-app in org some-org-name / space some-space-name as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("GET /health 200"))
+			Expect(testUI.Out).To(Say("app started"))
+			Expect(testUI.Out).To(Say("app failed"))
+			Expect(testUI.Out).To(Say("staging done"))
+			Expect(testUI.Err).To(Say("some-warning-1"))
+			Expect(testUI.Err).To(Say("some-warning-2"))
+
+			Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(client).To(Equal(fakeNOAAClient))
+		})
+
+		When("filters are provided", func() {
+			BeforeEach(func() {
+				cmd.Sources = []flag.LogSource{"APP"}
+				cmd.Instances = []int{0}
+				cmd.Stream = "stdout"
+				cmd.Match = flag.Regexp{Regexp: regexp.MustCompile("^app")}
+			})
+
+			It("only displays the log messages matching every filter", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("app started"))
+				Expect(testUI.Out).ToNot(Say("GET /health 200"))
+				Expect(testUI.Out).ToNot(Say("app failed"))
+				Expect(testUI.Out).ToNot(Say("staging done"))
+			})
+		})
+
+		When("the --since flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Since = time.Hour
+			})
+
+			It("does not display log messages older than the duration", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("GET /health 200"))
+				Expect(testUI.Out).To(Say("app started"))
+				Expect(testUI.Out).To(Say("staging done"))
+			})
+		})
+
+		When("the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+				cmd.Sources = []flag.LogSource{"STG"}
+			})
+
+			It("displays each log message as a JSON line without flavor text", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+				Expect(testUI.Out).To(Say(`\{"timestamp":"[^"]+","source_type":"STG","source_instance":"0","message_type":"OUT","message":"staging done"\}\n`))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+			})
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays each log message as a JSON line", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+				Expect(testUI.Out).To(Say(`"message":"GET /health 200"`))
+			})
+		})
+
+		When("Log Cache is available", func() {
+			var fakeLogCacheClient *v7actionfakes.FakeLogCacheClient
+
+			BeforeEach(func() {
+				fakeLogCacheClient = new(v7actionfakes.FakeLogCacheClient)
+				cmd.LogCacheClient = fakeLogCacheClient
+				cmd.Since = 2 * time.Hour
+
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheReturns(
+					[]v7action.LogMessage{
+						*v7action.NewLogMessage("from log cache", 1, time.Now().Add(-90*time.Minute), "APP/PROC/WEB", "0"),
+					},
+					v7action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("reads the recent logs since the given duration from Log Cache", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("from log cache"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheCallCount()).To(Equal(1))
+				appName, spaceGUID, since, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(since).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+				Expect(client).To(Equal(fakeLogCacheClient))
+			})
+		})
+
+		When("the logs actor returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					nil,
+					v7action.Warnings{"some-warning-1"},
+					errors.New("some-error"),
+				)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+			})
+		})
+	})
+
+	When("the --recent flag is not provided", func() {
+		When("the logs setup returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v7action.Warnings{"some-warning-1", "some-warning-2"}, errors.New("some-error"))
+			})
+
+			It("displays the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+				Expect(testUI.Err).To(Say("some-warning-2"))
+			})
+		})
+
+		When("the logs stream returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+					messages := make(chan *v7action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						logErrs <- errors.New("some-error")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v7action.Warnings{"some-warning-1"}, nil
+				}
+			})
+
+			It("closes the client and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the logs actor streams logs", func() {
+			BeforeEach(func() {
+				cmd.Stream = "stderr"
+
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+					messages := make(chan *v7action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v7action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						messages <- v7action.NewLogMessage("i am message 2", 2, time.Unix(1, 0), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v7action.Warnings{"some-warning-1", "some-warning-2"}, nil
+				}
+			})
+
+			It("displays the streamed log messages matching the filters and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("some-warning-1"))
+				Expect(testUI.Err).To(Say("some-warning-2"))
+
+				Expect(testUI.Out).To(Say("Retrieving logs for app some-app"))
+				Expect(testUI.Out).ToNot(Say("i am message 1"))
+				Expect(testUI.Out).To(Say("i am message 2"))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(fakeNOAAClient))
+			})
+		})
+
+		When("multiple app names are provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = []string{"app-1", "app-2"}
+
+				fakeActor.GetStreamingLogsForApplicationsByNamesAndSpaceStub = func(_ []string, _ string, _ v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+					messages := make(chan *v7action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v7action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						messages <- v7action.NewLogMessage("i am message 2", 1, time.Unix(1, 0), "RTR", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v7action.Warnings{"some-warning"}, nil
+				}
+			})
+
+			It("streams the logs of all the apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("i am message 1"))
+				Expect(testUI.Out).To(Say("i am message 2"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationsByNamesAndSpaceCallCount()).To(Equal(1))
+				appNames, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationsByNamesAndSpaceArgsForCall(0)
+				Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(fakeNOAAClient))
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --space flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Space = true
+				cmd.RequiredArgs.AppNames = nil
+
+				fakeActor.GetStreamingLogsForSpaceStub = func(_ string, _ v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+					messages := make(chan *v7action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v7action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, nil, nil
+				}
+			})
+
+			It("streams the logs of every app in the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Retrieving logs for all apps in org some-org-name / space some-space-name as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("i am message 1"))
+
+				Expect(fakeActor.GetStreamingLogsForSpaceCallCount()).To(Equal(1))
+				spaceGUID, client := fakeActor.GetStreamingLogsForSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(fakeNOAAClient))
+			})
+
+			When("the space has no apps", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForSpaceReturns(nil, nil, v7action.Warnings{"some-warning"}, actionerror.ApplicationsNotFoundError{})
+				})
+
+				It("displays that no apps were found", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("No apps found."))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
@@ -12,12 +13,13 @@ import (
 //go:generate counterfeiter . OrgsActor
 
 type OrgsActor interface {
-	GetOrganizations() ([]v7action.Organization, v7action.Warnings, error)
+	GetOrganizations(labelSelector string) ([]v7action.Organization, v7action.Warnings, error)
 }
 
 type OrgsCommand struct {
-	usage           interface{} `usage:"CF_NAME orgs"`
-	relatedCommands interface{} `related_commands:"create-org, org, org-users"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter organizations by labels"`
+	usage           interface{}        `usage:"CF_NAME orgs [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"create-org, org, org-users"`
 
 	UI          command.UI
 	Config      command.Config
@@ -56,7 +58,7 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	orgs, warnings, err := cmd.Actor.GetOrganizations()
+	orgs, warnings, err := cmd.Actor.GetOrganizations(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
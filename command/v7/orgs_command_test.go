@@ -127,6 +127,17 @@ var _ = Describe("orgs Command", func() {
 				})
 			})
 
+			When("the --labels flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Labels = "env=prod"
+				})
+
+				It("passes the label selector to the actor", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetOrganizationsArgsForCall(0)).To(Equal("env=prod"))
+				})
+			})
+
 			When("a translatable error is encountered getting orgs", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationsReturns(
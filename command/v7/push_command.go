@@ -3,6 +3,7 @@ package v7
 import (
 	"os"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -86,6 +87,7 @@ type PushCommand struct {
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Do not wait for the long-running operation to complete; push exits when one instance of the web process is healthy"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Number of apps from the manifest to push at the same time; an app is only pushed once the apps in its depends-on have been pushed"`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
@@ -94,13 +96,14 @@ type PushCommand struct {
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n   [--parallel NUM_APPS]\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n   [--parallel NUM_APPS]"`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	Config          command.Config
 	UI              command.UI
 	NOAAClient      v3action.NOAAClient
+	NewNOAAClient   func() v3action.NOAAClient
 	Actor           PushActor
 	VersionActor    V7ActorForPush
 	SharedActor     command.SharedActor
@@ -127,7 +130,10 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.VersionActor = v7actor
 	cmd.Actor = v7pushaction.NewActor(v7actor, sharedActor)
 
-	cmd.NOAAClient = v6shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	cmd.NewNOAAClient = func() v3action.NOAAClient {
+		return v6shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	}
+	cmd.NOAAClient = cmd.NewNOAAClient()
 
 	currentDir, err := os.Getwd()
 	cmd.PWD = currentDir
@@ -209,25 +215,121 @@ func (cmd PushCommand) Execute(args []string) error {
 	}
 	log.WithField("number of plans", len(pushPlans)).Debug("completed generating plan")
 
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeInParallel(pushPlans)
+	}
+
 	for _, plan := range pushPlans {
-		log.WithField("app_name", plan.Application.Name).Info("actualizing")
-		eventStream := cmd.Actor.Actualize(plan, cmd.ProgressBar)
-		err := cmd.eventStreamHandler(eventStream)
-
-		if cmd.shouldDisplaySummary(err) {
-			summaryErr := cmd.displayAppSummary(plan)
-			if summaryErr != nil {
-				return summaryErr
-			}
-		}
+		err = cmd.actualize(plan)
 		if err != nil {
-			return cmd.mapErr(plan.Application.Name, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd PushCommand) actualize(plan v7pushaction.PushPlan) error {
+	log.WithField("app_name", plan.Application.Name).Info("actualizing")
+	eventStream := cmd.Actor.Actualize(plan, cmd.ProgressBar)
+	err := cmd.eventStreamHandler(eventStream)
+
+	if cmd.shouldDisplaySummary(err) {
+		summaryErr := cmd.displayAppSummary(plan)
+		if summaryErr != nil {
+			return summaryErr
+		}
+	}
+	if err != nil {
+		return cmd.mapErr(plan.Application.Name, err)
+	}
+
+	return nil
+}
+
+// actualizeInParallel pushes up to cmd.Parallel apps at the same time. Each
+// app waits for the apps it depends on, and is skipped when one of them
+// fails. The plans are expected to be ordered by their dependencies.
+func (cmd PushCommand) actualizeInParallel(pushPlans []v7pushaction.PushPlan) error {
+	var (
+		wg      sync.WaitGroup
+		slots   = make(chan struct{}, cmd.Parallel.Value)
+		done    = map[string]chan struct{}{}
+		indexes = map[string]int{}
+		errs    = make([]error, len(pushPlans))
+		skipped = make([]bool, len(pushPlans))
+	)
+
+	for i, plan := range pushPlans {
+		done[plan.Application.Name] = make(chan struct{})
+		indexes[plan.Application.Name] = i
+	}
+
+	for i, plan := range pushPlans {
+		appCmd := cmd.forApp(plan.Application.Name)
+
+		wg.Add(1)
+		go func(i int, plan v7pushaction.PushPlan) {
+			defer wg.Done()
+			defer close(done[plan.Application.Name])
+			defer appCmd.UI.Flush()
+
+			for _, dependency := range plan.DependsOn {
+				dependencyDone, ok := done[dependency]
+				if !ok {
+					continue
+				}
+				<-dependencyDone
+
+				if errs[indexes[dependency]] != nil {
+					appCmd.UI.DisplayWarning("Skipping app {{.AppName}} because app {{.Dependency}} failed to push.", map[string]interface{}{
+						"AppName":    plan.Application.Name,
+						"Dependency": dependency,
+					})
+					errs[i] = errs[indexes[dependency]]
+					skipped[i] = true
+					return
+				}
+			}
+
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			errs[i] = appCmd.actualize(plan)
+			if errs[i] != nil {
+				appCmd.UI.DisplayError(translatableerror.ConvertToTranslatableError(errs[i]))
+			}
+		}(i, plan)
+	}
+
+	wg.Wait()
+
+	var failedAppNames []string
+	for i, err := range errs {
+		if err != nil && !skipped[i] {
+			failedAppNames = append(failedAppNames, pushPlans[i].Application.Name)
 		}
 	}
+	if len(failedAppNames) > 0 {
+		return translatableerror.ParallelPushFailedError{AppNames: failedAppNames}
+	}
 
 	return nil
 }
 
+// forApp returns a copy of the command for pushing a single app alongside
+// others: its output is prefixed with the app name, it streams staging logs
+// with its own NOAA client and it does not draw upload progress bars.
+func (cmd PushCommand) forApp(appName string) PushCommand {
+	appCmd := cmd
+	appCmd.UI = cmd.UI.WithAppPrefix(appName)
+	appCmd.ProgressBar = progressbar.NoopProgressBar{}
+	if cmd.NewNOAAClient != nil {
+		appCmd.NOAAClient = cmd.NewNOAAClient()
+	}
+	return appCmd
+}
+
 func (cmd PushCommand) shouldDisplaySummary(err error) bool {
 	if err == nil {
 		return true
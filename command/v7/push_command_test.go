@@ -21,6 +21,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/progressbar"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	. "github.com/onsi/ginkgo"
@@ -604,6 +605,66 @@ var _ = Describe("push Command", func() {
 
 										})
 
+										When("the --parallel flag is provided", func() {
+											BeforeEach(func() {
+												cmd.Parallel = flag.PositiveInteger{Value: 2}
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													return FillInEvents([]Step{
+														{
+															Plan:  pushPlan,
+															Event: v7pushaction.CreatingApplication,
+														},
+													})
+												}
+											})
+
+											It("actualizes every app without a progress bar and prefixes their output", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												_, progressBar := fakeActor.ActualizeArgsForCall(0)
+												Expect(progressBar).To(Equal(progressbar.NoopProgressBar{}))
+
+												Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[first-app] Creating app first-app..."))
+												Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[second-app] Creating app second-app..."))
+											})
+
+											When("an app depends on another app", func() {
+												BeforeEach(func() {
+													fakeActor.UpdateApplicationSettingsReturns(
+														[]v7pushaction.PushPlan{
+															{Application: v7action.Application{Name: appName1}},
+															{Application: v7action.Application{Name: appName2}, DependsOn: []string{appName1}},
+														},
+														nil, nil)
+												})
+
+												It("actualizes the app after the app it depends on", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+													Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+													firstPlan, _ := fakeActor.ActualizeArgsForCall(0)
+													Expect(firstPlan.Application.Name).To(Equal(appName1))
+												})
+
+												When("the app it depends on fails to push", func() {
+													BeforeEach(func() {
+														fakeActor.ActualizeStub = func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+															return FillInEvents([]Step{
+																{Error: errors.New("some-push-error")},
+															})
+														}
+													})
+
+													It("skips the app and returns a parallel push error", func() {
+														Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{AppNames: []string{appName1}}))
+														Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+
+														Expect(testUI.Err).To(Say(`\[first-app\] some-push-error`))
+														Expect(testUI.Err).To(Say(`\[second-app\] Skipping app second-app because app first-app failed to push\.`))
+													})
+												})
+											})
+										})
+
 										When("actualize returns an error", func() {
 											When("the error is generic", func() {
 												BeforeEach(func() {
@@ -0,0 +1,70 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . RenameServiceActor
+
+type RenameServiceActor interface {
+	RenameServiceInstance(currentServiceInstanceName string, spaceGUID string, newServiceInstanceName string) (v7action.Warnings, error)
+}
+
+type RenameServiceCommand struct {
+	RequiredArgs    flag.RenameServiceArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-service SERVICE_INSTANCE NEW_SERVICE_INSTANCE"`
+	relatedCommands interface{}            `related_commands:"services, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RenameServiceActor
+}
+
+func (cmd *RenameServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd RenameServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Renaming service {{.ServiceInstance}} to {{.NewServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance":    cmd.RequiredArgs.ServiceInstance,
+		"NewServiceInstance": cmd.RequiredArgs.NewServiceInstanceName,
+		"Org":                cmd.Config.TargetedOrganization().Name,
+		"Space":              cmd.Config.TargetedSpace().Name,
+		"User":               user.Name,
+	})
+
+	warnings, err := cmd.Actor.RenameServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.NewServiceInstanceName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
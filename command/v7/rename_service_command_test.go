@@ -0,0 +1,98 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-service Command", func() {
+	var (
+		cmd             v7.RenameServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRenameServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRenameServiceActor)
+
+		cmd = v7.RenameServiceCommand{
+			RequiredArgs: flag.RenameServiceArgs{
+				ServiceInstance:        "old-name",
+				NewServiceInstanceName: "new-name",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("renaming succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.RenameServiceInstanceReturns(v7action.Warnings{"rename-warning"}, nil)
+		})
+
+		It("renames the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RenameServiceInstanceCallCount()).To(Equal(1))
+			oldName, spaceGUID, newName := fakeActor.RenameServiceInstanceArgsForCall(0)
+			Expect(oldName).To(Equal("old-name"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(newName).To(Equal("new-name"))
+
+			Expect(testUI.Out).To(Say(`Renaming service old-name to new-name in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+		})
+	})
+
+	When("renaming fails", func() {
+		BeforeEach(func() {
+			fakeActor.RenameServiceInstanceReturns(v7action.Warnings{"rename-warning"}, errors.New("rename-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("rename-error"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+		})
+	})
+})
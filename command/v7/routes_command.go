@@ -6,7 +6,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 )
@@ -14,15 +16,16 @@ import (
 //go:generate counterfeiter . RoutesActor
 
 type RoutesActor interface {
-	GetRoutesBySpace(string) ([]v7action.Route, v7action.Warnings, error)
-	GetRoutesByOrg(string) ([]v7action.Route, v7action.Warnings, error)
+	GetRoutesBySpace(spaceGUID string, labelSelector string) ([]v7action.Route, v7action.Warnings, error)
+	GetRoutesByOrg(orgGUID string, labelSelector string) ([]v7action.Route, v7action.Warnings, error)
 	GetRouteSummaries([]v7action.Route) ([]v7action.RouteSummary, v7action.Warnings, error)
 }
 
 type RoutesCommand struct {
-	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
-	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
-	Orglevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
+	usage           interface{}        `usage:"CF_NAME routes [--orglevel] [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"check-route, domains, map-route, unmap-route"`
+	Orglevel        bool               `long:"orglevel" description:"List all the routes for all spaces of current organization"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter routes by labels"`
 
 	UI          command.UI
 	Config      command.Config
@@ -64,19 +67,24 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	targetedOrg := cmd.Config.TargetedOrganization()
 	targetedSpace := cmd.Config.TargetedSpace()
 
+	outputFormat := cmd.Config.OutputFormat()
 	if cmd.Orglevel {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":  targetedOrg.Name,
-			"CurrentUser": currentUser.Name,
-		})
-		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID)
+		if outputFormat == configv3.OutputFormatText {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":  targetedOrg.Name,
+				"CurrentUser": currentUser.Name,
+			})
+		}
+		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, string(cmd.Labels))
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":   targetedOrg.Name,
-			"CurrentSpace": targetedSpace.Name,
-			"CurrentUser":  currentUser.Name,
-		})
-		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID)
+		if outputFormat == configv3.OutputFormatText {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":   targetedOrg.Name,
+				"CurrentSpace": targetedSpace.Name,
+				"CurrentUser":  currentUser.Name,
+			})
+		}
+		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, string(cmd.Labels))
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -90,6 +98,10 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewRouteDocuments(routeSummaries))
+	}
+
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
 	} else {
@@ -173,6 +173,32 @@ var _ = Describe("routes Command", func() {
 			})
 		})
 
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector when getting space routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				spaceGUID, labelSelector := fakeActor.GetRoutesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
+
+			When("--orglevel is passed", func() {
+				BeforeEach(func() {
+					cmd.Orglevel = true
+				})
+
+				It("passes the label selector when getting org routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					orgGUID, labelSelector := fakeActor.GetRoutesByOrgArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(labelSelector).To(Equal("env=prod"))
+				})
+			})
+		})
+
 		When("getting space routes fails", func() {
 			var expectedErr error
 
@@ -210,4 +236,37 @@ var _ = Describe("routes Command", func() {
 			})
 		})
 	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			routes := []v7action.Route{
+				{GUID: "route-guid-1", SpaceName: "some-space", Host: "host", DomainName: "domain1", Path: "/path", URL: "host.domain1/path"},
+			}
+			fakeActor.GetRoutesBySpaceReturns(routes, v7action.Warnings{"warning-1"}, nil)
+			fakeActor.GetRouteSummariesReturns([]v7action.RouteSummary{
+				{Route: routes[0], AppNames: []string{"app1", "app2"}},
+			}, v7action.Warnings{"warning-2"}, nil)
+		})
+
+		It("displays the routes as json and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting routes"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"guid": "route-guid-1",
+					"url": "host.domain1/path",
+					"space": "some-space",
+					"host": "host",
+					"domain": "domain1",
+					"path": "/path",
+					"apps": ["app1", "app2"]
+				}
+			]`))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
 })
@@ -0,0 +1,243 @@
+package v7
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+const (
+	// taskLogIdleTimeout is how long --wait keeps displaying the logs of a
+	// finished task after the last one arrived, since the log stream lags
+	// behind the task state.
+	taskLogIdleTimeout = 2 * time.Second
+
+	// taskLogDrainTimeout is the longest --wait keeps displaying the logs of a
+	// finished task.
+	taskLogDrainTimeout = 10 * time.Second
+)
+
+//go:generate counterfeiter . RunTaskActor
+
+type RunTaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error)
+	PollTask(appGUID string, task v7action.Task, timeout time.Duration) (v7action.Task, v7action.Warnings, error)
+	RunTask(appGUID string, task v7action.Task) (v7action.Task, v7action.Warnings, error)
+}
+
+type RunTaskCommand struct {
+	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
+	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
+	Wait            bool             `long:"wait" short:"w" description:"Display the logs of the task and wait for it to finish, failing if the task fails"`
+	Timeout         time.Duration    `long:"timeout" description:"Terminate the task if it has not finished within this duration, such as 30s, 5m or 1h (requires --wait)"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait [--timeout DURATION]]\n\nTIP:\n   Use '--wait' to display the logs of the task and exit with a non-zero status if it fails. Use 'cf logs' to display the logs of the app and all its tasks.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait --timeout 30m"`
+	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RunTaskActor
+	NOAAClient  v7action.NOAAClient
+	Clock       clock.Clock
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Clock = clock.NewClock()
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, uaaClient, cmd.Clock)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd RunTaskCommand) Execute(args []string) error {
+	if cmd.Timeout != 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	inputTask := v7action.Task{
+		Command: cmd.RequiredArgs.Command,
+	}
+
+	if cmd.Name != "" {
+		inputTask.Name = cmd.Name
+	}
+	if cmd.Disk.IsSet {
+		inputTask.DiskInMB = cmd.Disk.Value
+	}
+	if cmd.Memory.IsSet {
+		inputTask.MemoryInMB = cmd.Memory.Value
+	}
+
+	// Start tailing before the task is created so that none of its output is
+	// missed.
+	var (
+		messages <-chan *v7action.LogMessage
+		logErrs  <-chan error
+	)
+	if cmd.Wait {
+		messages, logErrs = cmd.Actor.GetStreamingLogs(application.GUID, cmd.NOAAClient)
+		defer cmd.NOAAClient.Close()
+	}
+
+	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Task has been submitted successfully for execution.")
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("task name:"), task.Name},
+		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
+	}, 3)
+
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(application.GUID, task, messages, logErrs)
+}
+
+type polledTask struct {
+	task     v7action.Task
+	warnings v7action.Warnings
+	err      error
+}
+
+// waitForTask displays the logs of the task until polling finds it has
+// finished.
+func (cmd RunTaskCommand) waitForTask(appGUID string, task v7action.Task, messages <-chan *v7action.LogMessage, logErrs <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to finish...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	done := make(chan polledTask, 1)
+	go func() {
+		finishedTask, warnings, err := cmd.Actor.PollTask(appGUID, task, cmd.Timeout)
+		done <- polledTask{task: finishedTask, warnings: warnings, err: err}
+	}()
+
+	// Task logs have the source type APP/TASK/<task name>. Their source
+	// instance is always 0, so it cannot tell apart tasks sharing a name, and
+	// the logs of all of them are displayed.
+	filter := v7action.LogFilter{SourceTypes: []string{"APP/TASK/" + task.Name}}
+
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+			if filter.Matches(*message) {
+				cmd.UI.DisplayLogMessage(message, true)
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			switch logErr.(type) {
+			case actionerror.NOAATimeoutError:
+				cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+			default:
+				cmd.UI.DisplayWarning(logErr.Error())
+			}
+		case result := <-done:
+			cmd.drainTaskLogs(messages, filter)
+
+			cmd.UI.DisplayWarnings(result.warnings)
+			if result.err != nil {
+				return result.err
+			}
+
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Task {{.TaskName}} succeeded.", map[string]interface{}{
+				"TaskName": result.task.Name,
+			})
+			return nil
+		}
+	}
+}
+
+// drainTaskLogs displays the logs of the finished task that are still
+// arriving. It returns once no log of the task has arrived for
+// taskLogIdleTimeout, the log stream ends or taskLogDrainTimeout has passed.
+func (cmd RunTaskCommand) drainTaskLogs(messages <-chan *v7action.LogMessage, filter v7action.LogFilter) {
+	if messages == nil {
+		return
+	}
+
+	deadline := cmd.Clock.NewTimer(taskLogDrainTimeout)
+	defer deadline.Stop()
+	idle := cmd.Clock.NewTimer(taskLogIdleTimeout)
+	defer func() { idle.Stop() }()
+
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			if !filter.Matches(*message) {
+				break
+			}
+
+			idle.Stop()
+			idle = cmd.Clock.NewTimer(taskLogIdleTimeout)
+			cmd.UI.DisplayLogMessage(message, true)
+		case <-idle.C():
+			return
+		case <-deadline.C():
+			return
+		}
+	}
+}
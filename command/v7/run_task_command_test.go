@@ -0,0 +1,519 @@
+package v7_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-task Command", func() {
+	var (
+		cmd             RunTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRunTaskActor
+		fakeClock       *fakeclock.FakeClock
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRunTaskActor)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		cmd = RunTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Clock:       fakeClock,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.Command = "some command"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout = time.Minute
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.HasTargetedOrganizationReturns(true)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.HasTargetedSpaceReturns(true)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+		})
+
+		When("getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(
+					configv3.User{},
+					expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		When("getting the current user does not return an error", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(
+					configv3.User{Name: "some-user"},
+					nil)
+			})
+
+			When("provided a valid application name", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v7action.Application{GUID: "some-app-guid"},
+						v7action.Warnings{"get-application-warning-1", "get-application-warning-2"},
+						nil)
+				})
+
+				When("the task name is not provided", func() {
+					BeforeEach(func() {
+						fakeActor.RunTaskReturns(
+							v7action.Task{
+								Name:       "31337ddd",
+								SequenceID: 3,
+							},
+							v7action.Warnings{"get-application-warning-3"},
+							nil)
+					})
+
+					It("creates a new task and displays all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app-name"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+						appGUID, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(task).To(Equal(v7action.Task{Command: "some command"}))
+
+						Expect(testUI.Out).To(Say("Creating task for app some-app-name in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(testUI.Out).To(Say("Task has been submitted successfully for execution."))
+						Expect(testUI.Out).To(Say(`task name:\s+31337ddd`))
+						Expect(testUI.Out).To(Say(`task id:\s+3`))
+
+						Expect(testUI.Err).To(Say("get-application-warning-1"))
+						Expect(testUI.Err).To(Say("get-application-warning-2"))
+						Expect(testUI.Err).To(Say("get-application-warning-3"))
+					})
+				})
+
+				When("task disk space is provided", func() {
+					BeforeEach(func() {
+						cmd.Name = "some-task-name"
+						cmd.Disk = flag.Megabytes{NullUint64: types.NullUint64{Value: 321, IsSet: true}}
+						cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{Value: 123, IsSet: true}}
+						fakeActor.RunTaskReturns(
+							v7action.Task{
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							v7action.Warnings{"get-application-warning-3"},
+							nil)
+					})
+
+					It("creates a new task and outputs all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app-name"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+						appGUID, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(task).To(Equal(v7action.Task{
+							Command:    "some command",
+							Name:       "some-task-name",
+							DiskInMB:   321,
+							MemoryInMB: 123,
+						}))
+
+						Expect(testUI.Out).To(Say("Creating task for app some-app-name in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(testUI.Out).To(Say("Task has been submitted successfully for execution."))
+						Expect(testUI.Out).To(Say(`task name:\s+some-task-name`))
+						Expect(testUI.Out).To(Say(`task id:\s+3`))
+
+						Expect(testUI.Err).To(Say("get-application-warning-1"))
+						Expect(testUI.Err).To(Say("get-application-warning-2"))
+						Expect(testUI.Err).To(Say("get-application-warning-3"))
+					})
+				})
+				When("--wait is provided", func() {
+					var (
+						fakeNOAAClient *v7actionfakes.FakeNOAAClient
+						messages       chan *v7action.LogMessage
+						logErrs        chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+						cmd.Name = "some-task-name"
+						fakeNOAAClient = new(v7actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+
+						messages = make(chan *v7action.LogMessage, 4)
+						messages <- v7action.NewLogMessage("some-task-output", 1, time.Now(), "APP/TASK/some-task-name", "0")
+						messages <- v7action.NewLogMessage("some-web-output", 1, time.Now(), "APP/PROC/WEB", "0")
+						messages <- v7action.NewLogMessage("some-other-task-output", 1, time.Now(), "APP/TASK/some-other-task", "0")
+						logErrs = make(chan error, 1)
+						fakeActor.GetStreamingLogsReturns(messages, logErrs)
+
+						fakeActor.RunTaskReturns(
+							v7action.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							v7action.Warnings{"run-task-warning"},
+							nil)
+
+						// Finish polling only once the logs have been read, and end
+						// the log stream so that waiting does not idle.
+						fakeActor.PollTaskStub = func(string, v7action.Task, time.Duration) (v7action.Task, v7action.Warnings, error) {
+							for len(messages) > 0 || len(logErrs) > 0 {
+								time.Sleep(time.Millisecond)
+							}
+							close(messages)
+							return v7action.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+								State:      constant.TaskSucceeded,
+							}, v7action.Warnings{"poll-task-warning"}, nil
+						}
+					})
+
+					When("the task succeeds", func() {
+						It("displays the logs of the task and waits for it to finish", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+							appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+							appGUID, task, timeout := fakeActor.PollTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task).To(Equal(v7action.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+							}))
+							Expect(timeout).To(BeZero())
+
+							Expect(testUI.Out).To(Say("Task has been submitted successfully for execution."))
+							Expect(testUI.Out).To(Say("Waiting for task some-task-name to finish..."))
+							Expect(testUI.Out).To(Say("some-task-output"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("some-web-output"))
+							Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("some-other-task-output"))
+
+							Expect(testUI.Err).To(Say("run-task-warning"))
+							Expect(testUI.Err).To(Say("poll-task-warning"))
+
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					When("logs of the task arrive after it finished", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskStub = func(string, v7action.Task, time.Duration) (v7action.Task, v7action.Warnings, error) {
+								for len(messages) > 0 {
+									time.Sleep(time.Millisecond)
+								}
+								go func() {
+									time.Sleep(50 * time.Millisecond)
+									messages <- v7action.NewLogMessage("some-late-task-output", 1, time.Now(), "APP/TASK/some-task-name", "0")
+									close(messages)
+								}()
+								return v7action.Task{Name: "some-task-name", State: constant.TaskSucceeded}, nil, nil
+							}
+						})
+
+						It("displays them before reporting the result", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("some-task-output"))
+							Expect(testUI.Out).To(Say("some-late-task-output"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+						})
+					})
+
+					When("the log stream stays open after the task finished", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskStub = func(string, v7action.Task, time.Duration) (v7action.Task, v7action.Warnings, error) {
+								for len(messages) > 0 {
+									time.Sleep(time.Millisecond)
+								}
+								return v7action.Task{Name: "some-task-name", State: constant.TaskSucceeded}, nil, nil
+							}
+						})
+
+						When("no more logs of the task arrive", func() {
+							BeforeEach(func() {
+								go fakeClock.WaitForNWatchersAndIncrement(2*time.Second, 2)
+							})
+
+							It("stops waiting for logs once they have been idle for 2 seconds", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("some-task-output"))
+								Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							})
+						})
+
+						When("logs of the task keep arriving", func() {
+							BeforeEach(func() {
+								displayed := func(output string) bool {
+									return strings.Contains(string(testUI.Out.(*Buffer).Contents()), output)
+								}
+
+								go func() {
+									fakeClock.WaitForNWatchersAndIncrement(1500*time.Millisecond, 2)
+									for _, output := range []string{"late-1", "late-2", "late-3", "late-4", "late-5", "late-6"} {
+										messages <- v7action.NewLogMessage(output, 1, time.Now(), "APP/TASK/some-task-name", "0")
+										for !displayed(output) {
+											time.Sleep(time.Millisecond)
+										}
+										fakeClock.Increment(1500 * time.Millisecond)
+									}
+								}()
+							})
+
+							It("stops waiting for logs after 10 seconds", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("late-1"))
+								Expect(testUI.Out).To(Say("late-6"))
+								Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							})
+						})
+					})
+
+					When("a timeout is provided", func() {
+						BeforeEach(func() {
+							cmd.Timeout = 30 * time.Minute
+						})
+
+						It("polls the task with the timeout", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+							_, _, timeout := fakeActor.PollTaskArgsForCall(0)
+							Expect(timeout).To(Equal(30 * time.Minute))
+						})
+					})
+
+					When("the task fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = actionerror.TaskFailedError{
+								SequenceID:    3,
+								Name:          "some-task-name",
+								FailureReason: "Exited with status 1",
+							}
+							fakeActor.PollTaskStub = nil
+							fakeActor.PollTaskReturns(v7action.Task{}, v7action.Warnings{"poll-task-warning"}, expectedErr)
+							close(messages)
+						})
+
+						It("returns the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Out).ToNot(Say("succeeded"))
+							Expect(testUI.Err).To(Say("poll-task-warning"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					When("connecting to the log server times out", func() {
+						BeforeEach(func() {
+							logErrs <- actionerror.NOAATimeoutError{}
+						})
+
+						It("displays a warning and keeps waiting for the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("timeout connecting to log server, no log will be shown"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+						})
+					})
+
+					When("running the task returns an error", func() {
+						BeforeEach(func() {
+							fakeActor.RunTaskReturns(v7action.Task{}, nil, errors.New("run-task-error"))
+						})
+
+						It("stops tailing the logs and returns the error", func() {
+							Expect(executeErr).To(MatchError("run-task-error"))
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(0))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+				})
+			})
+
+			When("there are errors", func() {
+				When("the error is translatable", func() {
+					When("getting the app returns the error", func() {
+						var (
+							returnedErr error
+							expectedErr error
+						)
+
+						BeforeEach(func() {
+							expectedErr = errors.New("request-error")
+							returnedErr = ccerror.RequestError{Err: expectedErr}
+							fakeActor.GetApplicationByNameAndSpaceReturns(
+								v7action.Application{GUID: "some-app-guid"},
+								nil,
+								returnedErr)
+						})
+
+						It("returns a translatable error", func() {
+							Expect(executeErr).To(MatchError(ccerror.RequestError{Err: expectedErr}))
+						})
+					})
+
+					When("running the task returns the error", func() {
+						var returnedErr error
+
+						BeforeEach(func() {
+							returnedErr = ccerror.UnverifiedServerError{URL: "some-url"}
+							fakeActor.GetApplicationByNameAndSpaceReturns(
+								v7action.Application{GUID: "some-app-guid"},
+								nil,
+								nil)
+							fakeActor.RunTaskReturns(
+								v7action.Task{},
+								nil,
+								returnedErr)
+						})
+
+						It("returns a translatable error", func() {
+							Expect(executeErr).To(MatchError(returnedErr))
+						})
+					})
+				})
+
+				When("the error is not translatable", func() {
+					When("getting the app returns the error", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("got bananapants??")
+							fakeActor.GetApplicationByNameAndSpaceReturns(
+								v7action.Application{GUID: "some-app-guid"},
+								v7action.Warnings{"get-application-warning-1", "get-application-warning-2"},
+								expectedErr)
+						})
+
+						It("return the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+
+							Expect(testUI.Err).To(Say("get-application-warning-1"))
+							Expect(testUI.Err).To(Say("get-application-warning-2"))
+						})
+					})
+
+					When("running the task returns an error", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("got bananapants??")
+							fakeActor.GetApplicationByNameAndSpaceReturns(
+								v7action.Application{GUID: "some-app-guid"},
+								v7action.Warnings{"get-application-warning-1", "get-application-warning-2"},
+								nil)
+							fakeActor.RunTaskReturns(
+								v7action.Task{},
+								v7action.Warnings{"run-task-warning-1", "run-task-warning-2"},
+								expectedErr)
+						})
+
+						It("returns the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+
+							Expect(testUI.Err).To(Say("get-application-warning-1"))
+							Expect(testUI.Err).To(Say("get-application-warning-2"))
+							Expect(testUI.Err).To(Say("run-task-warning-1"))
+							Expect(testUI.Err).To(Say("run-task-warning-2"))
+						})
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,148 @@
+package v7
+
+import (
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . SharedSCPActor
+
+type SharedSCPActor interface {
+	TransferFiles(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, transfer sharedaction.FileTransfer) error
+}
+
+//go:generate counterfeiter . SCPActor
+
+type SCPActor interface {
+	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+}
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	ProcessIndex       uint         `long:"instance" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string       `long:"process" default:"web" description:"App process name"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Copy directories recursively"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [--process PROCESS] [-i INDEX] [-r] SOURCE DESTINATION\n\n   Either SOURCE or DESTINATION must be APP_NAME:PATH, a path in the app container.\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof .\n   CF_NAME scp -r ./config my-app:/home/vcap/app/config"`
+	relatedCommands interface{} `related_commands:"ssh, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SCPActor
+	SSHActor    SharedSCPActor
+	SSHClient   *clissh.SecureShell
+	ProgressBar clissh.TransferProgress
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient, clock.NewClock())
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewTransferProgressBar()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	transfer, appName, err := cmd.fileTransfer()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.SSHActor.TransferFiles(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		transfer,
+	)
+}
+
+// fileTransfer works out the direction of the copy from which of the source
+// and destination is an APP_NAME:PATH.
+func (cmd SCPCommand) fileTransfer() (sharedaction.FileTransfer, string, error) {
+	sourceApp, sourcePath, sourceIsRemote := splitAppPath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := splitAppPath(cmd.RequiredArgs.Destination)
+
+	transfer := sharedaction.FileTransfer{
+		Recursive: cmd.Recursive,
+		Progress:  cmd.ProgressBar,
+	}
+
+	switch {
+	case sourceIsRemote && !destinationIsRemote:
+		transfer.Direction = sharedaction.DownloadFromApp
+		transfer.RemotePath = sourcePath
+		transfer.LocalPath = cmd.RequiredArgs.Destination
+		return transfer, sourceApp, nil
+	case destinationIsRemote && !sourceIsRemote:
+		transfer.Direction = sharedaction.UploadToApp
+		transfer.LocalPath = cmd.RequiredArgs.Source
+		transfer.RemotePath = destinationPath
+		return transfer, destinationApp, nil
+	default:
+		return transfer, "", translatableerror.SSHCopyPathsError{}
+	}
+}
+
+// splitAppPath splits APP_NAME:PATH. Like scp, a colon after a slash is part
+// of a local path, so ./file:name stays local, as do Windows drive letters.
+func splitAppPath(arg string) (string, string, bool) {
+	colon := strings.Index(arg, ":")
+	if colon <= 0 || strings.ContainsAny(arg[:colon], `/\`) {
+		return "", "", false
+	}
+	if colon == 1 && runtime.GOOS == "windows" {
+		return "", "", false
+	}
+
+	remotePath := arg[colon+1:]
+	if remotePath == "" {
+		remotePath = "."
+	}
+	return arg[:colon], remotePath, true
+}
@@ -0,0 +1,194 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeSCPActor
+		fakeSSHActor    *v7fakes.FakeSharedSCPActor
+		progressBar     *progressbar.TransferProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeSCPActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSCPActor)
+		progressBar = progressbar.NewTransferProgressBar()
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SCPArgs{
+				Source:      "some-app:/home/vcap/app/heap.hprof",
+				Destination: "./heap.hprof",
+			},
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHActor:    fakeSSHActor,
+			ProgressBar: progressBar,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+			Expect(fakeSSHActor.TransferFilesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the source is in the app container", func() {
+		It("downloads the file from the app instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warnings"))
+
+			appName, spaceGUID, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("some-process-type"))
+			Expect(processIndex).To(Equal(uint(1)))
+
+			Expect(fakeSSHActor.TransferFilesCallCount()).To(Equal(1))
+			_, sshOptions, transfer := fakeSSHActor.TransferFilesArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				SkipHostValidation: true,
+				Username:           "some-username",
+			}))
+			Expect(transfer).To(Equal(sharedaction.FileTransfer{
+				Direction:  sharedaction.DownloadFromApp,
+				LocalPath:  "./heap.hprof",
+				RemotePath: "/home/vcap/app/heap.hprof",
+				Progress:   progressBar,
+			}))
+		})
+	})
+
+	When("the destination is in the app container", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{
+				Source:      "./config",
+				Destination: "some-app:",
+			}
+			cmd.Recursive = true
+		})
+
+		It("uploads the files to the app instance's home directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, _, _, _ := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+
+			_, _, transfer := fakeSSHActor.TransferFilesArgsForCall(0)
+			Expect(transfer).To(Equal(sharedaction.FileTransfer{
+				Direction:  sharedaction.UploadToApp,
+				LocalPath:  "./config",
+				RemotePath: ".",
+				Recursive:  true,
+				Progress:   progressBar,
+			}))
+		})
+	})
+
+	When("neither path is in an app container", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{
+				Source:      "./some:file",
+				Destination: "/tmp",
+			}
+		})
+
+		It("returns an SSHCopyPathsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCopyPathsError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both paths are in app containers", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{
+				Source:      "some-app:/tmp/a",
+				Destination: "other-app:/tmp/b",
+			}
+		})
+
+		It("returns an SSHCopyPathsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCopyPathsError{}))
+		})
+	})
+
+	When("getting the secure shell configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v7action.SSHAuthentication{},
+				v7action.Warnings{"some-warnings"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.TransferFilesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the transfer fails", func() {
+		BeforeEach(func() {
+			fakeSSHActor.TransferFilesReturns(errors.New("some-transfer-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-transfer-error"))
+		})
+	})
+})
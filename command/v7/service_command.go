@@ -0,0 +1,150 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . ServiceActor
+
+type ServiceActor interface {
+	GetServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (v7action.ServiceInstance, v7action.Warnings, error)
+	GetServiceInstanceSummaryByNameAndSpace(serviceInstanceName string, spaceGUID string) (v7action.ServiceInstanceSummary, v7action.Warnings, error)
+}
+
+type ServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	GUID            bool                 `long:"guid" description:"Retrieve and display the given service's guid. All other output for the service is suppressed."`
+	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
+	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceActor
+}
+
+func (cmd *ServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd ServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.GUID {
+		return cmd.displayServiceInstanceGUID()
+	}
+
+	return cmd.displayServiceInstanceSummary()
+}
+
+func (cmd ServiceCommand) displayServiceInstanceGUID() error {
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText(serviceInstance.GUID)
+	return nil
+}
+
+func (cmd ServiceCommand) displayServiceInstanceSummary() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Showing info of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"OrgName":             cmd.Config.TargetedOrganization().Name,
+			"SpaceName":           cmd.Config.TargetedSpace().Name,
+			"UserName":            user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	summary, warnings, err := cmd.Actor.GetServiceInstanceSummaryByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewServiceInstanceDocument(summary))
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+		{cmd.UI.TranslateText("service:"), serviceOfferingLabel(summary)},
+	}
+
+	if summary.IsManaged() {
+		table = append(table,
+			[]string{cmd.UI.TranslateText("plan:"), summary.ServicePlanName},
+			[]string{cmd.UI.TranslateText("dashboard:"), summary.DashboardURL},
+		)
+	}
+
+	table = append(table, []string{cmd.UI.TranslateText("tags:"), strings.Join(summary.Tags, ", ")})
+
+	if summary.RouteServiceURL.Value != "" {
+		table = append(table, []string{cmd.UI.TranslateText("route service url:"), summary.RouteServiceURL.Value})
+	}
+	if summary.SyslogDrainURL.Value != "" {
+		table = append(table, []string{cmd.UI.TranslateText("syslog drain url:"), summary.SyslogDrainURL.Value})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", table, ui.DefaultTableSpacePadding)
+
+	if summary.IsManaged() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Showing status of last operation from service {{.ServiceInstanceName}}...", map[string]interface{}{
+			"ServiceInstanceName": summary.Name,
+		})
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("status:"), lastOperationSummary(summary.ServiceInstance)},
+			{cmd.UI.TranslateText("message:"), summary.LastOperation.Description},
+			{cmd.UI.TranslateText("started:"), summary.LastOperation.CreatedAt},
+			{cmd.UI.TranslateText("updated:"), summary.LastOperation.UpdatedAt},
+		}, ui.DefaultTableSpacePadding)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(summary.BoundAppNames) == 0 {
+		cmd.UI.DisplayText("There are no bound apps for this service.")
+		return nil
+	}
+
+	boundAppsTable := [][]string{{cmd.UI.TranslateText("bound apps:")}}
+	for _, appName := range summary.BoundAppNames {
+		boundAppsTable = append(boundAppsTable, []string{appName})
+	}
+	cmd.UI.DisplayTableWithHeader("", boundAppsTable, ui.DefaultTableSpacePadding)
+
+	return nil
+}
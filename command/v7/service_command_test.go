@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service Command", func() {
+	var (
+		cmd             v7.ServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeServiceActor)
+
+		cmd = v7.ServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the --guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(v7action.ServiceInstance{GUID: "some-instance-guid"}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays only the guid", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			instanceName, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("^some-instance-guid\n$"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(fakeActor.GetServiceInstanceSummaryByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the service instance is managed", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(v7action.ServiceInstanceSummary{
+				ServiceInstance: v7action.ServiceInstance{
+					Name:         "some-instance",
+					Type:         constant.ManagedService,
+					Tags:         []string{"tag-1", "tag-2"},
+					DashboardURL: "https://dashboard.example.com",
+					LastOperation: ccv3.LastOperation{
+						Type:        constant.LastOperationUpdate,
+						State:       constant.LastOperationInProgress,
+						Description: "upgrading",
+						CreatedAt:   "2019-01-01T00:00:00Z",
+						UpdatedAt:   "2019-01-02T00:00:00Z",
+					},
+				},
+				ServiceOfferingName: "some-offering",
+				ServicePlanName:     "some-plan",
+				BoundAppNames:       []string{"app-1"},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the service instance and its last operation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Showing info of service some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name:\s+some-instance`))
+			Expect(testUI.Out).To(Say(`service:\s+some-offering`))
+			Expect(testUI.Out).To(Say(`plan:\s+some-plan`))
+			Expect(testUI.Out).To(Say(`dashboard:\s+https://dashboard.example.com`))
+			Expect(testUI.Out).To(Say(`tags:\s+tag-1, tag-2`))
+			Expect(testUI.Out).To(Say(`Showing status of last operation from service some-instance\.\.\.`))
+			Expect(testUI.Out).To(Say(`status:\s+update in progress`))
+			Expect(testUI.Out).To(Say(`message:\s+upgrading`))
+			Expect(testUI.Out).To(Say(`started:\s+2019-01-01T00:00:00Z`))
+			Expect(testUI.Out).To(Say(`updated:\s+2019-01-02T00:00:00Z`))
+			Expect(testUI.Out).To(Say(`bound apps:`))
+			Expect(testUI.Out).To(Say(`app-1`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("the service instance is user-provided", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(v7action.ServiceInstanceSummary{
+				ServiceInstance: v7action.ServiceInstance{
+					Name:           "some-instance",
+					Type:           constant.UserProvidedService,
+					SyslogDrainURL: types.NewNullString("https://syslog.example.com"),
+				},
+			}, nil, nil)
+		})
+
+		It("displays the service instance without a last operation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`service:\s+user-provided`))
+			Expect(testUI.Out).To(Say(`syslog drain url:\s+https://syslog.example.com`))
+			Expect(testUI.Out).ToNot(Say("last operation"))
+			Expect(testUI.Out).To(Say("There are no bound apps for this service."))
+		})
+	})
+
+	When("getting the service instance fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(v7action.ServiceInstanceSummary{}, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . ServiceKeyActor
+
+type ServiceKeyActor interface {
+	GetServiceKeyByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (v7action.ServiceCredentialBinding, v7action.Warnings, error)
+	GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName string, serviceKeyName string, spaceGUID string) (v7action.ServiceCredentialBindingDetails, v7action.Warnings, error)
+}
+
+type ServiceKeyCommand struct {
+	RequiredArgs    flag.ServiceInstanceKey `positional-args:"yes"`
+	GUID            bool                    `long:"guid" description:"Retrieve and display the given service-key's guid.  All other output for the service is suppressed."`
+	usage           interface{}             `usage:"CF_NAME service-key SERVICE_INSTANCE SERVICE_KEY\n\nEXAMPLES:\n   CF_NAME service-key mydb mykey"`
+	relatedCommands interface{}             `related_commands:"service-keys"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceKeyActor
+}
+
+func (cmd *ServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd ServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.GUID {
+		key, warnings, err := cmd.Actor.GetServiceKeyByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayText(key.GUID)
+		return nil
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"CurrentUser":         user.Name,
+		})
+		cmd.UI.DisplayNewline()
+
+		// The credentials are always displayed as JSON, as in previous
+		// versions of the CLI.
+		outputFormat = configv3.OutputFormatJSON
+	}
+
+	details, warnings, err := cmd.Actor.GetServiceKeyDetailsByServiceInstanceAndName(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	credentials := details.Credentials
+	if credentials == nil {
+		credentials = map[string]interface{}{}
+	}
+
+	return cmd.UI.DisplayStructuredOutput(outputFormat, credentials)
+}
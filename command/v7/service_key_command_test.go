@@ -0,0 +1,123 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-key Command", func() {
+	var (
+		cmd             v7.ServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeServiceKeyActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeServiceKeyActor)
+
+		cmd = v7.ServiceKeyCommand{
+			RequiredArgs: flag.ServiceInstanceKey{
+				ServiceInstance: "some-instance",
+				ServiceKey:      "some-key",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the --guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			fakeActor.GetServiceKeyByServiceInstanceAndNameReturns(v7action.ServiceCredentialBinding{GUID: "some-key-guid"}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays only the guid", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			instanceName, keyName, spaceGUID := fakeActor.GetServiceKeyByServiceInstanceAndNameArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(keyName).To(Equal("some-key"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("^some-key-guid\n$"))
+			Expect(fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the key details succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameReturns(v7action.ServiceCredentialBindingDetails{
+				Credentials: map[string]interface{}{"password": "secret"},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the credentials as json", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting key some-key for service instance some-instance as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`"password": "secret"`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("the output format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			})
+
+			It("displays only the credentials as yaml", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting key"))
+				Expect(testUI.Out).To(Say("password: secret"))
+			})
+		})
+	})
+
+	When("the key does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameReturns(v7action.ServiceCredentialBindingDetails{}, v7action.Warnings{"some-warning"}, actionerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-instance"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-instance"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
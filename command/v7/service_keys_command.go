@@ -0,0 +1,89 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . ServiceKeysActor
+
+type ServiceKeysActor interface {
+	GetServiceKeysByServiceInstance(serviceInstanceName string, spaceGUID string) ([]v7action.ServiceCredentialBinding, v7action.Warnings, error)
+}
+
+type ServiceKeysCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME service-keys SERVICE_INSTANCE\n\nEXAMPLES:\n   CF_NAME service-keys mydb"`
+	relatedCommands interface{}          `related_commands:"delete-service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceKeysActor
+}
+
+func (cmd *ServiceKeysCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd ServiceKeysCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting keys for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	keys, warnings, err := cmd.Actor.GetServiceKeysByServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		cmd.UI.DisplayText("No service key for service instance {{.ServiceInstanceName}}", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("last operation"),
+	}}
+	for _, key := range keys {
+		lastOperation := ""
+		if key.LastOperation.Type != "" {
+			lastOperation = string(key.LastOperation.Type) + " " + string(key.LastOperation.State)
+		}
+		table = append(table, []string{key.Name, lastOperation})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
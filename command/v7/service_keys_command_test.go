@@ -0,0 +1,116 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-keys Command", func() {
+	var (
+		cmd             v7.ServiceKeysCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeServiceKeysActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeServiceKeysActor)
+
+		cmd = v7.ServiceKeysCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the service instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns(nil, v7action.Warnings{"some-warning"}, actionerror.ServiceInstanceNotFoundError{Name: "some-instance"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "some-instance"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("the service instance has no keys", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns(nil, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays that there are no keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting keys for service instance some-instance as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No service key for service instance some-instance"))
+		})
+	})
+
+	When("the service instance has keys", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns([]v7action.ServiceCredentialBinding{
+				{
+					Name: "key-1",
+					LastOperation: ccv3.LastOperation{
+						Type:  constant.LastOperationCreate,
+						State: constant.LastOperationSucceeded,
+					},
+				},
+				{Name: "key-2"},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetServiceKeysByServiceInstanceCallCount()).To(Equal(1))
+			instanceName, spaceGUID := fakeActor.GetServiceKeysByServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`name\s+last operation`))
+			Expect(testUI.Out).To(Say(`key-1\s+create succeeded`))
+			Expect(testUI.Out).To(Say(`key-2`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
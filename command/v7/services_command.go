@@ -0,0 +1,116 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . ServicesActor
+
+type ServicesActor interface {
+	GetServiceInstancesSummaryBySpace(spaceGUID string) ([]v7action.ServiceInstanceSummary, v7action.Warnings, error)
+}
+
+type ServicesCommand struct {
+	usage           interface{} `usage:"CF_NAME services"`
+	relatedCommands interface{} `related_commands:"create-service, marketplace"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServicesActor
+}
+
+func (cmd *ServicesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd ServicesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatText {
+		cmd.UI.DisplayTextWithFlavor("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	summaries, warnings, err := cmd.Actor.GetServiceInstancesSummaryBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat != configv3.OutputFormatText {
+		return cmd.UI.DisplayStructuredOutput(outputFormat, shared.NewServiceInstanceDocuments(summaries))
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No services found")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("service"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("bound apps"),
+		cmd.UI.TranslateText("last operation"),
+	}}
+
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.Name,
+			serviceOfferingLabel(summary),
+			summary.ServicePlanName,
+			strings.Join(summary.BoundAppNames, ", "),
+			lastOperationSummary(summary.ServiceInstance),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func serviceOfferingLabel(summary v7action.ServiceInstanceSummary) string {
+	if summary.IsUserProvided() {
+		return "user-provided"
+	}
+	return summary.ServiceOfferingName
+}
+
+func lastOperationSummary(serviceInstance v7action.ServiceInstance) string {
+	if serviceInstance.LastOperation.Type == "" {
+		return ""
+	}
+	return strings.TrimSpace(string(serviceInstance.LastOperation.Type) + " " + string(serviceInstance.LastOperation.State))
+}
@@ -0,0 +1,164 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("services Command", func() {
+	var (
+		cmd             v7.ServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeServicesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeServicesActor)
+
+		cmd = v7.ServicesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the service instances fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesSummaryBySpaceReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("there are no service instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesSummaryBySpaceReturns(nil, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays that no services were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting services in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No services found"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("there are service instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesSummaryBySpaceReturns([]v7action.ServiceInstanceSummary{
+				{
+					ServiceInstance: v7action.ServiceInstance{
+						Name: "managed-instance",
+						Type: constant.ManagedService,
+						LastOperation: ccv3.LastOperation{
+							Type:  constant.LastOperationCreate,
+							State: constant.LastOperationSucceeded,
+						},
+					},
+					ServiceOfferingName: "some-offering",
+					ServicePlanName:     "some-plan",
+					BoundAppNames:       []string{"app-1", "app-2"},
+				},
+				{
+					ServiceInstance: v7action.ServiceInstance{
+						Name: "user-provided-instance",
+						Type: constant.UserProvidedService,
+					},
+				},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the service instances of the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetServiceInstancesSummaryBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetServiceInstancesSummaryBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting services in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+service\s+plan\s+bound apps\s+last operation`))
+			Expect(testUI.Out).To(Say(`managed-instance\s+some-offering\s+some-plan\s+app-1, app-2\s+create succeeded`))
+			Expect(testUI.Out).To(Say(`user-provided-instance\s+user-provided`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays the service instances as json", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting services"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{
+						"name": "managed-instance",
+						"guid": "",
+						"type": "managed",
+						"service_offering": "some-offering",
+						"service_plan": "some-plan",
+						"tags": [],
+						"bound_apps": ["app-1", "app-2"],
+						"last_operation": {"type": "create", "state": "succeeded"}
+					},
+					{
+						"name": "user-provided-instance",
+						"guid": "",
+						"type": "user-provided",
+						"tags": [],
+						"bound_apps": []
+					}
+				]`))
+			})
+		})
+	})
+})
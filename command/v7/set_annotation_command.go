@@ -0,0 +1,104 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . SetAnnotationActor
+
+type SetAnnotationActor interface {
+	UpdateApplicationAnnotationsByApplicationName(appName string, spaceGUID string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateBuildpackAnnotationsByBuildpackNameAndStack(buildpackName string, stack string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateOrganizationAnnotationsByOrganizationName(orgName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateRouteAnnotationsByRouteURL(routeURL string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceAnnotationsBySpaceName(spaceName string, orgGUID string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateStackAnnotationsByStackName(stackName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+}
+
+type SetAnnotationCommand struct {
+	RequiredArgs   flag.SetAnnotationArgs `positional-args:"yes"`
+	BuildpackStack string                 `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage          interface{}            `usage:"CF_NAME set-annotation RESOURCE RESOURCE_NAME KEY=VALUE...\n\nEXAMPLES:\n   cf set-annotation app dora owner=team-a\n   cf set-annotation space business_space example.com/runbook=https://example.com/runbooks/business\n   cf set-annotation route dora.example.com/path owner=team-a\n\nRESOURCES:\n   app\n   buildpack\n   org\n   route\n   space\n   stack\n\nSEE ALSO:\n   unset-annotation, annotations"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetAnnotationActor
+}
+
+func (cmd *SetAnnotationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+	return nil
+}
+
+func (cmd SetAnnotationCommand) Execute(args []string) error {
+	annotations := make(map[string]types.NullString)
+	for _, annotation := range cmd.RequiredArgs.Annotations {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) < 2 {
+			return fmt.Errorf("Metadata error: no value provided for annotation '%s'", annotation)
+		}
+		annotations[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	resource, err := newAnnotationResource(cmd.RequiredArgs.ResourceType, cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+	if err != nil {
+		return err
+	}
+
+	username, err := cmd.Config.CurrentUserName()
+	if err != nil {
+		return err
+	}
+
+	err = resource.checkTarget(cmd.SharedActor)
+	if err != nil {
+		return err
+	}
+
+	resource.displayFlavor(cmd.UI, cmd.Config, "Setting annotation(s)", username)
+
+	warnings, err := updateAnnotations(cmd.Actor, cmd.Config, resource, annotations)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// updateAnnotations sets the annotations on the resource. Annotations with an
+// unset value are removed.
+func updateAnnotations(actor SetAnnotationActor, config command.Config, resource annotationResource, annotations map[string]types.NullString) (v7action.Warnings, error) {
+	switch resource.Type {
+	case App:
+		return actor.UpdateApplicationAnnotationsByApplicationName(resource.Name, config.TargetedSpace().GUID, annotations)
+	case Buildpack:
+		return actor.UpdateBuildpackAnnotationsByBuildpackNameAndStack(resource.Name, resource.BuildpackStack, annotations)
+	case Org:
+		return actor.UpdateOrganizationAnnotationsByOrganizationName(resource.Name, annotations)
+	case Route:
+		return actor.UpdateRouteAnnotationsByRouteURL(resource.Name, annotations)
+	case Space:
+		return actor.UpdateSpaceAnnotationsBySpaceName(resource.Name, config.TargetedOrganization().GUID, annotations)
+	default:
+		return actor.UpdateStackAnnotationsByStackName(resource.Name, annotations)
+	}
+}
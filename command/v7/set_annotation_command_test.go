@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-annotation command", func() {
+	var (
+		cmd             SetAnnotationCommand
+		fakeActor       *v7fakes.FakeSetAnnotationActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeSetAnnotationActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		cmd = SetAnnotationCommand{
+			Actor:       fakeActor,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+		}
+
+		fakeConfig.CurrentUserNameReturns("some-user", nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("setting annotations on an app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "app",
+				ResourceName: "dora",
+				Annotations:  []string{"owner=team-a", "example.com/runbook=https://example.com/?a=b"},
+			}
+			fakeActor.UpdateApplicationAnnotationsByApplicationNameReturns(v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("sets the annotations, splitting each on the first '='", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeTrue())
+
+			appName, spaceGUID, annotations := fakeActor.UpdateApplicationAnnotationsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"owner":               types.NewNullString("team-a"),
+				"example.com/runbook": types.NewNullString("https://example.com/?a=b"),
+			}))
+
+			Expect(testUI.Out).To(Say(`Setting annotation\(s\) for app dora in org fake-org / space fake-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("setting annotations on a space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "space",
+				ResourceName: "some-space",
+				Annotations:  []string{"owner=team-a"},
+			}
+		})
+
+		It("sets the annotations on the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceAnnotationsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(testUI.Out).To(Say(`Setting annotation\(s\) for space some-space in org fake-org as some-user\.\.\.`))
+		})
+	})
+
+	When("setting annotations on a route", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "route",
+				ResourceName: "dora.example.com",
+				Annotations:  []string{"owner=team-a"},
+			}
+			fakeActor.UpdateRouteAnnotationsByRouteURLReturns(
+				v7action.Warnings{"some-warning"},
+				actionerror.RouteNotFoundError{Host: "dora", DomainName: "example.com"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "dora", DomainName: "example.com"}))
+
+			routeURL, annotations := fakeActor.UpdateRouteAnnotationsByRouteURLArgsForCall(0)
+			Expect(routeURL).To(Equal("dora.example.com"))
+			Expect(annotations).To(Equal(map[string]types.NullString{"owner": types.NewNullString("team-a")}))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	When("an annotation has no value", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "org",
+				ResourceName: "some-org",
+				Annotations:  []string{"owner"},
+			}
+		})
+
+		It("returns an error without updating", func() {
+			Expect(executeErr).To(MatchError("Metadata error: no value provided for annotation 'owner'"))
+			Expect(fakeActor.UpdateOrganizationAnnotationsByOrganizationNameCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "stack",
+				ResourceName: "some-stack",
+				Annotations:  []string{"owner=team-a"},
+			}
+			fakeConfig.CurrentUserNameReturns("", errors.New("user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("user-error"))
+			Expect(fakeActor.UpdateStackAnnotationsByStackNameCallCount()).To(Equal(0))
+		})
+	})
+})
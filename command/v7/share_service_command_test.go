@@ -0,0 +1,111 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("share-service Command", func() {
+	var (
+		cmd             v7.ShareServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeShareServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeShareServiceActor)
+
+		cmd = v7.ShareServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			SpaceName:    "other-space",
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("no org is provided", func() {
+		BeforeEach(func() {
+			fakeActor.ShareServiceInstanceToSpaceAndOrgReturns(v7action.Warnings{"share-warning"}, nil)
+		})
+
+		It("shares the service instance into a space of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ShareServiceInstanceToSpaceAndOrgCallCount()).To(Equal(1))
+			instanceName, sourceSpaceGUID, orgName, spaceName := fakeActor.ShareServiceInstanceToSpaceAndOrgArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(sourceSpaceGUID).To(Equal("some-space-guid"))
+			Expect(orgName).To(Equal("some-org"))
+			Expect(spaceName).To(Equal("other-space"))
+
+			Expect(testUI.Out).To(Say(`Sharing service instance some-instance into org some-org / space other-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("share-warning"))
+		})
+	})
+
+	When("an org is provided", func() {
+		BeforeEach(func() {
+			cmd.OrgName = "other-org"
+		})
+
+		It("shares the service instance into a space of the given org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, orgName, _ := fakeActor.ShareServiceInstanceToSpaceAndOrgArgsForCall(0)
+			Expect(orgName).To(Equal("other-org"))
+			Expect(testUI.Out).To(Say(`into org other-org / space other-space`))
+		})
+	})
+
+	When("sharing fails", func() {
+		BeforeEach(func() {
+			fakeActor.ShareServiceInstanceToSpaceAndOrgReturns(v7action.Warnings{"share-warning"}, errors.New("share-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("share-error"))
+			Expect(testUI.Err).To(Say("share-warning"))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/logcache"
+	logCacheWrapper "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewLogCacheClient creates a new Log Cache client.
+func NewLogCacheClient(logCacheURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *logcache.Client {
+	wrappers := []logcache.ConnectionWrapper{}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		wrappers = append(wrappers, logCacheWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		wrappers = append(wrappers, logCacheWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	wrappers = append(wrappers, logCacheWrapper.NewUAAAuthentication(uaaClient, config))
+	wrappers = append(wrappers, logCacheWrapper.NewRetryRequest(config.RequestRetryPolicy()))
+
+	return logcache.NewClient(logcache.Config{
+		AppName:    config.BinaryName(),
+		AppVersion: config.BinaryVersion(),
+		ConnectionConfig: logcache.ConnectionConfig{
+			DialTimeout:       config.DialTimeout(),
+			SkipSSLValidation: config.SkipSSLValidation(),
+		},
+		LogCacheEndpoint: logCacheURL,
+		Wrappers:         wrappers,
+	})
+}
@@ -0,0 +1,37 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("NewLogCacheClient", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		testUI     *ui.UI
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.VerboseReturns(true, nil)
+
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+	})
+
+	It("returns a Log Cache client", func() {
+		client := NewLogCacheClient("https://log-cache.example.com", fakeConfig, new(uaa.Client), testUI)
+		Expect(client).NotTo(BeNil())
+	})
+
+	It("retries requests with the configured retry policy", func() {
+		NewLogCacheClient("https://log-cache.example.com", fakeConfig, new(uaa.Client), testUI)
+		Expect(fakeConfig.RequestRetryPolicyCallCount()).To(Equal(1))
+	})
+})
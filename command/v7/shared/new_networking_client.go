@@ -3,6 +3,7 @@ package shared
 import (
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/wrapper"
+	cfnetWrapper "code.cloudfoundry.org/cli/api/cfnetworking/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -27,7 +28,7 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	authWrapper := wrapper.NewUAAAuthentication(uaaClient, config)
 	wrappers = append(wrappers, authWrapper)
 
-	wrappers = append(wrappers, wrapper.NewRetryRequest(config.RequestRetryCount()))
+	wrappers = append(wrappers, cfnetWrapper.NewRetryRequest(config.RequestRetryPolicy()))
 
 	return cfnetv1.NewClient(cfnetv1.Config{
 		AppName:           config.BinaryName(),
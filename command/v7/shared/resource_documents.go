@@ -0,0 +1,316 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// AppSummaryDocument is the structured representation of an app returned by
+// 'apps'.
+type AppSummaryDocument struct {
+	Name      string                   `json:"name" yaml:"name"`
+	GUID      string                   `json:"guid" yaml:"guid"`
+	State     string                   `json:"state" yaml:"state"`
+	Processes []ProcessSummaryDocument `json:"processes" yaml:"processes"`
+	Routes    []string                 `json:"routes" yaml:"routes"`
+}
+
+// AppDocument is the structured representation of an app returned by 'app'.
+type AppDocument struct {
+	Name             string                   `json:"name" yaml:"name"`
+	GUID             string                   `json:"guid" yaml:"guid"`
+	State            string                   `json:"state" yaml:"state"`
+	IsolationSegment string                   `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Routes           []string                 `json:"routes" yaml:"routes"`
+	LastUploaded     string                   `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	Stack            string                   `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks       []string                 `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	DockerImage      string                   `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	Processes        []ProcessSummaryDocument `json:"processes" yaml:"processes"`
+}
+
+// ProcessSummaryDocument is the structured representation of an app's
+// process.
+type ProcessSummaryDocument struct {
+	Type             string                    `json:"type" yaml:"type"`
+	Instances        int                       `json:"instances" yaml:"instances"`
+	RunningInstances int                       `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64                    `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                    `json:"disk_in_mb" yaml:"disk_in_mb"`
+	InstanceDetails  []ProcessInstanceDocument `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+// ProcessInstanceDocument is the structured representation of a single
+// instance of a process.
+type ProcessInstanceDocument struct {
+	Index       int64   `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since,omitempty" yaml:"since,omitempty"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   uint64  `json:"disk_quota" yaml:"disk_quota"`
+	Details     string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+// RouteDocument is the structured representation of a route returned by
+// 'routes'.
+type RouteDocument struct {
+	GUID   string   `json:"guid" yaml:"guid"`
+	URL    string   `json:"url" yaml:"url"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host" yaml:"host"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Path   string   `json:"path" yaml:"path"`
+	Apps   []string `json:"apps" yaml:"apps"`
+}
+
+// SpaceDocument is the structured representation of a space returned by
+// 'spaces'.
+type SpaceDocument struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+// BuildpackDocument is the structured representation of a buildpack returned
+// by 'buildpacks'.
+type BuildpackDocument struct {
+	Position int    `json:"position" yaml:"position"`
+	Name     string `json:"name" yaml:"name"`
+	GUID     string `json:"guid" yaml:"guid"`
+	Stack    string `json:"stack" yaml:"stack"`
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	Locked   bool   `json:"locked" yaml:"locked"`
+	Filename string `json:"filename" yaml:"filename"`
+}
+
+// DomainDocument is the structured representation of a domain returned by
+// 'domains'.
+type DomainDocument struct {
+	Name         string `json:"name" yaml:"name"`
+	GUID         string `json:"guid" yaml:"guid"`
+	Availability string `json:"availability" yaml:"availability"`
+	Internal     bool   `json:"internal" yaml:"internal"`
+}
+
+// ServiceInstanceDocument is the structured representation of a service
+// instance returned by 'services' and 'service'.
+type ServiceInstanceDocument struct {
+	Name            string                 `json:"name" yaml:"name"`
+	GUID            string                 `json:"guid" yaml:"guid"`
+	Type            string                 `json:"type" yaml:"type"`
+	ServiceOffering string                 `json:"service_offering,omitempty" yaml:"service_offering,omitempty"`
+	ServicePlan     string                 `json:"service_plan,omitempty" yaml:"service_plan,omitempty"`
+	Tags            []string               `json:"tags" yaml:"tags"`
+	BoundApps       []string               `json:"bound_apps" yaml:"bound_apps"`
+	DashboardURL    string                 `json:"dashboard_url,omitempty" yaml:"dashboard_url,omitempty"`
+	LastOperation   *LastOperationDocument `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+}
+
+// LastOperationDocument is the structured representation of the last
+// operation performed on a service instance.
+type LastOperationDocument struct {
+	Type        string `json:"type" yaml:"type"`
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+func NewAppSummaryDocuments(summaries []v7action.ApplicationSummary) []AppSummaryDocument {
+	documents := make([]AppSummaryDocument, 0, len(summaries))
+	for _, summary := range summaries {
+		documents = append(documents, AppSummaryDocument{
+			Name:      summary.Name,
+			GUID:      summary.GUID,
+			State:     strings.ToLower(string(summary.State)),
+			Processes: newProcessSummaryDocuments(summary.ProcessSummaries, false),
+			Routes:    routeURLs(summary.Routes),
+		})
+	}
+	return documents
+}
+
+func NewAppDocument(summary v7action.DetailedApplicationSummary) AppDocument {
+	document := AppDocument{
+		Name:      summary.Name,
+		GUID:      summary.GUID,
+		State:     strings.ToLower(string(summary.State)),
+		Routes:    routeURLs(summary.Routes),
+		Stack:     summary.CurrentDroplet.Stack,
+		Processes: newProcessSummaryDocuments(summary.ProcessSummaries, true),
+	}
+
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		document.IsolationSegment = name
+	}
+
+	if createdAt, err := time.Parse(time.RFC3339, summary.CurrentDroplet.CreatedAt); err == nil {
+		document.LastUploaded = createdAt.UTC().Format(time.RFC3339)
+	}
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		document.DockerImage = summary.CurrentDroplet.Image
+	} else {
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			if buildpack.DetectOutput != "" {
+				document.Buildpacks = append(document.Buildpacks, buildpack.DetectOutput)
+			} else {
+				document.Buildpacks = append(document.Buildpacks, buildpack.Name)
+			}
+		}
+	}
+
+	return document
+}
+
+func NewRouteDocuments(routeSummaries []v7action.RouteSummary) []RouteDocument {
+	documents := make([]RouteDocument, 0, len(routeSummaries))
+	for _, routeSummary := range routeSummaries {
+		apps := routeSummary.AppNames
+		if apps == nil {
+			apps = []string{}
+		}
+
+		documents = append(documents, RouteDocument{
+			GUID:   routeSummary.GUID,
+			URL:    routeSummary.URL,
+			Space:  routeSummary.SpaceName,
+			Host:   routeSummary.Host,
+			Domain: routeSummary.DomainName,
+			Path:   routeSummary.Path,
+			Apps:   apps,
+		})
+	}
+	return documents
+}
+
+func NewSpaceDocuments(spaces []v7action.Space) []SpaceDocument {
+	documents := make([]SpaceDocument, 0, len(spaces))
+	for _, space := range spaces {
+		documents = append(documents, SpaceDocument{
+			Name: space.Name,
+			GUID: space.GUID,
+		})
+	}
+	return documents
+}
+
+func NewBuildpackDocuments(buildpacks []v7action.Buildpack) []BuildpackDocument {
+	documents := make([]BuildpackDocument, 0, len(buildpacks))
+	for _, buildpack := range buildpacks {
+		documents = append(documents, BuildpackDocument{
+			Position: buildpack.Position.Value,
+			Name:     buildpack.Name,
+			GUID:     buildpack.GUID,
+			Stack:    buildpack.Stack,
+			Enabled:  buildpack.Enabled.Value,
+			Locked:   buildpack.Locked.Value,
+			Filename: buildpack.Filename,
+		})
+	}
+	return documents
+}
+
+func NewDomainDocuments(domains []v7action.Domain) []DomainDocument {
+	documents := make([]DomainDocument, 0, len(domains))
+	for _, domain := range domains {
+		availability := "private"
+		if domain.Shared() {
+			availability = "shared"
+		}
+
+		documents = append(documents, DomainDocument{
+			Name:         domain.Name,
+			GUID:         domain.GUID,
+			Availability: availability,
+			Internal:     domain.Internal.IsSet && domain.Internal.Value,
+		})
+	}
+	return documents
+}
+
+func newProcessSummaryDocuments(processSummaries v7action.ProcessSummaries, withInstanceDetails bool) []ProcessSummaryDocument {
+	documents := make([]ProcessSummaryDocument, 0, len(processSummaries))
+	for _, processSummary := range processSummaries {
+		document := ProcessSummaryDocument{
+			Type:             processSummary.Type,
+			Instances:        processSummary.TotalInstanceCount(),
+			RunningInstances: processSummary.HealthyInstanceCount(),
+			MemoryInMB:       processSummary.MemoryInMB.Value,
+			DiskInMB:         processSummary.DiskInMB.Value,
+		}
+
+		if withInstanceDetails {
+			for _, instance := range processSummary.InstanceDetails {
+				instanceDocument := ProcessInstanceDocument{
+					Index:       instance.Index,
+					State:       strings.ToLower(string(instance.State)),
+					CPU:         instance.CPU,
+					MemoryUsage: instance.MemoryUsage,
+					MemoryQuota: instance.MemoryQuota,
+					DiskUsage:   instance.DiskUsage,
+					DiskQuota:   instance.DiskQuota,
+					Details:     instance.Details,
+				}
+				if instance.Uptime > 0 {
+					instanceDocument.Since = instance.StartTime().UTC().Format(time.RFC3339)
+				}
+				document.InstanceDetails = append(document.InstanceDetails, instanceDocument)
+			}
+		}
+
+		documents = append(documents, document)
+	}
+	return documents
+}
+
+func routeURLs(routes []v7action.Route) []string {
+	urls := make([]string, 0, len(routes))
+	for _, route := range routes {
+		urls = append(urls, route.URL)
+	}
+	return urls
+}
+
+func NewServiceInstanceDocuments(summaries []v7action.ServiceInstanceSummary) []ServiceInstanceDocument {
+	documents := make([]ServiceInstanceDocument, 0, len(summaries))
+	for _, summary := range summaries {
+		documents = append(documents, NewServiceInstanceDocument(summary))
+	}
+	return documents
+}
+
+func NewServiceInstanceDocument(summary v7action.ServiceInstanceSummary) ServiceInstanceDocument {
+	document := ServiceInstanceDocument{
+		Name:            summary.Name,
+		GUID:            summary.GUID,
+		Type:            string(summary.Type),
+		ServiceOffering: summary.ServiceOfferingName,
+		ServicePlan:     summary.ServicePlanName,
+		Tags:            summary.Tags,
+		BoundApps:       summary.BoundAppNames,
+		DashboardURL:    summary.DashboardURL,
+	}
+
+	if document.Tags == nil {
+		document.Tags = []string{}
+	}
+	if document.BoundApps == nil {
+		document.BoundApps = []string{}
+	}
+
+	if summary.LastOperation.Type != "" {
+		document.LastOperation = &LastOperationDocument{
+			Type:        string(summary.LastOperation.Type),
+			State:       string(summary.LastOperation.State),
+			Description: summary.LastOperation.Description,
+			UpdatedAt:   summary.LastOperation.UpdatedAt,
+		}
+	}
+
+	return document
+}
@@ -0,0 +1,161 @@
+package shared_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resource documents", func() {
+	Describe("NewAppDocument", func() {
+		var (
+			summary  v7action.DetailedApplicationSummary
+			document AppDocument
+		)
+
+		BeforeEach(func() {
+			summary = v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: v7action.Application{
+						Name:          "some-app",
+						GUID:          "some-app-guid",
+						State:         constant.ApplicationStopped,
+						LifecycleType: constant.AppLifecycleTypeDocker,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: v7action.Process{Type: constant.ProcessTypeWeb},
+							InstanceDetails: []v7action.ProcessInstance{
+								{
+									Index:            0,
+									State:            constant.ProcessInstanceRunning,
+									IsolationSegment: "some-iso-seg",
+									Uptime:           time.Hour,
+									Details:          "some-details",
+								},
+								{
+									Index: 1,
+									State: constant.ProcessInstanceDown,
+								},
+							},
+						},
+					},
+				},
+				CurrentDroplet: v7action.Droplet{
+					Image:     "some-docker-image",
+					CreatedAt: "not-a-timestamp",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			document = NewAppDocument(summary)
+		})
+
+		It("converts the summary into a document", func() {
+			Expect(document.Name).To(Equal("some-app"))
+			Expect(document.GUID).To(Equal("some-app-guid"))
+			Expect(document.State).To(Equal("stopped"))
+			Expect(document.IsolationSegment).To(Equal("some-iso-seg"))
+			Expect(document.Routes).To(BeEmpty())
+			Expect(document.Routes).ToNot(BeNil())
+		})
+
+		It("displays the docker image instead of buildpacks", func() {
+			Expect(document.DockerImage).To(Equal("some-docker-image"))
+			Expect(document.Buildpacks).To(BeNil())
+		})
+
+		It("omits the last uploaded time when it cannot be parsed", func() {
+			Expect(document.LastUploaded).To(BeEmpty())
+		})
+
+		It("includes the instance details of each process", func() {
+			Expect(document.Processes).To(HaveLen(1))
+			Expect(document.Processes[0].Instances).To(Equal(2))
+			Expect(document.Processes[0].RunningInstances).To(Equal(1))
+			Expect(document.Processes[0].InstanceDetails).To(HaveLen(2))
+
+			running := document.Processes[0].InstanceDetails[0]
+			Expect(running.State).To(Equal("running"))
+			Expect(running.Details).To(Equal("some-details"))
+			since, err := time.Parse(time.RFC3339, running.Since)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(since).To(BeTemporally("~", time.Now().Add(-time.Hour), 10*time.Second))
+
+			down := document.Processes[0].InstanceDetails[1]
+			Expect(down.State).To(Equal("down"))
+			Expect(down.Since).To(BeEmpty())
+		})
+	})
+
+	Describe("NewAppSummaryDocuments", func() {
+		It("omits instance details", func() {
+			documents := NewAppSummaryDocuments([]v7action.ApplicationSummary{
+				{
+					Application: v7action.Application{Name: "some-app"},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process:         v7action.Process{Type: constant.ProcessTypeWeb},
+							InstanceDetails: []v7action.ProcessInstance{{Index: 0}},
+						},
+					},
+				},
+			})
+
+			Expect(documents).To(HaveLen(1))
+			Expect(documents[0].Processes).To(HaveLen(1))
+			Expect(documents[0].Processes[0].InstanceDetails).To(BeNil())
+		})
+
+		It("returns an empty list when there are no summaries", func() {
+			documents := NewAppSummaryDocuments(nil)
+			Expect(documents).ToNot(BeNil())
+			Expect(documents).To(BeEmpty())
+		})
+	})
+
+	Describe("NewServiceInstanceDocument", func() {
+		It("includes the last operation when there is one", func() {
+			document := NewServiceInstanceDocument(v7action.ServiceInstanceSummary{
+				ServiceInstance: v7action.ServiceInstance{
+					Name: "some-service-instance",
+					GUID: "some-guid",
+					Type: constant.ManagedService,
+					LastOperation: ccv3.LastOperation{
+						Type:  constant.LastOperationCreate,
+						State: constant.LastOperationSucceeded,
+					},
+				},
+				ServiceOfferingName: "some-offering",
+				ServicePlanName:     "some-plan",
+				BoundAppNames:       []string{"some-app"},
+			})
+
+			Expect(document).To(Equal(ServiceInstanceDocument{
+				Name:            "some-service-instance",
+				GUID:            "some-guid",
+				Type:            "managed",
+				ServiceOffering: "some-offering",
+				ServicePlan:     "some-plan",
+				Tags:            []string{},
+				BoundApps:       []string{"some-app"},
+				LastOperation:   &LastOperationDocument{Type: "create", State: "succeeded"},
+			}))
+		})
+
+		It("omits the last operation of user-provided service instances", func() {
+			document := NewServiceInstanceDocument(v7action.ServiceInstanceSummary{
+				ServiceInstance: v7action.ServiceInstance{Type: constant.UserProvidedService},
+			})
+
+			Expect(document.Type).To(Equal("user-provided"))
+			Expect(document.LastOperation).To(BeNil())
+		})
+	})
+})
@@ -89,7 +89,7 @@ var _ = Describe("spaces Command", func() {
 
 			When("there are no spaces", func() {
 				BeforeEach(func() {
-					fakeActor.GetOrganizationSpacesReturns(
+					fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
 						[]v7action.Space{},
 						v7action.Warnings{"get-spaces-warning"},
 						nil,
@@ -105,14 +105,15 @@ var _ = Describe("spaces Command", func() {
 
 					Expect(testUI.Err).To(Say("get-spaces-warning"))
 
-					Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
-					Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(fakeActor.GetOrganizationSpacesWithLabelSelectorCallCount()).To(Equal(1))
+					orgGUID, _ := fakeActor.GetOrganizationSpacesWithLabelSelectorArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
 				})
 			})
 
 			When("there are multiple spaces", func() {
 				BeforeEach(func() {
-					fakeActor.GetOrganizationSpacesReturns(
+					fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
 						[]v7action.Space{
 							{Name: "space-1"},
 							{Name: "space-2"},
@@ -133,14 +134,28 @@ var _ = Describe("spaces Command", func() {
 
 					Expect(testUI.Err).To(Say("get-spaces-warning"))
 
-					Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
-					Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(fakeActor.GetOrganizationSpacesWithLabelSelectorCallCount()).To(Equal(1))
+					orgGUID, _ := fakeActor.GetOrganizationSpacesWithLabelSelectorArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+				})
+			})
+
+			When("the --labels flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Labels = "env=prod"
+				})
+
+				It("passes the label selector to the actor", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					orgGUID, labelSelector := fakeActor.GetOrganizationSpacesWithLabelSelectorArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(labelSelector).To(Equal("env=prod"))
 				})
 			})
 
 			When("a translatable error is encountered getting spaces", func() {
 				BeforeEach(func() {
-					fakeActor.GetOrganizationSpacesReturns(
+					fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
 						nil,
 						v7action.Warnings{"get-spaces-warning"},
 						actionerror.OrganizationNotFoundError{Name: "not-found-org"},
@@ -155,10 +170,38 @@ var _ = Describe("spaces Command", func() {
 
 					Expect(testUI.Err).To(Say("get-spaces-warning"))
 
-					Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
-					Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(fakeActor.GetOrganizationSpacesWithLabelSelectorCallCount()).To(Equal(1))
+					orgGUID, _ := fakeActor.GetOrganizationSpacesWithLabelSelectorArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
 				})
 			})
 		})
 	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
+				[]v7action.Space{
+					{Name: "space-1", GUID: "space-guid-1"},
+					{Name: "space-2", GUID: "space-guid-2"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("displays the spaces as yaml and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting spaces"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchYAML(`
+- name: space-1
+  guid: space-guid-1
+- name: space-2
+  guid: space-guid-2
+`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -1,6 +1,11 @@
 package v7
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
@@ -8,34 +13,44 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 )
 
+// maxConcurrentSSHSessions bounds how many instances --all-instances runs the
+// command on at once.
+const maxConcurrentSSHSessions = 10
+
 //go:generate counterfeiter . SharedSSHActor
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellCommand(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, stdout io.Writer, stderr io.Writer) (int, error)
 }
 
 //go:generate counterfeiter . SSHActor
 
 type SSHActor interface {
+	GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string) ([]uint, v7action.Warnings, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 }
 
 type SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance of the process"`
+	ProcessIndex            flag.ProcessIndex               `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic SOCKS port forward specification"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX | --all-instances] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]...\n   [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n   [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -45,6 +60,9 @@ type SSHCommand struct {
 	Actor       SSHActor
 	SSHActor    SharedSSHActor
 	SSHClient   *clissh.SecureShell
+
+	// NewSSHClient creates a client for each session opened by --all-instances.
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -62,17 +80,30 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient, clock.NewClock())
 
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
 
 func (cmd SSHCommand) Execute(args []string) error {
+	if cmd.AllInstances {
+		err := cmd.validateAllInstancesFlags()
+		if err != nil {
+			return err
+		}
+	}
 
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
 
+	if cmd.AllInstances {
+		return cmd.executeOnAllInstances()
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -83,11 +114,21 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardAddresses []string
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardAddresses = append(dynamicForwardAddresses, spec.LocalAddress)
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.ProcessType,
-		cmd.ProcessIndex,
+		uint(cmd.ProcessIndex.Value),
 	)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -97,15 +138,17 @@ func (cmd SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                    cmd.Commands,
+			DynamicPortForwardAddresses: dynamicForwardAddresses,
+			Endpoint:                    sshAuth.Endpoint,
+			HostKeyFingerprint:          sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:       forwardSpecs,
+			Passcode:                    sshAuth.Passcode,
+			RemotePortForwardSpecs:      remoteForwardSpecs,
+			SkipHostValidation:          cmd.SkipHostValidation,
+			SkipRemoteExecution:         cmd.SkipRemoteExecution,
+			TTYOption:                   ttyOption,
+			Username:                    sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -141,3 +184,145 @@ func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
 
 	return option, nil
 }
+
+// validateAllInstancesFlags returns an error when --all-instances is used
+// without a command, or with flags that only make sense for a single session.
+func (cmd SSHCommand) validateAllInstancesFlags() error {
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}
+	}
+
+	conflicts := []string{"--all-instances"}
+	if cmd.ProcessIndex.IsSet {
+		conflicts = append(conflicts, "--app-instance-index, -i")
+	}
+	if len(cmd.LocalPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-L")
+	}
+	if len(cmd.RemotePortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-R")
+	}
+	if len(cmd.DynamicPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-D")
+	}
+	if cmd.SkipRemoteExecution {
+		conflicts = append(conflicts, "--skip-remote-execution, -N")
+	}
+	if cmd.ForcePseudoTTY {
+		conflicts = append(conflicts, "--force-pseudo-tty")
+	}
+	if cmd.RequestPseudoTTY {
+		conflicts = append(conflicts, "--request-pseudo-tty, -t")
+	}
+
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+
+	return nil
+}
+
+// executeOnAllInstances runs the command on every running instance, a bounded
+// number at a time, prefixing each line of output with the instance index.
+// Each session is authenticated just before it starts since SSH passcodes
+// are single use and short lived.
+func (cmd SSHCommand) executeOnAllInstances() error {
+	indexes, warnings, err := cmd.Actor.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		outputLock sync.Mutex
+		wg         sync.WaitGroup
+		sessions   = make(chan struct{}, maxConcurrentSSHSessions)
+		results    = make([]instanceResult, len(indexes))
+	)
+
+	for i, index := range indexes {
+		sessions <- struct{}{}
+
+		sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.ProcessType,
+			index,
+		)
+		outputLock.Lock()
+		cmd.UI.DisplayWarnings(warnings)
+		outputLock.Unlock()
+		if err != nil {
+			results[i].err = err
+			<-sessions
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, index uint, sshAuth v7action.SSHAuthentication) {
+			defer wg.Done()
+			defer func() { <-sessions }()
+
+			prefix := fmt.Sprintf("[%d] ", index)
+			stdout := ui.NewPrefixWriter(cmd.UI.GetOut(), prefix, &outputLock)
+			stderr := ui.NewPrefixWriter(cmd.UI.GetErr(), prefix, &outputLock)
+
+			results[i].exitStatus, results[i].err = cmd.SSHActor.ExecuteSecureShellCommand(
+				cmd.NewSSHClient(),
+				sharedaction.SSHOptions{
+					Commands:           cmd.Commands,
+					Endpoint:           sshAuth.Endpoint,
+					HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+					Passcode:           sshAuth.Passcode,
+					SkipHostValidation: cmd.SkipHostValidation,
+					Username:           sshAuth.Username,
+				},
+				stdout,
+				stderr,
+			)
+
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+		}(i, index, sshAuth)
+	}
+
+	wg.Wait()
+
+	return cmd.displayInstanceExitStatuses(indexes, results)
+}
+
+// instanceResult is the exit status of the command on an instance, or the
+// error that stopped it from running there.
+type instanceResult struct {
+	exitStatus int
+	err        error
+}
+
+func (cmd SSHCommand) displayInstanceExitStatuses(indexes []uint, results []instanceResult) error {
+	table := [][]string{{cmd.UI.TranslateText("instance"), cmd.UI.TranslateText("exit status")}}
+	var failedCount int
+
+	for i, index := range indexes {
+		status := strconv.Itoa(results[i].exitStatus)
+		if results[i].err != nil {
+			status = results[i].err.Error()
+		}
+		if results[i].err != nil || results[i].exitStatus != 0 {
+			failedCount++
+		}
+		table = append(table, []string{strconv.FormatUint(uint64(index), 10), status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failedCount > 0 {
+		return translatableerror.SSHInstancesFailedError{FailedCount: failedCount, InstanceCount: len(indexes)}
+	}
+
+	return nil
+}
@@ -2,15 +2,19 @@ package v7_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -43,7 +47,7 @@ var _ = Describe("ssh Command", func() {
 			RequiredArgs: flag.AppName{AppName: appName},
 
 			ProcessType:         "some-process-type",
-			ProcessIndex:        1,
+			ProcessIndex:        flag.ProcessIndex{NullUint64: types.NullUint64{Value: 1, IsSet: true}},
 			Commands:            []string{"some", "commands"},
 			SkipHostValidation:  true,
 			SkipRemoteExecution: true,
@@ -76,6 +80,47 @@ var _ = Describe("ssh Command", func() {
 			})
 		})
 
+		When("--all-instances is used without a command", func() {
+			BeforeEach(func() {
+				cmd.AllInstances = true
+				cmd.Commands = nil
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--all-instances is used with single session flags", func() {
+			BeforeEach(func() {
+				cmd.AllInstances = true
+				cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:8080"}}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--all-instances", "--app-instance-index, -i", "-L", "--skip-remote-execution, -N"},
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--all-instances is used with --app-instance-index 0", func() {
+			BeforeEach(func() {
+				cmd.AllInstances = true
+				cmd.SkipRemoteExecution = false
+				cmd.ProcessIndex = flag.ProcessIndex{NullUint64: types.NullUint64{Value: 0, IsSet: true}}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--all-instances", "--app-instance-index, -i"},
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		When("the user is targeted to an organization and space", func() {
 			BeforeEach(func() {
 				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
@@ -155,6 +200,28 @@ var _ = Describe("ssh Command", func() {
 							}))
 						})
 					})
+
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:8080", LocalAddress: "localhost:3000"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along port forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:8080", LocalAddress: "localhost:3000"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardAddresses).To(Equal([]string{"localhost:1080"}))
+						})
+					})
 				})
 
 				When("executing the secure shell fails", func() {
@@ -171,6 +238,120 @@ var _ = Describe("ssh Command", func() {
 				})
 			})
 
+			When("running the command on all instances", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.ProcessIndex = flag.ProcessIndex{}
+					cmd.SkipRemoteExecution = false
+					cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+						return new(sharedactionfakes.FakeSecureShellClient)
+					}
+
+					fakeActor.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns(
+						[]uint{0, 2}, v7action.Warnings{"some-instance-warnings"}, nil)
+					fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub = func(_ string, _ string, _ string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
+						return v7action.SSHAuthentication{
+							Endpoint:           "some-endpoint",
+							HostKeyFingerprint: "some-fingerprint",
+							Passcode:           fmt.Sprintf("some-passcode-%d", processIndex),
+							Username:           fmt.Sprintf("some-username-%d", processIndex),
+						}, v7action.Warnings{"some-warnings"}, nil
+					}
+					fakeSSHActor.ExecuteSecureShellCommandStub = func(_ sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, stdout io.Writer, stderr io.Writer) (int, error) {
+						fmt.Fprintf(stdout, "output from %s\nmore output", sshOptions.Username)
+						fmt.Fprintf(stderr, "error from %s\n", sshOptions.Username)
+						return 0, nil
+					}
+				})
+
+				It("runs the command on every running instance with its own passcode", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("some-instance-warnings"))
+
+					appName, spaceGUID, processType := fakeActor.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(processType).To(Equal("some-process-type"))
+
+					Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(2))
+					_, _, _, firstIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+					_, _, _, secondIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(1)
+					Expect([]uint{firstIndex, secondIndex}).To(Equal([]uint{0, 2}))
+
+					Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+					Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(2))
+					var passcodes []string
+					for i := 0; i < 2; i++ {
+						_, sshOptions, _, _ := fakeSSHActor.ExecuteSecureShellCommandArgsForCall(i)
+						Expect(sshOptions.Commands).To(Equal([]string{"some", "commands"}))
+						Expect(sshOptions.SkipHostValidation).To(BeTrue())
+						passcodes = append(passcodes, sshOptions.Passcode)
+					}
+					Expect(passcodes).To(ConsistOf("some-passcode-0", "some-passcode-2"))
+				})
+
+				It("prefixes every line of output with the instance index", func() {
+					out := string(testUI.Out.(*Buffer).Contents())
+					Expect(out).To(ContainSubstring("[0] output from some-username-0\n[0] more output\n"))
+					Expect(out).To(ContainSubstring("[2] output from some-username-2\n[2] more output\n"))
+
+					errOut := string(testUI.Err.(*Buffer).Contents())
+					Expect(errOut).To(ContainSubstring("[0] error from some-username-0\n"))
+					Expect(errOut).To(ContainSubstring("[2] error from some-username-2\n"))
+				})
+
+				It("displays the exit status of each instance", func() {
+					Expect(testUI.Out).To(Say(`instance\s+exit status`))
+					Expect(testUI.Out).To(Say(`0\s+0`))
+					Expect(testUI.Out).To(Say(`2\s+0`))
+				})
+
+				When("the command fails on some instances", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellCommandStub = func(_ sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, _ io.Writer, _ io.Writer) (int, error) {
+							if sshOptions.Username == "some-username-2" {
+								return 0, errors.New("some-connect-error")
+							}
+							return 42, nil
+						}
+					})
+
+					It("displays each instance's result and returns an SSHInstancesFailedError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHInstancesFailedError{FailedCount: 2, InstanceCount: 2}))
+						Expect(testUI.Out).To(Say(`0\s+42`))
+						Expect(testUI.Out).To(Say(`2\s+some-connect-error`))
+					})
+				})
+
+				When("getting the secure shell authentication for an instance fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub = nil
+						fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall(0, v7action.SSHAuthentication{}, nil, actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 0})
+						fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall(1, v7action.SSHAuthentication{Username: "some-username-2"}, nil, nil)
+					})
+
+					It("runs the command on the remaining instances and reports the failure", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHInstancesFailedError{FailedCount: 1, InstanceCount: 2}))
+						Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(1))
+						Expect(testUI.Out).To(Say(`0\s+Instance 0 of process some-process-type not running`))
+						Expect(testUI.Out).To(Say(`2\s+0`))
+					})
+				})
+
+				When("getting the running instances fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns(
+							nil, v7action.Warnings{"some-instance-warnings"}, actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"})
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+						Expect(testUI.Err).To(Say("some-instance-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("getting the secure shell authentication fails", func() {
 				BeforeEach(func() {
 					fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{}, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
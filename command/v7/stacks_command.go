@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/sorting"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -15,12 +16,13 @@ import (
 //go:generate counterfeiter . StacksActor
 
 type StacksActor interface {
-	GetStacks() ([]v7action.Stack, v7action.Warnings, error)
+	GetStacks(labelSelector string) ([]v7action.Stack, v7action.Warnings, error)
 }
 
 type StacksCommand struct {
-	usage           interface{} `usage:"CF_NAME stacks"`
-	relatedCommands interface{} `related_commands:"app, push"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter stacks by labels"`
+	usage           interface{}        `usage:"CF_NAME stacks [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"app, push"`
 
 	UI          command.UI
 	Config      command.Config
@@ -58,7 +60,7 @@ func (cmd StacksCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	stacks, warnings, err := cmd.Actor.GetStacks()
+	stacks, warnings, err := cmd.Actor.GetStacks(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
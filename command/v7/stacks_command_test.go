@@ -74,6 +74,17 @@ var _ = Describe("stacks Command", func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
 		})
 
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetStacksArgsForCall(0)).To(Equal("env=prod"))
+			})
+		})
+
 		When("StacksActor returns an error", func() {
 			var expectedErr error
 
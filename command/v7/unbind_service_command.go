@@ -0,0 +1,97 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . UnbindServiceActor
+
+type UnbindServiceActor interface {
+	UnbindServiceInstanceFromApp(appName string, serviceInstanceName string, spaceGUID string) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type UnbindServiceCommand struct {
+	RequiredArgs    flag.BindServiceArgs `positional-args:"yes"`
+	Wait            bool                 `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage           interface{}          `usage:"CF_NAME unbind-service APP_NAME SERVICE_INSTANCE [--wait]"`
+	relatedCommands interface{}          `related_commands:"apps, delete-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnbindServiceActor
+}
+
+func (cmd *UnbindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd UnbindServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unbinding app {{.AppName}} from service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	jobURL, warnings, err := cmd.Actor.UnbindServiceInstanceFromApp(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceBindingNotFoundError); ok {
+			cmd.UI.DisplayWarningV7("Binding between {{.ServiceInstanceName}} and {{.AppName}} did not exist", map[string]interface{}{
+				"AppName":             cmd.RequiredArgs.AppName,
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	if cmd.Wait {
+		warnings, err = cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Unbinding in progress. Use 'cf service {{.ServiceName}}' to check operation status.", map[string]interface{}{
+			"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		})
+	}
+
+	return nil
+}
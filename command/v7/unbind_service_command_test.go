@@ -0,0 +1,138 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unbind-service Command", func() {
+	var (
+		cmd             v7.UnbindServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeUnbindServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeUnbindServiceActor)
+
+		cmd = v7.UnbindServiceCommand{
+			RequiredArgs: flag.BindServiceArgs{
+				AppName:             "some-app",
+				ServiceInstanceName: "some-instance",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.UnbindServiceInstanceFromAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the binding is deleted", func() {
+		BeforeEach(func() {
+			fakeActor.UnbindServiceInstanceFromAppReturns("", v7action.Warnings{"unbind-warning"}, nil)
+		})
+
+		It("unbinds the service instance from the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UnbindServiceInstanceFromAppCallCount()).To(Equal(1))
+			appName, instanceName, spaceGUID := fakeActor.UnbindServiceInstanceFromAppArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Unbinding app some-app from service some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("unbind-warning"))
+		})
+	})
+
+	When("the service broker deletes the binding asynchronously", func() {
+		BeforeEach(func() {
+			fakeActor.UnbindServiceInstanceFromAppReturns("some-job-url", nil, nil)
+		})
+
+		It("displays that the unbinding is in progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`Unbinding in progress\. Use 'cf service some-instance' to check operation status\.`))
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+			})
+
+			It("waits for the job to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+				Expect(testUI.Out).ToNot(Say("in progress"))
+			})
+		})
+	})
+
+	When("the binding does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UnbindServiceInstanceFromAppReturns("", v7action.Warnings{"unbind-warning"}, actionerror.ServiceBindingNotFoundError{})
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("Binding between some-instance and some-app did not exist"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("unbinding fails", func() {
+		BeforeEach(func() {
+			fakeActor.UnbindServiceInstanceFromAppReturns("", v7action.Warnings{"unbind-warning"}, errors.New("unbind-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("unbind-error"))
+			Expect(testUI.Err).To(Say("unbind-warning"))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . UnsetAnnotationActor
+
+type UnsetAnnotationActor interface {
+	SetAnnotationActor
+}
+
+type UnsetAnnotationCommand struct {
+	RequiredArgs   flag.UnsetAnnotationArgs `positional-args:"yes"`
+	BuildpackStack string                   `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage          interface{}              `usage:"CF_NAME unset-annotation RESOURCE RESOURCE_NAME KEY...\n\nEXAMPLES:\n   cf unset-annotation app dora owner\n   cf unset-annotation route dora.example.com/path owner\n\nRESOURCES:\n   app\n   buildpack\n   org\n   route\n   space\n   stack\n\nSEE ALSO:\n   set-annotation, annotations"`
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          UnsetAnnotationActor
+}
+
+func (cmd *UnsetAnnotationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+	return nil
+}
+
+func (cmd UnsetAnnotationCommand) Execute(args []string) error {
+	resource, err := newAnnotationResource(cmd.RequiredArgs.ResourceType, cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+	if err != nil {
+		return err
+	}
+
+	username, err := cmd.Config.CurrentUserName()
+	if err != nil {
+		return err
+	}
+
+	err = resource.checkTarget(cmd.SharedActor)
+	if err != nil {
+		return err
+	}
+
+	annotations := make(map[string]types.NullString)
+	for _, key := range cmd.RequiredArgs.AnnotationKeys {
+		annotations[key] = types.NewNullString()
+	}
+
+	resource.displayFlavor(cmd.UI, cmd.Config, "Removing annotation(s)", username)
+
+	warnings, err := updateAnnotations(cmd.Actor, cmd.Config, resource, annotations)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,111 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unset-annotation command", func() {
+	var (
+		cmd             UnsetAnnotationCommand
+		fakeActor       *v7fakes.FakeUnsetAnnotationActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeUnsetAnnotationActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		cmd = UnsetAnnotationCommand{
+			Actor:       fakeActor,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+		}
+
+		fakeConfig.CurrentUserNameReturns("some-user", nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("unsetting annotations on an org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetAnnotationArgs{
+				ResourceType:   "org",
+				ResourceName:   "some-org",
+				AnnotationKeys: []string{"owner", "runbook"},
+			}
+			fakeActor.UpdateOrganizationAnnotationsByOrganizationNameReturns(v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("removes the annotations by setting them to null", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			orgName, annotations := fakeActor.UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"owner":   types.NewNullString(),
+				"runbook": types.NewNullString(),
+			}))
+
+			Expect(testUI.Out).To(Say(`Removing annotation\(s\) for org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("unsetting annotations on a buildpack", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetAnnotationArgs{
+				ResourceType:   "buildpack",
+				ResourceName:   "some-buildpack",
+				AnnotationKeys: []string{"owner"},
+			}
+			cmd.BuildpackStack = "cflinuxfs3"
+		})
+
+		It("removes the annotations from the buildpack on the given stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			buildpackName, stackName, _ := fakeActor.UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(0)
+			Expect(buildpackName).To(Equal("some-buildpack"))
+			Expect(stackName).To(Equal("cflinuxfs3"))
+			Expect(testUI.Out).To(Say(`Removing annotation\(s\) for buildpack some-buildpack with stack cflinuxfs3 as some-user\.\.\.`))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetAnnotationArgs{
+				ResourceType:   "app",
+				ResourceName:   "dora",
+				AnnotationKeys: []string{"owner"},
+			}
+			fakeSharedActor.CheckTargetReturns(errors.New("target-error"))
+		})
+
+		It("returns the error without updating", func() {
+			Expect(executeErr).To(MatchError("target-error"))
+			Expect(fakeActor.UpdateApplicationAnnotationsByApplicationNameCallCount()).To(Equal(0))
+		})
+	})
+})
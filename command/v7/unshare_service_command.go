@@ -0,0 +1,99 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . UnshareServiceActor
+
+type UnshareServiceActor interface {
+	UnshareServiceInstanceFromSpaceAndOrg(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgName string, sharedToSpaceName string) (v7action.Warnings, error)
+}
+
+type UnshareServiceCommand struct {
+	RequiredArgs      flag.ServiceInstance `positional-args:"yes"`
+	SharedToOrgName   string               `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
+	SharedToSpaceName string               `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	Force             bool                 `short:"f" description:"Force unshare without confirmation"`
+	usage             interface{}          `usage:"CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"`
+	relatedCommands   interface{}          `related_commands:"delete-service, service, services, share-service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnshareServiceActor
+}
+
+func (cmd *UnshareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd UnshareServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	if cmd.SharedToOrgName != "" {
+		orgName = cmd.SharedToOrgName
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayWarning("WARNING: Unsharing this service instance will remove any service bindings that exist in any spaces that this instance is shared into. This could cause applications to stop working.")
+		cmd.UI.DisplayNewline()
+
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really unshare the service instance?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !response {
+			cmd.UI.DisplayText("Unshare cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unsharing service instance {{.ServiceInstanceName}} from org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SharedToSpaceName,
+		"Username":            user.Name,
+	})
+
+	warnings, err := cmd.Actor.UnshareServiceInstanceFromSpaceAndOrg(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, orgName, cmd.SharedToSpaceName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceInstanceNotSharedToSpaceError); !ok {
+			return err
+		}
+		cmd.UI.DisplayText("Service instance {{.ServiceInstanceName}} is not shared with space {{.SpaceName}} in organization {{.OrgName}}.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"SpaceName":           cmd.SharedToSpaceName,
+			"OrgName":             orgName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
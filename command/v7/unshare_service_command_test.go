@@ -0,0 +1,145 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unshare-service Command", func() {
+	var (
+		cmd             v7.UnshareServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeUnshareServiceActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeUnshareServiceActor)
+
+		cmd = v7.UnshareServiceCommand{
+			RequiredArgs:      flag.ServiceInstance{ServiceInstance: "some-instance"},
+			SharedToSpaceName: "other-space",
+			UI:                testUI,
+			Config:            fakeConfig,
+			SharedActor:       fakeSharedActor,
+			Actor:             fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the user does not confirm", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not unshare the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("WARNING: Unsharing this service instance will remove any service bindings"))
+			Expect(testUI.Out).To(Say(`Really unshare the service instance\?`))
+			Expect(testUI.Out).To(Say("Unshare cancelled"))
+			Expect(fakeActor.UnshareServiceInstanceFromSpaceAndOrgCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.UnshareServiceInstanceFromSpaceAndOrgReturns(v7action.Warnings{"unshare-warning"}, nil)
+		})
+
+		It("unshares the service instance from the space of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UnshareServiceInstanceFromSpaceAndOrgCallCount()).To(Equal(1))
+			instanceName, sourceSpaceGUID, orgName, spaceName := fakeActor.UnshareServiceInstanceFromSpaceAndOrgArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(sourceSpaceGUID).To(Equal("some-space-guid"))
+			Expect(orgName).To(Equal("some-org"))
+			Expect(spaceName).To(Equal("other-space"))
+
+			Expect(testUI.Out).To(Say(`Unsharing service instance some-instance from org some-org / space other-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("unshare-warning"))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.SharedToOrgName = "other-org"
+		})
+
+		It("unshares without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really unshare"))
+			_, _, orgName, _ := fakeActor.UnshareServiceInstanceFromSpaceAndOrgArgsForCall(0)
+			Expect(orgName).To(Equal("other-org"))
+		})
+
+		When("the service instance is not shared to the space", func() {
+			BeforeEach(func() {
+				fakeActor.UnshareServiceInstanceFromSpaceAndOrgReturns(nil, actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-instance"})
+			})
+
+			It("displays that it is not shared and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Service instance some-instance is not shared with space other-space in organization other-org\.`))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("unsharing fails", func() {
+			BeforeEach(func() {
+				fakeActor.UnshareServiceInstanceFromSpaceAndOrgReturns(v7action.Warnings{"unshare-warning"}, errors.New("unshare-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("unshare-error"))
+				Expect(testUI.Err).To(Say("unshare-warning"))
+			})
+		})
+	})
+})
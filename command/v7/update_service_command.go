@@ -0,0 +1,188 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . UpdateServiceActor
+
+type UpdateServiceActor interface {
+	UpdateManagedServiceInstance(params v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)
+	UpgradeManagedServiceInstance(serviceInstanceName string, spaceGUID string) (ccv3.JobURL, v7action.Warnings, error)
+	PollJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
+}
+
+type UpdateServiceCommand struct {
+	RequiredArgs     flag.ServiceInstance          `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Plan             string                        `short:"p" description:"Change service plan for a service instance"`
+	Tags             flag.Tags                     `short:"t" description:"User provided tags"`
+	Upgrade          bool                          `short:"u" long:"upgrade" description:"Upgrade the service instance to the latest version of the service plan available. It cannot be combined with flags: -c, -p, -t."`
+	ForceUpgrade     bool                          `short:"f" long:"force" description:"Force the upgrade to the latest available version of the service plan. It can only be used with: -u, --upgrade."`
+	Wait             bool                          `short:"w" long:"wait" description:"Wait for the operation to complete"`
+	usage            interface{}                   `usage:"CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--upgrade] [--wait]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n   CF_NAME update-service SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file:\n   CF_NAME update-service SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\n   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.\n\nEXAMPLES:\n   CF_NAME update-service mydb -p gold\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}'\n   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json\n   CF_NAME update-service mydb -t \"list, of, tags\"\n   CF_NAME update-service mydb --upgrade\n   CF_NAME update-service mydb --upgrade --force"`
+	relatedCommands  interface{}                   `related_commands:"rename-service, services, update-user-provided-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateServiceActor
+}
+
+func (cmd *UpdateServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, clock.NewClock())
+
+	return nil
+}
+
+func (cmd UpdateServiceCommand) Execute(args []string) error {
+	if err := cmd.validateArgumentCombination(); err != nil {
+		return err
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Upgrade {
+		return cmd.upgrade()
+	}
+
+	if cmd.Plan == "" && !cmd.Tags.IsSet && cmd.ParametersAsJSON == nil {
+		cmd.UI.DisplayText("No changes were made.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if err = cmd.displayUpdatingMessage(); err != nil {
+		return err
+	}
+
+	params := v7action.ManagedServiceInstanceParams{
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServicePlanName:     cmd.Plan,
+		Parameters:          cmd.ParametersAsJSON,
+	}
+	if cmd.Tags.IsSet {
+		params.Tags = cmd.Tags.Value
+	}
+
+	jobURL, warnings, err := cmd.Actor.UpdateManagedServiceInstance(params)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.waitForJob(jobURL)
+}
+
+func (cmd UpdateServiceCommand) upgrade() error {
+	if !cmd.ForceUpgrade {
+		serviceName := map[string]interface{}{"ServiceName": cmd.RequiredArgs.ServiceInstance}
+
+		cmd.UI.DisplayTextWithFlavor("You are about to update {{.ServiceName}}.", serviceName)
+		cmd.UI.DisplayText("Warning: This operation may be long running and will block further operations on the service until complete.")
+
+		proceed, err := cmd.UI.DisplayBoolPrompt(false, "Really update service {{.ServiceName}}?", serviceName)
+		if err != nil {
+			return err
+		}
+
+		if !proceed {
+			cmd.UI.DisplayText("Update cancelled")
+			return nil
+		}
+	}
+
+	if err := cmd.displayUpdatingMessage(); err != nil {
+		return err
+	}
+
+	jobURL, warnings, err := cmd.Actor.UpgradeManagedServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceUpgradeNotAvailableError); ok {
+			return translatableerror.TipDecoratorError{
+				BaseError: err,
+				Tip:       "To find out if upgrade is available run `cf service {{.ServiceName}}`.",
+				TipKeys: map[string]interface{}{
+					"ServiceName": cmd.RequiredArgs.ServiceInstance,
+				},
+			}
+		}
+		return err
+	}
+
+	return cmd.waitForJob(jobURL)
+}
+
+func (cmd UpdateServiceCommand) displayUpdatingMessage() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"Org":             cmd.Config.TargetedOrganization().Name,
+		"Space":           cmd.Config.TargetedSpace().Name,
+		"User":            user.Name,
+	})
+
+	return nil
+}
+
+func (cmd UpdateServiceCommand) waitForJob(jobURL ccv3.JobURL) error {
+	if cmd.Wait {
+		warnings, err := cmd.Actor.PollJob(jobURL)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Wait && jobURL != "" {
+		cmd.UI.DisplayText("Update in progress. Use 'cf services' or 'cf service {{.ServiceInstance}}' to check operation status.", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+	}
+
+	return nil
+}
+
+func (cmd UpdateServiceCommand) validateArgumentCombination() error {
+	if cmd.Upgrade && (cmd.Tags.IsSet || cmd.ParametersAsJSON != nil || cmd.Plan != "") {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--upgrade", "-t", "-c", "-p"},
+		}
+	}
+
+	if cmd.ForceUpgrade && !cmd.Upgrade {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--force",
+			Arg2: "--upgrade",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-service Command", func() {
+	var (
+		cmd             v7.UpdateServiceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeUpdateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeUpdateServiceActor)
+
+		cmd = v7.UpdateServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("no flags are provided", func() {
+		It("makes no changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`No changes were made\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a new plan and parameters are provided", func() {
+		BeforeEach(func() {
+			cmd.Plan = "new-plan"
+			cmd.ParametersAsJSON = map[string]interface{}{"foo": "bar"}
+			fakeActor.UpdateManagedServiceInstanceReturns("", v7action.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.ManagedServiceInstanceParams{
+				ServiceInstanceName: "some-instance",
+				SpaceGUID:           "some-space-guid",
+				ServicePlanName:     "new-plan",
+				Parameters:          map[string]interface{}{"foo": "bar"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Updating service instance some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+
+		When("the service broker updates the service instance asynchronously", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateManagedServiceInstanceReturns("some-job-url", nil, nil)
+			})
+
+			It("displays that the update is in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Update in progress\. Use 'cf services' or 'cf service some-instance' to check operation status\.`))
+			})
+
+			When("--wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+				})
+
+				It("waits for the job to complete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+					Expect(testUI.Out).ToNot(Say("in progress"))
+				})
+			})
+		})
+
+		When("updating fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateManagedServiceInstanceReturns("", v7action.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-warning"))
+			})
+		})
+	})
+
+	When("empty tags are provided", func() {
+		BeforeEach(func() {
+			cmd.Tags = flag.Tags{IsSet: true, Value: []string{}}
+		})
+
+		It("clears the tags", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			params := fakeActor.UpdateManagedServiceInstanceArgsForCall(0)
+			Expect(params.Tags).To(Equal([]string{}))
+		})
+	})
+
+	When("--upgrade is provided", func() {
+		BeforeEach(func() {
+			cmd.Upgrade = true
+			fakeActor.UpgradeManagedServiceInstanceReturns("", v7action.Warnings{"upgrade-warning"}, nil)
+		})
+
+		When("the user confirms the upgrade", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("upgrades the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`You are about to update some-instance\.`))
+				Expect(testUI.Out).To(Say(`Warning: This operation may be long running and will block further operations on the service until complete\.`))
+				Expect(testUI.Out).To(Say(`Really update service some-instance\? \[yN\]:`))
+				Expect(testUI.Out).To(Say(`Updating service instance some-instance in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("upgrade-warning"))
+
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(1))
+				name, spaceGUID := fakeActor.UpgradeManagedServiceInstanceArgsForCall(0)
+				Expect(name).To(Equal("some-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			When("the service broker upgrades the service instance asynchronously", func() {
+				BeforeEach(func() {
+					fakeActor.UpgradeManagedServiceInstanceReturns("some-job-url", nil, nil)
+				})
+
+				It("displays that the update is in progress", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Update in progress\. Use 'cf services' or 'cf service some-instance' to check operation status\.`))
+				})
+
+				When("--wait is provided", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+					})
+
+					It("waits for the job to complete", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+						Expect(fakeActor.PollJobArgsForCall(0)).To(BeEquivalentTo("some-job-url"))
+					})
+				})
+			})
+
+			When("no upgrade is available", func() {
+				BeforeEach(func() {
+					fakeActor.UpgradeManagedServiceInstanceReturns("", v7action.Warnings{"upgrade-warning"}, actionerror.ServiceUpgradeNotAvailableError{})
+				})
+
+				It("returns the error with a tip", func() {
+					Expect(executeErr).To(MatchError(translatableerror.TipDecoratorError{
+						BaseError: actionerror.ServiceUpgradeNotAvailableError{},
+						Tip:       "To find out if upgrade is available run `cf service {{.ServiceName}}`.",
+						TipKeys: map[string]interface{}{
+							"ServiceName": "some-instance",
+						},
+					}))
+					Expect(testUI.Err).To(Say("upgrade-warning"))
+				})
+			})
+
+			When("upgrading fails", func() {
+				BeforeEach(func() {
+					fakeActor.UpgradeManagedServiceInstanceReturns("", nil, errors.New("upgrade-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("upgrade-error"))
+				})
+			})
+		})
+
+		When("the user declines the upgrade", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the update", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Update cancelled"))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--force is provided", func() {
+			BeforeEach(func() {
+				cmd.ForceUpgrade = true
+			})
+
+			It("upgrades without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really update service"))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+
+		When("other update flags are provided", func() {
+			BeforeEach(func() {
+				cmd.Plan = "new-plan"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--upgrade", "-t", "-c", "-p"},
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--force is provided without --upgrade", func() {
+		BeforeEach(func() {
+			cmd.ForceUpgrade = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--force",
+				Arg2: "--upgrade",
+			}))
+			Expect(fakeActor.UpdateManagedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-user-provided-service Command", func() {
+	var (
+		cmd             v7.UpdateUserProvidedServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeUpdateUserProvidedServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeUpdateUserProvidedServiceActor)
+
+		cmd = v7.UpdateUserProvidedServiceCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "some-instance"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("no flags are provided", func() {
+		It("makes no changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`No flags specified\. No changes were made\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("some flags are provided", func() {
+		BeforeEach(func() {
+			cmd.SyslogDrainURL = flag.OptionalString{IsSet: true, Value: "https://syslog.example.com"}
+			cmd.Tags = flag.Tags{IsSet: true, Value: []string{}}
+			fakeActor.UpdateUserProvidedServiceInstanceReturns(v7action.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates only the given fields", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			instanceName, spaceGUID, update := fakeActor.UpdateUserProvidedServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(update).To(Equal(v7action.ServiceInstance{
+				SyslogDrainURL: types.NewNullString("https://syslog.example.com"),
+				Tags:           []string{},
+			}))
+
+			Expect(testUI.Out).To(Say(`Updating user provided service some-instance in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Use 'cf restage' for any bound apps to ensure your env variable changes take effect"))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+
+		When("the service instance is not user-provided", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateUserProvidedServiceInstanceReturns(v7action.Warnings{"update-warning"}, actionerror.ServiceInstanceNotUserProvidedError{Name: "some-instance"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotUserProvidedError{Name: "some-instance"}))
+				Expect(testUI.Err).To(Say("update-warning"))
+			})
+		})
+	})
+})
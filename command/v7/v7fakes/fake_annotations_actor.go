@@ -0,0 +1,543 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type FakeAnnotationsActor struct {
+	GetApplicationAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getApplicationAnnotationsMutex       sync.RWMutex
+	getApplicationAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetBuildpackAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getBuildpackAnnotationsMutex       sync.RWMutex
+	getBuildpackAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getBuildpackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBuildpackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetOrganizationAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getOrganizationAnnotationsMutex       sync.RWMutex
+	getOrganizationAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getRouteAnnotationsMutex       sync.RWMutex
+	getRouteAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getRouteAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetSpaceAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getSpaceAnnotationsMutex       sync.RWMutex
+	getSpaceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetStackAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getStackAnnotationsMutex       sync.RWMutex
+	getStackAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getStackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationAnnotationsReturnsOnCall[len(fake.getApplicationAnnotationsArgsForCall)]
+	fake.getApplicationAnnotationsArgsForCall = append(fake.getApplicationAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationAnnotations", []interface{}{arg1, arg2})
+	fake.getApplicationAnnotationsMutex.Unlock()
+	if fake.GetApplicationAnnotationsStub != nil {
+		return fake.GetApplicationAnnotationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotationsCallCount() int {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	return len(fake.getApplicationAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotationsArgsForCall(i int) (string, string) {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getApplicationAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	fake.getApplicationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetApplicationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	if fake.getApplicationAnnotationsReturnsOnCall == nil {
+		fake.getApplicationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getBuildpackAnnotationsReturnsOnCall[len(fake.getBuildpackAnnotationsArgsForCall)]
+	fake.getBuildpackAnnotationsArgsForCall = append(fake.getBuildpackAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetBuildpackAnnotations", []interface{}{arg1, arg2})
+	fake.getBuildpackAnnotationsMutex.Unlock()
+	if fake.GetBuildpackAnnotationsStub != nil {
+		return fake.GetBuildpackAnnotationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getBuildpackAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotationsCallCount() int {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	return len(fake.getBuildpackAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotationsArgsForCall(i int) (string, string) {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getBuildpackAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	fake.getBuildpackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetBuildpackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	if fake.getBuildpackAnnotationsReturnsOnCall == nil {
+		fake.getBuildpackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationAnnotationsReturnsOnCall[len(fake.getOrganizationAnnotationsArgsForCall)]
+	fake.getOrganizationAnnotationsArgsForCall = append(fake.getOrganizationAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationAnnotations", []interface{}{arg1})
+	fake.getOrganizationAnnotationsMutex.Unlock()
+	if fake.GetOrganizationAnnotationsStub != nil {
+		return fake.GetOrganizationAnnotationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotationsCallCount() int {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	return len(fake.getOrganizationAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotationsArgsForCall(i int) string {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getOrganizationAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	fake.getOrganizationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetOrganizationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	if fake.getOrganizationAnnotationsReturnsOnCall == nil {
+		fake.getOrganizationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getRouteAnnotationsReturnsOnCall[len(fake.getRouteAnnotationsArgsForCall)]
+	fake.getRouteAnnotationsArgsForCall = append(fake.getRouteAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRouteAnnotations", []interface{}{arg1})
+	fake.getRouteAnnotationsMutex.Unlock()
+	if fake.GetRouteAnnotationsStub != nil {
+		return fake.GetRouteAnnotationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotationsCallCount() int {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	return len(fake.getRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotationsArgsForCall(i int) string {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.getRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	fake.getRouteAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetRouteAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	if fake.getRouteAnnotationsReturnsOnCall == nil {
+		fake.getRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getSpaceAnnotationsReturnsOnCall[len(fake.getSpaceAnnotationsArgsForCall)]
+	fake.getSpaceAnnotationsArgsForCall = append(fake.getSpaceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceAnnotations", []interface{}{arg1, arg2})
+	fake.getSpaceAnnotationsMutex.Unlock()
+	if fake.GetSpaceAnnotationsStub != nil {
+		return fake.GetSpaceAnnotationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotationsCallCount() int {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	return len(fake.getSpaceAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotationsArgsForCall(i int) (string, string) {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	argsForCall := fake.getSpaceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	fake.getSpaceAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetSpaceAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	if fake.getSpaceAnnotationsReturnsOnCall == nil {
+		fake.getSpaceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getStackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getStackAnnotationsReturnsOnCall[len(fake.getStackAnnotationsArgsForCall)]
+	fake.getStackAnnotationsArgsForCall = append(fake.getStackAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetStackAnnotations", []interface{}{arg1})
+	fake.getStackAnnotationsMutex.Unlock()
+	if fake.GetStackAnnotationsStub != nil {
+		return fake.GetStackAnnotationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getStackAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotationsCallCount() int {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	return len(fake.getStackAnnotationsArgsForCall)
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = stub
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotationsArgsForCall(i int) string {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getStackAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	fake.getStackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) GetStackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	if fake.getStackAnnotationsReturnsOnCall == nil {
+		fake.getStackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAnnotationsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAnnotationsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.AnnotationsActor = new(FakeAnnotationsActor)
@@ -0,0 +1,208 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeBindServiceActor struct {
+	BindServiceInstanceToAppStub        func(string, string, string, string, map[string]interface{}) (ccv3.JobURL, v7action.Warnings, error)
+	bindServiceInstanceToAppMutex       sync.RWMutex
+	bindServiceInstanceToAppArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 map[string]interface{}
+	}
+	bindServiceInstanceToAppReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	bindServiceInstanceToAppReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	PollJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		arg1 ccv3.JobURL
+	}
+	pollJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToApp(arg1 string, arg2 string, arg3 string, arg4 string, arg5 map[string]interface{}) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.bindServiceInstanceToAppMutex.Lock()
+	ret, specificReturn := fake.bindServiceInstanceToAppReturnsOnCall[len(fake.bindServiceInstanceToAppArgsForCall)]
+	fake.bindServiceInstanceToAppArgsForCall = append(fake.bindServiceInstanceToAppArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 map[string]interface{}
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("BindServiceInstanceToApp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.bindServiceInstanceToAppMutex.Unlock()
+	if fake.BindServiceInstanceToAppStub != nil {
+		return fake.BindServiceInstanceToAppStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.bindServiceInstanceToAppReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToAppCallCount() int {
+	fake.bindServiceInstanceToAppMutex.RLock()
+	defer fake.bindServiceInstanceToAppMutex.RUnlock()
+	return len(fake.bindServiceInstanceToAppArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToAppCalls(stub func(string, string, string, string, map[string]interface{}) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.bindServiceInstanceToAppMutex.Lock()
+	defer fake.bindServiceInstanceToAppMutex.Unlock()
+	fake.BindServiceInstanceToAppStub = stub
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToAppArgsForCall(i int) (string, string, string, string, map[string]interface{}) {
+	fake.bindServiceInstanceToAppMutex.RLock()
+	defer fake.bindServiceInstanceToAppMutex.RUnlock()
+	argsForCall := fake.bindServiceInstanceToAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToAppReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.bindServiceInstanceToAppMutex.Lock()
+	defer fake.bindServiceInstanceToAppMutex.Unlock()
+	fake.BindServiceInstanceToAppStub = nil
+	fake.bindServiceInstanceToAppReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBindServiceActor) BindServiceInstanceToAppReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.bindServiceInstanceToAppMutex.Lock()
+	defer fake.bindServiceInstanceToAppMutex.Unlock()
+	fake.BindServiceInstanceToAppStub = nil
+	if fake.bindServiceInstanceToAppReturnsOnCall == nil {
+		fake.bindServiceInstanceToAppReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.bindServiceInstanceToAppReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBindServiceActor) PollJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		arg1 ccv3.JobURL
+	}{arg1})
+	fake.recordInvocation("PollJob", []interface{}{arg1})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBindServiceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) PollJobCalls(stub func(ccv3.JobURL) (v7action.Warnings, error)) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = stub
+}
+
+func (fake *FakeBindServiceActor) PollJobArgsForCall(i int) ccv3.JobURL {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	argsForCall := fake.pollJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBindServiceActor) PollJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBindServiceActor) PollJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.bindServiceInstanceToAppMutex.RLock()
+	defer fake.bindServiceInstanceToAppMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBindServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.BindServiceActor = new(FakeBindServiceActor)
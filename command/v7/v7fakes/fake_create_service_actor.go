@@ -0,0 +1,200 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeCreateServiceActor struct {
+	CreateManagedServiceInstanceStub        func(v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)
+	createManagedServiceInstanceMutex       sync.RWMutex
+	createManagedServiceInstanceArgsForCall []struct {
+		arg1 v7action.ManagedServiceInstanceParams
+	}
+	createManagedServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	createManagedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	PollJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		arg1 ccv3.JobURL
+	}
+	pollJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstance(arg1 v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.createManagedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createManagedServiceInstanceReturnsOnCall[len(fake.createManagedServiceInstanceArgsForCall)]
+	fake.createManagedServiceInstanceArgsForCall = append(fake.createManagedServiceInstanceArgsForCall, struct {
+		arg1 v7action.ManagedServiceInstanceParams
+	}{arg1})
+	fake.recordInvocation("CreateManagedServiceInstance", []interface{}{arg1})
+	fake.createManagedServiceInstanceMutex.Unlock()
+	if fake.CreateManagedServiceInstanceStub != nil {
+		return fake.CreateManagedServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createManagedServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstanceCallCount() int {
+	fake.createManagedServiceInstanceMutex.RLock()
+	defer fake.createManagedServiceInstanceMutex.RUnlock()
+	return len(fake.createManagedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstanceCalls(stub func(v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.createManagedServiceInstanceMutex.Lock()
+	defer fake.createManagedServiceInstanceMutex.Unlock()
+	fake.CreateManagedServiceInstanceStub = stub
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstanceArgsForCall(i int) v7action.ManagedServiceInstanceParams {
+	fake.createManagedServiceInstanceMutex.RLock()
+	defer fake.createManagedServiceInstanceMutex.RUnlock()
+	argsForCall := fake.createManagedServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstanceReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.createManagedServiceInstanceMutex.Lock()
+	defer fake.createManagedServiceInstanceMutex.Unlock()
+	fake.CreateManagedServiceInstanceStub = nil
+	fake.createManagedServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateServiceActor) CreateManagedServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.createManagedServiceInstanceMutex.Lock()
+	defer fake.createManagedServiceInstanceMutex.Unlock()
+	fake.CreateManagedServiceInstanceStub = nil
+	if fake.createManagedServiceInstanceReturnsOnCall == nil {
+		fake.createManagedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createManagedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateServiceActor) PollJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		arg1 ccv3.JobURL
+	}{arg1})
+	fake.recordInvocation("PollJob", []interface{}{arg1})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreateServiceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeCreateServiceActor) PollJobCalls(stub func(ccv3.JobURL) (v7action.Warnings, error)) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = stub
+}
+
+func (fake *FakeCreateServiceActor) PollJobArgsForCall(i int) ccv3.JobURL {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	argsForCall := fake.pollJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateServiceActor) PollJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateServiceActor) PollJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createManagedServiceInstanceMutex.RLock()
+	defer fake.createManagedServiceInstanceMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCreateServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.CreateServiceActor = new(FakeCreateServiceActor)
@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeCreateUserProvidedServiceActor struct {
+	CreateUserProvidedServiceInstanceStub        func(v7action.ServiceInstance) (v7action.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		arg1 v7action.ServiceInstance
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstance(arg1 v7action.ServiceInstance) (v7action.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		arg1 v7action.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{arg1})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createUserProvidedServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstanceCalls(stub func(v7action.ServiceInstance) (v7action.Warnings, error)) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	defer fake.createUserProvidedServiceInstanceMutex.Unlock()
+	fake.CreateUserProvidedServiceInstanceStub = stub
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstanceArgsForCall(i int) v7action.ServiceInstance {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	argsForCall := fake.createUserProvidedServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstanceReturns(result1 v7action.Warnings, result2 error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	defer fake.createUserProvidedServiceInstanceMutex.Unlock()
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	defer fake.createUserProvidedServiceInstanceMutex.Unlock()
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCreateUserProvidedServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.CreateUserProvidedServiceActor = new(FakeCreateUserProvidedServiceActor)
@@ -0,0 +1,204 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeDeleteServiceKeyActor struct {
+	DeleteServiceKeyByServiceInstanceAndNameStub        func(string, string, string) (ccv3.JobURL, v7action.Warnings, error)
+	deleteServiceKeyByServiceInstanceAndNameMutex       sync.RWMutex
+	deleteServiceKeyByServiceInstanceAndNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteServiceKeyByServiceInstanceAndNameReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	deleteServiceKeyByServiceInstanceAndNameReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	PollJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		arg1 ccv3.JobURL
+	}
+	pollJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndName(arg1 string, arg2 string, arg3 string) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyByServiceInstanceAndNameReturnsOnCall[len(fake.deleteServiceKeyByServiceInstanceAndNameArgsForCall)]
+	fake.deleteServiceKeyByServiceInstanceAndNameArgsForCall = append(fake.deleteServiceKeyByServiceInstanceAndNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteServiceKeyByServiceInstanceAndName", []interface{}{arg1, arg2, arg3})
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	if fake.DeleteServiceKeyByServiceInstanceAndNameStub != nil {
+		return fake.DeleteServiceKeyByServiceInstanceAndNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteServiceKeyByServiceInstanceAndNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndNameCallCount() int {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	return len(fake.deleteServiceKeyByServiceInstanceAndNameArgsForCall)
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndNameCalls(stub func(string, string, string) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.DeleteServiceKeyByServiceInstanceAndNameStub = stub
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndNameArgsForCall(i int) (string, string, string) {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	argsForCall := fake.deleteServiceKeyByServiceInstanceAndNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndNameReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.DeleteServiceKeyByServiceInstanceAndNameStub = nil
+	fake.deleteServiceKeyByServiceInstanceAndNameReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteServiceKeyActor) DeleteServiceKeyByServiceInstanceAndNameReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.DeleteServiceKeyByServiceInstanceAndNameStub = nil
+	if fake.deleteServiceKeyByServiceInstanceAndNameReturnsOnCall == nil {
+		fake.deleteServiceKeyByServiceInstanceAndNameReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceKeyByServiceInstanceAndNameReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		arg1 ccv3.JobURL
+	}{arg1})
+	fake.recordInvocation("PollJob", []interface{}{arg1})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJobCalls(stub func(ccv3.JobURL) (v7action.Warnings, error)) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = stub
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJobArgsForCall(i int) ccv3.JobURL {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	argsForCall := fake.pollJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteServiceKeyActor) PollJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteServiceKeyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.deleteServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeleteServiceKeyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.DeleteServiceKeyActor = new(FakeDeleteServiceKeyActor)
@@ -0,0 +1,369 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeDeploymentActor struct {
+	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDeploymentSummaryStub        func(v7action.Deployment) (v7action.DeploymentSummary, v7action.Warnings, error)
+	getDeploymentSummaryMutex       sync.RWMutex
+	getDeploymentSummaryArgsForCall []struct {
+		arg1 v7action.Deployment
+	}
+	getDeploymentSummaryReturns struct {
+		result1 v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentSummaryReturnsOnCall map[int]struct {
+		result1 v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetLatestDeploymentForAppStub        func(string) (v7action.Deployment, v7action.Warnings, error)
+	getLatestDeploymentForAppMutex       sync.RWMutex
+	getLatestDeploymentForAppArgsForCall []struct {
+		arg1 string
+	}
+	getLatestDeploymentForAppReturns struct {
+		result1 v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getLatestDeploymentForAppReturnsOnCall map[int]struct {
+		result1 v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	PollDeploymentStub        func(string, func(v7action.DeploymentSummary)) (v7action.Warnings, error)
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 func(v7action.DeploymentSummary)
+	}
+	pollDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v7action.Application, v7action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpaceReturns(result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummary(arg1 v7action.Deployment) (v7action.DeploymentSummary, v7action.Warnings, error) {
+	fake.getDeploymentSummaryMutex.Lock()
+	ret, specificReturn := fake.getDeploymentSummaryReturnsOnCall[len(fake.getDeploymentSummaryArgsForCall)]
+	fake.getDeploymentSummaryArgsForCall = append(fake.getDeploymentSummaryArgsForCall, struct {
+		arg1 v7action.Deployment
+	}{arg1})
+	fake.recordInvocation("GetDeploymentSummary", []interface{}{arg1})
+	fake.getDeploymentSummaryMutex.Unlock()
+	if fake.GetDeploymentSummaryStub != nil {
+		return fake.GetDeploymentSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDeploymentSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummaryCallCount() int {
+	fake.getDeploymentSummaryMutex.RLock()
+	defer fake.getDeploymentSummaryMutex.RUnlock()
+	return len(fake.getDeploymentSummaryArgsForCall)
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummaryCalls(stub func(v7action.Deployment) (v7action.DeploymentSummary, v7action.Warnings, error)) {
+	fake.getDeploymentSummaryMutex.Lock()
+	defer fake.getDeploymentSummaryMutex.Unlock()
+	fake.GetDeploymentSummaryStub = stub
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummaryArgsForCall(i int) v7action.Deployment {
+	fake.getDeploymentSummaryMutex.RLock()
+	defer fake.getDeploymentSummaryMutex.RUnlock()
+	argsForCall := fake.getDeploymentSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummaryReturns(result1 v7action.DeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentSummaryMutex.Lock()
+	defer fake.getDeploymentSummaryMutex.Unlock()
+	fake.GetDeploymentSummaryStub = nil
+	fake.getDeploymentSummaryReturns = struct {
+		result1 v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) GetDeploymentSummaryReturnsOnCall(i int, result1 v7action.DeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentSummaryMutex.Lock()
+	defer fake.getDeploymentSummaryMutex.Unlock()
+	fake.GetDeploymentSummaryStub = nil
+	if fake.getDeploymentSummaryReturnsOnCall == nil {
+		fake.getDeploymentSummaryReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeploymentSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentSummaryReturnsOnCall[i] = struct {
+		result1 v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForApp(arg1 string) (v7action.Deployment, v7action.Warnings, error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	ret, specificReturn := fake.getLatestDeploymentForAppReturnsOnCall[len(fake.getLatestDeploymentForAppArgsForCall)]
+	fake.getLatestDeploymentForAppArgsForCall = append(fake.getLatestDeploymentForAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetLatestDeploymentForApp", []interface{}{arg1})
+	fake.getLatestDeploymentForAppMutex.Unlock()
+	if fake.GetLatestDeploymentForAppStub != nil {
+		return fake.GetLatestDeploymentForAppStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getLatestDeploymentForAppReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForAppCallCount() int {
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
+	return len(fake.getLatestDeploymentForAppArgsForCall)
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForAppCalls(stub func(string) (v7action.Deployment, v7action.Warnings, error)) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = stub
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForAppArgsForCall(i int) string {
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
+	argsForCall := fake.getLatestDeploymentForAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForAppReturns(result1 v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = nil
+	fake.getLatestDeploymentForAppReturns = struct {
+		result1 v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) GetLatestDeploymentForAppReturnsOnCall(i int, result1 v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = nil
+	if fake.getLatestDeploymentForAppReturnsOnCall == nil {
+		fake.getLatestDeploymentForAppReturnsOnCall = make(map[int]struct {
+			result1 v7action.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getLatestDeploymentForAppReturnsOnCall[i] = struct {
+		result1 v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentActor) PollDeployment(arg1 string, arg2 func(v7action.DeploymentSummary)) (v7action.Warnings, error) {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 func(v7action.DeploymentSummary)
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeployment", []interface{}{arg1, arg2})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentActor) PollDeploymentCalls(stub func(string, func(v7action.DeploymentSummary)) (v7action.Warnings, error)) {
+	fake.pollDeploymentMutex.Lock()
+	defer fake.pollDeploymentMutex.Unlock()
+	fake.PollDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentActor) PollDeploymentArgsForCall(i int) (string, func(v7action.DeploymentSummary)) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	argsForCall := fake.pollDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentActor) PollDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollDeploymentMutex.Lock()
+	defer fake.pollDeploymentMutex.Unlock()
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentActor) PollDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollDeploymentMutex.Lock()
+	defer fake.pollDeploymentMutex.Unlock()
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getDeploymentSummaryMutex.RLock()
+	defer fake.getDeploymentSummaryMutex.RUnlock()
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeploymentActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.DeploymentActor = new(FakeDeploymentActor)
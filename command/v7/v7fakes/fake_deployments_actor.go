@@ -0,0 +1,206 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeDeploymentsActor struct {
+	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDeploymentsForAppStub        func(string) ([]v7action.Deployment, v7action.Warnings, error)
+	getDeploymentsForAppMutex       sync.RWMutex
+	getDeploymentsForAppArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentsForAppReturns struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentsForAppReturnsOnCall map[int]struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v7action.Application, v7action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpaceReturns(result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentsActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForApp(arg1 string) ([]v7action.Deployment, v7action.Warnings, error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	ret, specificReturn := fake.getDeploymentsForAppReturnsOnCall[len(fake.getDeploymentsForAppArgsForCall)]
+	fake.getDeploymentsForAppArgsForCall = append(fake.getDeploymentsForAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDeploymentsForApp", []interface{}{arg1})
+	fake.getDeploymentsForAppMutex.Unlock()
+	if fake.GetDeploymentsForAppStub != nil {
+		return fake.GetDeploymentsForAppStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDeploymentsForAppReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForAppCallCount() int {
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	return len(fake.getDeploymentsForAppArgsForCall)
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForAppCalls(stub func(string) ([]v7action.Deployment, v7action.Warnings, error)) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = stub
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForAppArgsForCall(i int) string {
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	argsForCall := fake.getDeploymentsForAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForAppReturns(result1 []v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = nil
+	fake.getDeploymentsForAppReturns = struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentsActor) GetDeploymentsForAppReturnsOnCall(i int, result1 []v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = nil
+	if fake.getDeploymentsForAppReturnsOnCall == nil {
+		fake.getDeploymentsForAppReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentsForAppReturnsOnCall[i] = struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeploymentsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.DeploymentsActor = new(FakeDeploymentsActor)
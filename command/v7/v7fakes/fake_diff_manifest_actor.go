@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeDiffManifestActor struct {
+	DiffManifestStub        func(string, v7pushaction.ManifestParser) ([]v7pushaction.ManifestAppDiff, v7pushaction.Warnings, error)
+	diffManifestMutex       sync.RWMutex
+	diffManifestArgsForCall []struct {
+		arg1 string
+		arg2 v7pushaction.ManifestParser
+	}
+	diffManifestReturns struct {
+		result1 []v7pushaction.ManifestAppDiff
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	diffManifestReturnsOnCall map[int]struct {
+		result1 []v7pushaction.ManifestAppDiff
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiffManifestActor) DiffManifest(arg1 string, arg2 v7pushaction.ManifestParser) ([]v7pushaction.ManifestAppDiff, v7pushaction.Warnings, error) {
+	fake.diffManifestMutex.Lock()
+	ret, specificReturn := fake.diffManifestReturnsOnCall[len(fake.diffManifestArgsForCall)]
+	fake.diffManifestArgsForCall = append(fake.diffManifestArgsForCall, struct {
+		arg1 string
+		arg2 v7pushaction.ManifestParser
+	}{arg1, arg2})
+	fake.recordInvocation("DiffManifest", []interface{}{arg1, arg2})
+	fake.diffManifestMutex.Unlock()
+	if fake.DiffManifestStub != nil {
+		return fake.DiffManifestStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diffManifestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDiffManifestActor) DiffManifestCallCount() int {
+	fake.diffManifestMutex.RLock()
+	defer fake.diffManifestMutex.RUnlock()
+	return len(fake.diffManifestArgsForCall)
+}
+
+func (fake *FakeDiffManifestActor) DiffManifestCalls(stub func(string, v7pushaction.ManifestParser) ([]v7pushaction.ManifestAppDiff, v7pushaction.Warnings, error)) {
+	fake.diffManifestMutex.Lock()
+	defer fake.diffManifestMutex.Unlock()
+	fake.DiffManifestStub = stub
+}
+
+func (fake *FakeDiffManifestActor) DiffManifestArgsForCall(i int) (string, v7pushaction.ManifestParser) {
+	fake.diffManifestMutex.RLock()
+	defer fake.diffManifestMutex.RUnlock()
+	argsForCall := fake.diffManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDiffManifestActor) DiffManifestReturns(result1 []v7pushaction.ManifestAppDiff, result2 v7pushaction.Warnings, result3 error) {
+	fake.diffManifestMutex.Lock()
+	defer fake.diffManifestMutex.Unlock()
+	fake.DiffManifestStub = nil
+	fake.diffManifestReturns = struct {
+		result1 []v7pushaction.ManifestAppDiff
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffManifestActor) DiffManifestReturnsOnCall(i int, result1 []v7pushaction.ManifestAppDiff, result2 v7pushaction.Warnings, result3 error) {
+	fake.diffManifestMutex.Lock()
+	defer fake.diffManifestMutex.Unlock()
+	fake.DiffManifestStub = nil
+	if fake.diffManifestReturnsOnCall == nil {
+		fake.diffManifestReturnsOnCall = make(map[int]struct {
+			result1 []v7pushaction.ManifestAppDiff
+			result2 v7pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.diffManifestReturnsOnCall[i] = struct {
+		result1 []v7pushaction.ManifestAppDiff
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffManifestActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.diffManifestMutex.RLock()
+	defer fake.diffManifestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiffManifestActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.DiffManifestActor = new(FakeDiffManifestActor)
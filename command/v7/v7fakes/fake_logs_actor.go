@@ -0,0 +1,494 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeLogsActor struct {
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, v7action.NOAAClient) ([]v7action.LogMessage, v7action.Warnings, error)
+	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}
+	getRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub        func(string, string, time.Time, v7action.LogCacheClient) ([]v7action.LogMessage, v7action.Warnings, error)
+	getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex       sync.RWMutex
+	getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 v7action.LogCacheClient
+	}
+	getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturns struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall map[int]struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}
+	getStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	GetStreamingLogsForApplicationsByNamesAndSpaceStub        func([]string, string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+	getStreamingLogsForApplicationsByNamesAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}
+	getStreamingLogsForApplicationsByNamesAndSpaceReturns struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	GetStreamingLogsForSpaceStub        func(string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)
+	getStreamingLogsForSpaceMutex       sync.RWMutex
+	getStreamingLogsForSpaceArgsForCall []struct {
+		arg1 string
+		arg2 v7action.NOAAClient
+	}
+	getStreamingLogsForSpaceReturns struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	getStreamingLogsForSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v7action.NOAAClient) ([]v7action.LogMessage, v7action.Warnings, error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetRecentLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v7action.NOAAClient) ([]v7action.LogMessage, v7action.Warnings, error)) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v7action.NOAAClient) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []v7action.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []v7action.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCache(arg1 string, arg2 string, arg3 time.Time, arg4 v7action.LogCacheClient) ([]v7action.LogMessage, v7action.Warnings, error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall)]
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 v7action.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpaceFromLogCache", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Unlock()
+	if fake.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub != nil {
+		return fake.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCacheCallCount() int {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCacheCalls(stub func(string, string, time.Time, v7action.LogCacheClient) ([]v7action.LogMessage, v7action.Warnings, error)) {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub = stub
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall(i int) (string, string, time.Time, v7action.LogCacheClient) {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCacheReturns(result1 []v7action.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub = nil
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturns = struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall(i int, result1 []v7action.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceFromLogCacheStub = nil
+	if fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall = make(map[int]struct {
+			result1 []v7action.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheReturnsOnCall[i] = struct {
+		result1 []v7action.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getStreamingLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v7action.NOAAClient) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7action.LogMessage
+			result2 <-chan error
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpace(arg1 []string, arg2 string, arg3 v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+		arg3 v7action.NOAAClient
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsForApplicationsByNamesAndSpace", []interface{}{arg1Copy, arg2, arg3})
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsByNamesAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationsByNamesAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getStreamingLogsForApplicationsByNamesAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpaceCalls(stub func([]string, string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)) {
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNamesAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpaceArgsForCall(i int) ([]string, string, v7action.NOAAClient) {
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationsByNamesAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpaceReturns(result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNamesAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceReturns = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall(i int, result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNamesAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7action.LogMessage
+			result2 <-chan error
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpace(arg1 string, arg2 v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForSpaceReturnsOnCall[len(fake.getStreamingLogsForSpaceArgsForCall)]
+	fake.getStreamingLogsForSpaceArgsForCall = append(fake.getStreamingLogsForSpaceArgsForCall, struct {
+		arg1 string
+		arg2 v7action.NOAAClient
+	}{arg1, arg2})
+	fake.recordInvocation("GetStreamingLogsForSpace", []interface{}{arg1, arg2})
+	fake.getStreamingLogsForSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForSpaceStub != nil {
+		return fake.GetStreamingLogsForSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getStreamingLogsForSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceCallCount() int {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceCalls(stub func(string, v7action.NOAAClient) (<-chan *v7action.LogMessage, <-chan error, v7action.Warnings, error)) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	defer fake.getStreamingLogsForSpaceMutex.Unlock()
+	fake.GetStreamingLogsForSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceArgsForCall(i int) (string, v7action.NOAAClient) {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturns(result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	defer fake.getStreamingLogsForSpaceMutex.Unlock()
+	fake.GetStreamingLogsForSpaceStub = nil
+	fake.getStreamingLogsForSpaceReturns = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturnsOnCall(i int, result1 <-chan *v7action.LogMessage, result2 <-chan error, result3 v7action.Warnings, result4 error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	defer fake.getStreamingLogsForSpaceMutex.Unlock()
+	fake.GetStreamingLogsForSpaceStub = nil
+	if fake.getStreamingLogsForSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7action.LogMessage
+			result2 <-chan error
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v7action.LogMessage
+		result2 <-chan error
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceFromLogCacheMutex.RUnlock()
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLogsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.LogsActor = new(FakeLogsActor)
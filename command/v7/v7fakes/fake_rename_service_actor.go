@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeRenameServiceActor struct {
+	RenameServiceInstanceStub        func(string, string, string) (v7action.Warnings, error)
+	renameServiceInstanceMutex       sync.RWMutex
+	renameServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	renameServiceInstanceReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	renameServiceInstanceReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstance(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.renameServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.renameServiceInstanceReturnsOnCall[len(fake.renameServiceInstanceArgsForCall)]
+	fake.renameServiceInstanceArgsForCall = append(fake.renameServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RenameServiceInstance", []interface{}{arg1, arg2, arg3})
+	fake.renameServiceInstanceMutex.Unlock()
+	if fake.RenameServiceInstanceStub != nil {
+		return fake.RenameServiceInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.renameServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceCallCount() int {
+	fake.renameServiceInstanceMutex.RLock()
+	defer fake.renameServiceInstanceMutex.RUnlock()
+	return len(fake.renameServiceInstanceArgsForCall)
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.renameServiceInstanceMutex.Lock()
+	defer fake.renameServiceInstanceMutex.Unlock()
+	fake.RenameServiceInstanceStub = stub
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceArgsForCall(i int) (string, string, string) {
+	fake.renameServiceInstanceMutex.RLock()
+	defer fake.renameServiceInstanceMutex.RUnlock()
+	argsForCall := fake.renameServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceReturns(result1 v7action.Warnings, result2 error) {
+	fake.renameServiceInstanceMutex.Lock()
+	defer fake.renameServiceInstanceMutex.Unlock()
+	fake.RenameServiceInstanceStub = nil
+	fake.renameServiceInstanceReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.renameServiceInstanceMutex.Lock()
+	defer fake.renameServiceInstanceMutex.Unlock()
+	fake.RenameServiceInstanceStub = nil
+	if fake.renameServiceInstanceReturnsOnCall == nil {
+		fake.renameServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.renameServiceInstanceReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenameServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.renameServiceInstanceMutex.RLock()
+	defer fake.renameServiceInstanceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRenameServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.RenameServiceActor = new(FakeRenameServiceActor)
@@ -0,0 +1,208 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeServiceActor struct {
+	GetServiceInstanceByNameAndSpaceStub        func(string, string) (v7action.ServiceInstance, v7action.Warnings, error)
+	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceByNameAndSpaceReturns struct {
+		result1 v7action.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstanceSummaryByNameAndSpaceStub        func(string, string) (v7action.ServiceInstanceSummary, v7action.Warnings, error)
+	getServiceInstanceSummaryByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceSummaryByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceSummaryByNameAndSpaceReturns struct {
+		result1 v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceSummaryByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpace(arg1 string, arg2 string) (v7action.ServiceInstance, v7action.Warnings, error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceByNameAndSpaceArgsForCall = append(fake.getServiceInstanceByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetServiceInstanceByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceCalls(stub func(string, string) (v7action.ServiceInstance, v7action.Warnings, error)) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceByNameAndSpaceStub = stub
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceReturns(result1 v7action.ServiceInstance, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	fake.getServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v7action.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v7action.ServiceInstance, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	if fake.getServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstance
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpace(arg1 string, arg2 string) (v7action.ServiceInstanceSummary, v7action.Warnings, error) {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSummaryByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall = append(fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetServiceInstanceSummaryByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceSummaryByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceSummaryByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceSummaryByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceCalls(stub func(string, string) (v7action.ServiceInstanceSummary, v7action.Warnings, error)) {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceSummaryByNameAndSpaceStub = stub
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceReturns(result1 v7action.ServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceSummaryByNameAndSpaceStub = nil
+	fake.getServiceInstanceSummaryByNameAndSpaceReturns = struct {
+		result1 v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceReturnsOnCall(i int, result1 v7action.ServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Lock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.Unlock()
+	fake.GetServiceInstanceSummaryByNameAndSpaceStub = nil
+	if fake.getServiceInstanceSummaryByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceSummaryByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstanceSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSummaryByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstanceSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ServiceActor = new(FakeServiceActor)
@@ -0,0 +1,212 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeServiceKeyActor struct {
+	GetServiceKeyByServiceInstanceAndNameStub        func(string, string, string) (v7action.ServiceCredentialBinding, v7action.Warnings, error)
+	getServiceKeyByServiceInstanceAndNameMutex       sync.RWMutex
+	getServiceKeyByServiceInstanceAndNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServiceKeyByServiceInstanceAndNameReturns struct {
+		result1 v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceKeyByServiceInstanceAndNameReturnsOnCall map[int]struct {
+		result1 v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceKeyDetailsByServiceInstanceAndNameStub        func(string, string, string) (v7action.ServiceCredentialBindingDetails, v7action.Warnings, error)
+	getServiceKeyDetailsByServiceInstanceAndNameMutex       sync.RWMutex
+	getServiceKeyDetailsByServiceInstanceAndNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServiceKeyDetailsByServiceInstanceAndNameReturns struct {
+		result1 v7action.ServiceCredentialBindingDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall map[int]struct {
+		result1 v7action.ServiceCredentialBindingDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndName(arg1 string, arg2 string, arg3 string) (v7action.ServiceCredentialBinding, v7action.Warnings, error) {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyByServiceInstanceAndNameReturnsOnCall[len(fake.getServiceKeyByServiceInstanceAndNameArgsForCall)]
+	fake.getServiceKeyByServiceInstanceAndNameArgsForCall = append(fake.getServiceKeyByServiceInstanceAndNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetServiceKeyByServiceInstanceAndName", []interface{}{arg1, arg2, arg3})
+	fake.getServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	if fake.GetServiceKeyByServiceInstanceAndNameStub != nil {
+		return fake.GetServiceKeyByServiceInstanceAndNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeyByServiceInstanceAndNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndNameCallCount() int {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	return len(fake.getServiceKeyByServiceInstanceAndNameArgsForCall)
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndNameCalls(stub func(string, string, string) (v7action.ServiceCredentialBinding, v7action.Warnings, error)) {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyByServiceInstanceAndNameStub = stub
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndNameArgsForCall(i int) (string, string, string) {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	argsForCall := fake.getServiceKeyByServiceInstanceAndNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndNameReturns(result1 v7action.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyByServiceInstanceAndNameStub = nil
+	fake.getServiceKeyByServiceInstanceAndNameReturns = struct {
+		result1 v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyByServiceInstanceAndNameReturnsOnCall(i int, result1 v7action.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyByServiceInstanceAndNameStub = nil
+	if fake.getServiceKeyByServiceInstanceAndNameReturnsOnCall == nil {
+		fake.getServiceKeyByServiceInstanceAndNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceCredentialBinding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyByServiceInstanceAndNameReturnsOnCall[i] = struct {
+		result1 v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndName(arg1 string, arg2 string, arg3 string) (v7action.ServiceCredentialBindingDetails, v7action.Warnings, error) {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall[len(fake.getServiceKeyDetailsByServiceInstanceAndNameArgsForCall)]
+	fake.getServiceKeyDetailsByServiceInstanceAndNameArgsForCall = append(fake.getServiceKeyDetailsByServiceInstanceAndNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetServiceKeyDetailsByServiceInstanceAndName", []interface{}{arg1, arg2, arg3})
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Unlock()
+	if fake.GetServiceKeyDetailsByServiceInstanceAndNameStub != nil {
+		return fake.GetServiceKeyDetailsByServiceInstanceAndNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeyDetailsByServiceInstanceAndNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndNameCallCount() int {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
+	return len(fake.getServiceKeyDetailsByServiceInstanceAndNameArgsForCall)
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndNameCalls(stub func(string, string, string) (v7action.ServiceCredentialBindingDetails, v7action.Warnings, error)) {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyDetailsByServiceInstanceAndNameStub = stub
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndNameArgsForCall(i int) (string, string, string) {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
+	argsForCall := fake.getServiceKeyDetailsByServiceInstanceAndNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndNameReturns(result1 v7action.ServiceCredentialBindingDetails, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyDetailsByServiceInstanceAndNameStub = nil
+	fake.getServiceKeyDetailsByServiceInstanceAndNameReturns = struct {
+		result1 v7action.ServiceCredentialBindingDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeyActor) GetServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall(i int, result1 v7action.ServiceCredentialBindingDetails, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Lock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.Unlock()
+	fake.GetServiceKeyDetailsByServiceInstanceAndNameStub = nil
+	if fake.getServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall == nil {
+		fake.getServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceCredentialBindingDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyDetailsByServiceInstanceAndNameReturnsOnCall[i] = struct {
+		result1 v7action.ServiceCredentialBindingDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyByServiceInstanceAndNameMutex.RUnlock()
+	fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RLock()
+	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceKeyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ServiceKeyActor = new(FakeServiceKeyActor)
@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeServiceKeysActor struct {
+	GetServiceKeysByServiceInstanceStub        func(string, string) ([]v7action.ServiceCredentialBinding, v7action.Warnings, error)
+	getServiceKeysByServiceInstanceMutex       sync.RWMutex
+	getServiceKeysByServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceKeysByServiceInstanceReturns struct {
+		result1 []v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceKeysByServiceInstanceReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstance(arg1 string, arg2 string) ([]v7action.ServiceCredentialBinding, v7action.Warnings, error) {
+	fake.getServiceKeysByServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysByServiceInstanceReturnsOnCall[len(fake.getServiceKeysByServiceInstanceArgsForCall)]
+	fake.getServiceKeysByServiceInstanceArgsForCall = append(fake.getServiceKeysByServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetServiceKeysByServiceInstance", []interface{}{arg1, arg2})
+	fake.getServiceKeysByServiceInstanceMutex.Unlock()
+	if fake.GetServiceKeysByServiceInstanceStub != nil {
+		return fake.GetServiceKeysByServiceInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeysByServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstanceCallCount() int {
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceKeysByServiceInstanceArgsForCall)
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstanceCalls(stub func(string, string) ([]v7action.ServiceCredentialBinding, v7action.Warnings, error)) {
+	fake.getServiceKeysByServiceInstanceMutex.Lock()
+	defer fake.getServiceKeysByServiceInstanceMutex.Unlock()
+	fake.GetServiceKeysByServiceInstanceStub = stub
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstanceArgsForCall(i int) (string, string) {
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	argsForCall := fake.getServiceKeysByServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstanceReturns(result1 []v7action.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeysByServiceInstanceMutex.Lock()
+	defer fake.getServiceKeysByServiceInstanceMutex.Unlock()
+	fake.GetServiceKeysByServiceInstanceStub = nil
+	fake.getServiceKeysByServiceInstanceReturns = struct {
+		result1 []v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeysActor) GetServiceKeysByServiceInstanceReturnsOnCall(i int, result1 []v7action.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceKeysByServiceInstanceMutex.Lock()
+	defer fake.getServiceKeysByServiceInstanceMutex.Unlock()
+	fake.GetServiceKeysByServiceInstanceStub = nil
+	if fake.getServiceKeysByServiceInstanceReturnsOnCall == nil {
+		fake.getServiceKeysByServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceCredentialBinding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysByServiceInstanceReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceKeysActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceKeysActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ServiceKeysActor = new(FakeServiceKeysActor)
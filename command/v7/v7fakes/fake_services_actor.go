@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeServicesActor struct {
+	GetServiceInstancesSummaryBySpaceStub        func(string) ([]v7action.ServiceInstanceSummary, v7action.Warnings, error)
+	getServiceInstancesSummaryBySpaceMutex       sync.RWMutex
+	getServiceInstancesSummaryBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstancesSummaryBySpaceReturns struct {
+		result1 []v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstancesSummaryBySpaceReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpace(arg1 string) ([]v7action.ServiceInstanceSummary, v7action.Warnings, error) {
+	fake.getServiceInstancesSummaryBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesSummaryBySpaceReturnsOnCall[len(fake.getServiceInstancesSummaryBySpaceArgsForCall)]
+	fake.getServiceInstancesSummaryBySpaceArgsForCall = append(fake.getServiceInstancesSummaryBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceInstancesSummaryBySpace", []interface{}{arg1})
+	fake.getServiceInstancesSummaryBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesSummaryBySpaceStub != nil {
+		return fake.GetServiceInstancesSummaryBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstancesSummaryBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpaceCallCount() int {
+	fake.getServiceInstancesSummaryBySpaceMutex.RLock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesSummaryBySpaceArgsForCall)
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpaceCalls(stub func(string) ([]v7action.ServiceInstanceSummary, v7action.Warnings, error)) {
+	fake.getServiceInstancesSummaryBySpaceMutex.Lock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.Unlock()
+	fake.GetServiceInstancesSummaryBySpaceStub = stub
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesSummaryBySpaceMutex.RLock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.RUnlock()
+	argsForCall := fake.getServiceInstancesSummaryBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpaceReturns(result1 []v7action.ServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesSummaryBySpaceMutex.Lock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.Unlock()
+	fake.GetServiceInstancesSummaryBySpaceStub = nil
+	fake.getServiceInstancesSummaryBySpaceReturns = struct {
+		result1 []v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServicesActor) GetServiceInstancesSummaryBySpaceReturnsOnCall(i int, result1 []v7action.ServiceInstanceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstancesSummaryBySpaceMutex.Lock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.Unlock()
+	fake.GetServiceInstancesSummaryBySpaceStub = nil
+	if fake.getServiceInstancesSummaryBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesSummaryBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceInstanceSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesSummaryBySpaceReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceInstanceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServicesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceInstancesSummaryBySpaceMutex.RLock()
+	defer fake.getServiceInstancesSummaryBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServicesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ServicesActor = new(FakeServicesActor)
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeShareServiceActor struct {
+	ShareServiceInstanceToSpaceAndOrgStub        func(string, string, string, string) (v7action.Warnings, error)
+	shareServiceInstanceToSpaceAndOrgMutex       sync.RWMutex
+	shareServiceInstanceToSpaceAndOrgArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	shareServiceInstanceToSpaceAndOrgReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	shareServiceInstanceToSpaceAndOrgReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrg(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Warnings, error) {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpaceAndOrgReturnsOnCall[len(fake.shareServiceInstanceToSpaceAndOrgArgsForCall)]
+	fake.shareServiceInstanceToSpaceAndOrgArgsForCall = append(fake.shareServiceInstanceToSpaceAndOrgArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ShareServiceInstanceToSpaceAndOrg", []interface{}{arg1, arg2, arg3, arg4})
+	fake.shareServiceInstanceToSpaceAndOrgMutex.Unlock()
+	if fake.ShareServiceInstanceToSpaceAndOrgStub != nil {
+		return fake.ShareServiceInstanceToSpaceAndOrgStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.shareServiceInstanceToSpaceAndOrgReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrgCallCount() int {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpaceAndOrgArgsForCall)
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrgCalls(stub func(string, string, string, string) (v7action.Warnings, error)) {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.Lock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.Unlock()
+	fake.ShareServiceInstanceToSpaceAndOrgStub = stub
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrgArgsForCall(i int) (string, string, string, string) {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.RUnlock()
+	argsForCall := fake.shareServiceInstanceToSpaceAndOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrgReturns(result1 v7action.Warnings, result2 error) {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.Lock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.Unlock()
+	fake.ShareServiceInstanceToSpaceAndOrgStub = nil
+	fake.shareServiceInstanceToSpaceAndOrgReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceAndOrgReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.shareServiceInstanceToSpaceAndOrgMutex.Lock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.Unlock()
+	fake.ShareServiceInstanceToSpaceAndOrgStub = nil
+	if fake.shareServiceInstanceToSpaceAndOrgReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpaceAndOrgReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.shareServiceInstanceToSpaceAndOrgReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeShareServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.shareServiceInstanceToSpaceAndOrgMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceAndOrgMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeShareServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ShareServiceActor = new(FakeShareServiceActor)
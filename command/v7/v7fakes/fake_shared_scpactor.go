@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeSharedSCPActor struct {
+	TransferFilesStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.FileTransfer) error
+	transferFilesMutex       sync.RWMutex
+	transferFilesArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.FileTransfer
+	}
+	transferFilesReturns struct {
+		result1 error
+	}
+	transferFilesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSharedSCPActor) TransferFiles(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 sharedaction.FileTransfer) error {
+	fake.transferFilesMutex.Lock()
+	ret, specificReturn := fake.transferFilesReturnsOnCall[len(fake.transferFilesArgsForCall)]
+	fake.transferFilesArgsForCall = append(fake.transferFilesArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.FileTransfer
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("TransferFiles", []interface{}{arg1, arg2, arg3})
+	fake.transferFilesMutex.Unlock()
+	if fake.TransferFilesStub != nil {
+		return fake.TransferFilesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.transferFilesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSCPActor) TransferFilesCallCount() int {
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	return len(fake.transferFilesArgsForCall)
+}
+
+func (fake *FakeSharedSCPActor) TransferFilesCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.FileTransfer) error) {
+	fake.transferFilesMutex.Lock()
+	defer fake.transferFilesMutex.Unlock()
+	fake.TransferFilesStub = stub
+}
+
+func (fake *FakeSharedSCPActor) TransferFilesArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.FileTransfer) {
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	argsForCall := fake.transferFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSharedSCPActor) TransferFilesReturns(result1 error) {
+	fake.transferFilesMutex.Lock()
+	defer fake.transferFilesMutex.Unlock()
+	fake.TransferFilesStub = nil
+	fake.transferFilesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSCPActor) TransferFilesReturnsOnCall(i int, result1 error) {
+	fake.transferFilesMutex.Lock()
+	defer fake.transferFilesMutex.Unlock()
+	fake.TransferFilesStub = nil
+	if fake.transferFilesReturnsOnCall == nil {
+		fake.transferFilesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.transferFilesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSCPActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSharedSCPActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.SharedSCPActor = new(FakeSharedSCPActor)
@@ -2,6 +2,7 @@
 package v7fakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -21,6 +22,22 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellCommandStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) (int, error)
+	executeSecureShellCommandMutex       sync.RWMutex
+	executeSecureShellCommandArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}
+	executeSecureShellCommandReturns struct {
+		result1 int
+		result2 error
+	}
+	executeSecureShellCommandReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +103,79 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommand(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 io.Writer, arg4 io.Writer) (int, error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellCommandReturnsOnCall[len(fake.executeSecureShellCommandArgsForCall)]
+	fake.executeSecureShellCommandArgsForCall = append(fake.executeSecureShellCommandArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ExecuteSecureShellCommand", []interface{}{arg1, arg2, arg3, arg4})
+	fake.executeSecureShellCommandMutex.Unlock()
+	if fake.ExecuteSecureShellCommandStub != nil {
+		return fake.ExecuteSecureShellCommandStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.executeSecureShellCommandReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandCallCount() int {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	return len(fake.executeSecureShellCommandArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) (int, error)) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	argsForCall := fake.executeSecureShellCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandReturns(result1 int, result2 error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = nil
+	fake.executeSecureShellCommandReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandReturnsOnCall(i int, result1 int, result2 error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = nil
+	if fake.executeSecureShellCommandReturnsOnCall == nil {
+		fake.executeSecureShellCommandReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.executeSecureShellCommandReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,6 +9,23 @@ import (
 )
 
 type FakeSSHActor struct {
+	GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub        func(string, string, string) ([]uint, v7action.Warnings, error)
+	getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex       sync.RWMutex
+	getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns struct {
+		result1 []uint
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall map[int]struct {
+		result1 []uint
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
@@ -31,6 +48,74 @@ type FakeSSHActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType(arg1 string, arg2 string, arg3 string) ([]uint, v7action.Warnings, error) {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall[len(fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall)]
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall = append(fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessType", []interface{}{arg1, arg2, arg3})
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	if fake.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub != nil {
+		return fake.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeCallCount() int {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return len(fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeCalls(stub func(string, string, string) ([]uint, v7action.Warnings, error)) {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub = stub
+}
+
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall(i int) (string, string, string) {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	argsForCall := fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns(result1 []uint, result2 v7action.Warnings, result3 error) {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub = nil
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturns = struct {
+		result1 []uint
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSSHActor) GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall(i int, result1 []uint, result2 v7action.Warnings, result3 error) {
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeStub = nil
+	if fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall == nil {
+		fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 []uint
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeReturnsOnCall[i] = struct {
+		result1 []uint
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
 	ret, specificReturn := fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
@@ -103,6 +188,8 @@ func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProce
 func (fake *FakeSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getRunningProcessInstanceIndexesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,204 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeUnbindServiceActor struct {
+	PollJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		arg1 ccv3.JobURL
+	}
+	pollJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UnbindServiceInstanceFromAppStub        func(string, string, string) (ccv3.JobURL, v7action.Warnings, error)
+	unbindServiceInstanceFromAppMutex       sync.RWMutex
+	unbindServiceInstanceFromAppArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	unbindServiceInstanceFromAppReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	unbindServiceInstanceFromAppReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUnbindServiceActor) PollJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		arg1 ccv3.JobURL
+	}{arg1})
+	fake.recordInvocation("PollJob", []interface{}{arg1})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnbindServiceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeUnbindServiceActor) PollJobCalls(stub func(ccv3.JobURL) (v7action.Warnings, error)) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = stub
+}
+
+func (fake *FakeUnbindServiceActor) PollJobArgsForCall(i int) ccv3.JobURL {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	argsForCall := fake.pollJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUnbindServiceActor) PollJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnbindServiceActor) PollJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromApp(arg1 string, arg2 string, arg3 string) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.unbindServiceInstanceFromAppMutex.Lock()
+	ret, specificReturn := fake.unbindServiceInstanceFromAppReturnsOnCall[len(fake.unbindServiceInstanceFromAppArgsForCall)]
+	fake.unbindServiceInstanceFromAppArgsForCall = append(fake.unbindServiceInstanceFromAppArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UnbindServiceInstanceFromApp", []interface{}{arg1, arg2, arg3})
+	fake.unbindServiceInstanceFromAppMutex.Unlock()
+	if fake.UnbindServiceInstanceFromAppStub != nil {
+		return fake.UnbindServiceInstanceFromAppStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.unbindServiceInstanceFromAppReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromAppCallCount() int {
+	fake.unbindServiceInstanceFromAppMutex.RLock()
+	defer fake.unbindServiceInstanceFromAppMutex.RUnlock()
+	return len(fake.unbindServiceInstanceFromAppArgsForCall)
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromAppCalls(stub func(string, string, string) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.unbindServiceInstanceFromAppMutex.Lock()
+	defer fake.unbindServiceInstanceFromAppMutex.Unlock()
+	fake.UnbindServiceInstanceFromAppStub = stub
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromAppArgsForCall(i int) (string, string, string) {
+	fake.unbindServiceInstanceFromAppMutex.RLock()
+	defer fake.unbindServiceInstanceFromAppMutex.RUnlock()
+	argsForCall := fake.unbindServiceInstanceFromAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromAppReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.unbindServiceInstanceFromAppMutex.Lock()
+	defer fake.unbindServiceInstanceFromAppMutex.Unlock()
+	fake.UnbindServiceInstanceFromAppStub = nil
+	fake.unbindServiceInstanceFromAppReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUnbindServiceActor) UnbindServiceInstanceFromAppReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.unbindServiceInstanceFromAppMutex.Lock()
+	defer fake.unbindServiceInstanceFromAppMutex.Unlock()
+	fake.UnbindServiceInstanceFromAppStub = nil
+	if fake.unbindServiceInstanceFromAppReturnsOnCall == nil {
+		fake.unbindServiceInstanceFromAppReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.unbindServiceInstanceFromAppReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUnbindServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	fake.unbindServiceInstanceFromAppMutex.RLock()
+	defer fake.unbindServiceInstanceFromAppMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUnbindServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.UnbindServiceActor = new(FakeUnbindServiceActor)
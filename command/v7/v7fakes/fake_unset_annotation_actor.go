@@ -0,0 +1,525 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type FakeUnsetAnnotationActor struct {
+	UpdateApplicationAnnotationsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateApplicationAnnotationsByApplicationNameMutex       sync.RWMutex
+	updateApplicationAnnotationsByApplicationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateApplicationAnnotationsByApplicationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateApplicationAnnotationsByApplicationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateBuildpackAnnotationsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateBuildpackAnnotationsByBuildpackNameAndStackMutex       sync.RWMutex
+	updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateOrganizationAnnotationsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateOrganizationAnnotationsByOrganizationNameMutex       sync.RWMutex
+	updateOrganizationAnnotationsByOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateRouteAnnotationsByRouteURLStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateRouteAnnotationsByRouteURLMutex       sync.RWMutex
+	updateRouteAnnotationsByRouteURLArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateRouteAnnotationsByRouteURLReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateRouteAnnotationsByRouteURLReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateSpaceAnnotationsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateSpaceAnnotationsBySpaceNameMutex       sync.RWMutex
+	updateSpaceAnnotationsBySpaceNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateSpaceAnnotationsBySpaceNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateSpaceAnnotationsBySpaceNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateStackAnnotationsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateStackAnnotationsByStackNameMutex       sync.RWMutex
+	updateStackAnnotationsByStackNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateStackAnnotationsByStackNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateStackAnnotationsByStackNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)]
+	fake.updateApplicationAnnotationsByApplicationNameArgsForCall = append(fake.updateApplicationAnnotationsByApplicationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateApplicationAnnotationsByApplicationName", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	if fake.UpdateApplicationAnnotationsByApplicationNameStub != nil {
+		return fake.UpdateApplicationAnnotationsByApplicationNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateApplicationAnnotationsByApplicationNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationNameCallCount() int {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	return len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	argsForCall := fake.updateApplicationAnnotationsByApplicationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	fake.updateApplicationAnnotationsByApplicationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateApplicationAnnotationsByApplicationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	if fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall == nil {
+		fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)]
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall = append(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateBuildpackAnnotationsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	if fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub != nil {
+		return fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount() int {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	return len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	argsForCall := fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	if fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall == nil {
+		fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)]
+	fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall = append(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateOrganizationAnnotationsByOrganizationName", []interface{}{arg1, arg2})
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	if fake.UpdateOrganizationAnnotationsByOrganizationNameStub != nil {
+		return fake.UpdateOrganizationAnnotationsByOrganizationNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateOrganizationAnnotationsByOrganizationNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationNameCallCount() int {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	fake.updateOrganizationAnnotationsByOrganizationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateOrganizationAnnotationsByOrganizationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	if fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURL(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateRouteAnnotationsByRouteURLMutex.Lock()
+	ret, specificReturn := fake.updateRouteAnnotationsByRouteURLReturnsOnCall[len(fake.updateRouteAnnotationsByRouteURLArgsForCall)]
+	fake.updateRouteAnnotationsByRouteURLArgsForCall = append(fake.updateRouteAnnotationsByRouteURLArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateRouteAnnotationsByRouteURL", []interface{}{arg1, arg2})
+	fake.updateRouteAnnotationsByRouteURLMutex.Unlock()
+	if fake.UpdateRouteAnnotationsByRouteURLStub != nil {
+		return fake.UpdateRouteAnnotationsByRouteURLStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateRouteAnnotationsByRouteURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURLCallCount() int {
+	fake.updateRouteAnnotationsByRouteURLMutex.RLock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.RUnlock()
+	return len(fake.updateRouteAnnotationsByRouteURLArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURLCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateRouteAnnotationsByRouteURLMutex.Lock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.Unlock()
+	fake.UpdateRouteAnnotationsByRouteURLStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURLArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateRouteAnnotationsByRouteURLMutex.RLock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.RUnlock()
+	argsForCall := fake.updateRouteAnnotationsByRouteURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURLReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsByRouteURLMutex.Lock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.Unlock()
+	fake.UpdateRouteAnnotationsByRouteURLStub = nil
+	fake.updateRouteAnnotationsByRouteURLReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateRouteAnnotationsByRouteURLReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsByRouteURLMutex.Lock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.Unlock()
+	fake.UpdateRouteAnnotationsByRouteURLStub = nil
+	if fake.updateRouteAnnotationsByRouteURLReturnsOnCall == nil {
+		fake.updateRouteAnnotationsByRouteURLReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateRouteAnnotationsByRouteURLReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)]
+	fake.updateSpaceAnnotationsBySpaceNameArgsForCall = append(fake.updateSpaceAnnotationsBySpaceNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateSpaceAnnotationsBySpaceName", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	if fake.UpdateSpaceAnnotationsBySpaceNameStub != nil {
+		return fake.UpdateSpaceAnnotationsBySpaceNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateSpaceAnnotationsBySpaceNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceNameCallCount() int {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	argsForCall := fake.updateSpaceAnnotationsBySpaceNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	fake.updateSpaceAnnotationsBySpaceNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateSpaceAnnotationsBySpaceNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	if fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall == nil {
+		fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	ret, specificReturn := fake.updateStackAnnotationsByStackNameReturnsOnCall[len(fake.updateStackAnnotationsByStackNameArgsForCall)]
+	fake.updateStackAnnotationsByStackNameArgsForCall = append(fake.updateStackAnnotationsByStackNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateStackAnnotationsByStackName", []interface{}{arg1, arg2})
+	fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	if fake.UpdateStackAnnotationsByStackNameStub != nil {
+		return fake.UpdateStackAnnotationsByStackNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateStackAnnotationsByStackNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackNameCallCount() int {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	return len(fake.updateStackAnnotationsByStackNameArgsForCall)
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = stub
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	argsForCall := fake.updateStackAnnotationsByStackNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	fake.updateStackAnnotationsByStackNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) UpdateStackAnnotationsByStackNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	if fake.updateStackAnnotationsByStackNameReturnsOnCall == nil {
+		fake.updateStackAnnotationsByStackNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateStackAnnotationsByStackNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnsetAnnotationActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	fake.updateRouteAnnotationsByRouteURLMutex.RLock()
+	defer fake.updateRouteAnnotationsByRouteURLMutex.RUnlock()
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUnsetAnnotationActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.UnsetAnnotationActor = new(FakeUnsetAnnotationActor)
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeUnshareServiceActor struct {
+	UnshareServiceInstanceFromSpaceAndOrgStub        func(string, string, string, string) (v7action.Warnings, error)
+	unshareServiceInstanceFromSpaceAndOrgMutex       sync.RWMutex
+	unshareServiceInstanceFromSpaceAndOrgArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	unshareServiceInstanceFromSpaceAndOrgReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	unshareServiceInstanceFromSpaceAndOrgReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrg(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Warnings, error) {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromSpaceAndOrgReturnsOnCall[len(fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall)]
+	fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall = append(fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UnshareServiceInstanceFromSpaceAndOrg", []interface{}{arg1, arg2, arg3, arg4})
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Unlock()
+	if fake.UnshareServiceInstanceFromSpaceAndOrgStub != nil {
+		return fake.UnshareServiceInstanceFromSpaceAndOrgStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unshareServiceInstanceFromSpaceAndOrgReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrgCallCount() int {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall)
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrgCalls(stub func(string, string, string, string) (v7action.Warnings, error)) {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpaceAndOrgStub = stub
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrgArgsForCall(i int) (string, string, string, string) {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.RUnlock()
+	argsForCall := fake.unshareServiceInstanceFromSpaceAndOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrgReturns(result1 v7action.Warnings, result2 error) {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpaceAndOrgStub = nil
+	fake.unshareServiceInstanceFromSpaceAndOrgReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnshareServiceActor) UnshareServiceInstanceFromSpaceAndOrgReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.Lock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.Unlock()
+	fake.UnshareServiceInstanceFromSpaceAndOrgStub = nil
+	if fake.unshareServiceInstanceFromSpaceAndOrgReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromSpaceAndOrgReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.unshareServiceInstanceFromSpaceAndOrgReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUnshareServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.unshareServiceInstanceFromSpaceAndOrgMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceAndOrgMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUnshareServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.UnshareServiceActor = new(FakeUnshareServiceActor)
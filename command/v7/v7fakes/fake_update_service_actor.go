@@ -0,0 +1,285 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeUpdateServiceActor struct {
+	PollJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		arg1 ccv3.JobURL
+	}
+	pollJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateManagedServiceInstanceStub        func(v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)
+	updateManagedServiceInstanceMutex       sync.RWMutex
+	updateManagedServiceInstanceArgsForCall []struct {
+		arg1 v7action.ManagedServiceInstanceParams
+	}
+	updateManagedServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateManagedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	UpgradeManagedServiceInstanceStub        func(string, string) (ccv3.JobURL, v7action.Warnings, error)
+	upgradeManagedServiceInstanceMutex       sync.RWMutex
+	upgradeManagedServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	upgradeManagedServiceInstanceReturns struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	upgradeManagedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpdateServiceActor) PollJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		arg1 ccv3.JobURL
+	}{arg1})
+	fake.recordInvocation("PollJob", []interface{}{arg1})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdateServiceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeUpdateServiceActor) PollJobCalls(stub func(ccv3.JobURL) (v7action.Warnings, error)) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = stub
+}
+
+func (fake *FakeUpdateServiceActor) PollJobArgsForCall(i int) ccv3.JobURL {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	argsForCall := fake.pollJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpdateServiceActor) PollJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateServiceActor) PollJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollJobMutex.Lock()
+	defer fake.pollJobMutex.Unlock()
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstance(arg1 v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.updateManagedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateManagedServiceInstanceReturnsOnCall[len(fake.updateManagedServiceInstanceArgsForCall)]
+	fake.updateManagedServiceInstanceArgsForCall = append(fake.updateManagedServiceInstanceArgsForCall, struct {
+		arg1 v7action.ManagedServiceInstanceParams
+	}{arg1})
+	fake.recordInvocation("UpdateManagedServiceInstance", []interface{}{arg1})
+	fake.updateManagedServiceInstanceMutex.Unlock()
+	if fake.UpdateManagedServiceInstanceStub != nil {
+		return fake.UpdateManagedServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateManagedServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstanceCallCount() int {
+	fake.updateManagedServiceInstanceMutex.RLock()
+	defer fake.updateManagedServiceInstanceMutex.RUnlock()
+	return len(fake.updateManagedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstanceCalls(stub func(v7action.ManagedServiceInstanceParams) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.updateManagedServiceInstanceMutex.Lock()
+	defer fake.updateManagedServiceInstanceMutex.Unlock()
+	fake.UpdateManagedServiceInstanceStub = stub
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstanceArgsForCall(i int) v7action.ManagedServiceInstanceParams {
+	fake.updateManagedServiceInstanceMutex.RLock()
+	defer fake.updateManagedServiceInstanceMutex.RUnlock()
+	argsForCall := fake.updateManagedServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstanceReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.updateManagedServiceInstanceMutex.Lock()
+	defer fake.updateManagedServiceInstanceMutex.Unlock()
+	fake.UpdateManagedServiceInstanceStub = nil
+	fake.updateManagedServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateServiceActor) UpdateManagedServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.updateManagedServiceInstanceMutex.Lock()
+	defer fake.updateManagedServiceInstanceMutex.Unlock()
+	fake.UpdateManagedServiceInstanceStub = nil
+	if fake.updateManagedServiceInstanceReturnsOnCall == nil {
+		fake.updateManagedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateManagedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstance(arg1 string, arg2 string) (ccv3.JobURL, v7action.Warnings, error) {
+	fake.upgradeManagedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.upgradeManagedServiceInstanceReturnsOnCall[len(fake.upgradeManagedServiceInstanceArgsForCall)]
+	fake.upgradeManagedServiceInstanceArgsForCall = append(fake.upgradeManagedServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("UpgradeManagedServiceInstance", []interface{}{arg1, arg2})
+	fake.upgradeManagedServiceInstanceMutex.Unlock()
+	if fake.UpgradeManagedServiceInstanceStub != nil {
+		return fake.UpgradeManagedServiceInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.upgradeManagedServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstanceCallCount() int {
+	fake.upgradeManagedServiceInstanceMutex.RLock()
+	defer fake.upgradeManagedServiceInstanceMutex.RUnlock()
+	return len(fake.upgradeManagedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstanceCalls(stub func(string, string) (ccv3.JobURL, v7action.Warnings, error)) {
+	fake.upgradeManagedServiceInstanceMutex.Lock()
+	defer fake.upgradeManagedServiceInstanceMutex.Unlock()
+	fake.UpgradeManagedServiceInstanceStub = stub
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstanceArgsForCall(i int) (string, string) {
+	fake.upgradeManagedServiceInstanceMutex.RLock()
+	defer fake.upgradeManagedServiceInstanceMutex.RUnlock()
+	argsForCall := fake.upgradeManagedServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstanceReturns(result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.upgradeManagedServiceInstanceMutex.Lock()
+	defer fake.upgradeManagedServiceInstanceMutex.Unlock()
+	fake.UpgradeManagedServiceInstanceStub = nil
+	fake.upgradeManagedServiceInstanceReturns = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateServiceActor) UpgradeManagedServiceInstanceReturnsOnCall(i int, result1 ccv3.JobURL, result2 v7action.Warnings, result3 error) {
+	fake.upgradeManagedServiceInstanceMutex.Lock()
+	defer fake.upgradeManagedServiceInstanceMutex.Unlock()
+	fake.UpgradeManagedServiceInstanceStub = nil
+	if fake.upgradeManagedServiceInstanceReturnsOnCall == nil {
+		fake.upgradeManagedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.upgradeManagedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	fake.updateManagedServiceInstanceMutex.RLock()
+	defer fake.updateManagedServiceInstanceMutex.RUnlock()
+	fake.upgradeManagedServiceInstanceMutex.RLock()
+	defer fake.upgradeManagedServiceInstanceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpdateServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.UpdateServiceActor = new(FakeUpdateServiceActor)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeUpdateUserProvidedServiceActor struct {
+	UpdateUserProvidedServiceInstanceStub        func(string, string, v7action.ServiceInstance) (v7action.Warnings, error)
+	updateUserProvidedServiceInstanceMutex       sync.RWMutex
+	updateUserProvidedServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.ServiceInstance
+	}
+	updateUserProvidedServiceInstanceReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstance(arg1 string, arg2 string, arg3 v7action.ServiceInstance) (v7action.Warnings, error) {
+	fake.updateUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateUserProvidedServiceInstanceReturnsOnCall[len(fake.updateUserProvidedServiceInstanceArgsForCall)]
+	fake.updateUserProvidedServiceInstanceArgsForCall = append(fake.updateUserProvidedServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.ServiceInstance
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateUserProvidedServiceInstance", []interface{}{arg1, arg2, arg3})
+	fake.updateUserProvidedServiceInstanceMutex.Unlock()
+	if fake.UpdateUserProvidedServiceInstanceStub != nil {
+		return fake.UpdateUserProvidedServiceInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateUserProvidedServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstanceCallCount() int {
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.updateUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstanceCalls(stub func(string, string, v7action.ServiceInstance) (v7action.Warnings, error)) {
+	fake.updateUserProvidedServiceInstanceMutex.Lock()
+	defer fake.updateUserProvidedServiceInstanceMutex.Unlock()
+	fake.UpdateUserProvidedServiceInstanceStub = stub
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstanceArgsForCall(i int) (string, string, v7action.ServiceInstance) {
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	argsForCall := fake.updateUserProvidedServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstanceReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateUserProvidedServiceInstanceMutex.Lock()
+	defer fake.updateUserProvidedServiceInstanceMutex.Unlock()
+	fake.UpdateUserProvidedServiceInstanceStub = nil
+	fake.updateUserProvidedServiceInstanceReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) UpdateUserProvidedServiceInstanceReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateUserProvidedServiceInstanceMutex.Lock()
+	defer fake.updateUserProvidedServiceInstanceMutex.Unlock()
+	fake.UpdateUserProvidedServiceInstanceStub = nil
+	if fake.updateUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.updateUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpdateUserProvidedServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.UpdateUserProvidedServiceActor = new(FakeUpdateUserProvidedServiceActor)
@@ -0,0 +1,15 @@
+// +build !V7
+
+package main
+
+import (
+	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+func globalFlagOverride() configv3.FlagOverride {
+	return configv3.FlagOverride{
+		Verbose: common.Commands.VerboseOrVersion,
+		Profile: common.Commands.Profile,
+	}
+}
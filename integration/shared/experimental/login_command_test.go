@@ -36,7 +36,7 @@ var _ = Describe("login command", func() {
 				Expect(session).Should(Say("login - Log user in"))
 
 				Expect(session).Should(Say("USAGE:\n"))
-				Expect(session).Should(Say(`cf login \[-a API_URL\] \[-u USERNAME\] \[-p PASSWORD\] \[-o ORG\] \[-s SPACE\] \[--sso | --sso-passcode PASSCODE\]`))
+				Expect(session).Should(Say(`cf login \[-a API_URL\] \[-u USERNAME\] \[-p PASSWORD\] \[-o ORG\] \[-s SPACE\] \[--sso \| --sso-passcode PASSCODE \| --browser \| --device\]`))
 
 				Expect(session).Should(Say("WARNING:\n"))
 				Expect(session).Should(Say("Providing your password as a command line option is highly discouraged\n"))
@@ -48,12 +48,16 @@ var _ = Describe("login command", func() {
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login -u name@example.com -p \"my password\" (use quotes for passwords with a space)")))
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)")))
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login --sso (cf will provide a url to obtain a one-time passcode to login)")))
+				Expect(session).Should(Say(regexp.QuoteMeta("cf login --browser (cf will open a browser to log in)")))
+				Expect(session).Should(Say(regexp.QuoteMeta("cf login --device (cf will provide a url and a code to log in from another device)")))
 
 				Expect(session).Should(Say("ALIAS:\n"))
 				Expect(session).Should(Say("l"))
 
 				Expect(session).Should(Say("OPTIONS:\n"))
 				Expect(session).Should(Say(`-a\s+API endpoint \(e.g. https://api\.example\.com\)`))
+				Expect(session).Should(Say(`--browser\s+Log in through a browser on this machine`))
+				Expect(session).Should(Say(`--device\s+Log in by entering a code in a browser on another device`))
 				Expect(session).Should(Say(`-o\s+Org`))
 				Expect(session).Should(Say(`-p\s+Password`))
 				Expect(session).Should(Say(`-s\s+Space`))
@@ -19,15 +19,24 @@ var _ = Describe("add-network-policy command", func() {
 				Eventually(session).Should(Say("add-network-policy - Create policy to allow direct network traffic from one app to another"))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say(regexp.QuoteMeta("cf add-network-policy SOURCE_APP --destination-app DESTINATION_APP [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE]")))
+				Eventually(session).Should(Say(regexp.QuoteMeta("cf add-network-policy (SOURCE_APP | --source-labels SELECTOR) (--destination-app DESTINATION_APP | --destination-labels SELECTOR) [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] [--protocol (tcp | udp) --port RANGE] [--prune] [--dry-run]")))
+				Eventually(session).Should(Say(regexp.QuoteMeta("cf add-network-policy --all-apps-in-space [--protocol (tcp | udp) --port RANGE] [--prune] [--dry-run]")))
 				Eventually(session).Should(Say("EXAMPLES:"))
 				Eventually(session).Should(Say("   cf add-network-policy frontend --destination-app backend --protocol tcp --port 8081"))
 				Eventually(session).Should(Say("   cf add-network-policy frontend --destination-app backend -s backend-space -o backend-org --protocol tcp --port 8080-8090"))
+				Eventually(session).Should(Say("   cf add-network-policy --source-labels tier=web --destination-labels tier=api --prune"))
+				Eventually(session).Should(Say("   cf add-network-policy --all-apps-in-space --dry-run"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("   --destination-app      Name of app to connect to"))
-				Eventually(session).Should(Say(`   --port                 Port or range of ports for connection to destination app \(Default: 8080\)`))
-				Eventually(session).Should(Say(`   --protocol             Protocol to connect apps with \(Default: tcp\)`))
-				Eventually(session).Should(Say(`   -o                     The org of the destination app \(Default: targeted org\)`))
-				Eventually(session).Should(Say(`   -s                     The space of the destination app \(Default: targeted space\)`))
+				Eventually(session).Should(Say(`   --all-apps-in-space\s+Use every app in the space as the source or destination apps when they are not otherwise given`))
+				Eventually(session).Should(Say(`   --destination-app\s+Name of app to connect to`))
+				Eventually(session).Should(Say(`   --destination-labels\s+Selector to choose the apps to connect to by label`))
+				Eventually(session).Should(Say(`   --dry-run\s+Show the policies that would be added or removed without changing them`))
+				Eventually(session).Should(Say(`   --port\s+Port or range of ports for connection to destination app \(Default: 8080\)`))
+				Eventually(session).Should(Say(`   --protocol\s+Protocol to connect apps with \(Default: tcp\)`))
+				Eventually(session).Should(Say(`   --prune\s+Remove policies on the same protocol and ports that involve a selected app but are no longer matched`))
+				Eventually(session).Should(Say(`   --source-labels\s+Selector to choose the source apps in the targeted space by label`))
+				Eventually(session).Should(Say(`   -o\s+The org of the destination app \(Default: targeted org\)`))
+				Eventually(session).Should(Say(`   -s\s+The space of the destination app \(Default: targeted space\)`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("   apps, network-policies, remove-network-policy"))
 				Eventually(session).Should(Exit(0))
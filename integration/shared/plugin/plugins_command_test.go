@@ -26,7 +26,7 @@ var _ = Describe("plugins command", func() {
 				Eventually(session).Should(Say(`--checksum\s+Compute and show the sha1 value of the plugin binary file`))
 				Eventually(session).Should(Say(`--outdated\s+Search the plugin repositories for new versions of installed plugins`))
 				Eventually(session).Should(Say("SEE ALSO:"))
-				Eventually(session).Should(Say("install-plugin, repo-plugins, uninstall-plugin"))
+				Eventually(session).Should(Say("install-plugin, repo-plugins, uninstall-plugin, update-plugins"))
 				Eventually(session).Should(Exit(0))
 			})
 		})
@@ -220,7 +220,7 @@ var _ = Describe("plugins command", func() {
 						session := helpers.CF("plugins", "--outdated", "-k")
 						Eventually(session).Should(Say("Searching repo1 for newer versions of installed plugins..."))
 						Eventually(session).Should(Say(""))
-						Eventually(session).Should(Say(`plugin\s+version\s+latest version\n\nUse 'cf install-plugin' to update a plugin to the latest version\.`))
+						Eventually(session).Should(Say(`plugin\s+version\s+latest version\n\nUse 'cf update-plugins' to update plugins to the latest version\.`))
 						Eventually(session).Should(Exit(0))
 					})
 				})
@@ -248,7 +248,7 @@ var _ = Describe("plugins command", func() {
 						Eventually(session).Should(Say(`plugin-1\s+0\.9\.0\s+1\.0\.0`))
 						Eventually(session).Should(Say(`plugin-2\s+1\.9\.0\s+2\.0\.0`))
 						Eventually(session).Should(Say(""))
-						Eventually(session).Should(Say(`Use 'cf install-plugin' to update a plugin to the latest version\.`))
+						Eventually(session).Should(Say(`Use 'cf update-plugins' to update plugins to the latest version\.`))
 						Eventually(session).Should(Exit(0))
 					})
 				})
@@ -301,7 +301,7 @@ var _ = Describe("plugins command", func() {
 						Eventually(session).Should(Say(`plugin-1\s+0\.9\.0\s+1\.0\.0`))
 						Eventually(session).Should(Say(`plugin-2\s+1\.9\.0\s+2\.0\.0`))
 						Eventually(session).Should(Say(""))
-						Eventually(session).Should(Say(`Use 'cf install-plugin' to update a plugin to the latest version\.`))
+						Eventually(session).Should(Say(`Use 'cf update-plugins' to update plugins to the latest version\.`))
 						Eventually(session).Should(Exit(0))
 					})
 				})
@@ -328,7 +328,7 @@ var _ = Describe("plugins command", func() {
 						Eventually(session).Should(Say(`plugin-2\s+1\.9\.0\s+2\.0\.0`))
 						Eventually(session).Should(Say(`plugin-3\s+2\.9\.0\s+3\.5\.0`))
 						Eventually(session).Should(Say(""))
-						Eventually(session).Should(Say(`Use 'cf install-plugin' to update a plugin to the latest version\.`))
+						Eventually(session).Should(Say(`Use 'cf update-plugins' to update plugins to the latest version\.`))
 						Eventually(session).Should(Exit(0))
 					})
 				})
@@ -133,7 +133,7 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 		parse([]string{"help", originalArgs[0]}, commandList)
 		return 1
 	case flags.ErrUnknownCommand:
-		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		legacyMain()
 	case flags.ErrCommandRequired:
 		if common.Commands.VerboseOrVersion {
 			parse([]string{"version"}, commandList)
@@ -156,16 +156,40 @@ func isCommand(s string) bool {
 	return found
 }
 
+// legacyMain runs the command with the legacy code base. It reads the profile
+// to use from $CF_PROFILE, so the --profile flag is moved there instead of
+// being passed on as an argument.
+func legacyMain() {
+	args := make([]string, 0, len(os.Args))
+	for i := 0; i < len(os.Args); i++ {
+		arg := os.Args[i]
+		switch {
+		case arg == "--":
+			args = append(args, os.Args[i:]...)
+			i = len(os.Args)
+		case arg == "--profile" && i+1 < len(os.Args):
+			_ = os.Setenv("CF_PROFILE", os.Args[i+1])
+			i++
+		case strings.HasPrefix(arg, "--profile="):
+			_ = os.Setenv("CF_PROFILE", strings.TrimPrefix(arg, "--profile="))
+		default:
+			args = append(args, arg)
+		}
+	}
+
+	cmd.Main(os.Getenv("CF_TRACE"), args)
+}
+
 func isOption(s string) bool {
 	return strings.HasPrefix(s, "-")
 }
 
 func executionWrapper(cmd flags.Commander, args []string) error {
-	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
-	})
+	cfConfig, configErr := configv3.LoadConfig(globalFlagOverride())
 	if configErr != nil {
-		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
+		switch configErr.(type) {
+		case translatableerror.EmptyConfigError, configv3.ProfileNotFoundError:
+		default:
 			return configErr
 		}
 	}
@@ -176,6 +200,10 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	}
 	defer commandUI.FlushDeferred()
 
+	if profileErr, ok := configErr.(configv3.ProfileNotFoundError); ok {
+		return handleError(translatableerror.ProfileNotFoundError{Name: profileErr.Name}, commandUI)
+	}
+
 	err = preventExtraArgs(args)
 	if err != nil {
 		return handleError(err, commandUI)
@@ -225,7 +253,7 @@ func handleError(passedErr error, commandUI UI) error {
 			commandUI.DisplayWarning(typedErr.Error())
 		}
 
-		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		legacyMain()
 	case *ssh.ExitError:
 		exitStatus := typedErr.ExitStatus()
 		if sig := typedErr.Signal(); sig != "" {
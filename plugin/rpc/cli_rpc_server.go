@@ -33,6 +33,7 @@ type CliRpcService struct {
 	stopCh   chan struct{}
 	Pinged   bool
 	RpcCmd   *CliRpcCmd
+	RpcCmdV7 *CliRpcCmdV7
 	Server   *rpc.Server
 }
 
@@ -85,6 +86,7 @@ func NewRpcService(
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
 		},
+		RpcCmdV7: newCliRpcCmdV7(),
 	}
 
 	err := rpcService.Server.Register(rpcService.RpcCmd)
@@ -92,12 +94,24 @@ func NewRpcService(
 		return nil, err
 	}
 
+	err = rpcService.Server.Register(rpcService.RpcCmdV7)
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcService, nil
 }
 
 func (cli *CliRpcService) Stop() {
 	close(cli.stopCh)
 	cli.listener.Close()
+
+	// The v7 plugin API loads its own config, which must be written for tokens
+	// it refreshed to outlive the plugin.
+	err := cli.RpcCmdV7.writeConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing config: %s", err.Error())
+	}
 }
 
 func (cli *CliRpcService) Port() string {
@@ -0,0 +1,423 @@
+package rpc
+
+import (
+	"errors"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	plugin_v7 "code.cloudfoundry.org/cli/plugin/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . V7Actor
+
+// V7Actor is the subset of v7action.Actor that backs the v7 plugin API.
+type V7Actor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetApplicationDroplets(appName string, spaceGUID string) ([]v7action.Droplet, v7action.Warnings, error)
+	GetApplicationRoutes(appGUID string) ([]v7action.Route, v7action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v7action.Application, v7action.Warnings, error)
+	GetDeploymentsForApp(appGUID string) ([]v7action.Deployment, v7action.Warnings, error)
+	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
+	GetOrganizationByName(orgName string) (v7action.Organization, v7action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v7action.Space, v7action.Warnings, error)
+	MakeCloudControllerRequest(method string, path string, requestBody []byte) ([]byte, int, error)
+}
+
+//go:generate counterfeiter . V7Config
+
+// V7Config is the CLI configuration read by the v7 plugin API.
+type V7Config interface {
+	TargetedOrganization() configv3.Organization
+	TargetedSpace() configv3.Space
+}
+
+//go:generate counterfeiter . V7UI
+
+// V7UI displays the warnings returned while serving v7 plugin API calls.
+type V7UI interface {
+	DisplayWarnings(warnings []string)
+}
+
+// V7Dependencies are the objects backing the v7 plugin API. WriteConfig saves
+// the changes the API calls made to the config, such as refreshed tokens.
+type V7Dependencies struct {
+	Config      V7Config
+	Actor       V7Actor
+	UI          V7UI
+	WriteConfig func() error
+}
+
+var (
+	errNoOrgTargeted   = errors.New("No org targeted, use 'cf target -o ORG' to target an org.")
+	errNoSpaceTargeted = errors.New("No space targeted, use 'cf target -s SPACE' to target a space.")
+)
+
+// CliRpcCmdV7 serves the v7 plugin API. Its dependencies are only built the
+// first time a plugin calls one of its methods, so plugins using the original
+// plugin API never pay for connecting to the Cloud Controller.
+type CliRpcCmdV7 struct {
+	NewDependencies func() (V7Dependencies, error)
+
+	dependenciesMutex sync.Mutex
+	dependencies      *V7Dependencies
+}
+
+func newCliRpcCmdV7() *CliRpcCmdV7 {
+	return &CliRpcCmdV7{NewDependencies: newV7Dependencies}
+}
+
+func newV7Dependencies() (V7Dependencies, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return V7Dependencies{}, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return V7Dependencies{}, err
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, commandUI, true, "")
+	if err != nil {
+		return V7Dependencies{}, err
+	}
+
+	return V7Dependencies{
+		Config: config,
+		Actor:  v7action.NewActor(ccClient, config, sharedaction.NewActor(config), uaaClient, clock.NewClock()),
+		UI:     commandUI,
+		WriteConfig: func() error {
+			return configv3.WriteConfig(config)
+		},
+	}, nil
+}
+
+func (cmd *CliRpcCmdV7) getDependencies() (V7Dependencies, error) {
+	cmd.dependenciesMutex.Lock()
+	defer cmd.dependenciesMutex.Unlock()
+
+	if cmd.dependencies == nil {
+		dependencies, err := cmd.NewDependencies()
+		if err != nil {
+			return V7Dependencies{}, err
+		}
+		cmd.dependencies = &dependencies
+	}
+
+	return *cmd.dependencies, nil
+}
+
+// writeConfig saves the config of the dependencies, if any plugin API call
+// built them.
+func (cmd *CliRpcCmdV7) writeConfig() error {
+	cmd.dependenciesMutex.Lock()
+	defer cmd.dependenciesMutex.Unlock()
+
+	if cmd.dependencies == nil || cmd.dependencies.WriteConfig == nil {
+		return nil
+	}
+	return cmd.dependencies.WriteConfig()
+}
+
+// getTargetedSpace returns the dependencies and the GUID of the targeted
+// space, or an error when no space is targeted.
+func (cmd *CliRpcCmdV7) getTargetedSpace() (V7Dependencies, string, error) {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return V7Dependencies{}, "", err
+	}
+
+	if deps.Config.TargetedOrganization().GUID == "" {
+		return V7Dependencies{}, "", errNoOrgTargeted
+	}
+
+	spaceGUID := deps.Config.TargetedSpace().GUID
+	if spaceGUID == "" {
+		return V7Dependencies{}, "", errNoSpaceTargeted
+	}
+
+	return deps, spaceGUID, nil
+}
+
+func (cmd *CliRpcCmdV7) GetCurrentOrg(_ string, retVal *plugin_v7.Org) error {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	orgName := deps.Config.TargetedOrganization().Name
+	if orgName == "" {
+		return errNoOrgTargeted
+	}
+
+	return cmd.GetOrg(orgName, retVal)
+}
+
+func (cmd *CliRpcCmdV7) GetCurrentSpace(_ string, retVal *plugin_v7.Space) error {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	spaceName := deps.Config.TargetedSpace().Name
+	if spaceName == "" {
+		return errNoSpaceTargeted
+	}
+
+	return cmd.GetSpace(spaceName, retVal)
+}
+
+func (cmd *CliRpcCmdV7) GetOrg(orgName string, retVal *plugin_v7.Org) error {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	org, warnings, err := deps.Actor.GetOrganizationByName(orgName)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = plugin_v7.Org{
+		GUID:   org.GUID,
+		Name:   org.Name,
+		Labels: pluginLabels(org.Metadata),
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetSpace(spaceName string, retVal *plugin_v7.Space) error {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	orgGUID := deps.Config.TargetedOrganization().GUID
+	if orgGUID == "" {
+		return errNoOrgTargeted
+	}
+
+	space, warnings, err := deps.Actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = plugin_v7.Space{
+		GUID:    space.GUID,
+		Name:    space.Name,
+		OrgGUID: space.Relationships[constant.RelationshipTypeOrganization].GUID,
+		Labels:  pluginLabels((*v7action.Metadata)(space.Metadata)),
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetApp(appName string, retVal *plugin_v7.Application) error {
+	deps, spaceGUID, err := cmd.getTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	summary, warnings, err := deps.Actor.GetDetailedAppSummary(appName, spaceGUID, false)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	app := pluginApplication(summary.Application)
+	app.DropletGUID = summary.CurrentDroplet.GUID
+	app.Stack = summary.CurrentDroplet.Stack
+	for _, processSummary := range summary.ProcessSummaries {
+		app.Processes = append(app.Processes, plugin_v7.Process{
+			GUID:             processSummary.GUID,
+			Type:             processSummary.Type,
+			Command:          processSummary.Command.Value,
+			HealthCheckType:  string(processSummary.HealthCheckType),
+			Instances:        processSummary.Instances.Value,
+			RunningInstances: processSummary.HealthyInstanceCount(),
+			MemoryInMB:       processSummary.MemoryInMB.Value,
+			DiskInMB:         processSummary.DiskInMB.Value,
+		})
+	}
+
+	*retVal = app
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetApps(_ string, retVal *[]plugin_v7.Application) error {
+	deps, spaceGUID, err := cmd.getTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	apps, warnings, err := deps.Actor.GetApplicationsBySpace(spaceGUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_v7.Application{}
+	for _, app := range apps {
+		result = append(result, pluginApplication(app))
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetAppDroplets(appName string, retVal *[]plugin_v7.Droplet) error {
+	deps, spaceGUID, err := cmd.getTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	droplets, warnings, err := deps.Actor.GetApplicationDroplets(appName, spaceGUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_v7.Droplet{}
+	for _, droplet := range droplets {
+		var buildpacks []string
+		for _, buildpack := range droplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+
+		result = append(result, plugin_v7.Droplet{
+			GUID:       droplet.GUID,
+			State:      string(droplet.State),
+			CreatedAt:  droplet.CreatedAt,
+			Stack:      droplet.Stack,
+			Image:      droplet.Image,
+			Buildpacks: buildpacks,
+		})
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetAppDeployments(appName string, retVal *[]plugin_v7.Deployment) error {
+	deps, spaceGUID, err := cmd.getTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := deps.Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployments, warnings, err := deps.Actor.GetDeploymentsForApp(app.GUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_v7.Deployment{}
+	for _, deployment := range deployments {
+		result = append(result, plugin_v7.Deployment{
+			GUID:                deployment.GUID,
+			Status:              string(deployment.StatusValue),
+			StatusReason:        string(deployment.StatusReason),
+			Strategy:            string(deployment.Strategy),
+			DropletGUID:         deployment.DropletGUID,
+			PreviousDropletGUID: deployment.PreviousDropletGUID,
+			CreatedAt:           deployment.CreatedAt,
+			LastStatusChange:    deployment.LastStatusChange,
+		})
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) GetAppRoutes(appName string, retVal *[]plugin_v7.Route) error {
+	deps, spaceGUID, err := cmd.getTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := deps.Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	routes, warnings, err := deps.Actor.GetApplicationRoutes(app.GUID)
+	deps.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_v7.Route{}
+	for _, route := range routes {
+		result = append(result, plugin_v7.Route{
+			GUID:      route.GUID,
+			SpaceGUID: route.SpaceGUID,
+			Host:      route.Host,
+			Domain:    route.DomainName,
+			Path:      route.Path,
+			URL:       route.URL,
+			Labels:    pluginLabels(route.Metadata),
+		})
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmdV7) CloudControllerRequest(request plugin_v7.CloudControllerRequest, retVal *plugin_v7.CloudControllerResponse) error {
+	deps, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	body, statusCode, err := deps.Actor.MakeCloudControllerRequest(request.Method, request.Path, request.Body)
+	if err != nil {
+		return err
+	}
+
+	*retVal = plugin_v7.CloudControllerResponse{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+	return nil
+}
+
+func pluginApplication(app v7action.Application) plugin_v7.Application {
+	return plugin_v7.Application{
+		GUID:          app.GUID,
+		Name:          app.Name,
+		State:         string(app.State),
+		LifecycleType: string(app.LifecycleType),
+		Buildpacks:    app.LifecycleBuildpacks,
+		Stack:         app.StackName,
+		Labels:        pluginLabels(app.Metadata),
+	}
+}
+
+// pluginLabels converts resource metadata into a plain label map, leaving out
+// labels that have no value.
+func pluginLabels(metadata *v7action.Metadata) map[string]string {
+	labels := map[string]string{}
+	if metadata == nil {
+		return labels
+	}
+
+	for key, value := range metadata.Labels {
+		if value.IsSet {
+			labels[key] = value.Value
+		}
+	}
+	return labels
+}
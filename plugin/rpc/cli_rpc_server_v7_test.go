@@ -0,0 +1,400 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/cf/api"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	plugin_v7 "code.cloudfoundry.org/cli/plugin/v7"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server V7", func() {
+	var (
+		client     *rpc.Client
+		rpcService *CliRpcService
+
+		fakeActor  *rpcfakes.FakeV7Actor
+		fakeConfig *rpcfakes.FakeV7Config
+		fakeUI     *rpcfakes.FakeV7UI
+
+		newDependenciesCallCount int
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		var err error
+		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeActor = new(rpcfakes.FakeV7Actor)
+		fakeConfig = new(rpcfakes.FakeV7Config)
+		fakeUI = new(rpcfakes.FakeV7UI)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		newDependenciesCallCount = 0
+		rpcService.RpcCmdV7.NewDependencies = func() (V7Dependencies, error) {
+			newDependenciesCallCount++
+			return V7Dependencies{Config: fakeConfig, Actor: fakeActor, UI: fakeUI}, nil
+		}
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe("GetCurrentOrg", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationByNameReturns(
+				v7action.Organization{
+					GUID: "some-org-guid",
+					Name: "some-org",
+					Metadata: &v7action.Metadata{Labels: map[string]types.NullString{
+						"env":     types.NewNullString("prod"),
+						"removed": types.NewNullString(),
+					}},
+				},
+				v7action.Warnings{"get-org-warning"},
+				nil,
+			)
+		})
+
+		It("returns the targeted org with its labels", func() {
+			var result plugin_v7.Org
+			err := client.Call("CliRpcCmdV7.GetCurrentOrg", "", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal(plugin_v7.Org{
+				GUID:   "some-org-guid",
+				Name:   "some-org",
+				Labels: map[string]string{"env": "prod"},
+			}))
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeUI.DisplayWarningsArgsForCall(0)).To(ConsistOf("get-org-warning"))
+		})
+
+		It("only builds its dependencies once", func() {
+			var result plugin_v7.Org
+			Expect(client.Call("CliRpcCmdV7.GetCurrentOrg", "", &result)).To(Succeed())
+			Expect(client.Call("CliRpcCmdV7.GetCurrentOrg", "", &result)).To(Succeed())
+			Expect(newDependenciesCallCount).To(Equal(1))
+		})
+
+		When("no org is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{})
+			})
+
+			It("returns an error", func() {
+				var result plugin_v7.Org
+				err := client.Call("CliRpcCmdV7.GetCurrentOrg", "", &result)
+				Expect(err).To(MatchError("No org targeted, use 'cf target -o ORG' to target an org."))
+			})
+		})
+	})
+
+	Describe("GetSpace", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByNameAndOrganizationReturns(
+				v7action.Space{
+					GUID:          "other-space-guid",
+					Name:          "other-space",
+					Relationships: ccv3.Relationships{constant.RelationshipTypeOrganization: {GUID: "some-org-guid"}},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the space in the targeted org", func() {
+			var result plugin_v7.Space
+			err := client.Call("CliRpcCmdV7.GetSpace", "other-space", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result.GUID).To(Equal("other-space-guid"))
+			Expect(result.OrgGUID).To(Equal("some-org-guid"))
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	Describe("GetApp", func() {
+		BeforeEach(func() {
+			summary := v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: v7action.Application{
+						GUID:          "some-app-guid",
+						Name:          "some-app",
+						State:         constant.ApplicationStarted,
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{{
+						Process: v7action.Process{
+							GUID:       "web-guid",
+							Type:       constant.ProcessTypeWeb,
+							Instances:  types.NullInt{Value: 2, IsSet: true},
+							MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+						},
+						InstanceDetails: []v7action.ProcessInstance{
+							{State: constant.ProcessInstanceRunning},
+							{State: constant.ProcessInstanceStarting},
+						},
+					}},
+				},
+				CurrentDroplet: v7action.Droplet{GUID: "some-droplet-guid", Stack: "cflinuxfs3"},
+			}
+			fakeActor.GetDetailedAppSummaryReturns(summary, v7action.Warnings{"summary-warning"}, nil)
+		})
+
+		It("returns the app with its processes and current droplet", func() {
+			var result plugin_v7.Application
+			err := client.Call("CliRpcCmdV7.GetApp", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			appName, spaceGUID, _ := fakeActor.GetDetailedAppSummaryArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(result.GUID).To(Equal("some-app-guid"))
+			Expect(result.State).To(Equal("STARTED"))
+			Expect(result.DropletGUID).To(Equal("some-droplet-guid"))
+			Expect(result.Stack).To(Equal("cflinuxfs3"))
+			Expect(result.Processes).To(Equal([]plugin_v7.Process{{
+				GUID:             "web-guid",
+				Type:             "web",
+				Instances:        2,
+				RunningInstances: 1,
+				MemoryInMB:       256,
+			}}))
+			Expect(fakeUI.DisplayWarningsArgsForCall(0)).To(ConsistOf("summary-warning"))
+		})
+
+		When("no space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{})
+			})
+
+			It("returns an error without looking up the app", func() {
+				var result plugin_v7.Application
+				err := client.Call("CliRpcCmdV7.GetApp", "some-app", &result)
+				Expect(err).To(MatchError("No space targeted, use 'cf target -s SPACE' to target a space."))
+				Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{}, nil, errors.New("app not found"))
+			})
+
+			It("returns the error", func() {
+				var result plugin_v7.Application
+				err := client.Call("CliRpcCmdV7.GetApp", "some-app", &result)
+				Expect(err).To(MatchError("app not found"))
+			})
+		})
+	})
+
+	Describe("GetApps", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsBySpaceReturns(
+				[]v7action.Application{{GUID: "app-guid-1", Name: "app-1"}, {GUID: "app-guid-2", Name: "app-2"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the apps in the targeted space", func() {
+			var result []plugin_v7.Application
+			err := client.Call("CliRpcCmdV7.GetApps", "", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(result).To(HaveLen(2))
+			Expect(result[1].Name).To(Equal("app-2"))
+		})
+	})
+
+	Describe("GetAppDroplets", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationDropletsReturns(
+				[]v7action.Droplet{{
+					GUID:       "some-droplet-guid",
+					State:      constant.DropletStaged,
+					Buildpacks: []v7action.DropletBuildpack{{Name: "ruby_buildpack"}},
+				}},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the app's droplets", func() {
+			var result []plugin_v7.Droplet
+			err := client.Call("CliRpcCmdV7.GetAppDroplets", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_v7.Droplet{{
+				GUID:       "some-droplet-guid",
+				State:      "STAGED",
+				Buildpacks: []string{"ruby_buildpack"},
+			}}))
+		})
+	})
+
+	Describe("GetAppDeployments", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v7action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeActor.GetDeploymentsForAppReturns(
+				[]v7action.Deployment{{
+					GUID:         "some-deployment-guid",
+					StatusValue:  constant.DeploymentStatusValueFinalized,
+					StatusReason: constant.DeploymentStatusReasonDeployed,
+				}},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the app's deployments", func() {
+			var result []plugin_v7.Deployment
+			err := client.Call("CliRpcCmdV7.GetAppDeployments", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetDeploymentsForAppArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(result).To(Equal([]plugin_v7.Deployment{{
+				GUID:         "some-deployment-guid",
+				Status:       "FINALIZED",
+				StatusReason: "DEPLOYED",
+			}}))
+		})
+	})
+
+	Describe("GetAppRoutes", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v7action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeActor.GetApplicationRoutesReturns(
+				[]v7action.Route{{GUID: "some-route-guid", Host: "dora", DomainName: "example.com", URL: "dora.example.com"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the app's routes", func() {
+			var result []plugin_v7.Route
+			err := client.Call("CliRpcCmdV7.GetAppRoutes", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(result).To(Equal([]plugin_v7.Route{{
+				GUID:   "some-route-guid",
+				Host:   "dora",
+				Domain: "example.com",
+				URL:    "dora.example.com",
+				Labels: map[string]string{},
+			}}))
+		})
+	})
+
+	Describe("CloudControllerRequest", func() {
+		BeforeEach(func() {
+			fakeActor.MakeCloudControllerRequestReturns([]byte(`{"resources":[]}`), http.StatusOK, nil)
+		})
+
+		It("passes the request through to the Cloud Controller", func() {
+			var result plugin_v7.CloudControllerResponse
+			err := client.Call("CliRpcCmdV7.CloudControllerRequest", plugin_v7.CloudControllerRequest{
+				Method: http.MethodGet,
+				Path:   "/v3/apps?names=dora",
+			}, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			method, path, body := fakeActor.MakeCloudControllerRequestArgsForCall(0)
+			Expect(method).To(Equal(http.MethodGet))
+			Expect(path).To(Equal("/v3/apps?names=dora"))
+			Expect(body).To(BeEmpty())
+			Expect(result).To(Equal(plugin_v7.CloudControllerResponse{StatusCode: http.StatusOK, Body: []byte(`{"resources":[]}`)}))
+		})
+	})
+
+	Describe("stopping the service", func() {
+		var writeConfigCallCount int
+
+		BeforeEach(func() {
+			writeConfigCallCount = 0
+			rpcService.RpcCmdV7.NewDependencies = func() (V7Dependencies, error) {
+				return V7Dependencies{
+					Config: fakeConfig,
+					Actor:  fakeActor,
+					UI:     fakeUI,
+					WriteConfig: func() error {
+						writeConfigCallCount++
+						return nil
+					},
+				}, nil
+			}
+		})
+
+		When("a plugin called the v7 plugin API", func() {
+			It("writes the config the API calls used", func() {
+				var result []plugin_v7.Application
+				Expect(client.Call("CliRpcCmdV7.GetApps", "", &result)).To(Succeed())
+
+				rpcService.Stop()
+				Expect(writeConfigCallCount).To(Equal(1))
+
+				// AfterEach stops the service again.
+				Expect(rpcService.Start()).To(Succeed())
+			})
+		})
+
+		When("no plugin called the v7 plugin API", func() {
+			It("does not load or write the config", func() {
+				rpcService.Stop()
+				Expect(writeConfigCallCount).To(Equal(0))
+
+				// AfterEach stops the service again.
+				Expect(rpcService.Start()).To(Succeed())
+			})
+		})
+	})
+
+	When("building the dependencies fails", func() {
+		BeforeEach(func() {
+			rpcService.RpcCmdV7.NewDependencies = func() (V7Dependencies, error) {
+				return V7Dependencies{}, errors.New("not logged in")
+			}
+		})
+
+		It("returns the error", func() {
+			var result []plugin_v7.Application
+			err := client.Call("CliRpcCmdV7.GetApps", "", &result)
+			Expect(err).To(MatchError("not logged in"))
+		})
+	})
+})
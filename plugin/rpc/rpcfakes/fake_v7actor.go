@@ -0,0 +1,804 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV7Actor struct {
+	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationDropletsStub        func(string, string) ([]v7action.Droplet, v7action.Warnings, error)
+	getApplicationDropletsMutex       sync.RWMutex
+	getApplicationDropletsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationDropletsReturns struct {
+		result1 []v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationDropletsReturnsOnCall map[int]struct {
+		result1 []v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationRoutesStub        func(string) ([]v7action.Route, v7action.Warnings, error)
+	getApplicationRoutesMutex       sync.RWMutex
+	getApplicationRoutesArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationRoutesReturns struct {
+		result1 []v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationRoutesReturnsOnCall map[int]struct {
+		result1 []v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(string) ([]v7action.Application, v7action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDeploymentsForAppStub        func(string) ([]v7action.Deployment, v7action.Warnings, error)
+	getDeploymentsForAppMutex       sync.RWMutex
+	getDeploymentsForAppArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentsForAppReturns struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentsForAppReturnsOnCall map[int]struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDetailedAppSummaryStub        func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
+	getDetailedAppSummaryMutex       sync.RWMutex
+	getDetailedAppSummaryArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	getDetailedAppSummaryReturns struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDetailedAppSummaryReturnsOnCall map[int]struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetOrganizationByNameStub        func(string) (v7action.Organization, v7action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v7action.Organization
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v7action.Organization
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetSpaceByNameAndOrganizationStub        func(string, string) (v7action.Space, v7action.Warnings, error)
+	getSpaceByNameAndOrganizationMutex       sync.RWMutex
+	getSpaceByNameAndOrganizationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceByNameAndOrganizationReturns struct {
+		result1 v7action.Space
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceByNameAndOrganizationReturnsOnCall map[int]struct {
+		result1 v7action.Space
+		result2 v7action.Warnings
+		result3 error
+	}
+	MakeCloudControllerRequestStub        func(string, string, []byte) ([]byte, int, error)
+	makeCloudControllerRequestMutex       sync.RWMutex
+	makeCloudControllerRequestArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}
+	makeCloudControllerRequestReturns struct {
+		result1 []byte
+		result2 int
+		result3 error
+	}
+	makeCloudControllerRequestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 int
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v7action.Application, v7action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpaceReturns(result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationDroplets(arg1 string, arg2 string) ([]v7action.Droplet, v7action.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
+	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationDroplets", []interface{}{arg1, arg2})
+	fake.getApplicationDropletsMutex.Unlock()
+	if fake.GetApplicationDropletsStub != nil {
+		return fake.GetApplicationDropletsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationDropletsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationDropletsCallCount() int {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return len(fake.getApplicationDropletsArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationDropletsCalls(stub func(string, string) ([]v7action.Droplet, v7action.Warnings, error)) {
+	fake.getApplicationDropletsMutex.Lock()
+	defer fake.getApplicationDropletsMutex.Unlock()
+	fake.GetApplicationDropletsStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationDropletsArgsForCall(i int) (string, string) {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	argsForCall := fake.getApplicationDropletsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetApplicationDropletsReturns(result1 []v7action.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationDropletsMutex.Lock()
+	defer fake.getApplicationDropletsMutex.Unlock()
+	fake.GetApplicationDropletsStub = nil
+	fake.getApplicationDropletsReturns = struct {
+		result1 []v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationDropletsReturnsOnCall(i int, result1 []v7action.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationDropletsMutex.Lock()
+	defer fake.getApplicationDropletsMutex.Unlock()
+	fake.GetApplicationDropletsStub = nil
+	if fake.getApplicationDropletsReturnsOnCall == nil {
+		fake.getApplicationDropletsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDropletsReturnsOnCall[i] = struct {
+		result1 []v7action.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutes(arg1 string) ([]v7action.Route, v7action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{arg1})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesCalls(stub func(string) ([]v7action.Route, v7action.Warnings, error)) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	argsForCall := fake.getApplicationRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesReturns(result1 []v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 []v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesReturnsOnCall(i int, result1 []v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 []v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpace(arg1 string) ([]v7action.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpaceCalls(stub func(string) ([]v7action.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpaceReturns(result1 []v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForApp(arg1 string) ([]v7action.Deployment, v7action.Warnings, error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	ret, specificReturn := fake.getDeploymentsForAppReturnsOnCall[len(fake.getDeploymentsForAppArgsForCall)]
+	fake.getDeploymentsForAppArgsForCall = append(fake.getDeploymentsForAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDeploymentsForApp", []interface{}{arg1})
+	fake.getDeploymentsForAppMutex.Unlock()
+	if fake.GetDeploymentsForAppStub != nil {
+		return fake.GetDeploymentsForAppStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDeploymentsForAppReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForAppCallCount() int {
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	return len(fake.getDeploymentsForAppArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForAppCalls(stub func(string) ([]v7action.Deployment, v7action.Warnings, error)) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = stub
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForAppArgsForCall(i int) string {
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	argsForCall := fake.getDeploymentsForAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForAppReturns(result1 []v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = nil
+	fake.getDeploymentsForAppReturns = struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDeploymentsForAppReturnsOnCall(i int, result1 []v7action.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentsForAppMutex.Lock()
+	defer fake.getDeploymentsForAppMutex.Unlock()
+	fake.GetDeploymentsForAppStub = nil
+	if fake.getDeploymentsForAppReturnsOnCall == nil {
+		fake.getDeploymentsForAppReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentsForAppReturnsOnCall[i] = struct {
+		result1 []v7action.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummary(arg1 string, arg2 string, arg3 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
+	fake.getDetailedAppSummaryMutex.Lock()
+	ret, specificReturn := fake.getDetailedAppSummaryReturnsOnCall[len(fake.getDetailedAppSummaryArgsForCall)]
+	fake.getDetailedAppSummaryArgsForCall = append(fake.getDetailedAppSummaryArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetDetailedAppSummary", []interface{}{arg1, arg2, arg3})
+	fake.getDetailedAppSummaryMutex.Unlock()
+	if fake.GetDetailedAppSummaryStub != nil {
+		return fake.GetDetailedAppSummaryStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDetailedAppSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummaryCallCount() int {
+	fake.getDetailedAppSummaryMutex.RLock()
+	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	return len(fake.getDetailedAppSummaryArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummaryCalls(stub func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)) {
+	fake.getDetailedAppSummaryMutex.Lock()
+	defer fake.getDetailedAppSummaryMutex.Unlock()
+	fake.GetDetailedAppSummaryStub = stub
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummaryArgsForCall(i int) (string, string, bool) {
+	fake.getDetailedAppSummaryMutex.RLock()
+	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	argsForCall := fake.getDetailedAppSummaryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummaryReturns(result1 v7action.DetailedApplicationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedAppSummaryMutex.Lock()
+	defer fake.getDetailedAppSummaryMutex.Unlock()
+	fake.GetDetailedAppSummaryStub = nil
+	fake.getDetailedAppSummaryReturns = struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDetailedAppSummaryReturnsOnCall(i int, result1 v7action.DetailedApplicationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedAppSummaryMutex.Lock()
+	defer fake.getDetailedAppSummaryMutex.Unlock()
+	fake.GetDetailedAppSummaryStub = nil
+	if fake.getDetailedAppSummaryReturnsOnCall == nil {
+		fake.getDetailedAppSummaryReturnsOnCall = make(map[int]struct {
+			result1 v7action.DetailedApplicationSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDetailedAppSummaryReturnsOnCall[i] = struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetOrganizationByName(arg1 string) (v7action.Organization, v7action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{arg1})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetOrganizationByNameCalls(stub func(string) (v7action.Organization, v7action.Warnings, error)) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = stub
+}
+
+func (fake *FakeV7Actor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	argsForCall := fake.getOrganizationByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetOrganizationByNameReturns(result1 v7action.Organization, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v7action.Organization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetOrganizationByNameReturnsOnCall(i int, result1 v7action.Organization, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Organization
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v7action.Organization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (v7action.Space, v7action.Warnings, error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
+	fake.getSpaceByNameAndOrganizationArgsForCall = append(fake.getSpaceByNameAndOrganizationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceByNameAndOrganization", []interface{}{arg1, arg2})
+	fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	if fake.GetSpaceByNameAndOrganizationStub != nil {
+		return fake.GetSpaceByNameAndOrganizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceByNameAndOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganizationCallCount() int {
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	return len(fake.getSpaceByNameAndOrganizationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganizationCalls(stub func(string, string) (v7action.Space, v7action.Warnings, error)) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = stub
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganizationArgsForCall(i int) (string, string) {
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	argsForCall := fake.getSpaceByNameAndOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganizationReturns(result1 v7action.Space, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = nil
+	fake.getSpaceByNameAndOrganizationReturns = struct {
+		result1 v7action.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetSpaceByNameAndOrganizationReturnsOnCall(i int, result1 v7action.Space, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = nil
+	if fake.getSpaceByNameAndOrganizationReturnsOnCall == nil {
+		fake.getSpaceByNameAndOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Space
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByNameAndOrganizationReturnsOnCall[i] = struct {
+		result1 v7action.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequest(arg1 string, arg2 string, arg3 []byte) ([]byte, int, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.makeCloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.makeCloudControllerRequestReturnsOnCall[len(fake.makeCloudControllerRequestArgsForCall)]
+	fake.makeCloudControllerRequestArgsForCall = append(fake.makeCloudControllerRequestArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("MakeCloudControllerRequest", []interface{}{arg1, arg2, arg3Copy})
+	fake.makeCloudControllerRequestMutex.Unlock()
+	if fake.MakeCloudControllerRequestStub != nil {
+		return fake.MakeCloudControllerRequestStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.makeCloudControllerRequestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequestCallCount() int {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	return len(fake.makeCloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequestCalls(stub func(string, string, []byte) ([]byte, int, error)) {
+	fake.makeCloudControllerRequestMutex.Lock()
+	defer fake.makeCloudControllerRequestMutex.Unlock()
+	fake.MakeCloudControllerRequestStub = stub
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequestArgsForCall(i int) (string, string, []byte) {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	argsForCall := fake.makeCloudControllerRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequestReturns(result1 []byte, result2 int, result3 error) {
+	fake.makeCloudControllerRequestMutex.Lock()
+	defer fake.makeCloudControllerRequestMutex.Unlock()
+	fake.MakeCloudControllerRequestStub = nil
+	fake.makeCloudControllerRequestReturns = struct {
+		result1 []byte
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) MakeCloudControllerRequestReturnsOnCall(i int, result1 []byte, result2 int, result3 error) {
+	fake.makeCloudControllerRequestMutex.Lock()
+	defer fake.makeCloudControllerRequestMutex.Unlock()
+	fake.MakeCloudControllerRequestStub = nil
+	if fake.makeCloudControllerRequestReturnsOnCall == nil {
+		fake.makeCloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 int
+			result3 error
+		})
+	}
+	fake.makeCloudControllerRequestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getDeploymentsForAppMutex.RLock()
+	defer fake.getDeploymentsForAppMutex.RUnlock()
+	fake.getDetailedAppSummaryMutex.RLock()
+	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV7Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V7Actor = new(FakeV7Actor)
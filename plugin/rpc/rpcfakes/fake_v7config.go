@@ -0,0 +1,166 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeV7Config struct {
+	TargetedOrganizationStub        func() configv3.Organization
+	targetedOrganizationMutex       sync.RWMutex
+	targetedOrganizationArgsForCall []struct {
+	}
+	targetedOrganizationReturns struct {
+		result1 configv3.Organization
+	}
+	targetedOrganizationReturnsOnCall map[int]struct {
+		result1 configv3.Organization
+	}
+	TargetedSpaceStub        func() configv3.Space
+	targetedSpaceMutex       sync.RWMutex
+	targetedSpaceArgsForCall []struct {
+	}
+	targetedSpaceReturns struct {
+		result1 configv3.Space
+	}
+	targetedSpaceReturnsOnCall map[int]struct {
+		result1 configv3.Space
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV7Config) TargetedOrganization() configv3.Organization {
+	fake.targetedOrganizationMutex.Lock()
+	ret, specificReturn := fake.targetedOrganizationReturnsOnCall[len(fake.targetedOrganizationArgsForCall)]
+	fake.targetedOrganizationArgsForCall = append(fake.targetedOrganizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetedOrganization", []interface{}{})
+	fake.targetedOrganizationMutex.Unlock()
+	if fake.TargetedOrganizationStub != nil {
+		return fake.TargetedOrganizationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetedOrganizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV7Config) TargetedOrganizationCallCount() int {
+	fake.targetedOrganizationMutex.RLock()
+	defer fake.targetedOrganizationMutex.RUnlock()
+	return len(fake.targetedOrganizationArgsForCall)
+}
+
+func (fake *FakeV7Config) TargetedOrganizationCalls(stub func() configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = stub
+}
+
+func (fake *FakeV7Config) TargetedOrganizationReturns(result1 configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = nil
+	fake.targetedOrganizationReturns = struct {
+		result1 configv3.Organization
+	}{result1}
+}
+
+func (fake *FakeV7Config) TargetedOrganizationReturnsOnCall(i int, result1 configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = nil
+	if fake.targetedOrganizationReturnsOnCall == nil {
+		fake.targetedOrganizationReturnsOnCall = make(map[int]struct {
+			result1 configv3.Organization
+		})
+	}
+	fake.targetedOrganizationReturnsOnCall[i] = struct {
+		result1 configv3.Organization
+	}{result1}
+}
+
+func (fake *FakeV7Config) TargetedSpace() configv3.Space {
+	fake.targetedSpaceMutex.Lock()
+	ret, specificReturn := fake.targetedSpaceReturnsOnCall[len(fake.targetedSpaceArgsForCall)]
+	fake.targetedSpaceArgsForCall = append(fake.targetedSpaceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetedSpace", []interface{}{})
+	fake.targetedSpaceMutex.Unlock()
+	if fake.TargetedSpaceStub != nil {
+		return fake.TargetedSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetedSpaceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV7Config) TargetedSpaceCallCount() int {
+	fake.targetedSpaceMutex.RLock()
+	defer fake.targetedSpaceMutex.RUnlock()
+	return len(fake.targetedSpaceArgsForCall)
+}
+
+func (fake *FakeV7Config) TargetedSpaceCalls(stub func() configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = stub
+}
+
+func (fake *FakeV7Config) TargetedSpaceReturns(result1 configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = nil
+	fake.targetedSpaceReturns = struct {
+		result1 configv3.Space
+	}{result1}
+}
+
+func (fake *FakeV7Config) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = nil
+	if fake.targetedSpaceReturnsOnCall == nil {
+		fake.targetedSpaceReturnsOnCall = make(map[int]struct {
+			result1 configv3.Space
+		})
+	}
+	fake.targetedSpaceReturnsOnCall[i] = struct {
+		result1 configv3.Space
+	}{result1}
+}
+
+func (fake *FakeV7Config) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.targetedOrganizationMutex.RLock()
+	defer fake.targetedOrganizationMutex.RUnlock()
+	fake.targetedSpaceMutex.RLock()
+	defer fake.targetedSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV7Config) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V7Config = new(FakeV7Config)
@@ -0,0 +1,80 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV7UI struct {
+	DisplayWarningsStub        func([]string)
+	displayWarningsMutex       sync.RWMutex
+	displayWarningsArgsForCall []struct {
+		arg1 []string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV7UI) DisplayWarnings(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.displayWarningsMutex.Lock()
+	fake.displayWarningsArgsForCall = append(fake.displayWarningsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("DisplayWarnings", []interface{}{arg1Copy})
+	fake.displayWarningsMutex.Unlock()
+	if fake.DisplayWarningsStub != nil {
+		fake.DisplayWarningsStub(arg1)
+	}
+}
+
+func (fake *FakeV7UI) DisplayWarningsCallCount() int {
+	fake.displayWarningsMutex.RLock()
+	defer fake.displayWarningsMutex.RUnlock()
+	return len(fake.displayWarningsArgsForCall)
+}
+
+func (fake *FakeV7UI) DisplayWarningsCalls(stub func([]string)) {
+	fake.displayWarningsMutex.Lock()
+	defer fake.displayWarningsMutex.Unlock()
+	fake.DisplayWarningsStub = stub
+}
+
+func (fake *FakeV7UI) DisplayWarningsArgsForCall(i int) []string {
+	fake.displayWarningsMutex.RLock()
+	defer fake.displayWarningsMutex.RUnlock()
+	argsForCall := fake.displayWarningsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7UI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.displayWarningsMutex.RLock()
+	defer fake.displayWarningsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV7UI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V7UI = new(FakeV7UI)
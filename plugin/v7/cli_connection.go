@@ -0,0 +1,113 @@
+package v7
+
+import (
+	"net/rpc"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+type cliConnection struct {
+	cliServerPort string
+	legacy        plugin.CliConnection
+}
+
+func newCliConnection(cliServerPort string, legacy plugin.CliConnection) *cliConnection {
+	return &cliConnection{
+		cliServerPort: cliServerPort,
+		legacy:        legacy,
+	}
+}
+
+func (c *cliConnection) call(method string, args interface{}, result interface{}) error {
+	client, err := rpc.Dial("tcp", "127.0.0.1:"+c.cliServerPort)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Call("CliRpcCmdV7."+method, args, result)
+}
+
+func (c *cliConnection) CliCommand(args ...string) ([]string, error) {
+	return c.legacy.CliCommand(args...)
+}
+
+func (c *cliConnection) CliCommandWithoutTerminalOutput(args ...string) ([]string, error) {
+	return c.legacy.CliCommandWithoutTerminalOutput(args...)
+}
+
+func (c *cliConnection) IsLoggedIn() (bool, error) {
+	return c.legacy.IsLoggedIn()
+}
+
+func (c *cliConnection) Username() (string, error) {
+	return c.legacy.Username()
+}
+
+func (c *cliConnection) ApiEndpoint() (string, error) {
+	return c.legacy.ApiEndpoint()
+}
+
+func (c *cliConnection) AccessToken() (string, error) {
+	return c.legacy.AccessToken()
+}
+
+func (c *cliConnection) GetCurrentOrg() (Org, error) {
+	var result Org
+	err := c.call("GetCurrentOrg", "", &result)
+	return result, err
+}
+
+func (c *cliConnection) GetCurrentSpace() (Space, error) {
+	var result Space
+	err := c.call("GetCurrentSpace", "", &result)
+	return result, err
+}
+
+func (c *cliConnection) GetOrg(orgName string) (Org, error) {
+	var result Org
+	err := c.call("GetOrg", orgName, &result)
+	return result, err
+}
+
+func (c *cliConnection) GetSpace(spaceName string) (Space, error) {
+	var result Space
+	err := c.call("GetSpace", spaceName, &result)
+	return result, err
+}
+
+func (c *cliConnection) GetApp(appName string) (Application, error) {
+	var result Application
+	err := c.call("GetApp", appName, &result)
+	return result, err
+}
+
+func (c *cliConnection) GetApps() ([]Application, error) {
+	var result []Application
+	err := c.call("GetApps", "", &result)
+	return result, err
+}
+
+func (c *cliConnection) GetAppDroplets(appName string) ([]Droplet, error) {
+	var result []Droplet
+	err := c.call("GetAppDroplets", appName, &result)
+	return result, err
+}
+
+func (c *cliConnection) GetAppDeployments(appName string) ([]Deployment, error) {
+	var result []Deployment
+	err := c.call("GetAppDeployments", appName, &result)
+	return result, err
+}
+
+func (c *cliConnection) GetAppRoutes(appName string) ([]Route, error) {
+	var result []Route
+	err := c.call("GetAppRoutes", appName, &result)
+	return result, err
+}
+
+func (c *cliConnection) CloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, error) {
+	var result CloudControllerResponse
+	err := c.call("CloudControllerRequest", CloudControllerRequest{Method: method, Path: path, Body: body}, &result)
+	return result, err
+}
@@ -0,0 +1,89 @@
+package v7
+
+// Org is a Cloud Controller organization.
+type Org struct {
+	GUID   string
+	Name   string
+	Labels map[string]string
+}
+
+// Space is a Cloud Controller space.
+type Space struct {
+	GUID    string
+	Name    string
+	OrgGUID string
+	Labels  map[string]string
+}
+
+// Application is a Cloud Controller app. The processes and current droplet
+// are only populated by GetApp.
+type Application struct {
+	GUID          string
+	Name          string
+	State         string
+	LifecycleType string
+	Buildpacks    []string
+	Stack         string
+	DropletGUID   string
+	Labels        map[string]string
+	Processes     []Process
+}
+
+// Process is one of an app's processes, such as "web" or "worker".
+type Process struct {
+	GUID             string
+	Type             string
+	Command          string
+	HealthCheckType  string
+	Instances        int
+	RunningInstances int
+	MemoryInMB       uint64
+	DiskInMB         uint64
+}
+
+// Droplet is a staged app.
+type Droplet struct {
+	GUID       string
+	State      string
+	CreatedAt  string
+	Stack      string
+	Image      string
+	Buildpacks []string
+}
+
+// Deployment is a rolling deployment of an app.
+type Deployment struct {
+	GUID                string
+	Status              string
+	StatusReason        string
+	Strategy            string
+	DropletGUID         string
+	PreviousDropletGUID string
+	CreatedAt           string
+	LastStatusChange    string
+}
+
+// Route is a route mapped to an app.
+type Route struct {
+	GUID      string
+	SpaceGUID string
+	Host      string
+	Domain    string
+	Path      string
+	URL       string
+	Labels    map[string]string
+}
+
+// CloudControllerRequest is a request passed through to the Cloud Controller.
+type CloudControllerRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// CloudControllerResponse is the Cloud Controller's response to a
+// CloudControllerRequest.
+type CloudControllerResponse struct {
+	StatusCode int
+	Body       []byte
+}
@@ -0,0 +1,72 @@
+// Package v7 is the plugin API backed by the Cloud Controller V3 API. Plugins
+// using it receive a CliConnection whose models are built from the same V3
+// resources the CLI's own commands use, instead of the V2 models of the
+// original plugin API.
+//
+// A plugin opts in by calling v7.Start instead of plugin.Start:
+//
+//	func main() {
+//		v7.Start(new(MyPlugin))
+//	}
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+// Plugin is the interface a plugin using the v7 plugin API implements.
+type Plugin interface {
+	Run(cliConnection CliConnection, args []string)
+	GetMetadata() plugin.PluginMetadata
+}
+
+//go:generate counterfeiter . CliConnection
+
+// CliConnection is the set of CLI calls available to a plugin using the v7
+// plugin API. Methods that take an app name look the app up in the targeted
+// space.
+type CliConnection interface {
+	CliCommand(args ...string) ([]string, error)
+	CliCommandWithoutTerminalOutput(args ...string) ([]string, error)
+	IsLoggedIn() (bool, error)
+	Username() (string, error)
+	ApiEndpoint() (string, error)
+	AccessToken() (string, error)
+
+	GetCurrentOrg() (Org, error)
+	GetCurrentSpace() (Space, error)
+	GetOrg(orgName string) (Org, error)
+	GetSpace(spaceName string) (Space, error)
+	GetApp(appName string) (Application, error)
+	GetApps() ([]Application, error)
+	GetAppDroplets(appName string) ([]Droplet, error)
+	GetAppDeployments(appName string) ([]Deployment, error)
+	GetAppRoutes(appName string) ([]Route, error)
+
+	// CloudControllerRequest makes an authenticated request to the given
+	// Cloud Controller path, such as "/v3/apps?names=dora", refreshing the
+	// access token when it has expired. Responses with an error status are
+	// returned rather than treated as errors.
+	CloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, error)
+}
+
+// Start runs the plugin. It is the v7 equivalent of plugin.Start.
+func Start(cmd Plugin) {
+	plugin.Start(pluginShim{cmd: cmd})
+}
+
+// pluginShim adapts a v7 plugin to the original plugin interface so that it
+// can use the same handshake with the CLI.
+type pluginShim struct {
+	cmd Plugin
+}
+
+func (shim pluginShim) GetMetadata() plugin.PluginMetadata {
+	return shim.cmd.GetMetadata()
+}
+
+func (shim pluginShim) Run(cliConnection plugin.CliConnection, args []string) {
+	shim.cmd.Run(newCliConnection(os.Args[1], cliConnection), args)
+}
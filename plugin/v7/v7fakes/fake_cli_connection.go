@@ -0,0 +1,1231 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/plugin/v7"
+)
+
+type FakeCliConnection struct {
+	AccessTokenStub        func() (string, error)
+	accessTokenMutex       sync.RWMutex
+	accessTokenArgsForCall []struct {
+	}
+	accessTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	accessTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ApiEndpointStub        func() (string, error)
+	apiEndpointMutex       sync.RWMutex
+	apiEndpointArgsForCall []struct {
+	}
+	apiEndpointReturns struct {
+		result1 string
+		result2 error
+	}
+	apiEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CliCommandStub        func(...string) ([]string, error)
+	cliCommandMutex       sync.RWMutex
+	cliCommandArgsForCall []struct {
+		arg1 []string
+	}
+	cliCommandReturns struct {
+		result1 []string
+		result2 error
+	}
+	cliCommandReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CliCommandWithoutTerminalOutputStub        func(...string) ([]string, error)
+	cliCommandWithoutTerminalOutputMutex       sync.RWMutex
+	cliCommandWithoutTerminalOutputArgsForCall []struct {
+		arg1 []string
+	}
+	cliCommandWithoutTerminalOutputReturns struct {
+		result1 []string
+		result2 error
+	}
+	cliCommandWithoutTerminalOutputReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CloudControllerRequestStub        func(string, string, []byte) (v7.CloudControllerResponse, error)
+	cloudControllerRequestMutex       sync.RWMutex
+	cloudControllerRequestArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}
+	cloudControllerRequestReturns struct {
+		result1 v7.CloudControllerResponse
+		result2 error
+	}
+	cloudControllerRequestReturnsOnCall map[int]struct {
+		result1 v7.CloudControllerResponse
+		result2 error
+	}
+	GetAppStub        func(string) (v7.Application, error)
+	getAppMutex       sync.RWMutex
+	getAppArgsForCall []struct {
+		arg1 string
+	}
+	getAppReturns struct {
+		result1 v7.Application
+		result2 error
+	}
+	getAppReturnsOnCall map[int]struct {
+		result1 v7.Application
+		result2 error
+	}
+	GetAppDeploymentsStub        func(string) ([]v7.Deployment, error)
+	getAppDeploymentsMutex       sync.RWMutex
+	getAppDeploymentsArgsForCall []struct {
+		arg1 string
+	}
+	getAppDeploymentsReturns struct {
+		result1 []v7.Deployment
+		result2 error
+	}
+	getAppDeploymentsReturnsOnCall map[int]struct {
+		result1 []v7.Deployment
+		result2 error
+	}
+	GetAppDropletsStub        func(string) ([]v7.Droplet, error)
+	getAppDropletsMutex       sync.RWMutex
+	getAppDropletsArgsForCall []struct {
+		arg1 string
+	}
+	getAppDropletsReturns struct {
+		result1 []v7.Droplet
+		result2 error
+	}
+	getAppDropletsReturnsOnCall map[int]struct {
+		result1 []v7.Droplet
+		result2 error
+	}
+	GetAppRoutesStub        func(string) ([]v7.Route, error)
+	getAppRoutesMutex       sync.RWMutex
+	getAppRoutesArgsForCall []struct {
+		arg1 string
+	}
+	getAppRoutesReturns struct {
+		result1 []v7.Route
+		result2 error
+	}
+	getAppRoutesReturnsOnCall map[int]struct {
+		result1 []v7.Route
+		result2 error
+	}
+	GetAppsStub        func() ([]v7.Application, error)
+	getAppsMutex       sync.RWMutex
+	getAppsArgsForCall []struct {
+	}
+	getAppsReturns struct {
+		result1 []v7.Application
+		result2 error
+	}
+	getAppsReturnsOnCall map[int]struct {
+		result1 []v7.Application
+		result2 error
+	}
+	GetCurrentOrgStub        func() (v7.Org, error)
+	getCurrentOrgMutex       sync.RWMutex
+	getCurrentOrgArgsForCall []struct {
+	}
+	getCurrentOrgReturns struct {
+		result1 v7.Org
+		result2 error
+	}
+	getCurrentOrgReturnsOnCall map[int]struct {
+		result1 v7.Org
+		result2 error
+	}
+	GetCurrentSpaceStub        func() (v7.Space, error)
+	getCurrentSpaceMutex       sync.RWMutex
+	getCurrentSpaceArgsForCall []struct {
+	}
+	getCurrentSpaceReturns struct {
+		result1 v7.Space
+		result2 error
+	}
+	getCurrentSpaceReturnsOnCall map[int]struct {
+		result1 v7.Space
+		result2 error
+	}
+	GetOrgStub        func(string) (v7.Org, error)
+	getOrgMutex       sync.RWMutex
+	getOrgArgsForCall []struct {
+		arg1 string
+	}
+	getOrgReturns struct {
+		result1 v7.Org
+		result2 error
+	}
+	getOrgReturnsOnCall map[int]struct {
+		result1 v7.Org
+		result2 error
+	}
+	GetSpaceStub        func(string) (v7.Space, error)
+	getSpaceMutex       sync.RWMutex
+	getSpaceArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceReturns struct {
+		result1 v7.Space
+		result2 error
+	}
+	getSpaceReturnsOnCall map[int]struct {
+		result1 v7.Space
+		result2 error
+	}
+	IsLoggedInStub        func() (bool, error)
+	isLoggedInMutex       sync.RWMutex
+	isLoggedInArgsForCall []struct {
+	}
+	isLoggedInReturns struct {
+		result1 bool
+		result2 error
+	}
+	isLoggedInReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UsernameStub        func() (string, error)
+	usernameMutex       sync.RWMutex
+	usernameArgsForCall []struct {
+	}
+	usernameReturns struct {
+		result1 string
+		result2 error
+	}
+	usernameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCliConnection) AccessToken() (string, error) {
+	fake.accessTokenMutex.Lock()
+	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
+	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AccessToken", []interface{}{})
+	fake.accessTokenMutex.Unlock()
+	if fake.AccessTokenStub != nil {
+		return fake.AccessTokenStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.accessTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) AccessTokenCallCount() int {
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
+	return len(fake.accessTokenArgsForCall)
+}
+
+func (fake *FakeCliConnection) AccessTokenCalls(stub func() (string, error)) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = stub
+}
+
+func (fake *FakeCliConnection) AccessTokenReturns(result1 string, result2 error) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = nil
+	fake.accessTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) AccessTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.accessTokenMutex.Lock()
+	defer fake.accessTokenMutex.Unlock()
+	fake.AccessTokenStub = nil
+	if fake.accessTokenReturnsOnCall == nil {
+		fake.accessTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.accessTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) ApiEndpoint() (string, error) {
+	fake.apiEndpointMutex.Lock()
+	ret, specificReturn := fake.apiEndpointReturnsOnCall[len(fake.apiEndpointArgsForCall)]
+	fake.apiEndpointArgsForCall = append(fake.apiEndpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ApiEndpoint", []interface{}{})
+	fake.apiEndpointMutex.Unlock()
+	if fake.ApiEndpointStub != nil {
+		return fake.ApiEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.apiEndpointReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) ApiEndpointCallCount() int {
+	fake.apiEndpointMutex.RLock()
+	defer fake.apiEndpointMutex.RUnlock()
+	return len(fake.apiEndpointArgsForCall)
+}
+
+func (fake *FakeCliConnection) ApiEndpointCalls(stub func() (string, error)) {
+	fake.apiEndpointMutex.Lock()
+	defer fake.apiEndpointMutex.Unlock()
+	fake.ApiEndpointStub = stub
+}
+
+func (fake *FakeCliConnection) ApiEndpointReturns(result1 string, result2 error) {
+	fake.apiEndpointMutex.Lock()
+	defer fake.apiEndpointMutex.Unlock()
+	fake.ApiEndpointStub = nil
+	fake.apiEndpointReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) ApiEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.apiEndpointMutex.Lock()
+	defer fake.apiEndpointMutex.Unlock()
+	fake.ApiEndpointStub = nil
+	if fake.apiEndpointReturnsOnCall == nil {
+		fake.apiEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.apiEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CliCommand(arg1 ...string) ([]string, error) {
+	fake.cliCommandMutex.Lock()
+	ret, specificReturn := fake.cliCommandReturnsOnCall[len(fake.cliCommandArgsForCall)]
+	fake.cliCommandArgsForCall = append(fake.cliCommandArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	fake.recordInvocation("CliCommand", []interface{}{arg1})
+	fake.cliCommandMutex.Unlock()
+	if fake.CliCommandStub != nil {
+		return fake.CliCommandStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cliCommandReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) CliCommandCallCount() int {
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	return len(fake.cliCommandArgsForCall)
+}
+
+func (fake *FakeCliConnection) CliCommandCalls(stub func(...string) ([]string, error)) {
+	fake.cliCommandMutex.Lock()
+	defer fake.cliCommandMutex.Unlock()
+	fake.CliCommandStub = stub
+}
+
+func (fake *FakeCliConnection) CliCommandArgsForCall(i int) []string {
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	argsForCall := fake.cliCommandArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) CliCommandReturns(result1 []string, result2 error) {
+	fake.cliCommandMutex.Lock()
+	defer fake.cliCommandMutex.Unlock()
+	fake.CliCommandStub = nil
+	fake.cliCommandReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CliCommandReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.cliCommandMutex.Lock()
+	defer fake.cliCommandMutex.Unlock()
+	fake.CliCommandStub = nil
+	if fake.cliCommandReturnsOnCall == nil {
+		fake.cliCommandReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutput(arg1 ...string) ([]string, error) {
+	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	ret, specificReturn := fake.cliCommandWithoutTerminalOutputReturnsOnCall[len(fake.cliCommandWithoutTerminalOutputArgsForCall)]
+	fake.cliCommandWithoutTerminalOutputArgsForCall = append(fake.cliCommandWithoutTerminalOutputArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	fake.recordInvocation("CliCommandWithoutTerminalOutput", []interface{}{arg1})
+	fake.cliCommandWithoutTerminalOutputMutex.Unlock()
+	if fake.CliCommandWithoutTerminalOutputStub != nil {
+		return fake.CliCommandWithoutTerminalOutputStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cliCommandWithoutTerminalOutputReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputCallCount() int {
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	return len(fake.cliCommandWithoutTerminalOutputArgsForCall)
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputCalls(stub func(...string) ([]string, error)) {
+	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.Unlock()
+	fake.CliCommandWithoutTerminalOutputStub = stub
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputArgsForCall(i int) []string {
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	argsForCall := fake.cliCommandWithoutTerminalOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputReturns(result1 []string, result2 error) {
+	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.Unlock()
+	fake.CliCommandWithoutTerminalOutputStub = nil
+	fake.cliCommandWithoutTerminalOutputReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.Unlock()
+	fake.CliCommandWithoutTerminalOutputStub = nil
+	if fake.cliCommandWithoutTerminalOutputReturnsOnCall == nil {
+		fake.cliCommandWithoutTerminalOutputReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandWithoutTerminalOutputReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CloudControllerRequest(arg1 string, arg2 string, arg3 []byte) (v7.CloudControllerResponse, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.cloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.cloudControllerRequestReturnsOnCall[len(fake.cloudControllerRequestArgsForCall)]
+	fake.cloudControllerRequestArgsForCall = append(fake.cloudControllerRequestArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("CloudControllerRequest", []interface{}{arg1, arg2, arg3Copy})
+	fake.cloudControllerRequestMutex.Unlock()
+	if fake.CloudControllerRequestStub != nil {
+		return fake.CloudControllerRequestStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cloudControllerRequestReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestCallCount() int {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return len(fake.cloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestCalls(stub func(string, string, []byte) (v7.CloudControllerResponse, error)) {
+	fake.cloudControllerRequestMutex.Lock()
+	defer fake.cloudControllerRequestMutex.Unlock()
+	fake.CloudControllerRequestStub = stub
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestArgsForCall(i int) (string, string, []byte) {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	argsForCall := fake.cloudControllerRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestReturns(result1 v7.CloudControllerResponse, result2 error) {
+	fake.cloudControllerRequestMutex.Lock()
+	defer fake.cloudControllerRequestMutex.Unlock()
+	fake.CloudControllerRequestStub = nil
+	fake.cloudControllerRequestReturns = struct {
+		result1 v7.CloudControllerResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestReturnsOnCall(i int, result1 v7.CloudControllerResponse, result2 error) {
+	fake.cloudControllerRequestMutex.Lock()
+	defer fake.cloudControllerRequestMutex.Unlock()
+	fake.CloudControllerRequestStub = nil
+	if fake.cloudControllerRequestReturnsOnCall == nil {
+		fake.cloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 v7.CloudControllerResponse
+			result2 error
+		})
+	}
+	fake.cloudControllerRequestReturnsOnCall[i] = struct {
+		result1 v7.CloudControllerResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetApp(arg1 string) (v7.Application, error) {
+	fake.getAppMutex.Lock()
+	ret, specificReturn := fake.getAppReturnsOnCall[len(fake.getAppArgsForCall)]
+	fake.getAppArgsForCall = append(fake.getAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApp", []interface{}{arg1})
+	fake.getAppMutex.Unlock()
+	if fake.GetAppStub != nil {
+		return fake.GetAppStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAppReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetAppCallCount() int {
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	return len(fake.getAppArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppCalls(stub func(string) (v7.Application, error)) {
+	fake.getAppMutex.Lock()
+	defer fake.getAppMutex.Unlock()
+	fake.GetAppStub = stub
+}
+
+func (fake *FakeCliConnection) GetAppArgsForCall(i int) string {
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	argsForCall := fake.getAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetAppReturns(result1 v7.Application, result2 error) {
+	fake.getAppMutex.Lock()
+	defer fake.getAppMutex.Unlock()
+	fake.GetAppStub = nil
+	fake.getAppReturns = struct {
+		result1 v7.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppReturnsOnCall(i int, result1 v7.Application, result2 error) {
+	fake.getAppMutex.Lock()
+	defer fake.getAppMutex.Unlock()
+	fake.GetAppStub = nil
+	if fake.getAppReturnsOnCall == nil {
+		fake.getAppReturnsOnCall = make(map[int]struct {
+			result1 v7.Application
+			result2 error
+		})
+	}
+	fake.getAppReturnsOnCall[i] = struct {
+		result1 v7.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppDeployments(arg1 string) ([]v7.Deployment, error) {
+	fake.getAppDeploymentsMutex.Lock()
+	ret, specificReturn := fake.getAppDeploymentsReturnsOnCall[len(fake.getAppDeploymentsArgsForCall)]
+	fake.getAppDeploymentsArgsForCall = append(fake.getAppDeploymentsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAppDeployments", []interface{}{arg1})
+	fake.getAppDeploymentsMutex.Unlock()
+	if fake.GetAppDeploymentsStub != nil {
+		return fake.GetAppDeploymentsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAppDeploymentsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetAppDeploymentsCallCount() int {
+	fake.getAppDeploymentsMutex.RLock()
+	defer fake.getAppDeploymentsMutex.RUnlock()
+	return len(fake.getAppDeploymentsArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppDeploymentsCalls(stub func(string) ([]v7.Deployment, error)) {
+	fake.getAppDeploymentsMutex.Lock()
+	defer fake.getAppDeploymentsMutex.Unlock()
+	fake.GetAppDeploymentsStub = stub
+}
+
+func (fake *FakeCliConnection) GetAppDeploymentsArgsForCall(i int) string {
+	fake.getAppDeploymentsMutex.RLock()
+	defer fake.getAppDeploymentsMutex.RUnlock()
+	argsForCall := fake.getAppDeploymentsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetAppDeploymentsReturns(result1 []v7.Deployment, result2 error) {
+	fake.getAppDeploymentsMutex.Lock()
+	defer fake.getAppDeploymentsMutex.Unlock()
+	fake.GetAppDeploymentsStub = nil
+	fake.getAppDeploymentsReturns = struct {
+		result1 []v7.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppDeploymentsReturnsOnCall(i int, result1 []v7.Deployment, result2 error) {
+	fake.getAppDeploymentsMutex.Lock()
+	defer fake.getAppDeploymentsMutex.Unlock()
+	fake.GetAppDeploymentsStub = nil
+	if fake.getAppDeploymentsReturnsOnCall == nil {
+		fake.getAppDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []v7.Deployment
+			result2 error
+		})
+	}
+	fake.getAppDeploymentsReturnsOnCall[i] = struct {
+		result1 []v7.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppDroplets(arg1 string) ([]v7.Droplet, error) {
+	fake.getAppDropletsMutex.Lock()
+	ret, specificReturn := fake.getAppDropletsReturnsOnCall[len(fake.getAppDropletsArgsForCall)]
+	fake.getAppDropletsArgsForCall = append(fake.getAppDropletsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAppDroplets", []interface{}{arg1})
+	fake.getAppDropletsMutex.Unlock()
+	if fake.GetAppDropletsStub != nil {
+		return fake.GetAppDropletsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAppDropletsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetAppDropletsCallCount() int {
+	fake.getAppDropletsMutex.RLock()
+	defer fake.getAppDropletsMutex.RUnlock()
+	return len(fake.getAppDropletsArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppDropletsCalls(stub func(string) ([]v7.Droplet, error)) {
+	fake.getAppDropletsMutex.Lock()
+	defer fake.getAppDropletsMutex.Unlock()
+	fake.GetAppDropletsStub = stub
+}
+
+func (fake *FakeCliConnection) GetAppDropletsArgsForCall(i int) string {
+	fake.getAppDropletsMutex.RLock()
+	defer fake.getAppDropletsMutex.RUnlock()
+	argsForCall := fake.getAppDropletsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetAppDropletsReturns(result1 []v7.Droplet, result2 error) {
+	fake.getAppDropletsMutex.Lock()
+	defer fake.getAppDropletsMutex.Unlock()
+	fake.GetAppDropletsStub = nil
+	fake.getAppDropletsReturns = struct {
+		result1 []v7.Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppDropletsReturnsOnCall(i int, result1 []v7.Droplet, result2 error) {
+	fake.getAppDropletsMutex.Lock()
+	defer fake.getAppDropletsMutex.Unlock()
+	fake.GetAppDropletsStub = nil
+	if fake.getAppDropletsReturnsOnCall == nil {
+		fake.getAppDropletsReturnsOnCall = make(map[int]struct {
+			result1 []v7.Droplet
+			result2 error
+		})
+	}
+	fake.getAppDropletsReturnsOnCall[i] = struct {
+		result1 []v7.Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppRoutes(arg1 string) ([]v7.Route, error) {
+	fake.getAppRoutesMutex.Lock()
+	ret, specificReturn := fake.getAppRoutesReturnsOnCall[len(fake.getAppRoutesArgsForCall)]
+	fake.getAppRoutesArgsForCall = append(fake.getAppRoutesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAppRoutes", []interface{}{arg1})
+	fake.getAppRoutesMutex.Unlock()
+	if fake.GetAppRoutesStub != nil {
+		return fake.GetAppRoutesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAppRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetAppRoutesCallCount() int {
+	fake.getAppRoutesMutex.RLock()
+	defer fake.getAppRoutesMutex.RUnlock()
+	return len(fake.getAppRoutesArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppRoutesCalls(stub func(string) ([]v7.Route, error)) {
+	fake.getAppRoutesMutex.Lock()
+	defer fake.getAppRoutesMutex.Unlock()
+	fake.GetAppRoutesStub = stub
+}
+
+func (fake *FakeCliConnection) GetAppRoutesArgsForCall(i int) string {
+	fake.getAppRoutesMutex.RLock()
+	defer fake.getAppRoutesMutex.RUnlock()
+	argsForCall := fake.getAppRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetAppRoutesReturns(result1 []v7.Route, result2 error) {
+	fake.getAppRoutesMutex.Lock()
+	defer fake.getAppRoutesMutex.Unlock()
+	fake.GetAppRoutesStub = nil
+	fake.getAppRoutesReturns = struct {
+		result1 []v7.Route
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppRoutesReturnsOnCall(i int, result1 []v7.Route, result2 error) {
+	fake.getAppRoutesMutex.Lock()
+	defer fake.getAppRoutesMutex.Unlock()
+	fake.GetAppRoutesStub = nil
+	if fake.getAppRoutesReturnsOnCall == nil {
+		fake.getAppRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v7.Route
+			result2 error
+		})
+	}
+	fake.getAppRoutesReturnsOnCall[i] = struct {
+		result1 []v7.Route
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetApps() ([]v7.Application, error) {
+	fake.getAppsMutex.Lock()
+	ret, specificReturn := fake.getAppsReturnsOnCall[len(fake.getAppsArgsForCall)]
+	fake.getAppsArgsForCall = append(fake.getAppsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetApps", []interface{}{})
+	fake.getAppsMutex.Unlock()
+	if fake.GetAppsStub != nil {
+		return fake.GetAppsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAppsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetAppsCallCount() int {
+	fake.getAppsMutex.RLock()
+	defer fake.getAppsMutex.RUnlock()
+	return len(fake.getAppsArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppsCalls(stub func() ([]v7.Application, error)) {
+	fake.getAppsMutex.Lock()
+	defer fake.getAppsMutex.Unlock()
+	fake.GetAppsStub = stub
+}
+
+func (fake *FakeCliConnection) GetAppsReturns(result1 []v7.Application, result2 error) {
+	fake.getAppsMutex.Lock()
+	defer fake.getAppsMutex.Unlock()
+	fake.GetAppsStub = nil
+	fake.getAppsReturns = struct {
+		result1 []v7.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppsReturnsOnCall(i int, result1 []v7.Application, result2 error) {
+	fake.getAppsMutex.Lock()
+	defer fake.getAppsMutex.Unlock()
+	fake.GetAppsStub = nil
+	if fake.getAppsReturnsOnCall == nil {
+		fake.getAppsReturnsOnCall = make(map[int]struct {
+			result1 []v7.Application
+			result2 error
+		})
+	}
+	fake.getAppsReturnsOnCall[i] = struct {
+		result1 []v7.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetCurrentOrg() (v7.Org, error) {
+	fake.getCurrentOrgMutex.Lock()
+	ret, specificReturn := fake.getCurrentOrgReturnsOnCall[len(fake.getCurrentOrgArgsForCall)]
+	fake.getCurrentOrgArgsForCall = append(fake.getCurrentOrgArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetCurrentOrg", []interface{}{})
+	fake.getCurrentOrgMutex.Unlock()
+	if fake.GetCurrentOrgStub != nil {
+		return fake.GetCurrentOrgStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getCurrentOrgReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetCurrentOrgCallCount() int {
+	fake.getCurrentOrgMutex.RLock()
+	defer fake.getCurrentOrgMutex.RUnlock()
+	return len(fake.getCurrentOrgArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetCurrentOrgCalls(stub func() (v7.Org, error)) {
+	fake.getCurrentOrgMutex.Lock()
+	defer fake.getCurrentOrgMutex.Unlock()
+	fake.GetCurrentOrgStub = stub
+}
+
+func (fake *FakeCliConnection) GetCurrentOrgReturns(result1 v7.Org, result2 error) {
+	fake.getCurrentOrgMutex.Lock()
+	defer fake.getCurrentOrgMutex.Unlock()
+	fake.GetCurrentOrgStub = nil
+	fake.getCurrentOrgReturns = struct {
+		result1 v7.Org
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetCurrentOrgReturnsOnCall(i int, result1 v7.Org, result2 error) {
+	fake.getCurrentOrgMutex.Lock()
+	defer fake.getCurrentOrgMutex.Unlock()
+	fake.GetCurrentOrgStub = nil
+	if fake.getCurrentOrgReturnsOnCall == nil {
+		fake.getCurrentOrgReturnsOnCall = make(map[int]struct {
+			result1 v7.Org
+			result2 error
+		})
+	}
+	fake.getCurrentOrgReturnsOnCall[i] = struct {
+		result1 v7.Org
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetCurrentSpace() (v7.Space, error) {
+	fake.getCurrentSpaceMutex.Lock()
+	ret, specificReturn := fake.getCurrentSpaceReturnsOnCall[len(fake.getCurrentSpaceArgsForCall)]
+	fake.getCurrentSpaceArgsForCall = append(fake.getCurrentSpaceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetCurrentSpace", []interface{}{})
+	fake.getCurrentSpaceMutex.Unlock()
+	if fake.GetCurrentSpaceStub != nil {
+		return fake.GetCurrentSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getCurrentSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetCurrentSpaceCallCount() int {
+	fake.getCurrentSpaceMutex.RLock()
+	defer fake.getCurrentSpaceMutex.RUnlock()
+	return len(fake.getCurrentSpaceArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetCurrentSpaceCalls(stub func() (v7.Space, error)) {
+	fake.getCurrentSpaceMutex.Lock()
+	defer fake.getCurrentSpaceMutex.Unlock()
+	fake.GetCurrentSpaceStub = stub
+}
+
+func (fake *FakeCliConnection) GetCurrentSpaceReturns(result1 v7.Space, result2 error) {
+	fake.getCurrentSpaceMutex.Lock()
+	defer fake.getCurrentSpaceMutex.Unlock()
+	fake.GetCurrentSpaceStub = nil
+	fake.getCurrentSpaceReturns = struct {
+		result1 v7.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetCurrentSpaceReturnsOnCall(i int, result1 v7.Space, result2 error) {
+	fake.getCurrentSpaceMutex.Lock()
+	defer fake.getCurrentSpaceMutex.Unlock()
+	fake.GetCurrentSpaceStub = nil
+	if fake.getCurrentSpaceReturnsOnCall == nil {
+		fake.getCurrentSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7.Space
+			result2 error
+		})
+	}
+	fake.getCurrentSpaceReturnsOnCall[i] = struct {
+		result1 v7.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetOrg(arg1 string) (v7.Org, error) {
+	fake.getOrgMutex.Lock()
+	ret, specificReturn := fake.getOrgReturnsOnCall[len(fake.getOrgArgsForCall)]
+	fake.getOrgArgsForCall = append(fake.getOrgArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrg", []interface{}{arg1})
+	fake.getOrgMutex.Unlock()
+	if fake.GetOrgStub != nil {
+		return fake.GetOrgStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getOrgReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetOrgCallCount() int {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	return len(fake.getOrgArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetOrgCalls(stub func(string) (v7.Org, error)) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = stub
+}
+
+func (fake *FakeCliConnection) GetOrgArgsForCall(i int) string {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	argsForCall := fake.getOrgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetOrgReturns(result1 v7.Org, result2 error) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = nil
+	fake.getOrgReturns = struct {
+		result1 v7.Org
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetOrgReturnsOnCall(i int, result1 v7.Org, result2 error) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = nil
+	if fake.getOrgReturnsOnCall == nil {
+		fake.getOrgReturnsOnCall = make(map[int]struct {
+			result1 v7.Org
+			result2 error
+		})
+	}
+	fake.getOrgReturnsOnCall[i] = struct {
+		result1 v7.Org
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetSpace(arg1 string) (v7.Space, error) {
+	fake.getSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceReturnsOnCall[len(fake.getSpaceArgsForCall)]
+	fake.getSpaceArgsForCall = append(fake.getSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpace", []interface{}{arg1})
+	fake.getSpaceMutex.Unlock()
+	if fake.GetSpaceStub != nil {
+		return fake.GetSpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) GetSpaceCallCount() int {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	return len(fake.getSpaceArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetSpaceCalls(stub func(string) (v7.Space, error)) {
+	fake.getSpaceMutex.Lock()
+	defer fake.getSpaceMutex.Unlock()
+	fake.GetSpaceStub = stub
+}
+
+func (fake *FakeCliConnection) GetSpaceArgsForCall(i int) string {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	argsForCall := fake.getSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCliConnection) GetSpaceReturns(result1 v7.Space, result2 error) {
+	fake.getSpaceMutex.Lock()
+	defer fake.getSpaceMutex.Unlock()
+	fake.GetSpaceStub = nil
+	fake.getSpaceReturns = struct {
+		result1 v7.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetSpaceReturnsOnCall(i int, result1 v7.Space, result2 error) {
+	fake.getSpaceMutex.Lock()
+	defer fake.getSpaceMutex.Unlock()
+	fake.GetSpaceStub = nil
+	if fake.getSpaceReturnsOnCall == nil {
+		fake.getSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7.Space
+			result2 error
+		})
+	}
+	fake.getSpaceReturnsOnCall[i] = struct {
+		result1 v7.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) IsLoggedIn() (bool, error) {
+	fake.isLoggedInMutex.Lock()
+	ret, specificReturn := fake.isLoggedInReturnsOnCall[len(fake.isLoggedInArgsForCall)]
+	fake.isLoggedInArgsForCall = append(fake.isLoggedInArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsLoggedIn", []interface{}{})
+	fake.isLoggedInMutex.Unlock()
+	if fake.IsLoggedInStub != nil {
+		return fake.IsLoggedInStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.isLoggedInReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) IsLoggedInCallCount() int {
+	fake.isLoggedInMutex.RLock()
+	defer fake.isLoggedInMutex.RUnlock()
+	return len(fake.isLoggedInArgsForCall)
+}
+
+func (fake *FakeCliConnection) IsLoggedInCalls(stub func() (bool, error)) {
+	fake.isLoggedInMutex.Lock()
+	defer fake.isLoggedInMutex.Unlock()
+	fake.IsLoggedInStub = stub
+}
+
+func (fake *FakeCliConnection) IsLoggedInReturns(result1 bool, result2 error) {
+	fake.isLoggedInMutex.Lock()
+	defer fake.isLoggedInMutex.Unlock()
+	fake.IsLoggedInStub = nil
+	fake.isLoggedInReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) IsLoggedInReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isLoggedInMutex.Lock()
+	defer fake.isLoggedInMutex.Unlock()
+	fake.IsLoggedInStub = nil
+	if fake.isLoggedInReturnsOnCall == nil {
+		fake.isLoggedInReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isLoggedInReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) Username() (string, error) {
+	fake.usernameMutex.Lock()
+	ret, specificReturn := fake.usernameReturnsOnCall[len(fake.usernameArgsForCall)]
+	fake.usernameArgsForCall = append(fake.usernameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Username", []interface{}{})
+	fake.usernameMutex.Unlock()
+	if fake.UsernameStub != nil {
+		return fake.UsernameStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.usernameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCliConnection) UsernameCallCount() int {
+	fake.usernameMutex.RLock()
+	defer fake.usernameMutex.RUnlock()
+	return len(fake.usernameArgsForCall)
+}
+
+func (fake *FakeCliConnection) UsernameCalls(stub func() (string, error)) {
+	fake.usernameMutex.Lock()
+	defer fake.usernameMutex.Unlock()
+	fake.UsernameStub = stub
+}
+
+func (fake *FakeCliConnection) UsernameReturns(result1 string, result2 error) {
+	fake.usernameMutex.Lock()
+	defer fake.usernameMutex.Unlock()
+	fake.UsernameStub = nil
+	fake.usernameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) UsernameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.usernameMutex.Lock()
+	defer fake.usernameMutex.Unlock()
+	fake.UsernameStub = nil
+	if fake.usernameReturnsOnCall == nil {
+		fake.usernameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.usernameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
+	fake.apiEndpointMutex.RLock()
+	defer fake.apiEndpointMutex.RUnlock()
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	fake.getAppDeploymentsMutex.RLock()
+	defer fake.getAppDeploymentsMutex.RUnlock()
+	fake.getAppDropletsMutex.RLock()
+	defer fake.getAppDropletsMutex.RUnlock()
+	fake.getAppRoutesMutex.RLock()
+	defer fake.getAppRoutesMutex.RUnlock()
+	fake.getAppsMutex.RLock()
+	defer fake.getAppsMutex.RUnlock()
+	fake.getCurrentOrgMutex.RLock()
+	defer fake.getCurrentOrgMutex.RUnlock()
+	fake.getCurrentSpaceMutex.RLock()
+	defer fake.getCurrentSpaceMutex.RUnlock()
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	fake.isLoggedInMutex.RLock()
+	defer fake.isLoggedInMutex.RUnlock()
+	fake.usernameMutex.RLock()
+	defer fake.usernameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCliConnection) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.CliConnection = new(FakeCliConnection)
@@ -23,7 +23,7 @@ func NewNullString(optionalValue ...string) NullString {
 
 func (n NullString) MarshalJSON() ([]byte, error) {
 	if n.IsSet {
-		return []byte(fmt.Sprintf(`"%s"`, n.Value)), nil
+		return json.Marshal(n.Value)
 	}
 	return []byte("null"), nil
 }
@@ -46,3 +46,27 @@ func (n *NullString) UnmarshalJSON(rawJSON []byte) error {
 
 	return nil
 }
+
+// UnmarshalYAML reads any YAML scalar into a NullString. Manifests often
+// leave numbers and booleans unquoted, so these are kept in their string
+// form. A YAML null leaves the NullString unset.
+func (n *NullString) UnmarshalYAML(unmarshal func(v interface{}) error) error {
+	var value interface{}
+	err := unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	switch value.(type) {
+	case nil:
+		n.Value = ""
+		n.IsSet = false
+	case map[interface{}]interface{}, []interface{}:
+		return fmt.Errorf("expected a string value but got %v", value)
+	default:
+		n.Value = fmt.Sprint(value)
+		n.IsSet = true
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	yaml "gopkg.in/yaml.v2"
 )
 
 var _ = Describe("NullString", func() {
@@ -23,6 +24,15 @@ var _ = Describe("NullString", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(bytes)).To(ContainSubstring(`"some-string"`))
 			})
+
+			It("escapes special characters in the value", func() {
+				toMarshal := SamplePayload{
+					OptionalField: NullString{Value: `say "hi"`, IsSet: true},
+				}
+				bytes, err := json.Marshal(toMarshal)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(bytes)).To(Equal(`{"OptionalField":"say \"hi\""}`))
+			})
 		})
 
 		When("the NullString has no value", func() {
@@ -61,4 +71,48 @@ var _ = Describe("NullString", func() {
 		})
 	})
 
+	Context("YAML unmarshalling", func() {
+		type SampleYAMLPayload struct {
+			OptionalField NullString `yaml:"optionalField"`
+		}
+
+		When("the YAML has a string value", func() {
+			It("unmarshals to a NullString with the correct value", func() {
+				var samplePayload SampleYAMLPayload
+				err := yaml.Unmarshal([]byte(`optionalField: our-value`), &samplePayload)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(samplePayload.OptionalField).To(Equal(NullString{Value: "our-value", IsSet: true}))
+			})
+		})
+
+		When("the YAML has a number or boolean value", func() {
+			It("unmarshals to a NullString with the value as a string", func() {
+				var samplePayload SampleYAMLPayload
+				err := yaml.Unmarshal([]byte(`optionalField: 42`), &samplePayload)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(samplePayload.OptionalField).To(Equal(NullString{Value: "42", IsSet: true}))
+
+				err = yaml.Unmarshal([]byte(`optionalField: true`), &samplePayload)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(samplePayload.OptionalField).To(Equal(NullString{Value: "true", IsSet: true}))
+			})
+		})
+
+		When("the YAML has a null value", func() {
+			It("unmarshals to a NullString with no value", func() {
+				samplePayload := SampleYAMLPayload{OptionalField: NewNullString("previous")}
+				err := yaml.Unmarshal([]byte(`optionalField: null`), &samplePayload)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(samplePayload.OptionalField.IsSet).To(BeFalse())
+			})
+		})
+
+		When("the YAML has a map value", func() {
+			It("returns an error", func() {
+				var samplePayload SampleYAMLPayload
+				err := yaml.Unmarshal([]byte("optionalField:\n  nested: value"), &samplePayload)
+				Expect(err).To(MatchError(ContainSubstring("expected a string value")))
+			})
+		})
+	})
 })
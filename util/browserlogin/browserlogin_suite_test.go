@@ -0,0 +1,13 @@
+package browserlogin_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestBrowserlogin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Browserlogin Suite")
+}
@@ -0,0 +1,108 @@
+package browserlogin
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const callbackPath = "/callback"
+
+const callbackPage = `<!DOCTYPE html>
+<html>
+<head><title>%[1]s</title></head>
+<body><p>%[1]s You can close this window and return to the terminal.</p></body>
+</html>
+`
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// CallbackServer listens on a loopback port for the redirect that carries the
+// authorization code back from the browser.
+type CallbackServer struct {
+	state    string
+	listener net.Listener
+	server   *http.Server
+	results  chan callbackResult
+}
+
+// NewCallbackServer starts listening on an ephemeral loopback port for a
+// redirect carrying the given state.
+func NewCallbackServer(state string) (*CallbackServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	callbackServer := &CallbackServer{
+		state:    state,
+		listener: listener,
+		results:  make(chan callbackResult, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, callbackServer.handleCallback)
+	callbackServer.server = &http.Server{Handler: mux}
+
+	go callbackServer.server.Serve(listener) //nolint:errcheck
+
+	return callbackServer, nil
+}
+
+// RedirectURI is the URI to register with the authorization request.
+func (callbackServer *CallbackServer) RedirectURI() string {
+	return fmt.Sprintf("http://%s%s", callbackServer.listener.Addr().String(), callbackPath)
+}
+
+// WaitForCode blocks until the first redirect arrives and returns its
+// authorization code, or until the timeout passes.
+func (callbackServer *CallbackServer) WaitForCode(timeout time.Duration) (string, error) {
+	select {
+	case result := <-callbackServer.results:
+		return result.code, result.err
+	case <-time.After(timeout):
+		return "", TimeoutError{Timeout: timeout}
+	}
+}
+
+// Close stops listening for redirects.
+func (callbackServer *CallbackServer) Close() error {
+	return callbackServer.server.Close()
+}
+
+func (callbackServer *CallbackServer) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var result callbackResult
+	switch {
+	case query.Get("error") != "":
+		result.err = AuthorizationError{
+			Code:        query.Get("error"),
+			Description: query.Get("error_description"),
+		}
+	case query.Get("state") != callbackServer.state:
+		result.err = StateMismatchError{}
+	case query.Get("code") == "":
+		result.err = AuthorizationError{Code: "missing_code"}
+	default:
+		result.code = query.Get("code")
+	}
+
+	// Only the first redirect counts; a reload of the page must not replace it.
+	select {
+	case callbackServer.results <- result:
+	default:
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if result.err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, callbackPage, "Login failed.")
+		return
+	}
+	fmt.Fprintf(w, callbackPage, "Login succeeded.")
+}
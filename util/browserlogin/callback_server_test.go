@@ -0,0 +1,106 @@
+package browserlogin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/browserlogin"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CallbackServer", func() {
+	var (
+		callbackServer *CallbackServer
+		redirectURL    *url.URL
+	)
+
+	BeforeEach(func() {
+		var err error
+		callbackServer, err = NewCallbackServer("some-state")
+		Expect(err).ToNot(HaveOccurred())
+
+		redirectURL, err = url.Parse(callbackServer.RedirectURI())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(callbackServer.Close()).To(Succeed())
+	})
+
+	redirect := func(query url.Values) *http.Response {
+		callbackURL := *redirectURL
+		callbackURL.RawQuery = query.Encode()
+
+		response, err := http.Get(callbackURL.String())
+		Expect(err).ToNot(HaveOccurred())
+		return response
+	}
+
+	It("listens on a loopback address", func() {
+		Expect(redirectURL.Scheme).To(Equal("http"))
+		Expect(redirectURL.Hostname()).To(Equal("127.0.0.1"))
+		Expect(redirectURL.Port()).ToNot(BeEmpty())
+		Expect(redirectURL.Path).To(Equal("/callback"))
+	})
+
+	When("the redirect carries a code and the expected state", func() {
+		It("returns the code and tells the user to return to the terminal", func() {
+			response := redirect(url.Values{"code": {"some-code"}, "state": {"some-state"}})
+			defer response.Body.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(ContainSubstring("Login succeeded."))
+
+			code, err := callbackServer.WaitForCode(time.Second)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(code).To(Equal("some-code"))
+		})
+
+		It("ignores later redirects", func() {
+			redirect(url.Values{"code": {"some-code"}, "state": {"some-state"}}).Body.Close()
+			redirect(url.Values{"code": {"other-code"}, "state": {"some-state"}}).Body.Close()
+
+			code, err := callbackServer.WaitForCode(time.Second)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(code).To(Equal("some-code"))
+		})
+	})
+
+	When("the redirect carries a different state", func() {
+		It("returns a StateMismatchError", func() {
+			response := redirect(url.Values{"code": {"some-code"}, "state": {"other-state"}})
+			response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+			_, err := callbackServer.WaitForCode(time.Second)
+			Expect(err).To(MatchError(StateMismatchError{}))
+		})
+	})
+
+	When("the redirect carries an error", func() {
+		It("returns an AuthorizationError", func() {
+			response := redirect(url.Values{
+				"error":             {"access_denied"},
+				"error_description": {"User denied access"},
+				"state":             {"some-state"},
+			})
+			response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+			_, err := callbackServer.WaitForCode(time.Second)
+			Expect(err).To(MatchError(AuthorizationError{Code: "access_denied", Description: "User denied access"}))
+		})
+	})
+
+	When("no redirect arrives in time", func() {
+		It("returns a TimeoutError", func() {
+			_, err := callbackServer.WaitForCode(10 * time.Millisecond)
+			Expect(err).To(MatchError(TimeoutError{Timeout: 10 * time.Millisecond}))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package browserlogin
+
+import (
+	"fmt"
+	"time"
+)
+
+// AuthorizationError is returned when the login server redirects back with an
+// error instead of an authorization code, such as when the user denies access.
+type AuthorizationError struct {
+	Code        string
+	Description string
+}
+
+func (e AuthorizationError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("Browser login failed: %s", e.Code)
+	}
+	return fmt.Sprintf("Browser login failed: %s: %s", e.Code, e.Description)
+}
+
+// StateMismatchError is returned when the redirect does not carry the state
+// sent with the authorization request, so the code may not be ours.
+type StateMismatchError struct{}
+
+func (StateMismatchError) Error() string {
+	return "Browser login failed: the login response did not match the login request"
+}
+
+// TimeoutError is returned when no redirect arrives before the timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("Browser login timed out after %s", e.Timeout)
+}
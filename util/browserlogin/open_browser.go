@@ -0,0 +1,27 @@
+package browserlogin
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// OpenBrowser opens the URL in the user's default browser. It returns once the
+// browser has been asked to open the URL, not once the page has loaded.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	err := cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	go cmd.Wait() //nolint:errcheck
+	return nil
+}
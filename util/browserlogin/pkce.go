@@ -0,0 +1,53 @@
+// Package browserlogin implements the client side of the OAuth authorization
+// code grant for native apps (RFC 8252): a PKCE code verifier (RFC 7636), a
+// loopback server to receive the redirect, and opening the system browser.
+package browserlogin
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// PKCE is a proof key for a single authorization code exchange. The challenge
+// is sent with the authorization request and the verifier with the token
+// request, so that an intercepted code is useless on its own.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE returns a random verifier with its S256 challenge.
+func NewPKCE() (PKCE, error) {
+	verifier, err := randomString(32)
+	if err != nil {
+		return PKCE{}, err
+	}
+
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: S256Challenge(verifier),
+	}, nil
+}
+
+// S256Challenge returns the code challenge UAA expects for the verifier when
+// the challenge method is S256.
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// NewState returns a random value to bind an authorization request to the
+// redirect that answers it.
+func NewState() (string, error) {
+	return randomString(16)
+}
+
+func randomString(byteCount int) (string, error) {
+	randomBytes := make([]byte, byteCount)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
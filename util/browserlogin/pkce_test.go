@@ -0,0 +1,34 @@
+package browserlogin_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/browserlogin"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PKCE", func() {
+	Describe("NewPKCE", func() {
+		It("returns a URL safe verifier with its S256 challenge", func() {
+			pkce, err := NewPKCE()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pkce.Verifier).To(MatchRegexp(`^[A-Za-z0-9_-]{43}$`))
+			Expect(pkce.Challenge).To(Equal(S256Challenge(pkce.Verifier)))
+		})
+
+		It("returns a different verifier each time", func() {
+			first, err := NewPKCE()
+			Expect(err).ToNot(HaveOccurred())
+			second, err := NewPKCE()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(first.Verifier).ToNot(Equal(second.Verifier))
+		})
+	})
+
+	Describe("S256Challenge", func() {
+		It("matches the example in RFC 7636", func() {
+			Expect(S256Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")).To(Equal("E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"))
+		})
+	})
+})
@@ -44,6 +44,20 @@ type FakeSecureClient struct {
 		result1 net.Conn
 		result2 error
 	}
+	ListenStub        func(string, string) (net.Listener, error)
+	listenMutex       sync.RWMutex
+	listenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listenReturns struct {
+		result1 net.Listener
+		result2 error
+	}
+	listenReturnsOnCall map[int]struct {
+		result1 net.Listener
+		result2 error
+	}
 	NewSessionStub        func() (clissh.SecureSession, error)
 	newSessionMutex       sync.RWMutex
 	newSessionArgsForCall []struct {
@@ -238,6 +252,70 @@ func (fake *FakeSecureClient) DialReturnsOnCall(i int, result1 net.Conn, result2
 	}{result1, result2}
 }
 
+func (fake *FakeSecureClient) Listen(arg1 string, arg2 string) (net.Listener, error) {
+	fake.listenMutex.Lock()
+	ret, specificReturn := fake.listenReturnsOnCall[len(fake.listenArgsForCall)]
+	fake.listenArgsForCall = append(fake.listenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Listen", []interface{}{arg1, arg2})
+	fake.listenMutex.Unlock()
+	if fake.ListenStub != nil {
+		return fake.ListenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecureClient) ListenCallCount() int {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return len(fake.listenArgsForCall)
+}
+
+func (fake *FakeSecureClient) ListenCalls(stub func(string, string) (net.Listener, error)) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = stub
+}
+
+func (fake *FakeSecureClient) ListenArgsForCall(i int) (string, string) {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	argsForCall := fake.listenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecureClient) ListenReturns(result1 net.Listener, result2 error) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = nil
+	fake.listenReturns = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureClient) ListenReturnsOnCall(i int, result1 net.Listener, result2 error) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = nil
+	if fake.listenReturnsOnCall == nil {
+		fake.listenReturnsOnCall = make(map[int]struct {
+			result1 net.Listener
+			result2 error
+		})
+	}
+	fake.listenReturnsOnCall[i] = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) NewSession() (clissh.SecureSession, error) {
 	fake.newSessionMutex.Lock()
 	ret, specificReturn := fake.newSessionReturnsOnCall[len(fake.newSessionArgsForCall)]
@@ -354,6 +432,8 @@ func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	defer fake.connMutex.RUnlock()
 	fake.dialMutex.RLock()
 	defer fake.dialMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
 	fake.newSessionMutex.RLock()
 	defer fake.newSessionMutex.RUnlock()
 	fake.waitMutex.RLock()
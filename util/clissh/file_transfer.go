@@ -0,0 +1,329 @@
+package clissh
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	"github.com/pkg/sftp"
+)
+
+// TransferProgress is told the total number of bytes a copy will transfer
+// before it starts, and is then written every byte as it is transferred.
+type TransferProgress interface {
+	Start(totalBytes int64) io.Writer
+	Finish()
+}
+
+// Upload copies the local file, or directory when recursive, to the remote
+// path over SFTP. Like scp, when the remote path is an existing directory the
+// file is copied into it.
+func (c *SecureShell) Upload(localPath string, remotePath string, recursive bool, progress TransferProgress) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !recursive {
+		return ssherror.IsDirectoryError{Path: localPath}
+	}
+
+	var totalBytes int64
+	err = walkLocal(localPath, "", info, nil, func(_ string, entryInfo os.FileInfo) error {
+		if entryInfo.Mode().IsRegular() {
+			totalBytes += entryInfo.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.withSFTPClient(func(client *sftp.Client) error {
+		if remoteInfo, statErr := client.Stat(remotePath); statErr == nil && remoteInfo.IsDir() {
+			remotePath = path.Join(remotePath, filepath.Base(localPath))
+		}
+
+		writer := progress.Start(totalBytes)
+		defer progress.Finish()
+
+		return walkLocal(localPath, "", info, nil, func(relPath string, entryInfo os.FileInfo) error {
+			entryLocalPath := filepath.Join(localPath, filepath.FromSlash(relPath))
+			entryRemotePath := path.Join(remotePath, relPath)
+
+			if entryInfo.IsDir() {
+				return makeRemoteDir(client, entryRemotePath, entryInfo.Mode().Perm())
+			}
+			return uploadFile(client, entryLocalPath, entryInfo, entryRemotePath, writer)
+		})
+	})
+}
+
+// Download copies the remote file, or directory when recursive, to the local
+// path over SFTP. Like scp, when the local path is an existing directory the
+// file is copied into it.
+func (c *SecureShell) Download(remotePath string, localPath string, recursive bool, progress TransferProgress) error {
+	return c.withSFTPClient(func(client *sftp.Client) error {
+		info, err := client.Stat(remotePath)
+		if err != nil {
+			return remotePathError("stat", remotePath, err)
+		}
+		if info.IsDir() && !recursive {
+			return ssherror.IsDirectoryError{Path: remotePath}
+		}
+
+		if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+			localPath = filepath.Join(localPath, path.Base(remotePath))
+		}
+
+		var totalBytes int64
+		err = walkRemote(client, remotePath, "", info, nil, func(_ string, entryInfo os.FileInfo) error {
+			if entryInfo.Mode().IsRegular() {
+				totalBytes += entryInfo.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		writer := progress.Start(totalBytes)
+		defer progress.Finish()
+
+		return walkRemote(client, remotePath, "", info, nil, func(relPath string, entryInfo os.FileInfo) error {
+			entryRemotePath := path.Join(remotePath, relPath)
+			entryLocalPath := filepath.Join(localPath, filepath.FromSlash(relPath))
+
+			if entryInfo.IsDir() {
+				return os.MkdirAll(entryLocalPath, localMode(entryInfo)|0700)
+			}
+			return downloadFile(client, entryRemotePath, entryInfo, entryLocalPath, writer)
+		})
+	})
+}
+
+func (c *SecureShell) withSFTPClient(transfer func(*sftp.Client) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	in, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	out, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		return err
+	}
+
+	client, err := sftp.NewClientPipe(out, in)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return transfer(client)
+}
+
+// walkLocal calls visit with the path relative to the walk's root, separated
+// by slashes, of the local path and, for directories, every path below it.
+// Symbolic links are followed, and parents are the directories above
+// localPath, which they must not lead back to.
+func walkLocal(localPath string, relPath string, info os.FileInfo, parents []os.FileInfo, visit func(string, os.FileInfo) error) error {
+	err := visit(relPath, info)
+	if err != nil || !info.IsDir() {
+		return err
+	}
+
+	for _, parent := range parents {
+		if os.SameFile(info, parent) {
+			return ssherror.SymlinkLoopError{Path: localPath}
+		}
+	}
+	parents = append(parents, info)
+
+	entries, err := ioutil.ReadDir(localPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(localPath, entry.Name())
+		entryInfo, err := os.Stat(entryPath)
+		if err != nil {
+			return err
+		}
+
+		err = walkLocal(entryPath, path.Join(relPath, entry.Name()), entryInfo, parents, visit)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkRemote calls visit with the path relative to the walk's root of the
+// remote path and, for directories, every path below it. Symbolic links are
+// followed, and parents are the real paths of the directories above
+// remotePath, which they must not lead back to.
+func walkRemote(client *sftp.Client, remotePath string, relPath string, info os.FileInfo, parents []string, visit func(string, os.FileInfo) error) error {
+	err := visit(relPath, info)
+	if err != nil || !info.IsDir() {
+		return err
+	}
+
+	realPath, err := client.RealPath(remotePath)
+	if err != nil {
+		return remotePathError("realpath", remotePath, err)
+	}
+
+	for _, parent := range parents {
+		if parent == realPath {
+			return ssherror.SymlinkLoopError{Path: remotePath}
+		}
+	}
+	parents = append(parents, realPath)
+
+	entries, err := readRemoteDir(client, remotePath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := path.Join(remotePath, entry.Name())
+
+		// Directory listings describe links rather than the files they link to.
+		entryInfo := entry
+		if entry.Mode()&os.ModeSymlink != 0 {
+			entryInfo, err = client.Stat(entryPath)
+			if err != nil {
+				return remotePathError("stat", entryPath, err)
+			}
+		}
+
+		err = walkRemote(client, entryPath, path.Join(relPath, entry.Name()), entryInfo, parents, visit)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readRemoteDir lists the remote directory, rejecting entry names that would
+// be copied outside it, as a compromised container could return.
+func readRemoteDir(client *sftp.Client, remotePath string) ([]os.FileInfo, error) {
+	entries, err := client.ReadDir(remotePath)
+	if err != nil {
+		return nil, remotePathError("readdir", remotePath, err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/\\") {
+			return nil, ssherror.UnsafeFileNameError{Directory: remotePath, Name: name}
+		}
+	}
+	return entries, nil
+}
+
+func makeRemoteDir(client *sftp.Client, remotePath string, mode os.FileMode) error {
+	if info, err := client.Stat(remotePath); err == nil && info.IsDir() {
+		return nil
+	}
+
+	err := client.Mkdir(remotePath)
+	if err != nil {
+		return remotePathError("mkdir", remotePath, err)
+	}
+
+	return remotePathError("chmod", remotePath, client.Chmod(remotePath, mode))
+}
+
+func uploadFile(client *sftp.Client, localPath string, info os.FileInfo, remotePath string, progress io.Writer) error {
+	if !info.Mode().IsRegular() {
+		return ssherror.NotRegularFileError{Path: localPath}
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	remoteFile, err := client.OpenFile(remotePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return remotePathError("open", remotePath, err)
+	}
+
+	_, err = io.Copy(remoteFile, io.TeeReader(file, progress))
+	if err != nil {
+		_ = remoteFile.Close()
+		return err
+	}
+
+	err = remoteFile.Close()
+	if err != nil {
+		return err
+	}
+
+	return remotePathError("chmod", remotePath, client.Chmod(remotePath, info.Mode().Perm()))
+}
+
+func downloadFile(client *sftp.Client, remotePath string, info os.FileInfo, localPath string, progress io.Writer) error {
+	if !info.Mode().IsRegular() {
+		return ssherror.NotRegularFileError{Path: remotePath}
+	}
+
+	remoteFile, err := client.Open(remotePath)
+	if err != nil {
+		return remotePathError("open", remotePath, err)
+	}
+	defer remoteFile.Close()
+
+	file, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, localMode(info))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(io.MultiWriter(file, progress), remoteFile)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// localMode is the mode to create a downloaded file or directory with. SFTP
+// servers may leave permissions out of the attributes they return.
+func localMode(info os.FileInfo) os.FileMode {
+	if mode := info.Mode().Perm(); mode != 0 {
+		return mode
+	}
+	if info.IsDir() {
+		return 0755
+	}
+	return 0644
+}
+
+// remotePathError names the remote path in errors from the SFTP client, which
+// reports a missing file as a bare os.ErrNotExist.
+func remotePathError(op string, remotePath string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &os.PathError{Op: op, Path: remotePath, Err: err}
+}
@@ -0,0 +1,623 @@
+package clissh_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeTransferProgress struct {
+	totalBytes   int64
+	writtenBytes int64
+	finished     bool
+}
+
+func (p *fakeTransferProgress) Start(totalBytes int64) io.Writer {
+	p.totalBytes = totalBytes
+	return p
+}
+
+func (p *fakeTransferProgress) Write(data []byte) (int, error) {
+	p.writtenBytes += int64(len(data))
+	return len(data), nil
+}
+
+func (p *fakeTransferProgress) Finish() {
+	p.finished = true
+}
+
+// sftpTestServer describes how serveSFTP presents the files under its root.
+type sftpTestServer struct {
+	root string
+	// listedNames are returned by directory listings in place of the real
+	// names.
+	listedNames map[string]string
+	// deviceNames are the names of files reported as character devices.
+	deviceNames map[string]bool
+	// omitPermissions leaves permissions out of file attributes.
+	omitPermissions bool
+}
+
+// serveSFTP answers the SFTP requests the CLI makes from the files under
+// the server's root, standing in for the sftp subsystem of an app container.
+func serveSFTP(in io.Reader, out io.WriteCloser, server *sftpTestServer) {
+	root := server.root
+	defer out.Close()
+
+	files := map[string]*os.File{}
+	dirs := map[string][]os.FileInfo{}
+	nextHandle := 0
+
+	readUint32 := func(b []byte) (uint32, []byte) { return binary.BigEndian.Uint32(b), b[4:] }
+	readString := func(b []byte) (string, []byte) {
+		n, b := readUint32(b)
+		return string(b[:n]), b[n:]
+	}
+	appendUint32 := func(b []byte, v uint32) []byte {
+		return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	appendString := func(b []byte, s string) []byte { return append(appendUint32(b, uint32(len(s))), s...) }
+	appendAttrs := func(b []byte, info os.FileInfo) []byte {
+		perms := uint32(info.Mode().Perm()) | 0100000
+		if info.IsDir() {
+			perms = uint32(info.Mode().Perm()) | 0040000
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			perms = uint32(info.Mode().Perm()) | 0120000
+		}
+		if server.deviceNames[info.Name()] {
+			perms = uint32(info.Mode().Perm()) | 0020000
+		}
+		if server.omitPermissions {
+			b = appendUint32(b, 0x1)
+			return appendUint32(appendUint32(b, uint32(info.Size()>>32)), uint32(info.Size()))
+		}
+		b = appendUint32(b, 0x1|0x4)
+		b = appendUint32(appendUint32(b, uint32(info.Size()>>32)), uint32(info.Size()))
+		return appendUint32(b, perms)
+	}
+	send := func(packetType byte, id uint32, payload []byte) {
+		packet := appendUint32(nil, uint32(len(payload)+5))
+		packet = append(packet, packetType)
+		packet = appendUint32(packet, id)
+		_, _ = out.Write(append(packet, payload...))
+	}
+	status := func(id uint32, code uint32, message string) {
+		send(101, id, appendString(appendString(appendUint32(nil, code), message), ""))
+	}
+	errStatus := func(id uint32, err error) {
+		if os.IsNotExist(err) {
+			status(id, 2, "No such file")
+			return
+		}
+		status(id, 4, err.Error())
+	}
+	newHandle := func() string {
+		nextHandle++
+		return strconv.Itoa(nextHandle)
+	}
+
+	for {
+		header := make([]byte, 5)
+		if _, err := io.ReadFull(in, header); err != nil {
+			return
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header)-1)
+		if _, err := io.ReadFull(in, payload); err != nil {
+			return
+		}
+
+		if header[4] == 1 {
+			packet := appendUint32(nil, 5)
+			packet = append(packet, 2)
+			_, _ = out.Write(appendUint32(packet, 3))
+			continue
+		}
+
+		id, payload := readUint32(payload)
+		switch header[4] {
+		case 17: // stat
+			name, _ := readString(payload)
+			info, err := os.Stat(filepath.Join(root, name))
+			if err != nil {
+				errStatus(id, err)
+				continue
+			}
+			send(105, id, appendAttrs(nil, info))
+		case 16: // realpath
+			name, _ := readString(payload)
+			realRoot, _ := filepath.EvalSymlinks(root)
+			realPath, err := filepath.EvalSymlinks(filepath.Join(root, name))
+			if err != nil {
+				errStatus(id, err)
+				continue
+			}
+			relPath, _ := filepath.Rel(realRoot, realPath)
+			response := appendUint32(nil, 1)
+			response = appendString(response, "/"+filepath.ToSlash(relPath))
+			response = appendString(response, "")
+			send(104, id, appendUint32(response, 0))
+		case 3: // open
+			name, rest := readString(payload)
+			flags, _ := readUint32(rest)
+			osFlags := os.O_RDONLY
+			if flags&0x2 != 0 {
+				osFlags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+			}
+			file, err := os.OpenFile(filepath.Join(root, name), osFlags, 0600)
+			if err != nil {
+				errStatus(id, err)
+				continue
+			}
+			handle := newHandle()
+			files[handle] = file
+			send(102, id, appendString(nil, handle))
+		case 4: // close
+			handle, _ := readString(payload)
+			if file, ok := files[handle]; ok {
+				file.Close()
+				delete(files, handle)
+			}
+			delete(dirs, handle)
+			status(id, 0, "")
+		case 5: // read
+			handle, rest := readString(payload)
+			offset := binary.BigEndian.Uint64(rest)
+			length, _ := readUint32(rest[8:])
+			data := make([]byte, length)
+			n, err := files[handle].ReadAt(data, int64(offset))
+			if n == 0 && err == io.EOF {
+				status(id, 1, "EOF")
+				continue
+			}
+			send(103, id, appendString(nil, string(data[:n])))
+		case 6: // write
+			handle, rest := readString(payload)
+			offset := binary.BigEndian.Uint64(rest)
+			data, _ := readString(rest[8:])
+			if _, err := files[handle].WriteAt([]byte(data), int64(offset)); err != nil {
+				errStatus(id, err)
+				continue
+			}
+			status(id, 0, "")
+		case 14: // mkdir
+			name, _ := readString(payload)
+			if err := os.Mkdir(filepath.Join(root, name), 0700); err != nil {
+				errStatus(id, err)
+				continue
+			}
+			status(id, 0, "")
+		case 9: // setstat
+			name, rest := readString(payload)
+			flags, rest := readUint32(rest)
+			if flags&0x1 != 0 {
+				rest = rest[8:]
+			}
+			if flags&0x2 != 0 {
+				rest = rest[8:]
+			}
+			if flags&0x4 != 0 {
+				perms, _ := readUint32(rest)
+				if err := os.Chmod(filepath.Join(root, name), os.FileMode(perms&0777)); err != nil {
+					errStatus(id, err)
+					continue
+				}
+			}
+			status(id, 0, "")
+		case 11: // opendir
+			name, _ := readString(payload)
+			entries, err := ioutil.ReadDir(filepath.Join(root, name))
+			if err != nil {
+				errStatus(id, err)
+				continue
+			}
+			handle := newHandle()
+			dirs[handle] = entries
+			send(102, id, appendString(nil, handle))
+		case 12: // readdir
+			handle, _ := readString(payload)
+			entries := dirs[handle]
+			if len(entries) == 0 {
+				status(id, 1, "EOF")
+				continue
+			}
+			response := appendUint32(nil, uint32(len(entries)))
+			for _, entry := range entries {
+				name := entry.Name()
+				if listedName, ok := server.listedNames[name]; ok {
+					name = listedName
+				}
+				response = appendString(response, name)
+				response = appendString(response, name)
+				response = appendAttrs(response, entry)
+			}
+			dirs[handle] = nil
+			send(104, id, response)
+		default:
+			status(id, 8, "unsupported")
+		}
+	}
+}
+
+var _ = Describe("File transfer", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeSecureSession *clisshfakes.FakeSecureSession
+		secureShell       *SecureShell
+
+		localDir   string
+		remoteDir  string
+		sftpServer *sftpTestServer
+		progress   *fakeTransferProgress
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+
+		serverIn, clientStdin := io.Pipe()
+		clientStdout, serverOut := io.Pipe()
+		fakeSecureSession.StdinPipeReturns(clientStdin, nil)
+		fakeSecureSession.StdoutPipeReturns(clientStdout, nil)
+		fakeSecureSession.CloseStub = func() error {
+			return clientStdin.Close()
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "cli-scp-local")
+		Expect(err).ToNot(HaveOccurred())
+		remoteDir, err = ioutil.TempDir("", "cli-scp-remote")
+		Expect(err).ToNot(HaveOccurred())
+
+		sftpServer = &sftpTestServer{
+			root:        remoteDir,
+			listedNames: map[string]string{},
+			deviceNames: map[string]bool{},
+		}
+		go serveSFTP(serverIn, serverOut, sftpServer)
+
+		progress = new(fakeTransferProgress)
+
+		secureShell = NewSecureShell(fakeSecureDialer, new(clisshfakes.FakeTerminalHelper), new(clisshfakes.FakeListenerFactory), DefaultKeepAliveInterval)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+	})
+
+	Describe("Upload", func() {
+		var (
+			localPath  string
+			remotePath string
+			recursive  bool
+			uploadErr  error
+		)
+
+		BeforeEach(func() {
+			recursive = false
+			localPath = filepath.Join(localDir, "config.yml")
+			remotePath = "/config.yml"
+			Expect(ioutil.WriteFile(localPath, []byte("some-config"), 0600)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			uploadErr = secureShell.Upload(localPath, remotePath, recursive, progress)
+		})
+
+		It("requests the sftp subsystem", func() {
+			Expect(uploadErr).ToNot(HaveOccurred())
+			Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("copies the file and reports its progress", func() {
+			Expect(uploadErr).ToNot(HaveOccurred())
+			Expect(ioutil.ReadFile(filepath.Join(remoteDir, "config.yml"))).To(Equal([]byte("some-config")))
+
+			Expect(progress.totalBytes).To(BeEquivalentTo(len("some-config")))
+			Expect(progress.writtenBytes).To(BeEquivalentTo(len("some-config")))
+			Expect(progress.finished).To(BeTrue())
+		})
+
+		When("the local file has permissions", func() {
+			BeforeEach(func() {
+				if runtime.GOOS == "windows" {
+					Skip("Windows does not have Unix file permissions")
+				}
+				Expect(os.Chmod(localPath, 0640)).To(Succeed())
+			})
+
+			It("gives the remote file the same permissions", func() {
+				Expect(uploadErr).ToNot(HaveOccurred())
+				info, err := os.Stat(filepath.Join(remoteDir, "config.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+			})
+		})
+
+		When("the remote path is a directory", func() {
+			BeforeEach(func() {
+				Expect(os.Mkdir(filepath.Join(remoteDir, "tmp"), 0700)).To(Succeed())
+				remotePath = "/tmp"
+			})
+
+			It("copies the file into the directory", func() {
+				Expect(uploadErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(remoteDir, "tmp", "config.yml"))).To(Equal([]byte("some-config")))
+			})
+		})
+
+		When("the local path is a directory", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "dir")
+				remotePath = "/dir"
+				Expect(os.MkdirAll(filepath.Join(localPath, "nested"), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(localPath, "a.txt"), []byte("aaa"), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(localPath, "nested", "b.txt"), []byte("bbbbb"), 0600)).To(Succeed())
+			})
+
+			When("copying recursively", func() {
+				BeforeEach(func() {
+					recursive = true
+				})
+
+				It("copies the whole tree", func() {
+					Expect(uploadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(remoteDir, "dir", "a.txt"))).To(Equal([]byte("aaa")))
+					Expect(ioutil.ReadFile(filepath.Join(remoteDir, "dir", "nested", "b.txt"))).To(Equal([]byte("bbbbb")))
+					Expect(progress.totalBytes).To(BeEquivalentTo(8))
+					Expect(progress.writtenBytes).To(BeEquivalentTo(8))
+				})
+			})
+
+			When("it links to a file", func() {
+				BeforeEach(func() {
+					recursive = true
+					Expect(os.Symlink("a.txt", filepath.Join(localPath, "link.txt"))).To(Succeed())
+				})
+
+				It("copies and counts the linked file", func() {
+					Expect(uploadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(remoteDir, "dir", "link.txt"))).To(Equal([]byte("aaa")))
+					Expect(progress.totalBytes).To(BeEquivalentTo(11))
+					Expect(progress.writtenBytes).To(BeEquivalentTo(11))
+				})
+			})
+
+			When("it links to one of its parents", func() {
+				BeforeEach(func() {
+					recursive = true
+					Expect(os.Symlink("..", filepath.Join(localPath, "nested", "loop"))).To(Succeed())
+				})
+
+				It("returns a SymlinkLoopError", func() {
+					Expect(uploadErr).To(MatchError(ssherror.SymlinkLoopError{Path: filepath.Join(localPath, "nested", "loop")}))
+				})
+			})
+
+			When("not copying recursively", func() {
+				It("returns an IsDirectoryError without copying", func() {
+					Expect(uploadErr).To(MatchError(ssherror.IsDirectoryError{Path: localPath}))
+					Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the remote directory does not exist", func() {
+			BeforeEach(func() {
+				remotePath = "/missing/config.yml"
+			})
+
+			It("returns an error naming the remote path", func() {
+				Expect(uploadErr).To(MatchError("open /missing/config.yml: file does not exist"))
+				Expect(os.IsNotExist(uploadErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		var (
+			remotePath  string
+			localPath   string
+			recursive   bool
+			downloadErr error
+		)
+
+		BeforeEach(func() {
+			recursive = false
+			remotePath = "/heap.hprof"
+			localPath = filepath.Join(localDir, "heap.hprof")
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "heap.hprof"), make([]byte, 100*1024), 0600)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			downloadErr = secureShell.Download(remotePath, localPath, recursive, progress)
+		})
+
+		It("copies the file in chunks and reports its progress", func() {
+			Expect(downloadErr).ToNot(HaveOccurred())
+			Expect(ioutil.ReadFile(localPath)).To(HaveLen(100 * 1024))
+
+			Expect(progress.totalBytes).To(BeEquivalentTo(100 * 1024))
+			Expect(progress.writtenBytes).To(BeEquivalentTo(100 * 1024))
+			Expect(progress.finished).To(BeTrue())
+		})
+
+		When("the remote file is not a regular file", func() {
+			BeforeEach(func() {
+				sftpServer.deviceNames["heap.hprof"] = true
+			})
+
+			It("returns a NotRegularFileError without creating the local file", func() {
+				Expect(downloadErr).To(MatchError(ssherror.NotRegularFileError{Path: "/heap.hprof"}))
+				Expect(localPath).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the container leaves permissions out of file attributes", func() {
+			BeforeEach(func() {
+				if runtime.GOOS == "windows" {
+					Skip("Windows does not have Unix file permissions")
+				}
+				sftpServer.omitPermissions = true
+			})
+
+			It("creates the local file with default permissions", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				info, err := os.Stat(localPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0644)))
+			})
+		})
+
+		When("the local path is a directory", func() {
+			BeforeEach(func() {
+				localPath = localDir
+			})
+
+			It("copies the file into the directory", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(localDir, "heap.hprof"))).To(HaveLen(100 * 1024))
+			})
+		})
+
+		When("the remote path is a directory", func() {
+			BeforeEach(func() {
+				remotePath = "/logs"
+				localPath = filepath.Join(localDir, "logs")
+				Expect(os.MkdirAll(filepath.Join(remoteDir, "logs", "old"), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "app.log"), []byte("line"), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "old", "app.log.1"), []byte("older"), 0600)).To(Succeed())
+			})
+
+			When("copying recursively", func() {
+				BeforeEach(func() {
+					recursive = true
+				})
+
+				It("copies the whole tree", func() {
+					Expect(downloadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "app.log"))).To(Equal([]byte("line")))
+					Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "old", "app.log.1"))).To(Equal([]byte("older")))
+					Expect(progress.totalBytes).To(BeEquivalentTo(9))
+				})
+			})
+
+			When("it links to another directory", func() {
+				BeforeEach(func() {
+					recursive = true
+					Expect(os.Symlink("old", filepath.Join(remoteDir, "logs", "current"))).To(Succeed())
+				})
+
+				It("copies the linked directory", func() {
+					Expect(downloadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "current", "app.log.1"))).To(Equal([]byte("older")))
+				})
+			})
+
+			When("it links to one of its parents", func() {
+				BeforeEach(func() {
+					recursive = true
+					Expect(os.Symlink("..", filepath.Join(remoteDir, "logs", "old", "loop"))).To(Succeed())
+				})
+
+				It("returns a SymlinkLoopError", func() {
+					Expect(downloadErr).To(MatchError(ssherror.SymlinkLoopError{Path: "/logs/old/loop"}))
+				})
+			})
+
+			for listedName, unsafeName := range map[string]string{"": ".", `nested\outside`: `nested\outside`} {
+				listedName, unsafeName := listedName, unsafeName
+
+				When(fmt.Sprintf("the container lists the unsafe name %q", listedName), func() {
+					BeforeEach(func() {
+						recursive = true
+						sftpServer.listedNames["app.log"] = listedName
+					})
+
+					It("returns an UnsafeFileNameError without copying anything", func() {
+						Expect(downloadErr).To(MatchError(ssherror.UnsafeFileNameError{Directory: "/logs", Name: unsafeName}))
+						Expect(filepath.Join(localDir, "logs")).ToNot(BeADirectory())
+						Expect(filepath.Join(filepath.Dir(localDir), "outside")).ToNot(BeAnExistingFile())
+					})
+				})
+			}
+
+			for _, listedName := range []string{"../../outside", "nested/outside"} {
+				listedName := listedName
+
+				When(fmt.Sprintf("the container lists the name %q", listedName), func() {
+					BeforeEach(func() {
+						recursive = true
+						sftpServer.listedNames["app.log"] = listedName
+					})
+
+					It("only copies within the destination directory", func() {
+						Expect(downloadErr).To(MatchError("open /logs/outside: file does not exist"))
+						Expect(filepath.Join(filepath.Dir(localDir), "outside")).ToNot(BeAnExistingFile())
+						Expect(filepath.Join(localDir, "nested", "outside")).ToNot(BeAnExistingFile())
+					})
+				})
+			}
+
+			When("the container lists another file as \"..\"", func() {
+				BeforeEach(func() {
+					recursive = true
+					sftpServer.listedNames["app.log"] = ".."
+				})
+
+				It("skips it like the directory's own \"..\" entry", func() {
+					Expect(downloadErr).ToNot(HaveOccurred())
+					Expect(filepath.Join(localDir, "logs", "old", "app.log.1")).To(BeAnExistingFile())
+					Expect(filepath.Join(localDir, "logs", "app.log")).ToNot(BeAnExistingFile())
+				})
+			})
+
+			When("not copying recursively", func() {
+				It("returns an IsDirectoryError", func() {
+					Expect(downloadErr).To(MatchError(ssherror.IsDirectoryError{Path: "/logs"}))
+					Expect(progress.finished).To(BeFalse())
+				})
+			})
+		})
+
+		When("the remote file does not exist", func() {
+			BeforeEach(func() {
+				remotePath = "/missing"
+			})
+
+			It("returns an error naming the remote path", func() {
+				Expect(downloadErr).To(MatchError("stat /missing: file does not exist"))
+				Expect(os.IsNotExist(downloadErr)).To(BeTrue())
+			})
+		})
+
+		When("requesting the sftp subsystem fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.RequestSubsystemReturns(io.ErrUnexpectedEOF)
+			})
+
+			It("returns the error", func() {
+				Expect(downloadErr).To(MatchError(io.ErrUnexpectedEOF))
+			})
+		})
+	})
+})
@@ -37,13 +37,20 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+type dialFunc func(network string, address string) (net.Conn, error)
+
 type SecureShell struct {
 	secureDialer    SecureDialer
 	secureClient    SecureClient
 	terminalHelper  TerminalHelper
 	listenerFactory ListenerFactory
 
-	localListeners    []net.Listener
+	listeners         []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -56,7 +63,7 @@ func NewDefaultSecureShell() *SecureShell {
 		terminalHelper:    defaultTerminalHelper,
 		listenerFactory:   defaultListenerFactory,
 		keepAliveInterval: DefaultKeepAliveInterval,
-		localListeners:    []net.Listener{},
+		listeners:         []net.Listener{},
 	}
 }
 
@@ -71,12 +78,12 @@ func NewSecureShell(
 		terminalHelper:    terminalHelper,
 		listenerFactory:   listenerFactory,
 		keepAliveInterval: keepAliveInterval,
-		localListeners:    []net.Listener{},
+		listeners:         []net.Listener{},
 	}
 }
 
 func (c *SecureShell) Close() error {
-	for _, listener := range c.localListeners {
+	for _, listener := range c.listeners {
 		listener.Close()
 	}
 	return c.secureClient.Close()
@@ -205,15 +212,93 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// RunCommand runs the commands in a session without a terminal or stdin,
+// copying the session's output to stdout and stderr, and returns their exit
+// status. An error is only returned when the commands could not be run to
+// completion.
+func (c *SecureShell) RunCommand(commands []string, stdout io.Writer, stderr io.Writer) (int, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return 0, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return 0, err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	err = session.Wait()
+	wg.Wait()
+
+	if exitErr, ok := err.(*ssh.ExitError); ok {
+		return exitErr.ExitStatus(), nil
+	}
+	return 0, err
+}
+
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
 		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
+		c.listeners = append(c.listeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.secureClient.Dial, spec.RemoteAddress)
+	}
+
+	return nil
+}
+
+// RemotePortForward listens on each remote address inside the app container
+// and forwards the connections made to it to the local address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
+
+		go c.forwardAcceptLoop(listener, net.Dial, spec.LocalAddress)
+	}
+
+	return nil
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on each local address that connects
+// to the requested destinations from inside the app container.
+func (c *SecureShell) DynamicPortForward(localAddresses []string) error {
+	for _, address := range localAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
 
-		go c.localForwardAcceptLoop(listener, spec.RemoteAddress)
+		go c.acceptLoop(listener, c.handleSOCKSConnection)
 	}
 
 	return nil
@@ -228,6 +313,29 @@ func (c *SecureShell) Wait() error {
 	return c.secureClient.Wait()
 }
 
+func (c *SecureShell) acceptLoop(listener net.Listener, handleConnection func(net.Conn)) {
+	defer listener.Close()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return
+		}
+
+		go handleConnection(conn)
+	}
+}
+
+func (c *SecureShell) forwardAcceptLoop(listener net.Listener, dial dialFunc, addr string) {
+	c.acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, dial, addr)
+	})
+}
+
 func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height int) {
 	winSize, err := c.terminalHelper.GetWinsize(terminalFd)
 	if err != nil {
@@ -240,39 +348,42 @@ func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 	return int(winSize.Width), int(winSize.Height)
 }
 
-func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *SecureShell) handleForwardConnection(conn net.Conn, dial dialFunc, targetAddr string) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial("tcp", targetAddr)
 	if err != nil {
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
-
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
+	copyBetween(conn, target)
 }
 
-func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
-	defer listener.Close()
+func (c *SecureShell) handleSOCKSConnection(conn net.Conn) {
+	defer conn.Close()
 
-	for {
-		conn, err := listener.Accept()
-		if err != nil {
-			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
-				time.Sleep(100 * time.Millisecond)
-				continue
-			}
-			return
-		}
+	targetAddr, err := readSOCKSRequest(conn)
+	if err != nil {
+		fmt.Printf("SOCKS request failed: %s\n", err.Error())
+		return
+	}
 
-		go c.handleForwardConnection(conn, addr)
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = writeSOCKSReply(conn, socksReplyHostUnreachable)
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
 	}
+	defer target.Close()
+
+	err = writeSOCKSReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	copyBetween(conn, target)
 }
 
 func (c *SecureShell) resize(resized <-chan os.Signal, session SecureSession, terminalFd uintptr) {
@@ -335,6 +446,15 @@ func base64Sha256Fingerprint(key ssh.PublicKey) string {
 	return base64.RawStdEncoding.EncodeToString(sum[:])
 }
 
+func copyBetween(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
 func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	_, err := io.Copy(dest, src)
 	if err != nil {
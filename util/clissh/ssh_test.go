@@ -30,9 +30,23 @@ import (
 	"github.com/moby/moby/pkg/term"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
+func fakeReadOnce(output string) func([]byte) (int, error) {
+	var once sync.Once
+	return func(p []byte) (int, error) {
+		n := 0
+		once.Do(func() { n = copy(p, output) })
+		if n == 0 {
+			return 0, io.EOF
+		}
+		return n, nil
+	}
+}
+
 func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	waitUntilClosed := make(chan bool)
 	fake.AcceptStub = func() (net.Conn, error) {
@@ -174,6 +188,312 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			echoAddress   string
+			remoteAddress string
+
+			realRemoteListener net.Listener
+			forwardSpecs       []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			realRemoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			remoteAddress = realRemoteListener.Addr().String()
+			fakeSecureClient.ListenReturns(realRemoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:8080",
+				LocalAddress:  echoAddress,
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			echoListener.Close()
+			realRemoteListener.Close()
+		})
+
+		It("listens on the remote address through the secure client", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:8080"))
+		})
+
+		It("copies data between the remote connection and the local address", func() {
+			conn, err := net.Dial("tcp", remoteAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the container\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+		})
+
+		When("the secure client is closed", func() {
+			var fakeRemoteListener *fake_net.FakeListener
+
+			BeforeEach(func() {
+				fakeRemoteListener = new(fake_net.FakeListener)
+				BlockAcceptOnClose(fakeRemoteListener)
+				fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+			})
+
+			It("closes the remote listener", func() {
+				Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+				err := secureShell.Close()
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(fakeRemoteListener.CloseCallCount).Should(Equal(2))
+			})
+		})
+
+		When("listening on the remote address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener net.Listener
+			echoAddress  string
+			socksAddress string
+
+			realLocalListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			realLocalListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			socksAddress = realLocalListener.Addr().String()
+			fakeListenerFactory.ListenReturns(realLocalListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]string{"localhost:1080"})
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			echoListener.Close()
+			realLocalListener.Close()
+		})
+
+		socksDial := func(addr string) (net.Conn, error) {
+			dialer, err := proxy.SOCKS5("tcp", socksAddress, nil, proxy.Direct)
+			Expect(err).NotTo(HaveOccurred())
+			return dialer.Dial("tcp", addr)
+		}
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the address requested by the SOCKS client through the secure client", func() {
+			conn, err := socksDial(echoAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoAddress))
+
+			msg := "Hello through SOCKS\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		When("the SOCKS client requests a domain name", func() {
+			It("dials the domain name from inside the container", func() {
+				_, port, err := net.SplitHostPort(echoAddress)
+				Expect(err).NotTo(HaveOccurred())
+
+				conn, err := socksDial(net.JoinHostPort("localhost", port))
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				_, addr := fakeSecureClient.DialArgsForCall(0)
+				Expect(addr).To(Equal(net.JoinHostPort("localhost", port)))
+			})
+		})
+
+		When("dialing the requested address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("boom"))
+			})
+
+			It("reports the failure to the SOCKS client", func() {
+				_, err := socksDial("10.0.0.1:8080")
+				Expect(err).To(MatchError(ContainSubstring("host unreachable")))
+			})
+		})
+
+		When("listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
+	Describe("RunCommand", func() {
+		var (
+			stdout, stderr *gbytes.Buffer
+			exitStatus     int
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			stdout = gbytes.NewBuffer()
+			stderr = gbytes.NewBuffer()
+			commands = []string{"jcmd", "1", "Thread.print"}
+
+			stdoutPipe.ReadStub = fakeReadOnce("some-output\n")
+			stderrPipe.ReadStub = fakeReadOnce("some-error-output\n")
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+			exitStatus, runErr = secureShell.RunCommand(commands, stdout, stderr)
+		})
+
+		It("runs the command without a terminal and copies its output", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(exitStatus).To(Equal(0))
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("jcmd 1 Thread.print"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+
+			Expect(string(stdout.Contents())).To(Equal("some-output\n"))
+			Expect(string(stderr.Contents())).To(Equal("some-error-output\n"))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the session ends without the command completing", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("some-wait-error"))
+			})
+
+			It("returns the session's error", func() {
+				Expect(runErr).To(MatchError("some-wait-error"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("some-start-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("some-start-error"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("some-session-error"))
+			})
+
+			It("returns an error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: some-session-error"))
+			})
+		})
+	})
+
 	Describe("InteractiveSession", func() {
 		var (
 			stdin          *fake_io.FakeReadCloser
@@ -185,6 +505,9 @@ var _ = Describe("CLI SSH", func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -0,0 +1,11 @@
+package ssherror
+
+// IsDirectoryError is returned when a directory is copied without asking for
+// a recursive copy.
+type IsDirectoryError struct {
+	Path string
+}
+
+func (e IsDirectoryError) Error() string {
+	return e.Path + " is a directory"
+}
@@ -0,0 +1,11 @@
+package ssherror
+
+// NotRegularFileError is returned when a path to be copied is neither a
+// regular file nor a directory, such as a device, socket or named pipe.
+type NotRegularFileError struct {
+	Path string
+}
+
+func (e NotRegularFileError) Error() string {
+	return e.Path + " is not a regular file"
+}
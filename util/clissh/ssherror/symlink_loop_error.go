@@ -0,0 +1,11 @@
+package ssherror
+
+// SymlinkLoopError is returned when a recursive copy reaches a directory it
+// is already copying, through a symbolic link to one of its parents.
+type SymlinkLoopError struct {
+	Path string
+}
+
+func (e SymlinkLoopError) Error() string {
+	return e.Path + " links to a directory that is already being copied"
+}
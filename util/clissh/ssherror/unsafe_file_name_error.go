@@ -0,0 +1,14 @@
+package ssherror
+
+import "fmt"
+
+// UnsafeFileNameError is returned when the app container lists a directory
+// entry whose name would be copied outside the destination directory.
+type UnsafeFileNameError struct {
+	Directory string
+	Name      string
+}
+
+func (e UnsafeFileNameError) Error() string {
+	return fmt.Sprintf("refusing to copy %q from %s: the name is not a plain file name", e.Name, e.Directory)
+}
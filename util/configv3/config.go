@@ -24,6 +24,21 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// persistedProfile is the config file's current profile when a different
+	// profile is used through --profile or $CF_PROFILE.
+	persistedProfile string
+
+	// tokenStore keeps the credentials out of the config file when one is
+	// configured, and storedCredentials are the credentials of each profile
+	// it held when they were last loaded or stored.
+	tokenStore        TokenStore
+	storedCredentials map[string]Credentials
+
+	// loadedConfigFile is the config file as it was loaded or last written,
+	// which WriteConfig compares against to find the settings this process
+	// changed.
+	loadedConfigFile *JSONConfig
 }
 
 // BinaryVersion is the current version of the CF binary.
@@ -0,0 +1,43 @@
+package configv3
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// ConfigLockFileName is the name of the file in the .cf directory that cf
+// processes lock while they change the config file.
+const ConfigLockFileName = "config.lock"
+
+// lockConfig blocks until this process holds the advisory lock on the .cf
+// directory, which serializes config file changes between cf processes that
+// share it. The returned function releases the lock.
+func lockConfig() (func(), error) {
+	return LockConfigDirectory(configDirectory())
+}
+
+// LockConfigDirectory blocks until this process holds the advisory lock on
+// the given config directory. It lets the legacy config code take the same
+// lock as WriteConfig. The returned function releases the lock.
+func LockConfigDirectory(dir string) (func(), error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	lockFile, err := os.OpenFile(filepath.Join(dir, ConfigLockFileName), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lockFileExclusive(lockFile)
+	if err != nil {
+		lockFile.Close()
+		return nil, err
+	}
+
+	return func() {
+		_ = unlockFile(lockFile)
+		lockFile.Close()
+	}, nil
+}
@@ -0,0 +1,123 @@
+package configv3
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// mergeConfigChanges applies the settings that changed from loaded to current
+// to the config file on disk, leaving the settings changed by other cf
+// processes in place. Profiles are merged setting by setting regardless of
+// which one is in use, and profiles added or deleted in current are added to
+// or deleted from the config file.
+func mergeConfigChanges(onDisk JSONConfig, loaded JSONConfig, current JSONConfig) JSONConfig {
+	merged := onDisk.withAllProfiles()
+	mergeChanges(
+		reflect.ValueOf(&merged).Elem(),
+		reflect.ValueOf(loaded.withAllProfiles()),
+		reflect.ValueOf(current.withAllProfiles()),
+	)
+	return merged.withCurrentProfile()
+}
+
+// mergeChanges sets the parts of onDisk that differ between loaded and
+// current to their current value. Structs and maps are merged field by field
+// and key by key; everything else is replaced as a whole.
+func mergeChanges(onDisk reflect.Value, loaded reflect.Value, current reflect.Value) {
+	switch current.Kind() {
+	case reflect.Struct:
+		for i := 0; i < current.NumField(); i++ {
+			mergeChanges(onDisk.Field(i), loaded.Field(i), current.Field(i))
+		}
+	case reflect.Map:
+		if onDisk.IsNil() {
+			onDisk.Set(reflect.MakeMap(onDisk.Type()))
+		}
+
+		for _, key := range loaded.MapKeys() {
+			if !current.MapIndex(key).IsValid() {
+				onDisk.SetMapIndex(key, reflect.Value{})
+			}
+		}
+
+		for _, key := range current.MapKeys() {
+			currentValue := current.MapIndex(key)
+			loadedValue := loaded.MapIndex(key)
+			diskValue := onDisk.MapIndex(key)
+
+			switch {
+			case !loadedValue.IsValid():
+				onDisk.SetMapIndex(key, currentValue)
+			case !diskValue.IsValid():
+				// Deleted by another process; only bring it back if it was
+				// changed here.
+				if !reflect.DeepEqual(loadedValue.Interface(), currentValue.Interface()) {
+					onDisk.SetMapIndex(key, currentValue)
+				}
+			default:
+				mergedValue := reflect.New(diskValue.Type()).Elem()
+				mergedValue.Set(diskValue)
+				mergeChanges(mergedValue, loadedValue, currentValue)
+				onDisk.SetMapIndex(key, mergedValue)
+			}
+		}
+	default:
+		if !reflect.DeepEqual(loaded.Interface(), current.Interface()) {
+			onDisk.Set(current)
+		}
+	}
+}
+
+// clone returns a deep copy of the config file, so that later changes to the
+// config do not change the copy. The profiles are not part of the config
+// file's JSON, so they are copied separately.
+func (jsonConfig JSONConfig) clone() (*JSONConfig, error) {
+	rawConfig, err := json.Marshal(jsonConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var clone JSONConfig
+	err = json.Unmarshal(rawConfig, &clone)
+	if err != nil {
+		return nil, err
+	}
+
+	clone.CurrentProfile = jsonConfig.CurrentProfile
+	if jsonConfig.Profiles != nil {
+		clone.Profiles = make(map[string]Profile, len(jsonConfig.Profiles))
+		for name, profile := range jsonConfig.Profiles {
+			clone.Profiles[name] = profile
+		}
+	}
+	return &clone, nil
+}
+
+// withAllProfiles returns a copy of the config file with the settings of the
+// profile in use moved under Profiles.
+func (jsonConfig JSONConfig) withAllProfiles() JSONConfig {
+	profiles := make(map[string]Profile, len(jsonConfig.Profiles)+1)
+	for name, profile := range jsonConfig.Profiles {
+		profiles[name] = profile
+	}
+	profiles[jsonConfig.CurrentProfile] = jsonConfig.profile()
+
+	jsonConfig.setProfile(Profile{})
+	jsonConfig.Profiles = profiles
+	return jsonConfig
+}
+
+// withCurrentProfile undoes withAllProfiles, moving the settings of the
+// profile in use back to the top level.
+func (jsonConfig JSONConfig) withCurrentProfile() JSONConfig {
+	profiles := make(map[string]Profile, len(jsonConfig.Profiles))
+	for name, profile := range jsonConfig.Profiles {
+		if name != jsonConfig.CurrentProfile {
+			profiles[name] = profile
+		}
+	}
+
+	jsonConfig.setProfile(jsonConfig.Profiles[jsonConfig.CurrentProfile])
+	jsonConfig.Profiles = profiles
+	return jsonConfig
+}
@@ -1,11 +1,13 @@
 package configv3_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"code.cloudfoundry.org/cli/integration/helpers"
+	. "code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -43,6 +45,27 @@ func setConfig(homeDir string, rawConfig string) {
 	helpers.SetConfigContent(filepath.Join(homeDir, ".cf"), rawConfig)
 }
 
+func setProfiles(homeDir string, rawProfiles string) {
+	err := ioutil.WriteFile(filepath.Join(homeDir, ".cf", ProfilesFileName), []byte(rawProfiles), 0600)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+// readWrittenConfig returns the config file written to homeDir, with the
+// profiles from the profiles file next to it.
+func readWrittenConfig(homeDir string) JSONConfig {
+	rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+	Expect(err).ToNot(HaveOccurred())
+
+	var configFile JSONConfig
+	Expect(json.Unmarshal(rawConfig, &configFile)).To(Succeed())
+
+	profilesFile, err := ReadProfilesFile(filepath.Join(homeDir, ".cf"))
+	Expect(err).ToNot(HaveOccurred())
+	configFile.CurrentProfile = profilesFile.CurrentProfile
+	configFile.Profiles = profilesFile.Profiles
+	return configFile
+}
+
 func setPluginConfig(pluginDir string, rawConfig string) {
 	helpers.SetConfigContent(pluginDir, rawConfig)
 }
@@ -0,0 +1,144 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	credentialHelperPrefix = "cf-credential-"
+
+	credentialHelperGet   = "get"
+	credentialHelperStore = "store"
+	credentialHelperErase = "erase"
+)
+
+// credentialHelperRequest is written to the credential helper's stdin. The
+// credentials are only set for the store action.
+type credentialHelperRequest struct {
+	Profile string `json:"Profile"`
+	*Credentials
+}
+
+// CredentialHelperTokenStore keeps the credentials in an external program,
+// in the same way as git and docker credential helpers.
+//
+// The program is cf-credential-<name>, found on the PATH, run with one of the
+// actions get, store or erase as its only argument. A JSON object with the
+// profile name is written to its stdin:
+//
+//   {"Profile": "default"}
+//
+// For store the object also holds the AccessToken, RefreshToken and
+// UAAOAuthClientSecret to keep. For get the program writes a JSON object with
+// those three keys to its stdout, or nothing if it has no credentials for the
+// profile. A non-zero exit status is a failure, and the program's stderr is
+// shown to the user.
+type CredentialHelperTokenStore struct {
+	program string
+}
+
+// NewCredentialHelperTokenStore returns a token store that runs the
+// credential helper with the given name. The name must not contain path
+// separators, so that only programs named cf-credential-<name> on the PATH
+// are run.
+func NewCredentialHelperTokenStore(name string) (*CredentialHelperTokenStore, error) {
+	if strings.ContainsAny(name, `/\`) {
+		return nil, InvalidCredentialHelperError{Name: name}
+	}
+
+	return &CredentialHelperTokenStore{
+		program: credentialHelperPrefix + name,
+	}, nil
+}
+
+// Get asks the credential helper for the credentials of the profile.
+func (store *CredentialHelperTokenStore) Get(profile string) (Credentials, error) {
+	output, err := store.run(credentialHelperGet, credentialHelperRequest{Profile: profile})
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	if len(bytes.TrimSpace(output)) == 0 {
+		return credentials, nil
+	}
+
+	err = json.Unmarshal(output, &credentials)
+	if err != nil {
+		return Credentials{}, CredentialHelperError{
+			Program: store.program,
+			Action:  credentialHelperGet,
+			Message: fmt.Sprintf("invalid output: %s", err),
+		}
+	}
+	return credentials, nil
+}
+
+// Store hands the credentials of the profile to the credential helper.
+func (store *CredentialHelperTokenStore) Store(profile string, credentials Credentials) error {
+	_, err := store.run(credentialHelperStore, credentialHelperRequest{
+		Profile:     profile,
+		Credentials: &credentials,
+	})
+	return err
+}
+
+// Erase asks the credential helper to forget the credentials of the profile.
+func (store *CredentialHelperTokenStore) Erase(profile string) error {
+	_, err := store.run(credentialHelperErase, credentialHelperRequest{Profile: profile})
+	return err
+}
+
+func (store *CredentialHelperTokenStore) run(action string, request credentialHelperRequest) ([]byte, error) {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(store.program, action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, CredentialHelperError{
+			Program: store.program,
+			Action:  action,
+			Message: message,
+		}
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// CredentialHelperError is returned when the credential helper cannot be run,
+// fails, or writes output that is not understood.
+type CredentialHelperError struct {
+	Program string
+	Action  string
+	Message string
+}
+
+func (e CredentialHelperError) Error() string {
+	return fmt.Sprintf("Credential helper %s %s failed: %s", e.Program, e.Action, e.Message)
+}
+
+// InvalidCredentialHelperError is returned when the credential helper name
+// contains a path separator.
+type InvalidCredentialHelperError struct {
+	Name string
+}
+
+func (e InvalidCredentialHelperError) Error() string {
+	return fmt.Sprintf("Invalid credential helper '%s'. The name must not contain path separators.", e.Name)
+}
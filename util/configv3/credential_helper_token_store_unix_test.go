@@ -0,0 +1,135 @@
+// +build !windows
+
+package configv3_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// credentialHelperScript keeps one file per profile in $HELPER_DIR, and
+// records the last request it received.
+const credentialHelperScript = `#!/bin/sh
+request=$(cat)
+echo "$1 $request" > "$HELPER_DIR/last-request"
+profile=$(echo "$request" | sed 's/.*"Profile":"\([^"]*\)".*/\1/')
+case "$1" in
+  get)
+    if [ -f "$HELPER_DIR/$profile" ]; then
+      cat "$HELPER_DIR/$profile"
+    fi
+    ;;
+  store)
+    echo "$request" > "$HELPER_DIR/$profile"
+    ;;
+  erase)
+    rm -f "$HELPER_DIR/$profile"
+    ;;
+esac
+`
+
+var _ = Describe("CredentialHelperTokenStore", func() {
+	var (
+		helperDir string
+		oldPath   string
+
+		store *CredentialHelperTokenStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper-tests")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-test"), []byte(credentialHelperScript), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-broken"), []byte("#!/bin/sh\necho 'keychain is locked' >&2\nexit 1\n"), 0700)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", fmt.Sprintf("%s%c%s", helperDir, os.PathListSeparator, oldPath))).To(Succeed())
+		Expect(os.Setenv("HELPER_DIR", helperDir)).To(Succeed())
+
+		store, err = NewCredentialHelperTokenStore("test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("HELPER_DIR")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	lastRequest := func() string {
+		request, err := ioutil.ReadFile(filepath.Join(helperDir, "last-request"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(request)
+	}
+
+	It("sends the credentials to the helper on store", func() {
+		Expect(store.Store("default", Credentials{
+			AccessToken:          "bearer some-access-token",
+			RefreshToken:         "some-refresh-token",
+			UAAOAuthClientSecret: "some-client-secret",
+		})).To(Succeed())
+
+		Expect(lastRequest()).To(Equal(`store {"Profile":"default","AccessToken":"bearer some-access-token","RefreshToken":"some-refresh-token","UAAOAuthClientSecret":"some-client-secret"}` + "\n"))
+	})
+
+	It("reads the credentials from the helper on get", func() {
+		Expect(store.Store("default", Credentials{AccessToken: "bearer some-access-token"})).To(Succeed())
+
+		Expect(store.Get("default")).To(Equal(Credentials{AccessToken: "bearer some-access-token"}))
+		Expect(lastRequest()).To(Equal(`get {"Profile":"default"}` + "\n"))
+	})
+
+	It("returns empty credentials when the helper has none", func() {
+		Expect(store.Get("staging")).To(Equal(Credentials{}))
+	})
+
+	It("asks the helper to forget the credentials on erase", func() {
+		Expect(store.Store("default", Credentials{AccessToken: "bearer some-access-token"})).To(Succeed())
+		Expect(store.Erase("default")).To(Succeed())
+
+		Expect(store.Get("default")).To(Equal(Credentials{}))
+	})
+
+	When("the helper fails", func() {
+		It("returns a CredentialHelperError with the helper's stderr", func() {
+			brokenStore, err := NewCredentialHelperTokenStore("broken")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = brokenStore.Get("default")
+			Expect(err).To(MatchError(CredentialHelperError{
+				Program: "cf-credential-broken",
+				Action:  "get",
+				Message: "keychain is locked",
+			}))
+		})
+	})
+
+	When("the helper does not exist", func() {
+		It("returns a CredentialHelperError", func() {
+			missingStore, err := NewCredentialHelperTokenStore("missing")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = missingStore.Get("default")
+			Expect(err).To(BeAssignableToTypeOf(CredentialHelperError{}))
+		})
+	})
+
+	When("the helper name contains a path separator", func() {
+		It("returns an InvalidCredentialHelperError", func() {
+			_, err := NewCredentialHelperTokenStore("../test")
+			Expect(err).To(MatchError(InvalidCredentialHelperError{Name: "../test"}))
+
+			_, err = NewCredentialHelperTokenStore(`..\test`)
+			Expect(err).To(MatchError(InvalidCredentialHelperError{Name: `..\test`}))
+		})
+	})
+})
@@ -16,9 +16,17 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultPaginationWorkers is the default maximum number of pages of a
+	// list fetched concurrently.
+	DefaultPaginationWorkers = 4
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
+	// DefaultProfileName is the name of the profile created when migrating a
+	// config file without profiles.
+	DefaultProfileName = "default"
+
 	// DefaultStagingTimeout is the default timeout for application staging.
 	DefaultStagingTimeout = 15 * time.Minute
 
@@ -47,6 +55,13 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultRetryBackoff is the default delay before the first request retry.
+	DefaultRetryBackoff = 500 * time.Millisecond
+
+	// DefaultRetryMaxBackoff is the default maximum delay between request
+	// retries.
+	DefaultRetryMaxBackoff = 30 * time.Second
 )
 
 // NOAARequestRetryCount returns the number of request retries.
@@ -59,11 +74,6 @@ func (config *Config) PollingInterval() time.Duration {
 	return DefaultPollingInterval
 }
 
-// RequestRetryCount returns the number of request retries.
-func (*Config) RequestRetryCount() int {
-	return DefaultRetryCount
-}
-
 // UAADisableKeepAlives returns true when TCP connections should not be reused
 // for UAA.
 func (*Config) UAADisableKeepAlives() bool {
@@ -0,0 +1,293 @@
+package configv3
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	keyDerivationPassphrase = "pbkdf2-sha256"
+	keyDerivationKeyFile    = "hmac-sha256"
+
+	// passphraseIterations is the PBKDF2 iteration count used for new token
+	// files. The count is stored in the file, so it can be raised later
+	// without breaking existing files.
+	passphraseIterations = 200000
+
+	// minimumKeyFileSize is the least amount of key material accepted from a
+	// key file, which is not stretched like a passphrase.
+	minimumKeyFileSize = 32
+
+	encryptionKeySize = 32
+	saltSize          = 16
+)
+
+// encryptedTokenFile is the format of the encrypted-file token store's file.
+// The ciphertext is the AES-256-GCM encryption of the JSON credentials of
+// every profile.
+type encryptedTokenFile struct {
+	KeyDerivation string `json:"KeyDerivation"`
+	Iterations    int    `json:"Iterations,omitempty"`
+	Salt          []byte `json:"Salt"`
+	Nonce         []byte `json:"Nonce"`
+	Ciphertext    []byte `json:"Ciphertext"`
+}
+
+// EncryptedFileTokenStore keeps the credentials of every profile in a single
+// file, encrypted with a key derived from a passphrase or read from a key
+// file.
+type EncryptedFileTokenStore struct {
+	path          string
+	secret        []byte
+	keyDerivation string
+
+	iterations  int
+	salt        []byte
+	key         []byte
+	credentials map[string]Credentials
+}
+
+// NewEncryptedFileTokenStore returns a token store backed by the encrypted
+// file at path. The key is derived from the contents of keyFile if it is
+// set, and from passphrase otherwise.
+func NewEncryptedFileTokenStore(path string, keyFile string, passphrase string) (*EncryptedFileTokenStore, error) {
+	store := &EncryptedFileTokenStore{path: path}
+
+	switch {
+	case keyFile != "":
+		secret, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		if len(secret) < minimumKeyFileSize {
+			return nil, TokenStoreKeyFileTooShortError{Path: keyFile, MinimumSize: minimumKeyFileSize}
+		}
+		store.secret = secret
+		store.keyDerivation = keyDerivationKeyFile
+	case passphrase != "":
+		store.secret = []byte(passphrase)
+		store.keyDerivation = keyDerivationPassphrase
+	default:
+		return nil, TokenStoreKeyNotSetError{}
+	}
+
+	return store, nil
+}
+
+// Get returns the credentials stored for the profile.
+func (store *EncryptedFileTokenStore) Get(profile string) (Credentials, error) {
+	err := store.read()
+	if err != nil {
+		return Credentials{}, err
+	}
+	return store.credentials[profile], nil
+}
+
+// Store replaces the credentials stored for the profile and rewrites the
+// file. The credentials of other profiles are read again first, so that
+// changes made by other cf processes are kept.
+func (store *EncryptedFileTokenStore) Store(profile string, credentials Credentials) error {
+	err := store.read()
+	if err != nil {
+		return err
+	}
+	store.credentials[profile] = credentials
+	return store.write()
+}
+
+// Erase removes the credentials stored for the profile and rewrites the file.
+func (store *EncryptedFileTokenStore) Erase(profile string) error {
+	err := store.read()
+	if err != nil {
+		return err
+	}
+	if _, exists := store.credentials[profile]; !exists {
+		return nil
+	}
+	delete(store.credentials, profile)
+	return store.write()
+}
+
+// read decrypts the file, which other cf processes may have changed since it
+// was last read. A missing file holds no credentials. The key is only derived
+// again if the salt changed.
+func (store *EncryptedFileTokenStore) read() error {
+	rawFile, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		store.credentials = map[string]Credentials{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file encryptedTokenFile
+	err = json.Unmarshal(rawFile, &file)
+	if err != nil {
+		return TokenStoreDecryptionError{Path: store.path}
+	}
+	if file.KeyDerivation != store.keyDerivation {
+		return TokenStoreKeyMismatchError{Path: store.path}
+	}
+
+	if store.key == nil || store.iterations != file.Iterations || !bytes.Equal(store.salt, file.Salt) {
+		store.iterations = file.Iterations
+		store.salt = file.Salt
+		store.key = store.deriveKey()
+	}
+
+	gcm, err := newGCM(store.key)
+	if err != nil {
+		return err
+	}
+	if len(file.Nonce) != gcm.NonceSize() {
+		return TokenStoreDecryptionError{Path: store.path}
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, []byte(file.KeyDerivation))
+	if err != nil {
+		return TokenStoreDecryptionError{Path: store.path}
+	}
+
+	var credentials map[string]Credentials
+	err = json.Unmarshal(plaintext, &credentials)
+	if err != nil {
+		return TokenStoreDecryptionError{Path: store.path}
+	}
+	if credentials == nil {
+		credentials = map[string]Credentials{}
+	}
+	store.credentials = credentials
+	return nil
+}
+
+// write encrypts the credentials with a new nonce and replaces the file. The
+// salt, and so the key, of an existing file is kept.
+func (store *EncryptedFileTokenStore) write() error {
+	if store.key == nil {
+		store.iterations = 0
+		if store.keyDerivation == keyDerivationPassphrase {
+			store.iterations = passphraseIterations
+		}
+		store.salt = make([]byte, saltSize)
+		_, err := io.ReadFull(rand.Reader, store.salt)
+		if err != nil {
+			return err
+		}
+		store.key = store.deriveKey()
+	}
+
+	plaintext, err := json.Marshal(store.credentials)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(store.key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return err
+	}
+
+	rawFile, err := json.MarshalIndent(encryptedTokenFile{
+		KeyDerivation: store.keyDerivation,
+		Iterations:    store.iterations,
+		Salt:          store.salt,
+		Nonce:         nonce,
+		Ciphertext:    gcm.Seal(nil, nonce, plaintext, []byte(store.keyDerivation)),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-tokens")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = ioutil.WriteFile(tempFile.Name(), rawFile, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), store.path)
+}
+
+func (store *EncryptedFileTokenStore) deriveKey() []byte {
+	if store.keyDerivation == keyDerivationKeyFile {
+		mac := hmac.New(sha256.New, store.secret)
+		_, _ = mac.Write(store.salt)
+		return mac.Sum(nil)
+	}
+	return pbkdf2.Key(store.secret, store.salt, store.iterations, encryptionKeySize, sha256.New)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// TokenStoreKeyNotSetError is returned when the encrypted-file token store is
+// selected without a passphrase or a key file.
+type TokenStoreKeyNotSetError struct{}
+
+func (TokenStoreKeyNotSetError) Error() string {
+	return "The encrypted-file token store needs a key. Set CF_TOKEN_STORE_PASSPHRASE or CF_TOKEN_STORE_KEY_FILE."
+}
+
+// TokenStoreKeyFileTooShortError is returned when the key file holds too
+// little key material.
+type TokenStoreKeyFileTooShortError struct {
+	Path        string
+	MinimumSize int
+}
+
+func (e TokenStoreKeyFileTooShortError) Error() string {
+	return fmt.Sprintf("The token store key file %s must hold at least %d bytes.", e.Path, e.MinimumSize)
+}
+
+// TokenStoreKeyMismatchError is returned when the token file was encrypted
+// with a passphrase and a key file is configured, or the other way around.
+type TokenStoreKeyMismatchError struct {
+	Path string
+}
+
+func (e TokenStoreKeyMismatchError) Error() string {
+	return fmt.Sprintf("The tokens in %s were not encrypted with the kind of key configured. Use the passphrase or key file they were encrypted with, or delete the file and log in again.", e.Path)
+}
+
+// TokenStoreDecryptionError is returned when the token file cannot be
+// decrypted, because the key is wrong or the file is corrupt.
+type TokenStoreDecryptionError struct {
+	Path string
+}
+
+func (e TokenStoreDecryptionError) Error() string {
+	return fmt.Sprintf("Unable to decrypt the tokens in %s. Check the passphrase or key file, or delete the file and log in again.", e.Path)
+}
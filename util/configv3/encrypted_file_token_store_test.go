@@ -0,0 +1,131 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptedFileTokenStore", func() {
+	var (
+		tempDir   string
+		tokenPath string
+		keyFile   string
+
+		credentials Credentials
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "cli-token-store-tests")
+		Expect(err).ToNot(HaveOccurred())
+
+		tokenPath = filepath.Join(tempDir, ".cf", "tokens.enc")
+		keyFile = filepath.Join(tempDir, "key")
+		Expect(ioutil.WriteFile(keyFile, []byte("0123456789abcdef0123456789abcdef"), 0600)).To(Succeed())
+
+		credentials = Credentials{
+			AccessToken:          "bearer some-access-token",
+			RefreshToken:         "some-refresh-token",
+			UAAOAuthClientSecret: "some-client-secret",
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	newStore := func(keyFile string, passphrase string) *EncryptedFileTokenStore {
+		store, err := NewEncryptedFileTokenStore(tokenPath, keyFile, passphrase)
+		Expect(err).ToNot(HaveOccurred())
+		return store
+	}
+
+	When("the file does not exist", func() {
+		It("has no credentials", func() {
+			Expect(newStore("", "some-passphrase").Get("default")).To(Equal(Credentials{}))
+			Expect(tokenPath).ToNot(BeAnExistingFile())
+		})
+	})
+
+	When("the key is derived from a passphrase", func() {
+		It("stores the credentials encrypted and only readable by the user", func() {
+			Expect(newStore("", "some-passphrase").Store("default", credentials)).To(Succeed())
+
+			rawFile, err := ioutil.ReadFile(tokenPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawFile)).To(ContainSubstring(`"KeyDerivation": "pbkdf2-sha256"`))
+			Expect(string(rawFile)).ToNot(ContainSubstring("some-access-token"))
+			Expect(string(rawFile)).ToNot(ContainSubstring("some-refresh-token"))
+
+			info, err := os.Stat(tokenPath)
+			Expect(err).ToNot(HaveOccurred())
+			if os.PathSeparator == '/' {
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			}
+
+			Expect(newStore("", "some-passphrase").Get("default")).To(Equal(credentials))
+		})
+
+		It("returns a TokenStoreDecryptionError for the wrong passphrase", func() {
+			Expect(newStore("", "some-passphrase").Store("default", credentials)).To(Succeed())
+
+			_, err := newStore("", "some-other-passphrase").Get("default")
+			Expect(err).To(MatchError(TokenStoreDecryptionError{Path: tokenPath}))
+		})
+	})
+
+	When("the key is read from a key file", func() {
+		It("stores and reads the credentials", func() {
+			Expect(newStore(keyFile, "").Store("default", credentials)).To(Succeed())
+			Expect(newStore(keyFile, "").Get("default")).To(Equal(credentials))
+		})
+
+		It("prefers the key file over a passphrase", func() {
+			Expect(newStore(keyFile, "some-passphrase").Store("default", credentials)).To(Succeed())
+			Expect(newStore(keyFile, "").Get("default")).To(Equal(credentials))
+		})
+
+		It("returns a TokenStoreKeyMismatchError when the file was encrypted with a passphrase", func() {
+			Expect(newStore("", "some-passphrase").Store("default", credentials)).To(Succeed())
+
+			_, err := newStore(keyFile, "").Get("default")
+			Expect(err).To(MatchError(TokenStoreKeyMismatchError{Path: tokenPath}))
+		})
+
+		When("the key file is too short", func() {
+			It("returns a TokenStoreKeyFileTooShortError", func() {
+				Expect(ioutil.WriteFile(keyFile, []byte("short"), 0600)).To(Succeed())
+
+				_, err := NewEncryptedFileTokenStore(tokenPath, keyFile, "")
+				Expect(err).To(MatchError(TokenStoreKeyFileTooShortError{Path: keyFile, MinimumSize: 32}))
+			})
+		})
+	})
+
+	When("the file is corrupt", func() {
+		It("returns a TokenStoreDecryptionError", func() {
+			Expect(os.MkdirAll(filepath.Dir(tokenPath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(tokenPath, []byte("not json"), 0600)).To(Succeed())
+
+			_, err := newStore("", "some-passphrase").Get("default")
+			Expect(err).To(MatchError(TokenStoreDecryptionError{Path: tokenPath}))
+		})
+	})
+
+	It("keeps the credentials of each profile separately", func() {
+		store := newStore("", "some-passphrase")
+		Expect(store.Store("default", credentials)).To(Succeed())
+		Expect(store.Store("staging", Credentials{AccessToken: "bearer staging-access-token"})).To(Succeed())
+		Expect(store.Erase("default")).To(Succeed())
+
+		store = newStore("", "some-passphrase")
+		Expect(store.Get("default")).To(Equal(Credentials{}))
+		Expect(store.Get("staging")).To(Equal(Credentials{AccessToken: "bearer staging-access-token"}))
+	})
+})
@@ -8,24 +8,33 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName        string
-	CFColor           string
-	CFDialTimeout     string
-	CFHome            string
-	CFLogLevel        string
-	CFPassword        string
-	CFPluginHome      string
-	CFStagingTimeout  string
-	CFStartupTimeout  string
-	CFTrace           string
-	CFUsername        string
-	DockerPassword    string
-	Experimental      string
-	ExperimentalLogin string
-	ForceTTY          string
-	HTTPSProxy        string
-	Lang              string
-	LCAll             string
+	BinaryName             string
+	CFColor                string
+	CFDialTimeout          string
+	CFHome                 string
+	CFLogLevel             string
+	CFPaginationWorkers    string
+	CFPassword             string
+	CFPluginHome           string
+	CFProfile              string
+	CFRetryCount           string
+	CFRetryBackoff         string
+	CFRetryMaxBackoff      string
+	CFStagingTimeout       string
+	CFStartupTimeout       string
+	CFTokenStore           string
+	CFTokenStoreKeyFile    string
+	CFTokenStorePassphrase string
+	CFCredentialHelper     string
+	CFTrace                string
+	CFUsername             string
+	DockerPassword         string
+	Experimental           string
+	ExperimentalLogin      string
+	ForceTTY               string
+	HTTPSProxy             string
+	Lang                   string
+	LCAll                  string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -128,6 +137,21 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// PaginationWorkers returns the maximum number of pages of a list fetched
+// concurrently. This is based off of:
+//   1. The $CF_PAGINATION_WORKERS environment variable if set
+//   2. Defaults to DefaultPaginationWorkers
+func (config *Config) PaginationWorkers() int {
+	if config.ENV.CFPaginationWorkers != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFPaginationWorkers)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	return DefaultPaginationWorkers
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
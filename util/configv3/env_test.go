@@ -110,6 +110,18 @@ var _ = Describe("Config", func() {
 		Entry("dEbUg returns 5", "dEbUg", 5),
 	)
 
+	DescribeTable("PaginationWorkers",
+		func(envVal string, expected int) {
+			config.ENV.CFPaginationWorkers = envVal
+			Expect(config.PaginationWorkers()).To(Equal(expected))
+		},
+
+		Entry("uses the default if the environment value is not set", "", DefaultPaginationWorkers),
+		Entry("uses the environment value if it is a positive number", "8", 8),
+		Entry("uses the default if the environment value is not a number", "many", DefaultPaginationWorkers),
+		Entry("uses the default if the environment value is not positive", "0", DefaultPaginationWorkers),
+	)
+
 	Describe("StagingTimeout", func() {
 		When("no StagingTimeout is set in the env", func() {
 			BeforeEach(func() {
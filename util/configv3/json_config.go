@@ -29,8 +29,18 @@ type JSONConfig struct {
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	PluginRequireSigned      bool               `json:"PluginRequireSigned,omitempty"`
+	PluginTrustedKeys        []PluginTrustedKey `json:"PluginTrustedKeys,omitempty"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	RequestRetryCount        *int               `json:"RequestRetryCount,omitempty"`
+	RequestRetryBackoff      string             `json:"RequestRetryBackoff,omitempty"`
+	RequestRetryMaxBackoff   string             `json:"RequestRetryMaxBackoff,omitempty"`
+	CurrentProfile           string             `json:"-"`
+	Profiles                 map[string]Profile `json:"-"`
+	TokenStore               string             `json:"TokenStore,omitempty"`
+	TokenStoreKeyFile        string             `json:"TokenStoreKeyFile,omitempty"`
+	CredentialHelper         string             `json:"CredentialHelper,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -62,9 +62,10 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 	config := Config{
 		ConfigFile: JSONConfig{
-			ConfigVersion: 3,
-			Target:        DefaultTarget,
-			ColorEnabled:  DefaultColorEnabled,
+			ConfigVersion:  3,
+			Target:         DefaultTarget,
+			ColorEnabled:   DefaultColorEnabled,
+			CurrentProfile: DefaultProfileName,
 			PluginRepositories: []PluginRepository{{
 				Name: DefaultPluginRepoName,
 				URL:  DefaultPluginRepoURL,
@@ -94,6 +95,18 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
+	profilesFile, err := ReadProfilesFile(configDirectory())
+	if err != nil {
+		return nil, err
+	}
+	config.ConfigFile.setProfilesFile(profilesFile)
+	config.ConfigFile.migrate()
+
+	config.loadedConfigFile, err = config.ConfigFile.clone()
+	if err != nil {
+		return nil, err
+	}
+
 	if config.ConfigFile.SSHOAuthClient == "" {
 		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
 	}
@@ -104,32 +117,50 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:        filepath.Base(os.Args[0]),
-		CFColor:           os.Getenv("CF_COLOR"),
-		CFDialTimeout:     os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:        os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:        os.Getenv("CF_PASSWORD"),
-		CFPluginHome:      os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout:  os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout:  os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:           os.Getenv("CF_TRACE"),
-		CFUsername:        os.Getenv("CF_USERNAME"),
-		DockerPassword:    os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:      os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ExperimentalLogin: os.Getenv("CF_EXPERIMENTAL_LOGIN"),
-		ForceTTY:          os.Getenv("FORCE_TTY"),
-		HTTPSProxy:        os.Getenv("https_proxy"),
-		Lang:              os.Getenv("LANG"),
-		LCAll:             os.Getenv("LC_ALL"),
+		BinaryName:             filepath.Base(os.Args[0]),
+		CFColor:                os.Getenv("CF_COLOR"),
+		CFDialTimeout:          os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:             os.Getenv("CF_LOG_LEVEL"),
+		CFPaginationWorkers:    os.Getenv("CF_PAGINATION_WORKERS"),
+		CFPassword:             os.Getenv("CF_PASSWORD"),
+		CFPluginHome:           os.Getenv("CF_PLUGIN_HOME"),
+		CFProfile:              os.Getenv("CF_PROFILE"),
+		CFRetryCount:           os.Getenv("CF_RETRY_COUNT"),
+		CFRetryBackoff:         os.Getenv("CF_RETRY_BACKOFF"),
+		CFRetryMaxBackoff:      os.Getenv("CF_RETRY_MAX_BACKOFF"),
+		CFStagingTimeout:       os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:       os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTokenStore:           os.Getenv("CF_TOKEN_STORE"),
+		CFTokenStoreKeyFile:    os.Getenv("CF_TOKEN_STORE_KEY_FILE"),
+		CFTokenStorePassphrase: os.Getenv("CF_TOKEN_STORE_PASSPHRASE"),
+		CFCredentialHelper:     os.Getenv("CF_CREDENTIAL_HELPER"),
+		CFTrace:                os.Getenv("CF_TRACE"),
+		CFUsername:             os.Getenv("CF_USERNAME"),
+		DockerPassword:         os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:           os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ExperimentalLogin:      os.Getenv("CF_EXPERIMENTAL_LOGIN"),
+		ForceTTY:               os.Getenv("FORCE_TTY"),
+		HTTPSProxy:             os.Getenv("https_proxy"),
+		Lang:                   os.Getenv("LANG"),
+		LCAll:                  os.Getenv("LC_ALL"),
 	}
 
-	err = config.loadPluginConfig()
+	if len(flags) > 0 {
+		config.Flags = flags[0]
+	}
+
+	// The override is applied first so that only the credentials of the
+	// profile in use are read from the token store.
+	profileErr := config.applyProfileOverride()
+
+	err = config.loadCredentials()
 	if err != nil {
 		return nil, err
 	}
 
-	if len(flags) > 0 {
-		config.Flags = flags[0]
+	err = config.loadPluginConfig()
+	if err != nil {
+		return nil, err
 	}
 
 	pwd, err := os.Getwd()
@@ -155,18 +186,64 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		tty:              isTTY,
 	}
 
+	if profileErr != nil {
+		return &config, profileErr
+	}
+
 	return &config, jsonError
 }
 
+// readConfigFile returns the config file and the profiles file currently on
+// disk, migrated in the same way as LoadConfig does. It returns false if there
+// is no config file or it cannot be parsed.
+func readConfigFile() (JSONConfig, bool, error) {
+	file, err := ioutil.ReadFile(ConfigFilePath())
+	if os.IsNotExist(err) {
+		return JSONConfig{}, false, nil
+	}
+	if err != nil {
+		return JSONConfig{}, false, err
+	}
+
+	var configFile JSONConfig
+	if len(file) == 0 || json.Unmarshal(file, &configFile) != nil {
+		return JSONConfig{}, false, nil
+	}
+
+	profilesFile, err := ReadProfilesFile(configDirectory())
+	if err != nil {
+		return JSONConfig{}, false, err
+	}
+	configFile.setProfilesFile(profilesFile)
+	configFile.migrate()
+	return configFile, true, nil
+}
+
+// removeOldTempConfigFiles removes the temp-config* files left behind by cf
+// processes that were killed while writing the config. The files are only
+// removed while holding the config lock, so that the temp file of another
+// process that is writing the config right now is left alone.
 func removeOldTempConfigFiles() error {
-	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
+	pattern := filepath.Join(configDirectory(), "temp-config?*")
+	oldTempFileNames, err := filepath.Glob(pattern)
+	if err != nil || len(oldTempFileNames) == 0 {
+		return err
+	}
+
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	oldTempFileNames, err = filepath.Glob(pattern)
 	if err != nil {
 		return err
 	}
 
 	for _, oldTempFileName := range oldTempFileNames {
 		err = os.Remove(oldTempFileName)
-		if err != nil {
+		if err != nil && !os.IsNotExist(err) {
 			return err
 		}
 	}
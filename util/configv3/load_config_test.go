@@ -89,6 +89,7 @@ var _ = Describe("Config", func() {
 					JSONConfig{
 						ColorEnabled:         DefaultColorEnabled,
 						ConfigVersion:        3,
+						CurrentProfile:       DefaultProfileName,
 						SSHOAuthClient:       DefaultSSHOAuthClient,
 						UAAOAuthClient:       DefaultUAAOAuthClient,
 						UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
@@ -129,6 +130,7 @@ var _ = Describe("Config", func() {
 						JSONConfig{
 							ColorEnabled:         DefaultColorEnabled,
 							ConfigVersion:        3,
+							CurrentProfile:       DefaultProfileName,
 							SSHOAuthClient:       DefaultSSHOAuthClient,
 							UAAOAuthClient:       DefaultUAAOAuthClient,
 							UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
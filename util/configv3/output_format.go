@@ -0,0 +1,31 @@
+package configv3
+
+import "strings"
+
+const (
+	// OutputFormatText means command results are displayed as human readable
+	// text and tables.
+	OutputFormatText OutputFormat = ""
+
+	// OutputFormatJSON means command results are displayed as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means command results are displayed as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat is the format in which commands display their results.
+type OutputFormat string
+
+// OutputFormat returns the format commands should display their results in.
+// This is based off of:
+//   1. The '--output' global flag (json/yaml)
+//   2. Defaults to OutputFormatText
+func (config *Config) OutputFormat() OutputFormat {
+	switch format := OutputFormat(strings.ToLower(config.Flags.OutputFormat)); format {
+	case OutputFormatJSON, OutputFormatYAML:
+		return format
+	}
+
+	return OutputFormatText
+}
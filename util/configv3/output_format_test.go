@@ -0,0 +1,35 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("OutputFormat",
+		func(flagVal string, expected OutputFormat) {
+			config, err := LoadConfig(FlagOverride{OutputFormat: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+		Entry("flag=json", "json", OutputFormatJSON),
+		Entry("flag=JSON", "JSON", OutputFormatJSON),
+		Entry("flag=yaml", "yaml", OutputFormatYAML),
+		Entry("flag=unknown falls back to default", "xml", OutputFormatText),
+		Entry("flag=unset falls back to default", "", OutputFormatText),
+	)
+})
@@ -0,0 +1,20 @@
+package configv3
+
+// PluginTrustedKey is a plugin publisher's ed25519 public key that plugin
+// binary signatures are verified against.
+type PluginTrustedKey struct {
+	Name      string `json:"Name"`
+	PublicKey string `json:"PublicKey"`
+}
+
+// PluginRequireSigned returns true when only plugins with a verified
+// signature may be installed.
+func (config *Config) PluginRequireSigned() bool {
+	return config.ConfigFile.PluginRequireSigned
+}
+
+// PluginTrustedKeys returns the plugin publisher keys trusted in the
+// .cf/config.json.
+func (config *Config) PluginTrustedKeys() []PluginTrustedKey {
+	return config.ConfigFile.PluginTrustedKeys
+}
@@ -0,0 +1,37 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin signing", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{
+			ConfigFile: JSONConfig{
+				PluginRequireSigned: true,
+				PluginTrustedKeys: []PluginTrustedKey{
+					{Name: "some-publisher", PublicKey: "some-public-key"},
+				},
+			},
+		}
+	})
+
+	Describe("PluginRequireSigned", func() {
+		It("returns the policy from the config file", func() {
+			Expect(config.PluginRequireSigned()).To(BeTrue())
+		})
+	})
+
+	Describe("PluginTrustedKeys", func() {
+		It("returns the trusted keys from the config file", func() {
+			Expect(config.PluginTrustedKeys()).To(Equal([]PluginTrustedKey{
+				{Name: "some-publisher", PublicKey: "some-public-key"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,282 @@
+package configv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ProfilesFileName is the name of the file in the .cf directory that holds
+// the name of the profile in use and the settings of the other profiles. They
+// are kept out of the config file because older CLIs rewrite the config file
+// without the settings they do not know about.
+const ProfilesFileName = "profiles.json"
+
+// Profile is a named set of target settings. The settings of the profile in
+// use are stored at the top level of the config file, while the other
+// profiles are stored in the profiles file.
+type Profile struct {
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	RefreshToken             string       `json:"RefreshToken"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// CreateProfile adds an empty profile with the given name.
+func (config *Config) CreateProfile(name string) {
+	if config.ConfigFile.Profiles == nil {
+		config.ConfigFile.Profiles = map[string]Profile{}
+	}
+
+	config.ConfigFile.Profiles[name] = Profile{
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+}
+
+// CurrentProfile returns the name of the profile in use. LoadConfig uses the
+// profile named by the --profile flag, or else by $CF_PROFILE, instead of the
+// config file's CurrentProfile when either is set.
+func (config *Config) CurrentProfile() string {
+	return config.ConfigFile.CurrentProfile
+}
+
+// DeleteProfile removes the profile with the given name. It does not remove
+// the settings of the profile in use, so callers must not pass its name. When
+// the profile saved in the config file as the one in use is removed, the
+// profile in use is saved in its place.
+func (config *Config) DeleteProfile(name string) {
+	if name == config.persistedProfile {
+		config.persistedProfile = ""
+	}
+	delete(config.ConfigFile.Profiles, name)
+}
+
+// GetProfile returns the profile with the given name, and whether it exists.
+// When a token store is configured, the credentials of a profile other than
+// the one in use are not read until it is switched to.
+func (config *Config) GetProfile(name string) (Profile, bool) {
+	if name == config.ConfigFile.CurrentProfile {
+		return config.ConfigFile.profile(), true
+	}
+	profile, exists := config.ConfigFile.Profiles[name]
+	return profile, exists
+}
+
+// HasProfile returns true if a profile with the given name exists.
+func (config *Config) HasProfile(name string) bool {
+	if name == config.ConfigFile.CurrentProfile {
+		return true
+	}
+	_, exists := config.ConfigFile.Profiles[name]
+	return exists
+}
+
+// Profiles returns the names of all the profiles, sorted alphabetically.
+func (config *Config) Profiles() []string {
+	names := []string{config.ConfigFile.CurrentProfile}
+	for name := range config.ConfigFile.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SwitchProfile makes the profile with the given name the one in use, and
+// the one that is used by later commands. Its credentials are read from the
+// token store, if one is configured.
+func (config *Config) SwitchProfile(name string) error {
+	err := config.loadProfileCredentials(name)
+	if err != nil {
+		return err
+	}
+
+	config.persistedProfile = ""
+	config.ConfigFile.switchProfile(name)
+	return nil
+}
+
+// applyProfileOverride uses the profile set by the --profile flag or the
+// $CF_PROFILE environment variable, if any, for the rest of the command.
+func (config *Config) applyProfileOverride() error {
+	name := config.Flags.Profile
+	if name == "" {
+		name = config.ENV.CFProfile
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	if !config.HasProfile(name) {
+		return ProfileNotFoundError{Name: name}
+	}
+
+	config.useProfile(name)
+	return nil
+}
+
+// useProfile makes the profile with the given name the one in use without
+// changing the profile written to the config file.
+func (config *Config) useProfile(name string) {
+	if name == config.ConfigFile.CurrentProfile {
+		return
+	}
+
+	config.persistedProfile = config.ConfigFile.CurrentProfile
+	config.ConfigFile.switchProfile(name)
+}
+
+// fileContents returns the config to write to the config file, which uses the
+// profile that was in use before the --profile flag or $CF_PROFILE were
+// applied.
+func (config *Config) fileContents() JSONConfig {
+	configFile := config.ConfigFile
+	if config.persistedProfile != "" {
+		configFile.switchProfile(config.persistedProfile)
+	}
+	return configFile
+}
+
+// ProfilesFile represents .cf/profiles.json.
+type ProfilesFile struct {
+	CurrentProfile string             `json:"CurrentProfile"`
+	Profiles       map[string]Profile `json:"Profiles,omitempty"`
+}
+
+// ReadProfilesFile reads the profiles file in the given config directory. It
+// returns an empty ProfilesFile if there is no profiles file.
+func ReadProfilesFile(dir string) (ProfilesFile, error) {
+	var profilesFile ProfilesFile
+
+	file, err := ioutil.ReadFile(filepath.Join(dir, ProfilesFileName))
+	if os.IsNotExist(err) {
+		return profilesFile, nil
+	}
+	if err != nil || len(file) == 0 {
+		return profilesFile, err
+	}
+
+	err = json.Unmarshal(file, &profilesFile)
+	return profilesFile, err
+}
+
+// WriteProfilesFile replaces the profiles file in the given config directory.
+// The config lock must be held.
+func WriteProfilesFile(dir string, profilesFile ProfilesFile) error {
+	rawProfiles, err := json.MarshalIndent(profilesFile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeConfigDirectoryFile(dir, ProfilesFileName, rawProfiles)
+}
+
+// migrate upgrades a config directory written before profiles were added,
+// which has no profiles file. The settings in the config file become the
+// settings of the default profile. Profiles do not change the config file, so
+// its version is unchanged and older CLIs keep reading it.
+func (jsonConfig *JSONConfig) migrate() {
+	if jsonConfig.CurrentProfile == "" {
+		jsonConfig.CurrentProfile = DefaultProfileName
+	}
+}
+
+// switchProfile stores the settings of the profile in use under Profiles and
+// replaces them with the settings of the profile with the given name. The
+// Profiles map is copied so that other copies of the JSONConfig are not
+// modified.
+func (jsonConfig *JSONConfig) switchProfile(name string) {
+	profiles := make(map[string]Profile, len(jsonConfig.Profiles))
+	for profileName, profile := range jsonConfig.Profiles {
+		profiles[profileName] = profile
+	}
+
+	profiles[jsonConfig.CurrentProfile] = jsonConfig.profile()
+	jsonConfig.setProfile(profiles[name])
+	delete(profiles, name)
+
+	jsonConfig.CurrentProfile = name
+	jsonConfig.Profiles = profiles
+}
+
+func (jsonConfig JSONConfig) profilesFile() ProfilesFile {
+	return ProfilesFile{
+		CurrentProfile: jsonConfig.CurrentProfile,
+		Profiles:       jsonConfig.Profiles,
+	}
+}
+
+func (jsonConfig *JSONConfig) setProfilesFile(profilesFile ProfilesFile) {
+	jsonConfig.CurrentProfile = profilesFile.CurrentProfile
+	jsonConfig.Profiles = profilesFile.Profiles
+}
+
+func (jsonConfig JSONConfig) profile() Profile {
+	return Profile{
+		Target:                   jsonConfig.Target,
+		APIVersion:               jsonConfig.APIVersion,
+		AuthorizationEndpoint:    jsonConfig.AuthorizationEndpoint,
+		DopplerEndpoint:          jsonConfig.DopplerEndpoint,
+		UAAEndpoint:              jsonConfig.UAAEndpoint,
+		RoutingEndpoint:          jsonConfig.RoutingEndpoint,
+		AccessToken:              jsonConfig.AccessToken,
+		SSHOAuthClient:           jsonConfig.SSHOAuthClient,
+		UAAOAuthClient:           jsonConfig.UAAOAuthClient,
+		UAAOAuthClientSecret:     jsonConfig.UAAOAuthClientSecret,
+		UAAGrantType:             jsonConfig.UAAGrantType,
+		RefreshToken:             jsonConfig.RefreshToken,
+		TargetedOrganization:     jsonConfig.TargetedOrganization,
+		TargetedSpace:            jsonConfig.TargetedSpace,
+		SkipSSLValidation:        jsonConfig.SkipSSLValidation,
+		MinCLIVersion:            jsonConfig.MinCLIVersion,
+		MinRecommendedCLIVersion: jsonConfig.MinRecommendedCLIVersion,
+	}
+}
+
+func (jsonConfig *JSONConfig) setProfile(profile Profile) {
+	jsonConfig.Target = profile.Target
+	jsonConfig.APIVersion = profile.APIVersion
+	jsonConfig.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	jsonConfig.DopplerEndpoint = profile.DopplerEndpoint
+	jsonConfig.UAAEndpoint = profile.UAAEndpoint
+	jsonConfig.RoutingEndpoint = profile.RoutingEndpoint
+	jsonConfig.AccessToken = profile.AccessToken
+	jsonConfig.SSHOAuthClient = profile.SSHOAuthClient
+	jsonConfig.UAAOAuthClient = profile.UAAOAuthClient
+	jsonConfig.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+	jsonConfig.UAAGrantType = profile.UAAGrantType
+	jsonConfig.RefreshToken = profile.RefreshToken
+	jsonConfig.TargetedOrganization = profile.TargetedOrganization
+	jsonConfig.TargetedSpace = profile.TargetedSpace
+	jsonConfig.SkipSSLValidation = profile.SkipSSLValidation
+	jsonConfig.MinCLIVersion = profile.MinCLIVersion
+	jsonConfig.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+}
+
+// ProfileNotFoundError is returned when the --profile flag or $CF_PROFILE
+// names a profile that does not exist.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("Profile '%s' not found.", e.Name)
+}
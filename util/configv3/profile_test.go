@@ -0,0 +1,210 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	When("the config file was written before profiles existed", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.foo.com",
+				"OrganizationFields": {"Name": "some-org"}
+			}`
+			setConfig(homeDir, rawConfig)
+		})
+
+		It("uses its settings as the default profile", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.ConfigFile.ConfigVersion).To(Equal(3))
+			Expect(config.CurrentProfile()).To(Equal(DefaultProfileName))
+			Expect(config.Profiles()).To(Equal([]string{DefaultProfileName}))
+			Expect(config.Target()).To(Equal("https://api.foo.com"))
+			Expect(config.TargetedOrganizationName()).To(Equal("some-org"))
+		})
+
+		It("keeps the profiles out of the config file so that older CLIs do not drop them", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			config.CreateProfile("staging")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).To(ContainSubstring(`"ConfigVersion": 3`))
+			Expect(string(rawConfig)).ToNot(ContainSubstring("Profile"))
+
+			profilesFile, err := ReadProfilesFile(filepath.Join(homeDir, ".cf"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profilesFile.CurrentProfile).To(Equal(DefaultProfileName))
+			Expect(profilesFile.Profiles).To(HaveKey("staging"))
+		})
+	})
+
+	Describe("managing profiles", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			config.SetTargetInformation("https://api.foo.com", "2.59.0", "", "", "", "", false)
+		})
+
+		Describe("CreateProfile", func() {
+			It("adds an empty profile without switching to it", func() {
+				config.CreateProfile("staging")
+
+				Expect(config.CurrentProfile()).To(Equal(DefaultProfileName))
+				Expect(config.Target()).To(Equal("https://api.foo.com"))
+				Expect(config.Profiles()).To(Equal([]string{DefaultProfileName, "staging"}))
+
+				profile, exists := config.GetProfile("staging")
+				Expect(exists).To(BeTrue())
+				Expect(profile.Target).To(BeEmpty())
+				Expect(profile.UAAOAuthClient).To(Equal(DefaultUAAOAuthClient))
+				Expect(profile.SSHOAuthClient).To(Equal(DefaultSSHOAuthClient))
+			})
+		})
+
+		Describe("GetProfile", func() {
+			It("returns the settings of the profile in use", func() {
+				profile, exists := config.GetProfile(DefaultProfileName)
+				Expect(exists).To(BeTrue())
+				Expect(profile.Target).To(Equal("https://api.foo.com"))
+			})
+
+			It("returns false when the profile does not exist", func() {
+				_, exists := config.GetProfile("does-not-exist")
+				Expect(exists).To(BeFalse())
+			})
+		})
+
+		Describe("SwitchProfile", func() {
+			BeforeEach(func() {
+				config.CreateProfile("staging")
+				Expect(config.SwitchProfile("staging")).To(Succeed())
+				config.SetTargetInformation("https://api.staging.com", "2.59.0", "", "", "", "", false)
+			})
+
+			It("stores the settings of the previous profile and uses the new one", func() {
+				Expect(config.CurrentProfile()).To(Equal("staging"))
+				Expect(config.Target()).To(Equal("https://api.staging.com"))
+
+				profile, exists := config.GetProfile(DefaultProfileName)
+				Expect(exists).To(BeTrue())
+				Expect(profile.Target).To(Equal("https://api.foo.com"))
+			})
+
+			It("writes the new profile as the one in use", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenConfig := readWrittenConfig(homeDir)
+				Expect(writtenConfig.CurrentProfile).To(Equal("staging"))
+				Expect(writtenConfig.Target).To(Equal("https://api.staging.com"))
+				Expect(writtenConfig.Profiles).To(HaveKey(DefaultProfileName))
+				Expect(writtenConfig.Profiles[DefaultProfileName].Target).To(Equal("https://api.foo.com"))
+			})
+		})
+
+		Describe("DeleteProfile", func() {
+			It("removes the profile", func() {
+				config.CreateProfile("staging")
+				config.DeleteProfile("staging")
+
+				Expect(config.HasProfile("staging")).To(BeFalse())
+				Expect(config.Profiles()).To(Equal([]string{DefaultProfileName}))
+			})
+		})
+	})
+
+	Describe("overriding the profile", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.foo.com"
+			}`
+			setConfig(homeDir, rawConfig)
+			setProfiles(homeDir, `{
+				"CurrentProfile": "default",
+				"Profiles": {
+					"staging": {"Target": "https://api.staging.com"},
+					"production": {"Target": "https://api.production.com"}
+				}
+			}`)
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+		})
+
+		When("CF_PROFILE is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			})
+
+			It("uses the given profile", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.CurrentProfile()).To(Equal("staging"))
+				Expect(config.Target()).To(Equal("https://api.staging.com"))
+			})
+
+			It("keeps the profile in use when writing the config file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetSpaceInformation("some-space-guid", "some-space", false)
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenConfig := readWrittenConfig(homeDir)
+				Expect(writtenConfig.CurrentProfile).To(Equal(DefaultProfileName))
+				Expect(writtenConfig.Target).To(Equal("https://api.foo.com"))
+				Expect(writtenConfig.Profiles["staging"].TargetedSpace.Name).To(Equal("some-space"))
+			})
+
+			When("the --profile flag is also set", func() {
+				It("uses the profile given by the flag", func() {
+					config, err := LoadConfig(FlagOverride{Profile: "production"})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(config.CurrentProfile()).To(Equal("production"))
+					Expect(config.Target()).To(Equal("https://api.production.com"))
+				})
+			})
+		})
+
+		When("the profile does not exist", func() {
+			It("returns the config and a ProfileNotFoundError", func() {
+				config, err := LoadConfig(FlagOverride{Profile: "does-not-exist"})
+				Expect(err).To(MatchError(ProfileNotFoundError{Name: "does-not-exist"}))
+
+				Expect(config).ToNot(BeNil())
+				Expect(config.CurrentProfile()).To(Equal(DefaultProfileName))
+			})
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package configv3
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/retry"
+)
+
+// RequestRetryCount returns the number of request retries. This is based off
+// of:
+//   1. The $CF_RETRY_COUNT environment variable if set
+//   2. The config file's RequestRetryCount value if set
+//   3. Defaults to DefaultRetryCount
+func (config *Config) RequestRetryCount() int {
+	if config.ENV.CFRetryCount != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRetryCount)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	if config.ConfigFile.RequestRetryCount != nil && *config.ConfigFile.RequestRetryCount >= 0 {
+		return *config.ConfigFile.RequestRetryCount
+	}
+
+	return DefaultRetryCount
+}
+
+// RequestRetryBackoff returns the delay before the first request retry. This
+// is based off of:
+//   1. The $CF_RETRY_BACKOFF environment variable if set
+//   2. The config file's RequestRetryBackoff value if set
+//   3. Defaults to DefaultRetryBackoff
+func (config *Config) RequestRetryBackoff() time.Duration {
+	if backoff, ok := parseRetryDuration(config.ENV.CFRetryBackoff); ok {
+		return backoff
+	}
+
+	if backoff, ok := parseRetryDuration(config.ConfigFile.RequestRetryBackoff); ok {
+		return backoff
+	}
+
+	return DefaultRetryBackoff
+}
+
+// RequestRetryMaxBackoff returns the maximum delay between request retries.
+// This is based off of:
+//   1. The $CF_RETRY_MAX_BACKOFF environment variable if set
+//   2. The config file's RequestRetryMaxBackoff value if set
+//   3. Defaults to DefaultRetryMaxBackoff
+func (config *Config) RequestRetryMaxBackoff() time.Duration {
+	if backoff, ok := parseRetryDuration(config.ENV.CFRetryMaxBackoff); ok {
+		return backoff
+	}
+
+	if backoff, ok := parseRetryDuration(config.ConfigFile.RequestRetryMaxBackoff); ok {
+		return backoff
+	}
+
+	return DefaultRetryMaxBackoff
+}
+
+// RequestRetryPolicy returns the policy used to retry failed requests.
+func (config *Config) RequestRetryPolicy() retry.Policy {
+	return retry.Policy{
+		MaxRetries:     config.RequestRetryCount(),
+		InitialBackoff: config.RequestRetryBackoff(),
+		MaxBackoff:     config.RequestRetryMaxBackoff(),
+	}
+}
+
+// parseRetryDuration parses either a duration ("500ms", "2s") or a whole
+// number of seconds.
+func parseRetryDuration(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, false
+	}
+	return duration, true
+}
@@ -0,0 +1,82 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/retry"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retry Policy", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	When("nothing is configured", func() {
+		It("returns the default policy", func() {
+			Expect(config.RequestRetryPolicy()).To(Equal(retry.Policy{
+				MaxRetries:     DefaultRetryCount,
+				InitialBackoff: DefaultRetryBackoff,
+				MaxBackoff:     DefaultRetryMaxBackoff,
+			}))
+		})
+	})
+
+	When("the config file sets the retry policy", func() {
+		BeforeEach(func() {
+			count := 0
+			config.ConfigFile.RequestRetryCount = &count
+			config.ConfigFile.RequestRetryBackoff = "250ms"
+			config.ConfigFile.RequestRetryMaxBackoff = "10"
+		})
+
+		It("uses the config file values", func() {
+			Expect(config.RequestRetryPolicy()).To(Equal(retry.Policy{
+				MaxRetries:     0,
+				InitialBackoff: 250 * time.Millisecond,
+				MaxBackoff:     10 * time.Second,
+			}))
+		})
+
+		When("the environment also sets the retry policy", func() {
+			BeforeEach(func() {
+				config.ENV = EnvOverride{
+					CFRetryCount:      "5",
+					CFRetryBackoff:    "1",
+					CFRetryMaxBackoff: "1m",
+				}
+			})
+
+			It("uses the environment values", func() {
+				Expect(config.RequestRetryPolicy()).To(Equal(retry.Policy{
+					MaxRetries:     5,
+					InitialBackoff: time.Second,
+					MaxBackoff:     time.Minute,
+				}))
+			})
+		})
+	})
+
+	When("the values are invalid", func() {
+		BeforeEach(func() {
+			config.ENV = EnvOverride{
+				CFRetryCount:      "-1",
+				CFRetryBackoff:    "soon",
+				CFRetryMaxBackoff: "-2s",
+			}
+		})
+
+		It("falls back to the defaults", func() {
+			Expect(config.RequestRetryPolicy()).To(Equal(retry.Policy{
+				MaxRetries:     DefaultRetryCount,
+				InitialBackoff: DefaultRetryBackoff,
+				MaxBackoff:     DefaultRetryMaxBackoff,
+			}))
+		})
+	})
+})
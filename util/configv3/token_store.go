@@ -0,0 +1,298 @@
+package configv3
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+const (
+	// TokenStorePlaintext keeps the tokens in the config file. This is the
+	// default.
+	TokenStorePlaintext = "plaintext"
+
+	// TokenStoreEncryptedFile keeps the tokens in a file next to the config
+	// file, encrypted with a key derived from a passphrase or a key file.
+	TokenStoreEncryptedFile = "encrypted-file"
+
+	// TokenStoreCredentialHelper hands the tokens to an external credential
+	// helper program.
+	TokenStoreCredentialHelper = "credential-helper"
+)
+
+// EncryptedTokenFileName is the name of the file the encrypted-file token
+// store writes in the .cf directory.
+const EncryptedTokenFileName = "tokens.enc"
+
+// TokenStore keeps the credentials of each profile outside of the config
+// file.
+type TokenStore interface {
+	// Get returns the credentials stored for the profile, or empty
+	// credentials if there are none.
+	Get(profile string) (Credentials, error)
+
+	// Store replaces the credentials stored for the profile.
+	Store(profile string, credentials Credentials) error
+
+	// Erase removes the credentials stored for the profile, if any.
+	Erase(profile string) error
+}
+
+// Credentials are the secrets of a profile that a TokenStore keeps out of
+// the config file.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken"`
+	RefreshToken         string `json:"RefreshToken"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret"`
+}
+
+// IsEmpty returns true if none of the credentials are set.
+func (credentials Credentials) IsEmpty() bool {
+	return credentials == Credentials{}
+}
+
+// TokenStoreSettings select and configure a TokenStore.
+type TokenStoreSettings struct {
+	// Type is one of TokenStorePlaintext, TokenStoreEncryptedFile or
+	// TokenStoreCredentialHelper. Empty means TokenStorePlaintext.
+	Type string
+
+	// KeyFile and Passphrase are the secret the encrypted-file store derives
+	// its key from. KeyFile is used if both are set.
+	KeyFile    string
+	Passphrase string
+
+	// CredentialHelper is the name of the credential helper; the program run
+	// is cf-credential-<name>.
+	CredentialHelper string
+}
+
+// NewTokenStore returns the token store selected by the settings, or nil if
+// the tokens are kept in the config file.
+func NewTokenStore(settings TokenStoreSettings) (TokenStore, error) {
+	switch settings.Type {
+	case "", TokenStorePlaintext:
+		return nil, nil
+	case TokenStoreEncryptedFile:
+		return NewEncryptedFileTokenStore(filepath.Join(configDirectory(), EncryptedTokenFileName), settings.KeyFile, settings.Passphrase)
+	case TokenStoreCredentialHelper:
+		if settings.CredentialHelper == "" {
+			return nil, CredentialHelperNotSetError{}
+		}
+		return NewCredentialHelperTokenStore(settings.CredentialHelper)
+	default:
+		return nil, UnknownTokenStoreError{Type: settings.Type}
+	}
+}
+
+// TokenStoreSettings returns the token store settings. These are based off
+// of:
+//   1. The $CF_TOKEN_STORE, $CF_TOKEN_STORE_KEY_FILE and
+//      $CF_CREDENTIAL_HELPER environment variables if set
+//   2. The config file's TokenStore, TokenStoreKeyFile and CredentialHelper
+//      values
+//
+// The passphrase is only taken from $CF_TOKEN_STORE_PASSPHRASE.
+func (config *Config) TokenStoreSettings() TokenStoreSettings {
+	settings := TokenStoreSettings{
+		Type:             config.ConfigFile.TokenStore,
+		KeyFile:          config.ConfigFile.TokenStoreKeyFile,
+		Passphrase:       config.ENV.CFTokenStorePassphrase,
+		CredentialHelper: config.ConfigFile.CredentialHelper,
+	}
+
+	if config.ENV.CFTokenStore != "" {
+		settings.Type = config.ENV.CFTokenStore
+	}
+	if config.ENV.CFTokenStoreKeyFile != "" {
+		settings.KeyFile = config.ENV.CFTokenStoreKeyFile
+	}
+	if config.ENV.CFCredentialHelper != "" {
+		settings.CredentialHelper = config.ENV.CFCredentialHelper
+	}
+
+	return settings
+}
+
+// loadCredentials fills in the credentials of the profile in use from the
+// token store, if one is configured. The credentials of the other profiles are
+// only read when a profile is switched to or written, since a credential
+// helper may have to unlock a keychain for each of them.
+func (config *Config) loadCredentials() error {
+	store, err := NewTokenStore(config.TokenStoreSettings())
+	if err != nil || store == nil {
+		return err
+	}
+
+	config.tokenStore = store
+	config.storedCredentials = map[string]Credentials{}
+	return config.loadProfileCredentials(config.ConfigFile.CurrentProfile)
+}
+
+// loadProfileCredentials fills in the credentials of the named profile from
+// the token store, unless they have already been read. Credentials still in
+// the config file are kept if the store has nothing for the profile, so that
+// they are moved into the store the next time the config is written.
+func (config *Config) loadProfileCredentials(name string) error {
+	if config.tokenStore == nil {
+		return nil
+	}
+	if _, loaded := config.storedCredentials[name]; loaded {
+		return nil
+	}
+
+	credentials, err := config.tokenStore.Get(name)
+	if err != nil {
+		return err
+	}
+	config.storedCredentials[name] = credentials
+	if credentials.IsEmpty() {
+		return nil
+	}
+
+	if name == config.ConfigFile.CurrentProfile {
+		config.ConfigFile.setCredentials(credentials)
+	} else if profile, exists := config.ConfigFile.Profiles[name]; exists {
+		profile.setCredentials(credentials)
+		config.ConfigFile.Profiles[name] = profile
+	}
+	return nil
+}
+
+// storeCredentials moves the credentials of every profile in configFile into
+// the token store, if one is configured. Only credentials that changed since
+// they were loaded are written, and the credentials of deleted profiles are
+// erased.
+func (config *Config) storeCredentials(configFile *JSONConfig) error {
+	if config.tokenStore == nil {
+		return nil
+	}
+
+	current := map[string]Credentials{
+		configFile.CurrentProfile: configFile.credentials(),
+	}
+	configFile.setCredentials(Credentials{})
+
+	profiles := make(map[string]Profile, len(configFile.Profiles))
+	for name, profile := range configFile.Profiles {
+		current[name] = profile.credentials()
+		profile.setCredentials(Credentials{})
+		profiles[name] = profile
+	}
+	if configFile.Profiles != nil {
+		configFile.Profiles = profiles
+	}
+
+	for name, credentials := range current {
+		stored, loaded := config.storedCredentials[name]
+		if !loaded {
+			// The stored credentials of a profile that was not used are only
+			// read to decide whether the ones still in the config file need
+			// to be moved into the store.
+			if credentials.IsEmpty() {
+				continue
+			}
+
+			var err error
+			stored, err = config.tokenStore.Get(name)
+			if err != nil {
+				return err
+			}
+			config.storedCredentials[name] = stored
+			if !stored.IsEmpty() {
+				continue
+			}
+		}
+
+		if credentials == stored {
+			continue
+		}
+
+		var err error
+		if credentials.IsEmpty() {
+			err = config.tokenStore.Erase(name)
+		} else {
+			err = config.tokenStore.Store(name, credentials)
+		}
+		if err != nil {
+			return err
+		}
+		config.storedCredentials[name] = credentials
+	}
+
+	for name, credentials := range config.storedCredentials {
+		if _, exists := current[name]; exists {
+			continue
+		}
+		if !credentials.IsEmpty() {
+			err := config.tokenStore.Erase(name)
+			if err != nil {
+				return err
+			}
+		}
+		delete(config.storedCredentials, name)
+	}
+
+	// Deleted profiles whose credentials were never read are erased as well.
+	if config.loadedConfigFile != nil {
+		for name := range config.loadedConfigFile.withAllProfiles().Profiles {
+			if _, exists := current[name]; exists {
+				continue
+			}
+			if _, loaded := config.storedCredentials[name]; loaded {
+				continue
+			}
+			err := config.tokenStore.Erase(name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (jsonConfig JSONConfig) credentials() Credentials {
+	return Credentials{
+		AccessToken:          jsonConfig.AccessToken,
+		RefreshToken:         jsonConfig.RefreshToken,
+		UAAOAuthClientSecret: jsonConfig.UAAOAuthClientSecret,
+	}
+}
+
+func (jsonConfig *JSONConfig) setCredentials(credentials Credentials) {
+	jsonConfig.AccessToken = credentials.AccessToken
+	jsonConfig.RefreshToken = credentials.RefreshToken
+	jsonConfig.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+}
+
+func (profile Profile) credentials() Credentials {
+	return Credentials{
+		AccessToken:          profile.AccessToken,
+		RefreshToken:         profile.RefreshToken,
+		UAAOAuthClientSecret: profile.UAAOAuthClientSecret,
+	}
+}
+
+func (profile *Profile) setCredentials(credentials Credentials) {
+	profile.AccessToken = credentials.AccessToken
+	profile.RefreshToken = credentials.RefreshToken
+	profile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+}
+
+// UnknownTokenStoreError is returned when the token store type is not one of
+// plaintext, encrypted-file or credential-helper.
+type UnknownTokenStoreError struct {
+	Type string
+}
+
+func (e UnknownTokenStoreError) Error() string {
+	return fmt.Sprintf("Unknown token store '%s'. Use %s, %s or %s.", e.Type, TokenStorePlaintext, TokenStoreEncryptedFile, TokenStoreCredentialHelper)
+}
+
+// CredentialHelperNotSetError is returned when the credential-helper token
+// store is selected without naming a credential helper.
+type CredentialHelperNotSetError struct{}
+
+func (CredentialHelperNotSetError) Error() string {
+	return "The credential-helper token store needs a credential helper. Set CF_CREDENTIAL_HELPER or CredentialHelper in the config file."
+}
@@ -0,0 +1,267 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenStore", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("NewTokenStore", func() {
+		It("keeps the tokens in the config file by default", func() {
+			store, err := NewTokenStore(TokenStoreSettings{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(store).To(BeNil())
+
+			store, err = NewTokenStore(TokenStoreSettings{Type: TokenStorePlaintext})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(store).To(BeNil())
+		})
+
+		It("returns an encrypted file store in the .cf directory", func() {
+			store, err := NewTokenStore(TokenStoreSettings{Type: TokenStoreEncryptedFile, Passphrase: "some-passphrase"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(store.Store("default", Credentials{AccessToken: "some-access-token"})).To(Succeed())
+			Expect(filepath.Join(homeDir, ".cf", EncryptedTokenFileName)).To(BeARegularFile())
+		})
+
+		It("returns a credential helper store", func() {
+			store, err := NewTokenStore(TokenStoreSettings{Type: TokenStoreCredentialHelper, CredentialHelper: "some-helper"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(store).To(BeAssignableToTypeOf(&CredentialHelperTokenStore{}))
+		})
+
+		When("the encrypted file store has no key", func() {
+			It("returns a TokenStoreKeyNotSetError", func() {
+				_, err := NewTokenStore(TokenStoreSettings{Type: TokenStoreEncryptedFile})
+				Expect(err).To(MatchError(TokenStoreKeyNotSetError{}))
+			})
+		})
+
+		When("the credential helper store has no helper", func() {
+			It("returns a CredentialHelperNotSetError", func() {
+				_, err := NewTokenStore(TokenStoreSettings{Type: TokenStoreCredentialHelper})
+				Expect(err).To(MatchError(CredentialHelperNotSetError{}))
+			})
+		})
+
+		When("the credential helper name contains a path separator", func() {
+			It("returns an InvalidCredentialHelperError", func() {
+				_, err := NewTokenStore(TokenStoreSettings{Type: TokenStoreCredentialHelper, CredentialHelper: "/tmp/evil"})
+				Expect(err).To(MatchError(InvalidCredentialHelperError{Name: "/tmp/evil"}))
+			})
+		})
+
+		When("the type is unknown", func() {
+			It("returns an UnknownTokenStoreError", func() {
+				_, err := NewTokenStore(TokenStoreSettings{Type: "keychain"})
+				Expect(err).To(MatchError(UnknownTokenStoreError{Type: "keychain"}))
+			})
+		})
+	})
+
+	Describe("TokenStoreSettings", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					TokenStore:        TokenStoreEncryptedFile,
+					TokenStoreKeyFile: "config-key-file",
+					CredentialHelper:  "config-helper",
+				},
+				ENV: EnvOverride{
+					CFTokenStorePassphrase: "some-passphrase",
+				},
+			}
+		})
+
+		It("uses the config file settings", func() {
+			Expect(config.TokenStoreSettings()).To(Equal(TokenStoreSettings{
+				Type:             TokenStoreEncryptedFile,
+				KeyFile:          "config-key-file",
+				Passphrase:       "some-passphrase",
+				CredentialHelper: "config-helper",
+			}))
+		})
+
+		When("the environment variables are set", func() {
+			BeforeEach(func() {
+				config.ENV.CFTokenStore = TokenStoreCredentialHelper
+				config.ENV.CFTokenStoreKeyFile = "env-key-file"
+				config.ENV.CFCredentialHelper = "env-helper"
+			})
+
+			It("prefers the environment variables", func() {
+				Expect(config.TokenStoreSettings()).To(Equal(TokenStoreSettings{
+					Type:             TokenStoreCredentialHelper,
+					KeyFile:          "env-key-file",
+					Passphrase:       "some-passphrase",
+					CredentialHelper: "env-helper",
+				}))
+			})
+		})
+	})
+
+	Describe("loading and writing the config with a token store", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_TOKEN_STORE", TokenStoreEncryptedFile)).To(Succeed())
+			Expect(os.Setenv("CF_TOKEN_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"AccessToken": "bearer default-access-token",
+				"RefreshToken": "default-refresh-token",
+				"UAAOAuthClient": "cf",
+				"UAAOAuthClientSecret": ""
+			}`)
+			setProfiles(homeDir, `{
+				"CurrentProfile": "default",
+				"Profiles": {
+					"staging": {
+						"AccessToken": "bearer staging-access-token",
+						"RefreshToken": "staging-refresh-token",
+						"UAAOAuthClient": "some-client",
+						"UAAOAuthClientSecret": "some-client-secret"
+					}
+				}
+			}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_TOKEN_STORE")).To(Succeed())
+			Expect(os.Unsetenv("CF_TOKEN_STORE_PASSPHRASE")).To(Succeed())
+		})
+
+		It("moves the tokens of every profile out of the config file", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			configFile := readWrittenConfig(homeDir)
+			Expect(configFile.AccessToken).To(BeEmpty())
+			Expect(configFile.RefreshToken).To(BeEmpty())
+			Expect(configFile.Profiles["staging"].AccessToken).To(BeEmpty())
+			Expect(configFile.Profiles["staging"].RefreshToken).To(BeEmpty())
+			Expect(configFile.Profiles["staging"].UAAOAuthClient).To(Equal("some-client"))
+			Expect(configFile.Profiles["staging"].UAAOAuthClientSecret).To(BeEmpty())
+
+			rawTokenFile, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", EncryptedTokenFileName))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawTokenFile)).ToNot(ContainSubstring("access-token"))
+			Expect(string(rawTokenFile)).ToNot(ContainSubstring("some-client-secret"))
+
+			Expect(config.AccessToken()).To(Equal("bearer default-access-token"))
+		})
+
+		It("reads the tokens back from the store", func() {
+			config.SetAccessToken("bearer new-access-token")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+			Expect(config.RefreshToken()).To(Equal("default-refresh-token"))
+
+			Expect(config.SwitchProfile("staging")).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer staging-access-token"))
+			Expect(config.UAAOAuthClientSecret()).To(Equal("some-client-secret"))
+		})
+
+		It("only reads the tokens of other profiles when they are switched to", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			staging, _ := config.GetProfile("staging")
+			Expect(staging.AccessToken).To(BeEmpty())
+
+			config.SetAccessToken("bearer new-access-token")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			Expect(config.SwitchProfile("staging")).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer staging-access-token"))
+		})
+
+		It("reads the tokens of the profile given by CF_PROFILE", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			defer os.Unsetenv("CF_PROFILE")
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer staging-access-token"))
+		})
+
+		When("the user logs out", func() {
+			It("erases the tokens from the store", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.UnsetUserInformation()
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.RefreshToken()).To(BeEmpty())
+			})
+		})
+
+		When("a profile is deleted", func() {
+			It("erases its tokens from the store", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.DeleteProfile("staging")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				store, err := NewTokenStore(config.TokenStoreSettings())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(store.Get("staging")).To(Equal(Credentials{}))
+			})
+
+			It("erases its tokens from the store when they were never read", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.DeleteProfile("staging")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				store, err := NewTokenStore(config.TokenStoreSettings())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(store.Get("staging")).To(Equal(Credentials{}))
+			})
+		})
+
+		When("the passphrase is wrong", func() {
+			It("returns a TokenStoreDecryptionError", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				Expect(os.Setenv("CF_TOKEN_STORE_PASSPHRASE", "some-other-passphrase")).To(Succeed())
+				_, err := LoadConfig()
+				Expect(err).To(MatchError(TokenStoreDecryptionError{Path: filepath.Join(homeDir, ".cf", EncryptedTokenFileName)}))
+			})
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package configv3
+
+// SyncTokens calls refresh while holding the config lock, after replacing the
+// access and refresh tokens with the ones last stored for the profile in use
+// by any cf process, and then stores the tokens refresh sets. cf processes
+// sharing the config that find the same expired token therefore refresh it
+// once, rather than each spending the same refresh token.
+func (config *Config) SyncTokens(refresh func() error) error {
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = config.reloadTokens()
+	if err != nil {
+		return err
+	}
+
+	accessToken, refreshToken := config.ConfigFile.AccessToken, config.ConfigFile.RefreshToken
+	err = refresh()
+	if err != nil {
+		return err
+	}
+
+	if config.ConfigFile.AccessToken == accessToken && config.ConfigFile.RefreshToken == refreshToken {
+		return nil
+	}
+	return config.storeTokens()
+}
+
+// reloadTokens replaces the access and refresh tokens with the ones stored for
+// the profile in use. The tokens are kept if none are stored.
+func (config *Config) reloadTokens() error {
+	onDisk, exists, err := readConfigFile()
+	if err != nil || !exists {
+		return err
+	}
+
+	name := config.ConfigFile.CurrentProfile
+	profile, exists := onDisk.withAllProfiles().Profiles[name]
+	if !exists {
+		return nil
+	}
+
+	credentials := profile.credentials()
+	if config.tokenStore != nil {
+		credentials, err = config.tokenStore.Get(name)
+		if err != nil {
+			return err
+		}
+		config.storedCredentials[name] = credentials
+	}
+
+	if credentials.AccessToken == "" {
+		return nil
+	}
+
+	config.ConfigFile.AccessToken = credentials.AccessToken
+	config.ConfigFile.RefreshToken = credentials.RefreshToken
+
+	// The reloaded tokens are not changes for WriteConfig to write.
+	return config.setLoadedTokens(name, profile.AccessToken, profile.RefreshToken)
+}
+
+// storeTokens stores the access and refresh tokens of the profile in use, in
+// the token store or in the config file on disk, leaving the other settings
+// on disk as they are. The config lock must be held.
+func (config *Config) storeTokens() error {
+	name := config.ConfigFile.CurrentProfile
+	credentials := config.ConfigFile.credentials()
+
+	if config.tokenStore != nil {
+		if credentials == config.storedCredentials[name] {
+			return nil
+		}
+		err := config.tokenStore.Store(name, credentials)
+		if err != nil {
+			return err
+		}
+		config.storedCredentials[name] = credentials
+		return nil
+	}
+
+	// Without a snapshot of the profile there is nothing to merge the tokens
+	// into; they are written with the rest of the config by WriteConfig.
+	if config.loadedConfigFile == nil {
+		return nil
+	}
+	loaded := config.loadedConfigFile.withAllProfiles()
+	if _, exists := loaded.Profiles[name]; !exists {
+		return nil
+	}
+
+	onDisk, exists, err := readConfigFile()
+	if err != nil || !exists {
+		return err
+	}
+
+	updated, err := loaded.withCurrentProfile().withTokens(name, credentials.AccessToken, credentials.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	err = writeConfigFile(mergeConfigChanges(onDisk, *config.loadedConfigFile, updated))
+	if err != nil {
+		return err
+	}
+
+	config.loadedConfigFile = &updated
+	return nil
+}
+
+// setLoadedTokens sets the tokens of the named profile in the snapshot
+// WriteConfig compares against, if the snapshot has the profile.
+func (config *Config) setLoadedTokens(name string, accessToken string, refreshToken string) error {
+	if config.loadedConfigFile == nil {
+		return nil
+	}
+	if _, exists := config.loadedConfigFile.withAllProfiles().Profiles[name]; !exists {
+		return nil
+	}
+
+	updated, err := config.loadedConfigFile.withTokens(name, accessToken, refreshToken)
+	if err != nil {
+		return err
+	}
+	config.loadedConfigFile = &updated
+	return nil
+}
+
+// withTokens returns a copy of the config file with the tokens of the named
+// profile replaced.
+func (jsonConfig JSONConfig) withTokens(name string, accessToken string, refreshToken string) (JSONConfig, error) {
+	clone, err := jsonConfig.clone()
+	if err != nil {
+		return JSONConfig{}, err
+	}
+
+	allProfiles := clone.withAllProfiles()
+	profile := allProfiles.Profiles[name]
+	profile.AccessToken = accessToken
+	profile.RefreshToken = refreshToken
+	allProfiles.Profiles[name] = profile
+	return allProfiles.withCurrentProfile(), nil
+}
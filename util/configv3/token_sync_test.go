@@ -0,0 +1,234 @@
+package configv3_test
+
+import (
+	"os"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SyncTokens", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		setConfig(homeDir, `{
+			"ConfigVersion": 3,
+			"AccessToken": "bearer old-access-token",
+			"RefreshToken": "old-refresh-token"
+		}`)
+		setProfiles(homeDir, `{
+			"CurrentProfile": "default",
+			"Profiles": {
+				"staging": {"AccessToken": "bearer old-staging-access-token"}
+			}
+		}`)
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+
+	refreshTo := func(accessToken string, refreshToken string) func() error {
+		return func() error {
+			config.SetAccessToken(accessToken)
+			config.SetRefreshToken(refreshToken)
+			return nil
+		}
+	}
+
+	When("another process wrote new tokens", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"AccessToken": "bearer new-access-token",
+				"RefreshToken": "new-refresh-token"
+			}`)
+			setProfiles(homeDir, `{
+				"CurrentProfile": "default",
+				"Profiles": {
+					"staging": {"AccessToken": "bearer new-staging-access-token"}
+				}
+			}`)
+		})
+
+		It("refreshes with the new tokens", func() {
+			var accessToken, refreshToken string
+			Expect(config.SyncTokens(func() error {
+				accessToken, refreshToken = config.AccessToken(), config.RefreshToken()
+				return nil
+			})).To(Succeed())
+
+			Expect(accessToken).To(Equal("bearer new-access-token"))
+			Expect(refreshToken).To(Equal("new-refresh-token"))
+			Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+		})
+
+		It("reads the tokens of the profile in use", func() {
+			Expect(config.SwitchProfile("staging")).To(Succeed())
+
+			Expect(config.SyncTokens(func() error { return nil })).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer new-staging-access-token"))
+		})
+
+		It("does not count the new tokens as changed by this process", func() {
+			Expect(config.SyncTokens(func() error { return nil })).To(Succeed())
+
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"AccessToken": "bearer newest-access-token",
+				"RefreshToken": "newest-refresh-token"
+			}`)
+			setProfiles(homeDir, `{"CurrentProfile": "default"}`)
+			Expect(WriteConfig(config)).To(Succeed())
+
+			Expect(readWrittenConfig(homeDir).AccessToken).To(Equal("bearer newest-access-token"))
+		})
+	})
+
+	When("another process logged out", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ConfigVersion": 3}`)
+			setProfiles(homeDir, `{"CurrentProfile": "default"}`)
+		})
+
+		It("keeps the tokens", func() {
+			Expect(config.SyncTokens(func() error { return nil })).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer old-access-token"))
+			Expect(config.RefreshToken()).To(Equal("old-refresh-token"))
+		})
+	})
+
+	When("refresh sets new tokens", func() {
+		It("writes only the new tokens to the config file", func() {
+			config.SetOrganizationInformation("some-org-guid", "some-org")
+
+			Expect(config.SyncTokens(refreshTo("bearer refreshed-access-token", "refreshed-refresh-token"))).To(Succeed())
+
+			configFile := readWrittenConfig(homeDir)
+			Expect(configFile.AccessToken).To(Equal("bearer refreshed-access-token"))
+			Expect(configFile.RefreshToken).To(Equal("refreshed-refresh-token"))
+			Expect(configFile.TargetedOrganization.GUID).To(BeEmpty())
+			Expect(configFile.Profiles["staging"].AccessToken).To(Equal("bearer old-staging-access-token"))
+		})
+
+		It("writes the tokens of the profile in use", func() {
+			Expect(config.SwitchProfile("staging")).To(Succeed())
+
+			Expect(config.SyncTokens(refreshTo("bearer refreshed-staging-access-token", "refreshed-staging-refresh-token"))).To(Succeed())
+
+			configFile := readWrittenConfig(homeDir)
+			Expect(configFile.AccessToken).To(Equal("bearer old-access-token"))
+			Expect(configFile.Profiles["staging"].AccessToken).To(Equal("bearer refreshed-staging-access-token"))
+		})
+
+		It("does not write the tokens again with the rest of the config", func() {
+			Expect(config.SyncTokens(refreshTo("bearer refreshed-access-token", "refreshed-refresh-token"))).To(Succeed())
+
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"AccessToken": "bearer newest-access-token",
+				"RefreshToken": "newest-refresh-token"
+			}`)
+			setProfiles(homeDir, `{"CurrentProfile": "default"}`)
+			config.SetOrganizationInformation("some-org-guid", "some-org")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			configFile := readWrittenConfig(homeDir)
+			Expect(configFile.AccessToken).To(Equal("bearer newest-access-token"))
+			Expect(configFile.TargetedOrganization.GUID).To(Equal("some-org-guid"))
+		})
+
+		When("a token store is configured", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_TOKEN_STORE", TokenStoreEncryptedFile)).To(Succeed())
+				Expect(os.Setenv("CF_TOKEN_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_TOKEN_STORE")).To(Succeed())
+				Expect(os.Unsetenv("CF_TOKEN_STORE_PASSPHRASE")).To(Succeed())
+			})
+
+			It("stores the tokens in the token store", func() {
+				Expect(config.SyncTokens(refreshTo("bearer refreshed-access-token", "refreshed-refresh-token"))).To(Succeed())
+
+				store, err := NewTokenStore(config.TokenStoreSettings())
+				Expect(err).ToNot(HaveOccurred())
+				credentials, err := store.Get("default")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.AccessToken).To(Equal("bearer refreshed-access-token"))
+				Expect(credentials.RefreshToken).To(Equal("refreshed-refresh-token"))
+			})
+		})
+	})
+
+	When("refresh fails", func() {
+		It("returns the error without writing anything", func() {
+			Expect(config.SyncTokens(func() error {
+				config.SetAccessToken("bearer half-refreshed-access-token")
+				return os.ErrPermission
+			})).To(MatchError(os.ErrPermission))
+
+			Expect(readWrittenConfig(homeDir).AccessToken).To(Equal("bearer old-access-token"))
+		})
+	})
+
+	When("several processes find the same expired token", func() {
+		It("refreshes it once", func() {
+			configs := make([]*Config, 5)
+			for i := range configs {
+				var err error
+				configs[i], err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			var (
+				refreshesMutex sync.Mutex
+				refreshes      int
+				wg             sync.WaitGroup
+			)
+			for _, config := range configs {
+				wg.Add(1)
+				go func(config *Config) {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					Expect(config.SyncTokens(func() error {
+						if config.RefreshToken() != "old-refresh-token" {
+							return nil
+						}
+						refreshesMutex.Lock()
+						refreshes++
+						refreshesMutex.Unlock()
+						config.SetAccessToken("bearer refreshed-access-token")
+						config.SetRefreshToken("refreshed-refresh-token")
+						return nil
+					})).To(Succeed())
+				}(config)
+			}
+			wg.Wait()
+
+			Expect(refreshes).To(Equal(1))
+			for _, config := range configs {
+				Expect(config.RefreshToken()).To(Equal("refreshed-refresh-token"))
+			}
+		})
+	})
+})
@@ -5,24 +5,81 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 )
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory, along with the profiles file next to it. When a token store is
+// configured, the tokens are written to it instead of the config.json.
+//
+// Other cf processes may have changed the config.json since it was loaded, so
+// it is written while holding the config lock, and only the settings changed
+// by this process are applied to the config.json on disk.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	configFile := c.fileContents()
+	err = c.storeCredentials(&configFile)
+	if err != nil {
+		return err
+	}
+
+	// Later writes compare against the config as this process sees it, not
+	// the merged config written to disk.
+	var written *JSONConfig
+	if c.loadedConfigFile != nil {
+		written, err = configFile.clone()
+		if err != nil {
+			return err
+		}
+
+		onDisk, exists, readErr := readConfigFile()
+		if readErr != nil {
+			return readErr
+		}
+		if exists {
+			configFile = mergeConfigChanges(onDisk, *c.loadedConfigFile, configFile)
+		}
+	}
+
+	err = writeConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	if written != nil {
+		c.loadedConfigFile = written
+	}
+	return nil
+}
+
+// writeConfigFile replaces the config file and the profiles file with
+// configFile. The config lock must be held.
+func writeConfigFile(configFile JSONConfig) error {
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
 
 	dir := configDirectory()
-	err = os.MkdirAll(dir, 0700)
+	err = writeConfigDirectoryFile(dir, filepath.Base(ConfigFilePath()), rawConfig)
 	if err != nil {
 		return err
 	}
 
+	return WriteProfilesFile(dir, configFile.profilesFile())
+}
+
+// writeConfigDirectoryFile replaces the named file in the config directory by
+// writing a temp file and renaming it, so that other cf processes never read
+// a partially written file.
+func writeConfigDirectoryFile(dir string, name string, contents []byte) error {
 	// Developer Note: The following is untested! Change at your own risk.
 	// Setup notifications of termination signals to channel sig, create a process to
 	// watch for these signals so we can remove transient config temp files.
@@ -39,12 +96,12 @@ func WriteConfig(c *Config) error {
 
 	go catchSignal(sig, tempConfigFileName)
 
-	err = ioutil.WriteFile(tempConfigFileName, rawConfig, 0600)
+	err = ioutil.WriteFile(tempConfigFileName, contents, 0600)
 	if err != nil {
 		return err
 	}
 
-	return os.Rename(tempConfigFileName, ConfigFilePath())
+	return os.Rename(tempConfigFileName, filepath.Join(dir, name))
 }
 
 // catchSignal tries to catch SIGHUP, SIGINT, SIGKILL, SIGQUIT and SIGTERM, and
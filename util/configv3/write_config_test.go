@@ -2,8 +2,10 @@ package configv3_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"sync"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -55,5 +57,142 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
 		})
+
+		When("another process changed the config file since it was loaded", func() {
+			var writtenCFConfig JSONConfig
+
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.foo.com",
+					"AccessToken": "bearer old-access-token",
+					"RefreshToken": "old-refresh-token",
+					"OrganizationFields": {"GUID": "old-org-guid", "Name": "old-org"}
+				}`)
+				setProfiles(homeDir, `{
+					"CurrentProfile": "default",
+					"Profiles": {
+						"staging": {"Target": "https://api.staging.com"},
+						"prod": {"Target": "https://api.prod.com"}
+					}
+				}`)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.foo.com",
+					"AccessToken": "bearer other-access-token",
+					"RefreshToken": "other-refresh-token",
+					"OrganizationFields": {"GUID": "old-org-guid", "Name": "old-org"}
+				}`)
+				setProfiles(homeDir, `{
+					"CurrentProfile": "default",
+					"Profiles": {
+						"staging": {"Target": "https://api.staging.com", "AccessToken": "bearer staging-access-token"},
+						"prod": {"Target": "https://api.prod.com"}
+					}
+				}`)
+			})
+
+			JustBeforeEach(func() {
+				Expect(WriteConfig(config)).To(Succeed())
+				writtenCFConfig = readWrittenConfig(homeDir)
+			})
+
+			When("this process changed other settings", func() {
+				BeforeEach(func() {
+					config.SetOrganizationInformation("new-org-guid", "new-org")
+					config.DeleteProfile("prod")
+				})
+
+				It("keeps the changes of both processes", func() {
+					Expect(writtenCFConfig.AccessToken).To(Equal("bearer other-access-token"))
+					Expect(writtenCFConfig.RefreshToken).To(Equal("other-refresh-token"))
+					Expect(writtenCFConfig.TargetedOrganization.GUID).To(Equal("new-org-guid"))
+					Expect(writtenCFConfig.TargetedOrganization.Name).To(Equal("new-org"))
+					Expect(writtenCFConfig.Profiles).To(HaveLen(1))
+					Expect(writtenCFConfig.Profiles["staging"].AccessToken).To(Equal("bearer staging-access-token"))
+				})
+			})
+
+			When("this process changed the same settings", func() {
+				BeforeEach(func() {
+					config.SetAccessToken("bearer new-access-token")
+				})
+
+				It("writes the settings changed by this process", func() {
+					Expect(writtenCFConfig.AccessToken).To(Equal("bearer new-access-token"))
+					Expect(writtenCFConfig.RefreshToken).To(Equal("other-refresh-token"))
+				})
+			})
+
+			When("this process switched to another profile", func() {
+				BeforeEach(func() {
+					Expect(config.SwitchProfile("staging")).To(Succeed())
+				})
+
+				It("keeps the changes the other process made to either profile", func() {
+					Expect(writtenCFConfig.CurrentProfile).To(Equal("staging"))
+					Expect(writtenCFConfig.Target).To(Equal("https://api.staging.com"))
+					Expect(writtenCFConfig.AccessToken).To(Equal("bearer staging-access-token"))
+					Expect(writtenCFConfig.Profiles["default"].AccessToken).To(Equal("bearer other-access-token"))
+					Expect(writtenCFConfig.Profiles["default"].TargetedOrganization.GUID).To(Equal("old-org-guid"))
+				})
+			})
+
+			When("the config is written twice", func() {
+				BeforeEach(func() {
+					config.SetOrganizationInformation("new-org-guid", "new-org")
+					Expect(WriteConfig(config)).To(Succeed())
+
+					setConfig(homeDir, `{
+						"ConfigVersion": 3,
+						"Target": "https://api.foo.com",
+						"AccessToken": "bearer third-access-token",
+						"OrganizationFields": {"GUID": "new-org-guid", "Name": "new-org"}
+					}`)
+					setProfiles(homeDir, `{"CurrentProfile": "default"}`)
+				})
+
+				It("only writes the settings changed since the last write", func() {
+					Expect(writtenCFConfig.AccessToken).To(Equal("bearer third-access-token"))
+					Expect(writtenCFConfig.TargetedOrganization.GUID).To(Equal("new-org-guid"))
+				})
+			})
+		})
+	})
+
+	Describe("WriteConfig from several configs at once", func() {
+		It("keeps the changes of every config", func() {
+			setConfig(homeDir, `{"ConfigVersion": 3}`)
+			setProfiles(homeDir, `{"CurrentProfile": "default"}`)
+
+			configs := make([]*Config, 10)
+			for i := range configs {
+				var err error
+				configs[i], err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				configs[i].CreateProfile(fmt.Sprintf("profile-%d", i))
+			}
+
+			var wg sync.WaitGroup
+			for _, config := range configs {
+				wg.Add(1)
+				go func(config *Config) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(WriteConfig(config)).To(Succeed())
+				}(config)
+			}
+			wg.Wait()
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Profiles()).To(HaveLen(len(configs) + 1))
+		})
 	})
+
 })
@@ -9,6 +9,12 @@ type ApplicationModel struct {
 	Path        string  `yaml:"path"`
 	NoRoute     bool    `yaml:"no-route"`
 	RandomRoute bool    `yaml:"random-route"`
+	// DependsOn lists the apps in the same manifest that must finish pushing
+	// before this app is pushed. It is only used by the CLI and is not sent
+	// to the API.
+	DependsOn []string `yaml:"depends-on"`
+	// Metadata holds the labels and annotations the manifest sets on the app.
+	Metadata *Metadata `yaml:"metadata"`
 }
 
 type Application struct {
@@ -25,7 +31,15 @@ func (application *Application) UnmarshalYAML(unmarshal func(v interface{}) erro
 	if err != nil {
 		return err
 	}
-	return unmarshal(&application.ApplicationModel)
+	err = unmarshal(&application.ApplicationModel)
+	if err != nil {
+		return err
+	}
+	delete(application.FullUnmarshalledApplication, "depends-on")
+	if application.Metadata != nil {
+		application.FullUnmarshalledApplication["metadata"] = application.Metadata.manifestMap()
+	}
+	return nil
 }
 
 type Docker struct {
@@ -1,6 +1,7 @@
 package manifestparser_test
 
 import (
+	"code.cloudfoundry.org/cli/types"
 	. "code.cloudfoundry.org/cli/util/manifestparser"
 	"gopkg.in/yaml.v2"
 
@@ -87,5 +88,84 @@ random-route: true
 				Expect(application.RandomRoute).To(BeTrue())
 			})
 		})
+
+		Context("when depends-on is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+name: web
+depends-on:
+- migrations
+`)
+			})
+
+			It("unmarshals the depends-on property", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.DependsOn).To(Equal([]string{"migrations"}))
+			})
+
+			It("does not keep depends-on in the full application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.FullUnmarshalledApplication).ToNot(HaveKey("depends-on"))
+			})
+		})
+
+		Context("when metadata is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+name: web
+metadata:
+  labels:
+    env: prod
+  annotations:
+    owner: team-a
+    ticket: 1234
+    retired: null
+`)
+			})
+
+			It("unmarshals the labels and annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Metadata).To(Equal(&Metadata{
+					Labels: map[string]types.NullString{
+						"env": types.NewNullString("prod"),
+					},
+					Annotations: map[string]types.NullString{
+						"owner":   types.NewNullString("team-a"),
+						"ticket":  types.NewNullString("1234"),
+						"retired": types.NewNullString(),
+					},
+				}))
+			})
+
+			It("keeps the metadata in the full application with string values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.FullUnmarshalledApplication["metadata"]).To(Equal(map[string]interface{}{
+					"labels": map[string]interface{}{
+						"env": "prod",
+					},
+					"annotations": map[string]interface{}{
+						"owner":   "team-a",
+						"ticket":  "1234",
+						"retired": nil,
+					},
+				}))
+			})
+		})
+
+		Context("when an annotation value is not a scalar", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+name: web
+metadata:
+  annotations:
+    owner:
+      name: team-a
+`)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(ContainSubstring("expected a string value")))
+			})
+		})
 	})
 })
@@ -0,0 +1,14 @@
+package manifestparser
+
+import "fmt"
+
+// InvalidManifestDependencyError is returned when an app's depends-on lists
+// itself or an app that is not in the manifest.
+type InvalidManifestDependencyError struct {
+	AppName   string
+	DependsOn string
+}
+
+func (e InvalidManifestDependencyError) Error() string {
+	return fmt.Sprintf("App '%s' depends on '%s', which is not another app in the manifest", e.AppName, e.DependsOn)
+}
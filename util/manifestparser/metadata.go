@@ -0,0 +1,36 @@
+package manifestparser
+
+import "code.cloudfoundry.org/cli/types"
+
+// Metadata is the labels and annotations a manifest sets on an app. A key
+// with a null value removes that label or annotation from the app.
+type Metadata struct {
+	Labels      map[string]types.NullString `yaml:"labels"`
+	Annotations map[string]types.NullString `yaml:"annotations"`
+}
+
+// manifestMap returns the metadata with every value as a string, or nil when
+// it is unset, so that unquoted numbers and booleans in the manifest are
+// accepted by the API.
+func (metadata Metadata) manifestMap() map[string]interface{} {
+	fields := map[string]interface{}{}
+	if metadata.Labels != nil {
+		fields["labels"] = nullStringMap(metadata.Labels)
+	}
+	if metadata.Annotations != nil {
+		fields["annotations"] = nullStringMap(metadata.Annotations)
+	}
+	return fields
+}
+
+func nullStringMap(values map[string]types.NullString) map[string]interface{} {
+	converted := map[string]interface{}{}
+	for key, value := range values {
+		if value.IsSet {
+			converted[key] = value.Value
+		} else {
+			converted[key] = nil
+		}
+	}
+	return converted
+}
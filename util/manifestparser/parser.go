@@ -138,6 +138,11 @@ func (parser *Parser) parse(manifestBytes []byte, appName string) error {
 		raw.Applications[0].FullUnmarshalledApplication["name"] = appName
 	}
 
+	err = validateDependencies(raw.Applications)
+	if err != nil {
+		return err
+	}
+
 	filteredIndex := -1
 
 	for i := range raw.Applications {
@@ -184,3 +189,20 @@ func (parser *Parser) parse(manifestBytes []byte, appName string) error {
 	parser.hasParsed = true
 	return nil
 }
+
+func validateDependencies(applications []Application) error {
+	appNames := map[string]bool{}
+	for _, app := range applications {
+		appNames[app.Name] = true
+	}
+
+	for _, app := range applications {
+		for _, dependency := range app.DependsOn {
+			if dependency == app.Name || !appNames[dependency] {
+				return InvalidManifestDependencyError{AppName: app.Name, DependsOn: dependency}
+			}
+		}
+	}
+
+	return nil
+}
@@ -385,6 +385,45 @@ applications:
 			})
 		})
 
+		When("an app depends on another app", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`---
+applications:
+- name: migrations
+- name: web
+  depends-on: [migrations]
+`)
+				err := ioutil.WriteFile(pathToManifest, rawManifest, 0666)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("parses the dependency and leaves it out of the raw manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parser.Applications[1].DependsOn).To(Equal([]string{"migrations"}))
+				Expect(parser.FullRawManifest()).To(MatchYAML(`---
+applications:
+- name: migrations
+- name: web
+`))
+			})
+		})
+
+		When("an app depends on an app that is not in the manifest", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`---
+applications:
+- name: web
+  depends-on: [migrations]
+`)
+				err := ioutil.WriteFile(pathToManifest, rawManifest, 0666)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestDependencyError{AppName: "web", DependsOn: "migrations"}))
+			})
+		})
+
 		When("passing an app name override", func() {
 			BeforeEach(func() {
 				appName = "mashed-potato"
@@ -0,0 +1,16 @@
+package progressbar
+
+import "io"
+
+// NoopProgressBar tracks nothing and draws nothing. It is used when several
+// uploads run at the same time and their progress bars would overwrite each
+// other.
+type NoopProgressBar struct{}
+
+func (NoopProgressBar) Complete() {}
+
+func (NoopProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return reader
+}
+
+func (NoopProgressBar) Ready() {}
@@ -0,0 +1,31 @@
+package progressbar
+
+import (
+	"io"
+	"time"
+
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// TransferProgressBar draws the progress of a copy whose bytes are written to
+// it as they are transferred.
+type TransferProgressBar struct {
+	bar *pb.ProgressBar
+}
+
+func NewTransferProgressBar() *TransferProgressBar {
+	return &TransferProgressBar{}
+}
+
+func (p *TransferProgressBar) Start(totalBytes int64) io.Writer {
+	p.bar = pb.New64(totalBytes).SetUnits(pb.U_BYTES)
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar
+}
+
+func (p *TransferProgressBar) Finish() {
+	// Adding sleep to ensure UI has finished drawing
+	time.Sleep(time.Second)
+	p.bar.Finish()
+}
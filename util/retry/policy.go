@@ -0,0 +1,148 @@
+// Package retry decides whether, and after how long, a failed HTTP request
+// should be retried.
+package retry
+
+import (
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Policy describes how failed requests are retried. Requests are retried at
+// most MaxRetries times. The delay before each retry doubles from
+// InitialBackoff up to MaxBackoff, with a random jitter of up to half the
+// delay. A Retry-After header on a 429 or 503 response is used as the delay
+// instead, unless it is longer than MaxBackoff, in which case the request is
+// not retried.
+type Policy struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Delay returns how long to wait before retrying a request that failed on the
+// given attempt (starting from 0), and whether it should be retried at all.
+// response is nil when no response was received, in which case err is used
+// to determine whether the failure was transient.
+//
+// Rate limited (429) responses, and 503 responses with a Retry-After header,
+// are retried for all methods since the server did not process the request.
+// Other 5XX responses and transient network errors are only retried for
+// idempotent methods.
+func (policy Policy) Delay(attempt int, method string, response *http.Response, err error) (time.Duration, bool) {
+	if attempt >= policy.MaxRetries {
+		return 0, false
+	}
+
+	if response == nil {
+		if isIdempotent(method) && IsTransientError(err) {
+			return policy.backoff(attempt), true
+		}
+		return 0, false
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return retryAfter, retryAfter <= policy.MaxBackoff
+		}
+
+		if response.StatusCode == http.StatusTooManyRequests || isIdempotent(method) {
+			return policy.backoff(attempt), true
+		}
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		if isIdempotent(method) {
+			return policy.backoff(attempt), true
+		}
+	}
+
+	return 0, false
+}
+
+// IsTransientError returns true if err is a timeout or a connection that was
+// reset or closed by the server.
+func IsTransientError(err error) bool {
+	for err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return true
+		}
+
+		switch e := err.(type) {
+		case *url.Error:
+			err = e.Err
+		case *net.OpError:
+			err = e.Err
+		case *os.SyscallError:
+			err = e.Err
+		case syscall.Errno:
+			return e == syscall.ECONNRESET || e == syscall.ECONNABORTED || e == syscall.EPIPE
+		default:
+			return err == io.EOF ||
+				err == io.ErrUnexpectedEOF ||
+				strings.Contains(err.Error(), "connection reset by peer")
+		}
+	}
+
+	return false
+}
+
+func (policy Policy) backoff(attempt int) time.Duration {
+	delay := policy.InitialBackoff
+	for i := 0; i < attempt && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := time.Until(date)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
@@ -0,0 +1,141 @@
+package retry_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ = Describe("Policy", func() {
+	var policy Policy
+
+	BeforeEach(func() {
+		policy = Policy{
+			MaxRetries:     3,
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Second,
+		}
+	})
+
+	responseWithStatus := func(statusCode int, retryAfter string) *http.Response {
+		response := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+		if retryAfter != "" {
+			response.Header.Set("Retry-After", retryAfter)
+		}
+		return response
+	}
+
+	Describe("Delay", func() {
+		DescribeTable("whether the request is retried",
+			func(method string, statusCode int, retryAfter string, expectedRetry bool) {
+				_, retry := policy.Delay(0, method, responseWithStatus(statusCode, retryAfter), errors.New("some-error"))
+				Expect(retry).To(Equal(expectedRetry))
+			},
+
+			Entry("GET 500", http.MethodGet, http.StatusInternalServerError, "", true),
+			Entry("GET 502", http.MethodGet, http.StatusBadGateway, "", true),
+			Entry("GET 503", http.MethodGet, http.StatusServiceUnavailable, "", true),
+			Entry("GET 504", http.MethodGet, http.StatusGatewayTimeout, "", true),
+			Entry("PUT 500", http.MethodPut, http.StatusInternalServerError, "", true),
+			Entry("DELETE 502", http.MethodDelete, http.StatusBadGateway, "", true),
+			Entry("GET 404", http.MethodGet, http.StatusNotFound, "", false),
+			Entry("GET 501", http.MethodGet, http.StatusNotImplemented, "", false),
+
+			Entry("POST 500", http.MethodPost, http.StatusInternalServerError, "", false),
+			Entry("PATCH 502", http.MethodPatch, http.StatusBadGateway, "", false),
+			Entry("POST 503 without Retry-After", http.MethodPost, http.StatusServiceUnavailable, "", false),
+			Entry("POST 503 with Retry-After", http.MethodPost, http.StatusServiceUnavailable, "1", true),
+
+			Entry("GET 429", http.MethodGet, http.StatusTooManyRequests, "", true),
+			Entry("POST 429", http.MethodPost, http.StatusTooManyRequests, "", true),
+			Entry("POST 429 with Retry-After", http.MethodPost, http.StatusTooManyRequests, "2", true),
+			Entry("GET 429 with Retry-After longer than MaxBackoff", http.MethodGet, http.StatusTooManyRequests, "60", false),
+		)
+
+		It("does not retry once MaxRetries is reached", func() {
+			_, retry := policy.Delay(2, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+			Expect(retry).To(BeTrue())
+
+			_, retry = policy.Delay(3, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+			Expect(retry).To(BeFalse())
+		})
+
+		It("doubles the delay on each attempt with up to half of it as jitter, capped at MaxBackoff", func() {
+			for i := 0; i < 20; i++ {
+				delay, _ := policy.Delay(0, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+				Expect(delay).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+
+				delay, _ = policy.Delay(1, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+				Expect(delay).To(BeNumerically("~", 1500*time.Millisecond, 500*time.Millisecond))
+
+				delay, _ = policy.Delay(2, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+				Expect(delay).To(BeNumerically("~", 3*time.Second, time.Second))
+			}
+
+			policy.MaxRetries = 10
+			delay, _ := policy.Delay(8, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+			Expect(delay).To(BeNumerically("<=", 5*time.Second))
+			Expect(delay).To(BeNumerically(">=", 2500*time.Millisecond))
+		})
+
+		It("does not wait when InitialBackoff is 0", func() {
+			policy.InitialBackoff = 0
+			delay, retry := policy.Delay(2, http.MethodGet, responseWithStatus(http.StatusBadGateway, ""), nil)
+			Expect(retry).To(BeTrue())
+			Expect(delay).To(BeZero())
+		})
+
+		When("the response has a Retry-After header", func() {
+			It("waits for the number of seconds in the header", func() {
+				delay, retry := policy.Delay(0, http.MethodGet, responseWithStatus(http.StatusTooManyRequests, "3"), nil)
+				Expect(retry).To(BeTrue())
+				Expect(delay).To(Equal(3 * time.Second))
+			})
+
+			It("waits until the date in the header", func() {
+				date := time.Now().Add(4 * time.Second).UTC().Format(http.TimeFormat)
+				delay, retry := policy.Delay(0, http.MethodGet, responseWithStatus(http.StatusServiceUnavailable, date), nil)
+				Expect(retry).To(BeTrue())
+				Expect(delay).To(BeNumerically("~", 4*time.Second, time.Second))
+			})
+
+			It("falls back to the backoff when the header is invalid", func() {
+				delay, retry := policy.Delay(0, http.MethodGet, responseWithStatus(http.StatusTooManyRequests, "soon"), nil)
+				Expect(retry).To(BeTrue())
+				Expect(delay).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+			})
+		})
+
+		When("no response was received", func() {
+			DescribeTable("retries transient errors for idempotent methods",
+				func(method string, err error, expectedRetry bool) {
+					_, retry := policy.Delay(0, method, nil, err)
+					Expect(retry).To(Equal(expectedRetry))
+				},
+
+				Entry("GET timeout", http.MethodGet, &url.Error{Op: "Get", URL: "https://example.com", Err: timeoutError{}}, true),
+				Entry("PUT connection reset", http.MethodPut, &url.Error{Op: "Put", URL: "https://example.com", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true),
+				Entry("GET unexpected EOF", http.MethodGet, &url.Error{Op: "Get", URL: "https://example.com", Err: io.EOF}, true),
+				Entry("GET other error", http.MethodGet, errors.New("x509: certificate signed by unknown authority"), false),
+				Entry("POST timeout", http.MethodPost, &url.Error{Op: "Post", URL: "https://example.com", Err: timeoutError{}}, false),
+				Entry("GET nil error", http.MethodGet, nil, false),
+			)
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// Interface is the set of methods UI provides to commands. It is declared
+// here, rather than only by the commands, so that WithAppPrefix can return it.
+type Interface interface {
+	DeferText(template string, data ...map[string]interface{})
+	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
+	DisplayChangesForPush(changeSet []Change) error
+	DisplayDeprecationWarning()
+	DisplayError(err error)
+	DisplayFileDeprecationWarning()
+	DisplayHeader(text string)
+	DisplayInstancesTableForApp(table [][]string)
+	DisplayKeyValueTable(prefix string, table [][]string, padding int)
+	DisplayKeyValueTableForApp(table [][]string)
+	DisplayLogMessage(message LogMessage, displayHeader bool)
+	DisplayLogMessageJSON(message LogMessage) error
+	DisplayNewline()
+	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
+	DisplayOK()
+	DisplayOptionalTextPrompt(defaultValue string, template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayStructuredOutput(format configv3.OutputFormat, data interface{}) error
+	DisplayTableWithHeader(prefix string, table [][]string, padding int)
+	DisplayText(template string, data ...map[string]interface{})
+	DisplayTextMenu(choices []string, promptTemplate string, templateValues ...map[string]interface{}) (string, error)
+	DisplayTextPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayTextWithBold(text string, keys ...map[string]interface{})
+	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
+	DisplayWarning(formattedString string, keys ...map[string]interface{})
+	DisplayWarnings(warnings []string)
+	DisplayWarningV7(formattedString string, keys ...map[string]interface{})
+	Flush()
+	GetErr() io.Writer
+	GetIn() io.Reader
+	GetOut() io.Writer
+	RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter
+	RequestLoggerTerminalDisplay() *RequestLoggerTerminalDisplay
+	TranslateText(template string, data ...map[string]interface{}) string
+	UserFriendlyDate(input time.Time) string
+	WithAppPrefix(appName string) Interface
+	Writer() io.Writer
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,11 +24,38 @@ type LogMessage interface {
 	SourceInstance() string
 }
 
-// DisplayLogMessage formats and outputs a given log message.
+//go:generate counterfeiter . AppLogMessage
+
+// AppLogMessage is a log message tagged with the name of the application it
+// was logged by. It is used when displaying the logs of several applications
+// together.
+type AppLogMessage interface {
+	LogMessage
+	AppName() string
+}
+
+// appNameColors are the colors application name prefixes cycle through. Red
+// is left out since it is used for ERR lines.
+var appNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgBlue,
+	color.FgGreen,
+}
+
+// DisplayLogMessage formats and outputs a given log message. If the message is
+// an AppLogMessage with an application name, each line is prefixed with the
+// name in a color picked for that application.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	var prefix string
+	if appName := logMessageAppName(message); appName != "" {
+		prefix = ui.modifyColor(fmt.Sprintf("[%s]", appName), ui.appNameColor(appName)) + " "
+	}
+
 	var header string
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
@@ -45,6 +73,60 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
 	}
 }
+
+// appNameColor returns the color for the application's name prefix. Colors are
+// handed out in the order applications are first displayed.
+func (ui *UI) appNameColor(appName string) *color.Color {
+	if ui.appColors == nil {
+		ui.appColors = map[string]*color.Color{}
+	}
+
+	if appColor, ok := ui.appColors[appName]; ok {
+		return appColor
+	}
+
+	appColor := color.New(appNameColors[len(ui.appColors)%len(appNameColors)], color.Bold)
+	ui.appColors[appName] = appColor
+	return appColor
+}
+
+func logMessageAppName(message LogMessage) string {
+	if appMessage, ok := message.(AppLogMessage); ok {
+		return appMessage.AppName()
+	}
+	return ""
+}
+
+type logMessageJSON struct {
+	AppName        string `json:"app_name,omitempty"`
+	Timestamp      string `json:"timestamp"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	MessageType    string `json:"message_type"`
+	Message        string `json:"message"`
+}
+
+// DisplayLogMessageJSON outputs a given log message as a single line JSON
+// object. The application name is included for an AppLogMessage.
+func (ui *UI) DisplayLogMessageJSON(message LogMessage) error {
+	line, err := json.Marshal(logMessageJSON{
+		AppName:        logMessageAppName(message),
+		Timestamp:      message.Timestamp().UTC().Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		MessageType:    message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprintf(ui.Out, "%s\n", line)
+	return err
+}
@@ -114,5 +114,77 @@ var _ = Describe("Log Message", func() {
 				Expect(out).To(Say("\x1b\\[31mThis is a log message\x1b\\[0m\n"))
 			})
 		})
+
+		Context("app log messages", func() {
+			var appMessage *uifakes.FakeAppLogMessage
+
+			newAppMessage := func(appName string) *uifakes.FakeAppLogMessage {
+				m := new(uifakes.FakeAppLogMessage)
+				m.AppNameReturns(appName)
+				m.MessageReturns("This is a log message")
+				m.TypeReturns("OUT")
+				m.TimestampReturns(time.Unix(1468969692, 0))
+				m.SourceTypeReturns("APP/PROC/WEB")
+				m.SourceInstanceReturns("12")
+				return m
+			}
+
+			BeforeEach(func() {
+				appMessage = newAppMessage("app-1")
+			})
+
+			It("prefixes each line with the app name in the app's color", func() {
+				ui.DisplayLogMessage(appMessage, true)
+				ui.DisplayLogMessage(newAppMessage("app-2"), false)
+				ui.DisplayLogMessage(appMessage, false)
+				Expect(out).To(Say("\x1b\\[36;1m\\[app-1\\]\x1b\\[0m 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is a log message\n"))
+				Expect(out).To(Say("\x1b\\[35;1m\\[app-2\\]\x1b\\[0m This is a log message\n"))
+				Expect(out).To(Say("\x1b\\[36;1m\\[app-1\\]\x1b\\[0m This is a log message\n"))
+			})
+
+			When("the app name is empty", func() {
+				BeforeEach(func() {
+					appMessage.AppNameReturns("")
+				})
+
+				It("does not prefix the line", func() {
+					ui.DisplayLogMessage(appMessage, false)
+					Expect(out).To(Say("   This is a log message\n"))
+				})
+			})
+		})
+	})
+
+	Describe("DisplayLogMessageJSON", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 500))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints the message as a single line of JSON in UTC without color", func() {
+			Expect(ui.DisplayLogMessageJSON(message)).To(Succeed())
+			Expect(string(out.Contents())).To(Equal(`{"timestamp":"2016-07-19T23:08:12.0000005Z","source_type":"APP/PROC/WEB","source_instance":"12","message_type":"ERR","message":"This is a \"log\" message\nwith two lines"}` + "\n"))
+		})
+
+		When("the message is an app log message", func() {
+			It("includes the app name", func() {
+				appMessage := new(uifakes.FakeAppLogMessage)
+				appMessage.AppNameReturns("some-app")
+				appMessage.MessageReturns("hello")
+				appMessage.TypeReturns("OUT")
+				appMessage.TimestampReturns(time.Unix(0, 0))
+				appMessage.SourceTypeReturns("RTR")
+				appMessage.SourceInstanceReturns("0")
+
+				Expect(ui.DisplayLogMessageJSON(appMessage)).To(Succeed())
+				Expect(string(out.Contents())).To(Equal(`{"app_name":"some-app","timestamp":"1970-01-01T00:00:00Z","source_type":"RTR","source_instance":"0","message_type":"OUT","message":"hello"}` + "\n"))
+			})
+		})
 	})
 })
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixWriter writes every line written to it to an underlying writer with a
+// prefix in front of it. It only writes whole lines, while holding a lock that
+// can be shared between writers, so that the output of writers sharing the
+// lock does not interleave within a line. Flush writes a final line that did
+// not end in a newline.
+type PrefixWriter struct {
+	out    io.Writer
+	prefix []byte
+	lock   sync.Locker
+
+	partial []byte
+}
+
+// NewPrefixWriter returns a PrefixWriter that writes to out, prefixing every
+// line with prefix, while holding lock.
+func NewPrefixWriter(out io.Writer, prefix string, lock sync.Locker) *PrefixWriter {
+	return &PrefixWriter{
+		out:    out,
+		prefix: []byte(prefix),
+		lock:   lock,
+	}
+}
+
+func (writer *PrefixWriter) Write(p []byte) (int, error) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	writer.partial = append(writer.partial, p...)
+
+	end := bytes.LastIndexByte(writer.partial, '\n') + 1
+	if end == 0 {
+		return len(p), nil
+	}
+
+	err := writer.writeLines(writer.partial[:end])
+	writer.partial = append([]byte(nil), writer.partial[end:]...)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes any final line that did not end in a newline.
+func (writer *PrefixWriter) Flush() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	if len(writer.partial) == 0 {
+		return nil
+	}
+
+	err := writer.writeLines(append(writer.partial, '\n'))
+	writer.partial = nil
+	return err
+}
+
+func (writer *PrefixWriter) writeLines(lines []byte) error {
+	var prefixed bytes.Buffer
+	for _, line := range bytes.SplitAfter(lines, []byte("\n")) {
+		if len(line) > 0 {
+			prefixed.Write(writer.prefix)
+			prefixed.Write(line)
+		}
+	}
+
+	_, err := writer.out.Write(prefixed.Bytes())
+	return err
+}
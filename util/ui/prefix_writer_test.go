@@ -0,0 +1,97 @@
+package ui_test
+
+import (
+	"errors"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write-error")
+}
+
+var _ = Describe("PrefixWriter", func() {
+	var (
+		out    *Buffer
+		lock   *sync.Mutex
+		writer *PrefixWriter
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		lock = new(sync.Mutex)
+		writer = NewPrefixWriter(out, "[some-prefix] ", lock)
+	})
+
+	It("prefixes every line", func() {
+		n, err := writer.Write([]byte("first line\nsecond line\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(23))
+
+		Expect(string(out.Contents())).To(Equal("[some-prefix] first line\n[some-prefix] second line\n"))
+	})
+
+	It("does not write partial lines until they are finished", func() {
+		_, err := writer.Write([]byte("partial"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Contents()).To(BeEmpty())
+
+		_, err = writer.Write([]byte(" line\nnext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out.Contents())).To(Equal("[some-prefix] partial line\n"))
+	})
+
+	It("writes lines while holding the lock", func() {
+		lock.Lock()
+		written := make(chan struct{})
+		go func() {
+			defer close(written)
+			_, _ = writer.Write([]byte("some line\n"))
+		}()
+
+		Consistently(written).ShouldNot(BeClosed())
+		lock.Unlock()
+		Eventually(written).Should(BeClosed())
+		Expect(string(out.Contents())).To(Equal("[some-prefix] some line\n"))
+	})
+
+	Describe("Flush", func() {
+		It("writes the final partial line with a newline", func() {
+			_, err := writer.Write([]byte("done\nno newline"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(writer.Flush()).To(Succeed())
+			Expect(string(out.Contents())).To(Equal("[some-prefix] done\n[some-prefix] no newline\n"))
+		})
+
+		It("does nothing when there is no partial line", func() {
+			_, err := writer.Write([]byte("done\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(writer.Flush()).To(Succeed())
+			Expect(writer.Flush()).To(Succeed())
+			Expect(string(out.Contents())).To(Equal("[some-prefix] done\n"))
+		})
+	})
+
+	When("the underlying writer fails", func() {
+		BeforeEach(func() {
+			writer = NewPrefixWriter(failingWriter{}, "[some-prefix] ", lock)
+		})
+
+		It("returns the error", func() {
+			_, err := writer.Write([]byte("some line\n"))
+			Expect(err).To(MatchError("write-error"))
+
+			_, err = writer.Write([]byte("partial"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writer.Flush()).To(MatchError("write-error"))
+		})
+	})
+})
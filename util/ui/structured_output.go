@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"gopkg.in/yaml.v2"
+)
+
+// DisplayStructuredOutput marshals data into the provided format and outputs
+// the resulting document to ui.Out. The data is not translated.
+func (ui *UI) DisplayStructuredOutput(format configv3.OutputFormat, data interface{}) error {
+	var (
+		document []byte
+		err      error
+	)
+
+	switch format {
+	case configv3.OutputFormatJSON:
+		document, err = json.MarshalIndent(data, "", "  ")
+		document = append(document, '\n')
+	case configv3.OutputFormatYAML:
+		document, err = yaml.Marshal(data)
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(document)
+	return err
+}
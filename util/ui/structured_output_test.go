@@ -0,0 +1,92 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayStructuredOutput", func() {
+	type document struct {
+		Name  string   `json:"name" yaml:"name"`
+		Items []string `json:"items" yaml:"items"`
+	}
+
+	var (
+		ui         *UI
+		out        *Buffer
+		format     configv3.OutputFormat
+		data       interface{}
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+		data = []document{{Name: "some-name", Items: []string{"a", "b"}}}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = ui.DisplayStructuredOutput(format, data)
+	})
+
+	When("the format is json", func() {
+		BeforeEach(func() {
+			format = configv3.OutputFormatJSON
+		})
+
+		It("displays the data as indented json", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(Equal(`[
+  {
+    "name": "some-name",
+    "items": [
+      "a",
+      "b"
+    ]
+  }
+]
+`))
+		})
+	})
+
+	When("the format is yaml", func() {
+		BeforeEach(func() {
+			format = configv3.OutputFormatYAML
+		})
+
+		It("displays the data as yaml", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(Equal(`- name: some-name
+  items:
+  - a
+  - b
+`))
+		})
+	})
+
+	When("the format is text", func() {
+		BeforeEach(func() {
+			format = configv3.OutputFormatText
+		})
+
+		It("returns an error and displays nothing", func() {
+			Expect(executeErr).To(MatchError(`unsupported output format ""`))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+
+	When("the data cannot be marshalled", func() {
+		BeforeEach(func() {
+			format = configv3.OutputFormatJSON
+			data = make(chan int)
+		})
+
+		It("returns the error and displays nothing", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+})
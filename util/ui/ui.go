@@ -94,6 +94,8 @@ type UI struct {
 	TimezoneLocation *time.Location
 
 	deferred []string
+
+	appColors map[string]*color.Color
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -3,9 +3,11 @@ package ui
 import (
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/types"
 
@@ -14,6 +16,10 @@ import (
 
 var ErrValueMissmatch = errors.New("values provided were of different types")
 
+// prefixedOutputLock keeps lines written by UIs returned from WithAppPrefix
+// from interleaving with each other.
+var prefixedOutputLock = &sync.Mutex{}
+
 type Change struct {
 	Header       string
 	CurrentValue interface{}
@@ -93,6 +99,32 @@ func (ui *UI) DisplayChangesForPush(changeSet []Change) error {
 	return nil
 }
 
+// WithAppPrefix returns a copy of the UI that prefixes every line written to
+// Out and Err with the app name, so that the events and staging logs of apps
+// pushed in parallel stay readable. Flush must be called on the copy once it
+// is no longer used, to write a final line that did not end in a newline.
+func (ui *UI) WithAppPrefix(appName string) Interface {
+	prefix := ui.modifyColor(fmt.Sprintf("[%s]", appName), color.New(color.FgCyan, color.Bold)) + " "
+
+	prefixedUI := *ui
+	prefixedUI.Out = NewPrefixWriter(ui.Out, prefix, prefixedOutputLock)
+	prefixedUI.OutForInteration = NewPrefixWriter(ui.OutForInteration, prefix, prefixedOutputLock)
+	prefixedUI.Err = NewPrefixWriter(ui.Err, prefix, prefixedOutputLock)
+	prefixedUI.deferred = nil
+	return &prefixedUI
+}
+
+// Flush writes any final line that a UI returned from WithAppPrefix is still
+// holding back because it did not end in a newline. It does nothing for
+// other UIs.
+func (ui *UI) Flush() {
+	for _, writer := range []io.Writer{ui.Out, ui.OutForInteration, ui.Err} {
+		if prefixWriter, ok := writer.(*PrefixWriter); ok {
+			_ = prefixWriter.Flush()
+		}
+	}
+}
+
 func (ui UI) displayDiffForInt(offset string, header string, oldValue int, newValue int) {
 	if oldValue != newValue {
 		formattedOld := fmt.Sprintf("- %s%s%d", ui.TranslateText(header), offset, oldValue)
@@ -390,4 +390,54 @@ var _ = Describe("UI", func() {
 			})
 		})
 	})
+
+	Describe("WithAppPrefix", func() {
+		var (
+			prefixedUI *UI
+			errBuffer  *Buffer
+		)
+
+		JustBeforeEach(func() {
+			errBuffer = NewBuffer()
+			ui.Err = errBuffer
+			prefixedUI = ui.WithAppPrefix("some-app").(*UI)
+		})
+
+		It("prefixes every line written to out with the app name", func() {
+			prefixedUI.DisplayText("first line\nsecond line")
+			prefixedUI.DisplayNewline()
+
+			Expect(out).To(Say("\x1b\\[36;1m\\[some-app\\]\x1b\\[0m first line\n"))
+			Expect(out).To(Say("\x1b\\[36;1m\\[some-app\\]\x1b\\[0m second line\n"))
+			Expect(out).To(Say("\x1b\\[36;1m\\[some-app\\]\x1b\\[0m \n"))
+		})
+
+		It("prefixes every line written to err with the app name", func() {
+			prefixedUI.DisplayWarning("some-warning")
+
+			Expect(errBuffer).To(Say("\\[some-app\\]\x1b\\[0m some-warning\n"))
+		})
+
+		It("does not write partial lines", func() {
+			_, err := prefixedUI.Out.Write([]byte("partial"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		It("writes a final partial line when flushed", func() {
+			_, err := prefixedUI.Out.Write([]byte("partial"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = prefixedUI.Err.Write([]byte("partial error"))
+			Expect(err).ToNot(HaveOccurred())
+
+			prefixedUI.Flush()
+			Expect(out).To(Say("\x1b\\[36;1m\\[some-app\\]\x1b\\[0m partial\n"))
+			Expect(errBuffer).To(Say("\\[some-app\\]\x1b\\[0m partial error\n"))
+		})
+
+		It("does not change the original UI", func() {
+			ui.DisplayText("unprefixed")
+			Expect(out).To(Say("^unprefixed\n"))
+		})
+	})
 })